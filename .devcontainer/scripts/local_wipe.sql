@@ -1,5 +1,5 @@
 BEGIN;
 
-TRUNCATE incidents, timeline_events;
+TRUNCATE incidents, timeline_events, incident_roles, incident_notifications, incident_subscribers, job_runs, oncall_schedules, oncall_overrides, job_queue, incident_escalations, oncall_handoff_notes, slack_installations, services, incident_services, incident_checklists, incident_checklist_items, alert_incidents, idempotency_keys;
 
 COMMIT;
\ No newline at end of file
@@ -2,10 +2,40 @@
 package db
 
 import (
+	"context"
+	"database/sql"
 	"embed"
+	"fmt"
+	"io/fs"
+
+	"github.com/pressly/goose/v3"
 )
 
 // Migrations contain an embedded filesystem with all the sql migration files
 //
 //go:embed migrations/*.sql
 var Migrations embed.FS
+
+// CheckVersion returns an error unless the database has every embedded migration applied
+func CheckVersion(ctx context.Context, db *sql.DB) error {
+	migrations, err := fs.Sub(Migrations, "migrations")
+	if err != nil {
+		return err
+	}
+
+	provider, err := goose.NewProvider(goose.DialectPostgres, db, migrations)
+	if err != nil {
+		return err
+	}
+
+	current, target, err := provider.GetVersions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if current < target {
+		return fmt.Errorf("database is at migration version %d, want %d", current, target)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE incidents DROP CONSTRAINT incidents_severity_check;
+ALTER TABLE incidents ADD CONSTRAINT incidents_severity_check CHECK (severity IN ('sev0', 'sev1', 'sev2', 'sev3'));
+ALTER TABLE incidents ADD COLUMN slack_channel_name VARCHAR;
+ALTER TABLE incidents ADD COLUMN started_by_user_id VARCHAR;
+ALTER TABLE incidents ALTER COLUMN started_at SET NOT NULL;
+ALTER TABLE incidents ALTER COLUMN last_updated SET NOT NULL;
+
+CREATE INDEX incidents_started_at_idx ON incidents (started_at);
+CREATE INDEX incidents_slack_channel_id_idx ON incidents (slack_channel_id);
+
+-- The bot records more kinds of timeline entries than the original list, so
+-- event types are no longer constrained by the database.
+ALTER TABLE timeline_events DROP CONSTRAINT timeline_events_event_type_check;
+ALTER TABLE timeline_events ADD COLUMN entry_key VARCHAR NOT NULL DEFAULT gen_random_uuid()::text;
+ALTER TABLE timeline_events ADD COLUMN slack_username VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE timeline_events ADD COLUMN content TEXT NOT NULL DEFAULT '';
+ALTER TABLE timeline_events ALTER COLUMN timestamp SET NOT NULL;
+ALTER TABLE timeline_events ALTER COLUMN metadata SET NOT NULL;
+
+CREATE UNIQUE INDEX timeline_events_incident_id_entry_key_idx ON timeline_events (incident_id, entry_key);
+
+CREATE TABLE incident_roles (
+    incident_id UUID NOT NULL REFERENCES incidents(id) ON DELETE CASCADE,
+    role VARCHAR(50) NOT NULL,
+    slack_user_id VARCHAR NOT NULL,
+    assigned_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (incident_id, role)
+);
+
+CREATE INDEX incident_roles_slack_user_id_idx ON incident_roles (slack_user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE incident_roles;
+
+DROP INDEX timeline_events_incident_id_entry_key_idx;
+ALTER TABLE timeline_events ALTER COLUMN metadata DROP NOT NULL;
+ALTER TABLE timeline_events ALTER COLUMN timestamp DROP NOT NULL;
+ALTER TABLE timeline_events DROP COLUMN content;
+ALTER TABLE timeline_events DROP COLUMN slack_username;
+ALTER TABLE timeline_events DROP COLUMN entry_key;
+ALTER TABLE timeline_events ADD CONSTRAINT timeline_events_event_type_check CHECK (
+    event_type IN (
+        'incident_started',
+        'severity_change',
+        'message_reaction',
+        'file_upload',
+        'resolved',
+        'custom'
+    )
+);
+
+DROP INDEX incidents_slack_channel_id_idx;
+DROP INDEX incidents_started_at_idx;
+ALTER TABLE incidents ALTER COLUMN last_updated DROP NOT NULL;
+ALTER TABLE incidents ALTER COLUMN started_at DROP NOT NULL;
+ALTER TABLE incidents DROP COLUMN started_by_user_id;
+ALTER TABLE incidents DROP COLUMN slack_channel_name;
+ALTER TABLE incidents DROP CONSTRAINT incidents_severity_check;
+ALTER TABLE incidents ADD CONSTRAINT incidents_severity_check CHECK (severity IN ('sev1', 'sev2', 'sev3'));
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Every run of a scheduled job is recorded so that replicas firing the same
+-- schedule don't repeat a run that another replica already completed.
+CREATE TABLE job_runs (
+    job VARCHAR(100) NOT NULL,
+    scheduled_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    finished_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (job, scheduled_at)
+);
+
+CREATE INDEX incidents_resolved_at_idx ON incidents (resolved_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX incidents_resolved_at_idx;
+DROP TABLE job_runs;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- When the next status update of an open incident is due; NULL when no
+-- update cadence applies to the incident.
+ALTER TABLE incidents ADD COLUMN next_update_due TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX incidents_next_update_due_idx ON incidents (next_update_due) WHERE status = 'open';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX incidents_next_update_due_idx;
+ALTER TABLE incidents DROP COLUMN next_update_due;
+-- +goose StatementEnd
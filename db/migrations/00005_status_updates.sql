@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The stage of an open incident as given by its latest status update
+ALTER TABLE incidents ADD COLUMN sub_status VARCHAR(20)
+    CHECK (sub_status IN ('investigating', 'identified', 'monitoring', 'resolved'));
+
+-- Messages announcing an incident, so that updates can be threaded under them
+CREATE TABLE incident_notifications (
+    incident_id UUID NOT NULL REFERENCES incidents(id) ON DELETE CASCADE,
+    slack_channel_id VARCHAR NOT NULL,
+    slack_message_ts VARCHAR NOT NULL,
+    posted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (incident_id, slack_channel_id)
+);
+
+-- People who receive status updates of an incident by direct message
+CREATE TABLE incident_subscribers (
+    incident_id UUID NOT NULL REFERENCES incidents(id) ON DELETE CASCADE,
+    slack_user_id VARCHAR NOT NULL,
+    subscribed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (incident_id, slack_user_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE incident_subscribers;
+DROP TABLE incident_notifications;
+ALTER TABLE incidents DROP COLUMN sub_status;
+-- +goose StatementEnd
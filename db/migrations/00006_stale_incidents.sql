@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- When the channel of an open incident was last prompted for going stale, and
+-- until when the prompts are snoozed.
+ALTER TABLE incidents ADD COLUMN stale_prompted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE incidents ADD COLUMN stale_snoozed_until TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX incidents_last_updated_idx ON incidents (last_updated) WHERE status = 'open';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX incidents_last_updated_idx;
+ALTER TABLE incidents DROP COLUMN stale_snoozed_until;
+ALTER TABLE incidents DROP COLUMN stale_prompted_at;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Rotations of participants, in order. Shifts hand off at handoff_time in the
+-- schedule's timezone, and the first participant's first shift starts on start_date.
+CREATE TABLE oncall_schedules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(80) NOT NULL UNIQUE,
+    rotation VARCHAR(20) NOT NULL CHECK (rotation IN ('daily', 'weekly')),
+    participants TEXT[] NOT NULL,
+    handoff_time VARCHAR(5) NOT NULL,
+    timezone VARCHAR NOT NULL,
+    start_date DATE NOT NULL,
+    created_by VARCHAR NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Periods in which someone is on call instead of the rotation
+CREATE TABLE oncall_overrides (
+    id BIGSERIAL PRIMARY KEY,
+    schedule_id UUID NOT NULL REFERENCES oncall_schedules(id) ON DELETE CASCADE,
+    slack_user_id VARCHAR NOT NULL,
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ends_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_by VARCHAR NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX oncall_overrides_schedule_id_ends_at_idx ON oncall_overrides (schedule_id, ends_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE oncall_overrides;
+DROP TABLE oncall_schedules;
+-- +goose StatementEnd
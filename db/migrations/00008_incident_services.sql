@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The services affected by an incident, given with --service when it is declared. They don't
+-- have to be in the service catalog.
+CREATE TABLE incident_services (
+    incident_id UUID NOT NULL REFERENCES incidents(id) ON DELETE CASCADE,
+    service VARCHAR(80) NOT NULL,
+    added_by VARCHAR,
+    added_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (incident_id, service)
+);
+
+CREATE INDEX incident_services_service_idx ON incident_services (service);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE incident_services;
+-- +goose StatementEnd
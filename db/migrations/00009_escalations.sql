@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Durable background jobs. Workers on any replica pick due jobs with
+-- FOR UPDATE SKIP LOCKED, so jobs survive restarts and run once.
+CREATE TABLE job_queue (
+    id BIGSERIAL PRIMARY KEY,
+    kind VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}',
+    run_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX job_queue_run_at_idx ON job_queue (run_at) WHERE finished_at IS NULL;
+
+-- The escalation of an incident through the levels of its escalation policy
+CREATE TABLE incident_escalations (
+    incident_id UUID PRIMARY KEY REFERENCES incidents(id) ON DELETE CASCADE,
+    policy VARCHAR(80) NOT NULL,
+    level INTEGER NOT NULL DEFAULT 0,
+    escalated_at TIMESTAMP WITH TIME ZONE,
+    acknowledged_by VARCHAR,
+    acknowledged_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE incident_escalations;
+DROP TABLE job_queue;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Notes for the next person on call, entered with /shift oncall note and
+-- included in the summary posted at the next handoff of the rotation
+CREATE TABLE oncall_handoff_notes (
+    id BIGSERIAL PRIMARY KEY,
+    rotation VARCHAR(80) NOT NULL,
+    slack_user_id VARCHAR NOT NULL,
+    note TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX oncall_handoff_notes_rotation_idx ON oncall_handoff_notes (rotation, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE oncall_handoff_notes;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Workspaces the bot was installed in through the OAuth flow, with their bot tokens.
+-- Org-wide Enterprise Grid installs are keyed by the enterprise ID.
+CREATE TABLE slack_installations (
+    id VARCHAR(20) PRIMARY KEY,
+    team_id VARCHAR(20),
+    team_name VARCHAR,
+    enterprise_id VARCHAR(20),
+    enterprise_name VARCHAR,
+    is_enterprise_install BOOLEAN NOT NULL DEFAULT FALSE,
+    bot_token VARCHAR NOT NULL,
+    bot_user_id VARCHAR(20) NOT NULL,
+    installed_by VARCHAR(20),
+    installed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- The workspace an incident was declared in; NULL for incidents declared before
+-- multi-workspace support, which belong to the workspace of SLACK_BOT_TOKEN
+ALTER TABLE incidents ADD COLUMN team_id VARCHAR(20);
+ALTER TABLE incidents ADD COLUMN enterprise_id VARCHAR(20);
+
+CREATE INDEX incidents_team_id_idx ON incidents (team_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX incidents_team_id_idx;
+ALTER TABLE incidents DROP COLUMN enterprise_id;
+ALTER TABLE incidents DROP COLUMN team_id;
+DROP TABLE slack_installations;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The team an incident is tagged with, given with --team when it is declared
+ALTER TABLE incidents ADD COLUMN team VARCHAR(80);
+
+CREATE INDEX incidents_team_idx ON incidents (team);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX incidents_team_idx;
+ALTER TABLE incidents DROP COLUMN team;
+-- +goose StatementEnd
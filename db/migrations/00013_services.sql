@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The service catalog, managed with "ohshift services"
+CREATE TABLE services (
+    name VARCHAR(80) PRIMARY KEY,
+    owner_team VARCHAR(80),
+    oncall_usergroup VARCHAR(20),
+    runbook_url TEXT,
+    slack_channel VARCHAR(80),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE services;
+-- +goose StatementEnd
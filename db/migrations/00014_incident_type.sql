@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The incident type given with --type when an incident is declared
+ALTER TABLE incidents ADD COLUMN incident_type VARCHAR(80);
+
+CREATE INDEX incidents_incident_type_idx ON incidents (incident_type);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX incidents_incident_type_idx;
+ALTER TABLE incidents DROP COLUMN incident_type;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The checklist message posted in an incident channel
+CREATE TABLE incident_checklists (
+    incident_id UUID PRIMARY KEY REFERENCES incidents(id) ON DELETE CASCADE,
+    slack_channel_id VARCHAR NOT NULL,
+    slack_message_ts VARCHAR,
+    -- When unfinished required items are flagged in the channel next
+    next_reminder TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX incident_checklists_next_reminder_idx ON incident_checklists (next_reminder);
+
+-- The items of a checklist, in the order they are shown
+CREATE TABLE incident_checklist_items (
+    incident_id UUID NOT NULL REFERENCES incident_checklists(incident_id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    text TEXT NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    completed_by VARCHAR,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (incident_id, position)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE incident_checklist_items;
+DROP TABLE incident_checklists;
+-- +goose StatementEnd
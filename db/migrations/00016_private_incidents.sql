@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Private incidents are declared in private channels and kept out of public notifications and exports
+ALTER TABLE incidents ADD COLUMN private BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE incidents DROP COLUMN private;
+-- +goose StatementEnd
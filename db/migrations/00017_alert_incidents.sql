@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The incident declared for each Alertmanager alert fingerprint. A row without an incident is
+-- claimed by the replica declaring it.
+CREATE TABLE alert_incidents (
+    fingerprint VARCHAR(64) PRIMARY KEY,
+    incident_id UUID REFERENCES incidents(id) ON DELETE CASCADE,
+    claimed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX alert_incidents_incident_id_idx ON alert_incidents (incident_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE alert_incidents;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- When someone other than the person who declared an incident first posted or reacted in its channel
+ALTER TABLE incidents ADD COLUMN first_response_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE incidents DROP COLUMN first_response_at;
+-- +goose StatementEnd
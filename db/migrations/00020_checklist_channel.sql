@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Checklist checkboxes are looked up by the channel they were clicked in
+CREATE INDEX incident_checklists_slack_channel_id_idx ON incident_checklists (slack_channel_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX incident_checklists_slack_channel_id_idx;
+-- +goose StatementEnd
@@ -0,0 +1,332 @@
+// Package alertmanager receives Prometheus Alertmanager webhooks and turns alerts into incidents.
+package alertmanager
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/logger"
+	"github.com/fishnix/ohshift/internal/store"
+	"github.com/fishnix/ohshift/internal/timeline"
+)
+
+const (
+	// StatusFiring is the status of an alert that is currently firing
+	StatusFiring = "firing"
+	// StatusResolved is the status of an alert that has stopped firing
+	StatusResolved = "resolved"
+
+	// Username is shown as the starter of incidents declared from alerts
+	Username = "alertmanager"
+
+	defaultTitle = `{{ .Labels.alertname }}{{ with .Annotations.summary }}: {{ . }}{{ end }}`
+
+	maxPayloadBytes = 1 << 20
+
+	// claimTimeout is how long a replica may take to declare the incident for an alert before
+	// another one may try
+	claimTimeout = 5 * time.Minute
+)
+
+// ErrNoMatchingRule is returned when none of the configured rules match an alert
+var ErrNoMatchingRule = errors.New("no rule matches alert")
+
+// DefaultRules are used when no rules are configured
+var DefaultRules = []config.AlertRule{
+	{Match: map[string]string{"severity": "critical"}, Severity: string(incident.Severity1)},
+	{Match: map[string]string{"severity": "warning"}, Severity: string(incident.Severity2)},
+	{Severity: string(incident.Severity3)},
+}
+
+// Message is the payload Alertmanager posts to webhook receivers
+type Message struct {
+	Version           string            `json:"version"`
+	GroupKey          string            `json:"groupKey"`
+	Status            string            `json:"status"`
+	Receiver          string            `json:"receiver"`
+	GroupLabels       map[string]string `json:"groupLabels"`
+	CommonLabels      map[string]string `json:"commonLabels"`
+	CommonAnnotations map[string]string `json:"commonAnnotations"`
+	ExternalURL       string            `json:"externalURL"`
+	Alerts            []Alert           `json:"alerts"`
+}
+
+// Alert is a single alert within a webhook payload
+type Alert struct {
+	Status       string            `json:"status"`
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL"`
+	Fingerprint  string            `json:"fingerprint"`
+}
+
+// Declarer declares new incidents
+type Declarer interface {
+	DeclareIncident(cmd *incident.Command) (*incident.Incident, error)
+}
+
+// rule is a compiled config.AlertRule
+type rule struct {
+	match    map[string]string
+	severity incident.Severity
+	title    *template.Template
+}
+
+// Receiver handles Alertmanager webhooks. The incident declared for each alert fingerprint is
+// kept in the database, so alerts still firing after a restart or on another replica are added
+// to the same incident until it is resolved.
+type Receiver struct {
+	declarer Declarer
+	timeline *timeline.Manager
+	store    *store.Store
+	rules    []rule
+	logger   *slog.Logger
+	now      func() time.Time
+}
+
+// NewReceiver creates a new Alertmanager webhook receiver
+func NewReceiver(cfg *config.Config, declarer Declarer, timelineMgr *timeline.Manager, st *store.Store) (*Receiver, error) {
+	rules, err := compileRules(cfg.AlertmanagerRules)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Receiver{
+		declarer: declarer,
+		timeline: timelineMgr,
+		store:    st,
+		rules:    rules,
+		logger:   logger.With("component", "alertmanager_receiver"),
+		now:      time.Now,
+	}, nil
+}
+
+// compileRules validates the configured rules and parses their title templates
+func compileRules(configured []config.AlertRule) ([]rule, error) {
+	if len(configured) == 0 {
+		configured = DefaultRules
+	}
+
+	rules := make([]rule, 0, len(configured))
+
+	for i, r := range configured {
+		severity, err := incident.ParseSeverity(r.Severity)
+		if err != nil {
+			return nil, fmt.Errorf("alertmanager rule %d: %w", i, err)
+		}
+
+		title := r.Title
+		if title == "" {
+			title = defaultTitle
+		}
+
+		tmpl, err := template.New(fmt.Sprintf("rule_%d", i)).Option("missingkey=zero").Parse(title)
+		if err != nil {
+			return nil, fmt.Errorf("alertmanager rule %d: invalid title template: %w", i, err)
+		}
+
+		rules = append(rules, rule{match: r.Match, severity: severity, title: tmpl})
+	}
+
+	return rules, nil
+}
+
+// ServeHTTP handles a webhook request from Alertmanager
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	var msg Message
+	if err := json.NewDecoder(http.MaxBytesReader(w, req.Body, maxPayloadBytes)).Decode(&msg); err != nil {
+		r.logger.Warn("Failed to decode Alertmanager payload", "error", err)
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+
+		return
+	}
+
+	r.logger.Info("Received Alertmanager webhook",
+		"receiver", msg.Receiver,
+		"status", msg.Status,
+		"group_key", msg.GroupKey,
+		"alerts_count", len(msg.Alerts))
+
+	if err := r.Process(&msg); err != nil {
+		r.logger.Error("Failed to process Alertmanager webhook", "error", err, "group_key", msg.GroupKey)
+		// A server error makes Alertmanager retry the notification
+		http.Error(w, "failed to process alerts", http.StatusInternalServerError)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Process declares incidents for new firing alerts and records every alert on its incident's timeline
+func (r *Receiver) Process(msg *Message) error {
+	var errs []error
+
+	for i := range msg.Alerts {
+		if err := r.processAlert(&msg.Alerts[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%d of %d alerts failed, first error: %w", len(errs), len(msg.Alerts), errs[0])
+	}
+
+	return nil
+}
+
+// processAlert handles a single alert
+func (r *Receiver) processAlert(alert *Alert) error {
+	alertName := alert.Labels["alertname"]
+
+	incidentID, err := r.alertIncident(alert.Fingerprint)
+	if err != nil {
+		return err
+	}
+
+	if incidentID == "" {
+		if alert.Status != StatusFiring {
+			r.logger.Debug("Skipping resolved alert without an incident",
+				"alert_name", alertName,
+				"fingerprint", alert.Fingerprint)
+
+			return nil
+		}
+
+		cmd, err := r.Command(alert)
+		if errors.Is(err, ErrNoMatchingRule) {
+			r.logger.Info("Ignoring alert that matches no rule",
+				"alert_name", alertName,
+				"fingerprint", alert.Fingerprint)
+
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if incidentID, err = r.declare(alert, cmd); err != nil {
+			return err
+		}
+
+		r.logger.Info("Incident declared from alert",
+			"incident_id", incidentID,
+			"alert_name", alertName,
+			"fingerprint", alert.Fingerprint,
+			"severity", cmd.Severity)
+	}
+
+	err = r.timeline.AddAlertEntry(incidentID, alert.Status, alertName,
+		alert.Annotations["summary"], alert.Fingerprint, alert.StartsAt)
+	if err != nil {
+		return fmt.Errorf("failed to add alert %s to timeline: %w", alertName, err)
+	}
+
+	return nil
+}
+
+// alertIncident returns the open incident declared for an alert fingerprint, empty when there is none
+func (r *Receiver) alertIncident(fingerprint string) (string, error) {
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	incidentID, err := r.store.AlertIncident(ctx, fingerprint)
+	if errors.Is(err, store.ErrNotFound) {
+		return "", nil
+	}
+
+	return incidentID, err
+}
+
+// declare declares the incident for a firing alert. The fingerprint is claimed first, so that
+// concurrent webhooks and replicas don't declare it twice.
+func (r *Receiver) declare(alert *Alert, cmd *incident.Command) (string, error) {
+	alertName := alert.Labels["alertname"]
+	now := r.now()
+
+	ctx, cancel := store.Context()
+	claimed, err := r.store.ClaimAlert(ctx, alert.Fingerprint, now, now.Add(-claimTimeout))
+
+	cancel()
+
+	if err != nil {
+		return "", err
+	}
+
+	if !claimed {
+		// Failing makes Alertmanager retry once the incident has been declared
+		return "", fmt.Errorf("incident for alert %s is being declared elsewhere", alertName)
+	}
+
+	inc, err := r.declarer.DeclareIncident(cmd)
+	if err != nil {
+		ctx, cancel := store.Context()
+		defer cancel()
+
+		if releaseErr := r.store.ReleaseAlert(ctx, alert.Fingerprint); releaseErr != nil {
+			r.logger.Warn("Failed to release alert", "error", releaseErr, "fingerprint", alert.Fingerprint)
+		}
+
+		return "", fmt.Errorf("failed to declare incident for alert %s: %w", alertName, err)
+	}
+
+	ctx, cancel = store.Context()
+	defer cancel()
+
+	if err := r.store.SetAlertIncident(ctx, alert.Fingerprint, inc.ID); err != nil {
+		return "", fmt.Errorf("failed to record incident for alert %s: %w", alertName, err)
+	}
+
+	return inc.ID, nil
+}
+
+// Command builds the incident command for an alert using the first matching rule
+func (r *Receiver) Command(alert *Alert) (*incident.Command, error) {
+	for _, rl := range r.rules {
+		if !rl.matches(alert.Labels) {
+			continue
+		}
+
+		var title strings.Builder
+		if err := rl.title.Execute(&title, alert); err != nil {
+			return nil, fmt.Errorf("failed to render title for alert %s: %w", alert.Labels["alertname"], err)
+		}
+
+		titleText := strings.TrimSpace(title.String())
+		if titleText == "" {
+			titleText = alert.Labels["alertname"]
+		}
+
+		return &incident.Command{
+			Action:      "start",
+			Severity:    rl.severity,
+			Title:       titleText,
+			Description: alert.Annotations["description"],
+			Username:    Username,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w %s", ErrNoMatchingRule, alert.Labels["alertname"])
+}
+
+// matches reports whether all of the rule's label matchers are present on labels
+func (rl *rule) matches(labels map[string]string) bool {
+	for name, value := range rl.match {
+		if labels[name] != value {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,127 @@
+package alertmanager
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/incident"
+)
+
+func TestNewReceiverInvalidRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules []config.AlertRule
+	}{
+		{
+			name:  "invalid severity",
+			rules: []config.AlertRule{{Severity: "SEV9"}},
+		},
+		{
+			name:  "invalid title template",
+			rules: []config.AlertRule{{Severity: "SEV1", Title: "{{ .Labels.alertname"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewReceiver(&config.Config{AlertmanagerRules: tt.rules}, nil, nil, nil)
+			if err == nil {
+				t.Errorf("NewReceiver() expected error for rules %+v", tt.rules)
+			}
+		})
+	}
+}
+
+func TestReceiverCommand(t *testing.T) {
+	rules := []config.AlertRule{
+		{
+			Match:    map[string]string{"team": "payments", "severity": "critical"},
+			Severity: "sev0",
+			Title:    "Payments: {{ .Annotations.summary }}",
+		},
+		{
+			Match:    map[string]string{"severity": "critical"},
+			Severity: "SEV1",
+		},
+		{
+			Severity: "SEV3",
+		},
+	}
+
+	receiver, err := NewReceiver(&config.Config{AlertmanagerRules: rules}, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("NewReceiver() error = %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		alert        Alert
+		wantSeverity incident.Severity
+		wantTitle    string
+	}{
+		{
+			name: "most specific rule first",
+			alert: Alert{
+				Labels:      map[string]string{"alertname": "CheckoutErrors", "team": "payments", "severity": "critical"},
+				Annotations: map[string]string{"summary": "error rate above 5%"},
+			},
+			wantSeverity: incident.Severity0,
+			wantTitle:    "Payments: error rate above 5%",
+		},
+		{
+			name: "default title with summary",
+			alert: Alert{
+				Labels:      map[string]string{"alertname": "HighLatency", "severity": "critical"},
+				Annotations: map[string]string{"summary": "p99 above 2s"},
+			},
+			wantSeverity: incident.Severity1,
+			wantTitle:    "HighLatency: p99 above 2s",
+		},
+		{
+			name: "catch-all rule without summary",
+			alert: Alert{
+				Labels: map[string]string{"alertname": "DiskFilling", "severity": "info"},
+			},
+			wantSeverity: incident.Severity3,
+			wantTitle:    "DiskFilling",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := receiver.Command(&tt.alert)
+			if err != nil {
+				t.Fatalf("Command() error = %v", err)
+			}
+
+			if got.Severity != tt.wantSeverity {
+				t.Errorf("Command() Severity = %v, want %v", got.Severity, tt.wantSeverity)
+			}
+
+			if got.Title != tt.wantTitle {
+				t.Errorf("Command() Title = %v, want %v", got.Title, tt.wantTitle)
+			}
+
+			if got.Username != Username {
+				t.Errorf("Command() Username = %v, want %v", got.Username, Username)
+			}
+		})
+	}
+}
+
+func TestReceiverCommandNoMatch(t *testing.T) {
+	rules := []config.AlertRule{
+		{Match: map[string]string{"severity": "critical"}, Severity: "SEV1"},
+	}
+
+	receiver, err := NewReceiver(&config.Config{AlertmanagerRules: rules}, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("NewReceiver() error = %v", err)
+	}
+
+	alert := &Alert{Labels: map[string]string{"alertname": "Watchdog", "severity": "none"}}
+	if _, err := receiver.Command(alert); !errors.Is(err, ErrNoMatchingRule) {
+		t.Errorf("Command() error = %v, want %v", err, ErrNoMatchingRule)
+	}
+}
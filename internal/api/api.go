@@ -0,0 +1,414 @@
+// Package api provides the token-authenticated JSON HTTP API of the OhShift! bot.
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/incidenttype"
+	"github.com/fishnix/ohshift/internal/logger"
+	"github.com/fishnix/ohshift/internal/server"
+	"github.com/fishnix/ohshift/internal/store"
+	"github.com/fishnix/ohshift/internal/timeline"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header carrying a client supplied idempotency key
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	// defaultSource is shown as the author of incidents and events that don't name one
+	defaultSource = "api"
+
+	maxBodyBytes = 64 << 10
+)
+
+// Declarer declares new incidents
+type Declarer interface {
+	DeclareIncident(cmd *incident.Command) (*incident.Incident, error)
+}
+
+// Handler serves the incident API
+type Handler struct {
+	config      *config.Config
+	declarer    Declarer
+	timeline    *timeline.Manager
+	store       *store.Store
+	idempotency *idempotencyKeys
+	logger      *slog.Logger
+}
+
+// NewHandler creates a new API handler
+func NewHandler(cfg *config.Config, declarer Declarer, timelineMgr *timeline.Manager, st *store.Store) *Handler {
+	return &Handler{
+		config:      cfg,
+		declarer:    declarer,
+		timeline:    timelineMgr,
+		store:       st,
+		idempotency: newIdempotencyKeys(st, idempotencyTTL),
+		logger:      logger.With("component", "api"),
+	}
+}
+
+// route is an authenticated API endpoint
+type route struct {
+	method  string
+	path    string
+	handler http.HandlerFunc
+}
+
+// routes returns the API endpoints; every one of them must be described in openapi.yaml
+func (h *Handler) routes() []route {
+	return []route{
+		{http.MethodGet, "/api/v1/incidents", h.ListIncidents},
+		{http.MethodPost, "/api/v1/incidents", h.CreateIncident},
+		{http.MethodGet, "/api/v1/incidents/{id}", h.GetIncident},
+		{http.MethodGet, "/api/v1/incidents/{id}/timeline", h.GetTimeline},
+		{http.MethodPost, "/api/v1/incidents/{id}/events", h.CreateEvent},
+	}
+}
+
+// Register registers the API routes on srv behind bearer token authentication
+func (h *Handler) Register(srv *server.Server) {
+	for _, rt := range h.routes() {
+		srv.Handle(rt.method+" "+rt.path, server.RequireToken(h.config.APITokens, rt.handler))
+	}
+
+	srv.HandleFunc("GET /api/v1/openapi.yaml", ServeOpenAPISpec)
+}
+
+// CreateIncidentRequest is the body of POST /api/v1/incidents
+type CreateIncidentRequest struct {
+	Severity    string `json:"severity"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	// StartedBy names the tool or person declaring the incident
+	StartedBy string `json:"started_by,omitempty"`
+	// SlackUserID is invited to the incident channel when set
+	SlackUserID string `json:"slack_user_id,omitempty"`
+	// Service is an affected service, kept for clients predating Services
+	Service string `json:"service,omitempty"`
+	// Services are the affected services, used to page their on-call responders
+	Services []string `json:"services,omitempty"`
+	// Team tags the incident with the team it belongs to
+	Team string `json:"team,omitempty"`
+	// Type is a configured incident type; its default severity is used when Severity is empty
+	Type string `json:"type,omitempty"`
+	// Private declares the incident in a private channel
+	Private bool `json:"private,omitempty"`
+}
+
+// IncidentResponse describes a declared incident
+type IncidentResponse struct {
+	ID          string    `json:"id"`
+	Severity    string    `json:"severity"`
+	Title       string    `json:"title"`
+	Description string    `json:"description,omitempty"`
+	ChannelID   string    `json:"channel_id"`
+	ChannelName string    `json:"channel_name"`
+	StartedBy   string    `json:"started_by"`
+	StartedAt   time.Time `json:"started_at"`
+}
+
+// CreateEventRequest is the body of POST /api/v1/incidents/{id}/events
+type CreateEventRequest struct {
+	Text string `json:"text"`
+	// Source names the tool or person the event comes from, e.g. "ci"
+	Source   string                 `json:"source,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// EventResponse describes a timeline entry added through the API
+type EventResponse struct {
+	ID         string    `json:"id"`
+	IncidentID string    `json:"incident_id"`
+	Type       string    `json:"type"`
+	Source     string    `json:"source"`
+	Text       string    `json:"text"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// errorResponse is the body of every error response
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// CreateIncident declares a new incident. Retries carrying the same Idempotency-Key
+// return the incident declared by the first request instead of declaring another one.
+func (h *Handler) CreateIncident(w http.ResponseWriter, r *http.Request) {
+	var req CreateIncidentRequest
+	if err := decodeBody(w, r, &req); err != nil {
+		h.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd, err := req.command()
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	key := r.Header.Get(IdempotencyKeyHeader)
+
+	resp, replayed, err := idempotent(h.idempotency, idempotencyKey(r, "incidents"), req, func() (*IncidentResponse, error) {
+		inc, err := h.declarer.DeclareIncident(cmd)
+		if err != nil {
+			return nil, err
+		}
+
+		return newIncidentResponse(inc), nil
+	})
+
+	// The incident is declared, so it is returned even though a retry may declare another one
+	if errors.Is(err, errIdempotencyResponseNotRemembered) {
+		h.logger.Error("Failed to remember declared incident", "error", err, "incident_id", resp.ID, "idempotency_key", key)
+		w.Header().Set("Warning", `199 ohshift "the idempotency key was not recorded, retries may declare another incident"`)
+
+		err = nil
+	}
+
+	switch {
+	case errors.Is(err, errIdempotencyKeyReused):
+		h.writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	case errors.Is(err, errIdempotencyKeyInProgress):
+		h.writeError(w, http.StatusConflict, err.Error())
+		return
+	case errors.Is(err, incidenttype.ErrUnknownType), errors.Is(err, incident.ErrNoSeverity):
+		h.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	case err != nil:
+		h.logger.Error("Failed to declare incident", "error", err, "title", cmd.Title, "idempotency_key", key)
+		h.writeError(w, http.StatusInternalServerError, "failed to declare incident")
+
+		return
+	}
+
+	if replayed {
+		h.logger.Info("Replaying idempotent incident creation", "incident_id", resp.ID, "idempotency_key", key)
+		w.Header().Set("Idempotent-Replayed", "true")
+		h.writeJSON(w, http.StatusOK, resp)
+
+		return
+	}
+
+	h.logger.Info("Incident declared through API",
+		"incident_id", resp.ID,
+		"severity", resp.Severity,
+		"started_by", resp.StartedBy,
+		"idempotency_key", key)
+
+	h.writeJSON(w, http.StatusCreated, resp)
+}
+
+// CreateEvent appends a custom entry to an incident's timeline. Retries carrying the same
+// Idempotency-Key return the entry added by the first request instead of adding another one.
+func (h *Handler) CreateEvent(w http.ResponseWriter, r *http.Request) {
+	incidentID := r.PathValue("id")
+
+	if _, exists := h.timeline.GetTimeline(incidentID); !exists {
+		h.writeError(w, http.StatusNotFound, fmt.Sprintf("incident not found: %s", incidentID))
+		return
+	}
+
+	var req CreateEventRequest
+	if err := decodeBody(w, r, &req); err != nil {
+		h.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	req.Text = strings.TrimSpace(req.Text)
+	if req.Text == "" {
+		h.writeError(w, http.StatusBadRequest, "text is required")
+		return
+	}
+
+	if req.Source == "" {
+		req.Source = defaultSource
+	}
+
+	key := r.Header.Get(IdempotencyKeyHeader)
+
+	resp, replayed, err := idempotent(h.idempotency, idempotencyKey(r, "incidents/"+incidentID+"/events"), req, func() (*EventResponse, error) {
+		return h.addEvent(incidentID, &req)
+	})
+
+	// The entry is added, so it is returned even though a retry may add it again
+	if errors.Is(err, errIdempotencyResponseNotRemembered) {
+		h.logger.Error("Failed to remember added event", "error", err, "incident_id", incidentID, "idempotency_key", key)
+		w.Header().Set("Warning", `199 ohshift "the idempotency key was not recorded, retries may add the event again"`)
+
+		err = nil
+	}
+
+	switch {
+	case errors.Is(err, errIdempotencyKeyReused):
+		h.writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	case errors.Is(err, errIdempotencyKeyInProgress):
+		h.writeError(w, http.StatusConflict, err.Error())
+		return
+	case err != nil:
+		h.logger.Error("Failed to add API event to timeline", "error", err, "incident_id", incidentID, "idempotency_key", key)
+		h.writeError(w, http.StatusInternalServerError, "failed to add event")
+
+		return
+	}
+
+	if replayed {
+		h.logger.Info("Replaying idempotent event creation", "incident_id", incidentID, "event_id", resp.ID, "idempotency_key", key)
+		w.Header().Set("Idempotent-Replayed", "true")
+		h.writeJSON(w, http.StatusOK, resp)
+
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, resp)
+}
+
+// addEvent adds the entry of an event request to an incident's timeline
+func (h *Handler) addEvent(incidentID string, req *CreateEventRequest) (*EventResponse, error) {
+	metadata := map[string]interface{}{"source": req.Source}
+	for k, v := range req.Metadata {
+		metadata[k] = v
+	}
+
+	entry := timeline.Entry{
+		ID:        fmt.Sprintf("api_%d", time.Now().UnixNano()),
+		Timestamp: time.Now(),
+		Type:      "custom",
+		Username:  req.Source,
+		Content:   req.Text,
+		Metadata:  metadata,
+	}
+
+	if err := h.timeline.AddEntry(incidentID, entry); err != nil {
+		return nil, err
+	}
+
+	return &EventResponse{
+		ID:         entry.ID,
+		IncidentID: incidentID,
+		Type:       entry.Type,
+		Source:     req.Source,
+		Text:       entry.Content,
+		Timestamp:  entry.Timestamp,
+	}, nil
+}
+
+// command validates the request and converts it to an incident command
+func (req *CreateIncidentRequest) command() (*incident.Command, error) {
+	var (
+		incidentType string
+		severity     incident.Severity
+		err          error
+	)
+
+	if req.Type != "" {
+		if incidentType, err = incident.ParseType(strings.TrimSpace(req.Type)); err != nil {
+			return nil, err
+		}
+	}
+
+	// The severity can be left out when the incident type defaults one
+	if req.Severity != "" || incidentType == "" {
+		if severity, err = incident.ParseSeverity(req.Severity); err != nil {
+			return nil, err
+		}
+	}
+
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		return nil, fmt.Errorf("title is required")
+	}
+
+	startedBy := strings.TrimSpace(req.StartedBy)
+	if startedBy == "" {
+		startedBy = defaultSource
+	}
+
+	var services []string
+
+	for _, name := range append([]string{req.Service}, req.Services...) {
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+
+		service, err := incident.ParseService(strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+
+		if !slices.Contains(services, service) {
+			services = append(services, service)
+		}
+	}
+
+	var team string
+	if req.Team != "" {
+		if team, err = incident.ParseTeam(strings.TrimSpace(req.Team)); err != nil {
+			return nil, err
+		}
+	}
+
+	return &incident.Command{
+		Action:      "start",
+		Severity:    severity,
+		Title:       title,
+		Description: strings.TrimSpace(req.Description),
+		Username:    startedBy,
+		UserID:      strings.TrimSpace(req.SlackUserID),
+		Services:    services,
+		Team:        team,
+		Type:        incidentType,
+		Private:     req.Private,
+	}, nil
+}
+
+// newIncidentResponse converts an incident to its API representation
+func newIncidentResponse(inc *incident.Incident) *IncidentResponse {
+	return &IncidentResponse{
+		ID:          inc.ID,
+		Severity:    string(inc.Severity),
+		Title:       inc.Title,
+		Description: inc.Description,
+		ChannelID:   inc.ChannelID,
+		ChannelName: inc.ChannelName,
+		StartedBy:   inc.StartedBy,
+		StartedAt:   inc.StartedAt,
+	}
+}
+
+// decodeBody decodes a JSON request body into v
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) error {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("invalid request body: %v", err)
+	}
+
+	return nil
+}
+
+// writeJSON writes v as a JSON response with the given status code
+func (h *Handler) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.logger.Error("Failed to encode API response", "error", err)
+	}
+}
+
+// writeError writes a JSON error response
+func (h *Handler) writeError(w http.ResponseWriter, status int, message string) {
+	h.writeJSON(w, status, &errorResponse{Error: message})
+}
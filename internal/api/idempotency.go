@@ -0,0 +1,228 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/server"
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+const (
+	// idempotencyTTL is how long an idempotency key is remembered
+	idempotencyTTL = 24 * time.Hour
+	// idempotencyClaimTimeout is how long a request may take before another one may use its key
+	idempotencyClaimTimeout = 5 * time.Minute
+)
+
+var (
+	errIdempotencyKeyReused     = errors.New("idempotency key was already used with a different request")
+	errIdempotencyKeyInProgress = errors.New("a request with this idempotency key is still being processed")
+	// errIdempotencyResponseNotRemembered is returned with the response of a request whose key
+	// couldn't be completed, so that a retry may run it again
+	errIdempotencyResponseNotRemembered = errors.New("idempotency key was not recorded")
+)
+
+// idempotencyStore keeps idempotency keys with the response of the request that first used them
+type idempotencyStore interface {
+	ClaimIdempotencyKey(ctx context.Context, key, fingerprint string, now, expires, staleBefore time.Time) (bool, error)
+	GetIdempotencyKey(ctx context.Context, key string) (*store.IdempotencyKey, error)
+	SetIdempotencyResponse(ctx context.Context, key string, response []byte) error
+	ReleaseIdempotencyKey(ctx context.Context, key string) error
+}
+
+// idempotencyKeys remembers the responses of requests made with idempotency keys. Keys are kept
+// in the database so that retries are recognized across restarts and replicas.
+type idempotencyKeys struct {
+	store idempotencyStore
+	ttl   time.Duration
+	now   func() time.Time
+	locks keyLocks
+}
+
+// newIdempotencyKeys remembers keys in st for ttl
+func newIdempotencyKeys(st idempotencyStore, ttl time.Duration) *idempotencyKeys {
+	return &idempotencyKeys{
+		store: st,
+		ttl:   ttl,
+		now:   time.Now,
+	}
+}
+
+// idempotent runs fn unless key was already used, in which case the first response is
+// returned and replayed is true. Failed attempts are not remembered so they can be retried.
+// When fn succeeds but its response can't be remembered, the response is returned along with
+// errIdempotencyResponseNotRemembered since fn must not be reported as failed.
+func idempotent[T any](k *idempotencyKeys, key string, req any, fn func() (*T, error)) (*T, bool, error) {
+	if key == "" {
+		resp, err := fn()
+		return resp, false, err
+	}
+
+	fingerprint, err := requestFingerprint(req)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// A retry racing the first attempt on this replica waits for it instead of failing
+	unlock := k.locks.lock(key)
+	defer unlock()
+
+	now := k.now()
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	claimed, err := k.store.ClaimIdempotencyKey(ctx, key, fingerprint, now, now.Add(k.ttl), now.Add(-idempotencyClaimTimeout))
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !claimed {
+		data, err := k.replay(ctx, key, fingerprint)
+		if err != nil {
+			return nil, false, err
+		}
+
+		var resp T
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return nil, false, fmt.Errorf("failed to decode remembered response: %w", err)
+		}
+
+		return &resp, true, nil
+	}
+
+	resp, err := fn()
+	if err != nil {
+		k.release(key)
+		return nil, false, err
+	}
+
+	if err := k.remember(key, resp); err != nil {
+		return resp, false, fmt.Errorf("%w: %v", errIdempotencyResponseNotRemembered, err)
+	}
+
+	return resp, false, nil
+}
+
+// replay returns the JSON response remembered for a key held by another request
+func (k *idempotencyKeys) replay(ctx context.Context, key, fingerprint string) ([]byte, error) {
+	held, err := k.store.GetIdempotencyKey(ctx, key)
+	if errors.Is(err, store.ErrNotFound) {
+		// The other request failed and released the key in the meantime
+		return nil, errIdempotencyKeyInProgress
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if held.Fingerprint != fingerprint {
+		return nil, errIdempotencyKeyReused
+	}
+
+	if held.Response == nil {
+		return nil, errIdempotencyKeyInProgress
+	}
+
+	return held.Response, nil
+}
+
+// remember records the response of the request holding a key. The store context of the claim
+// may have expired while the request was processed, so a new one is used.
+func (k *idempotencyKeys) remember(key string, resp any) error {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	return k.store.SetIdempotencyResponse(ctx, key, data)
+}
+
+// release gives up a key after its request failed
+func (k *idempotencyKeys) release(key string) {
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	// An unreleased key is claimed again after idempotencyClaimTimeout
+	_ = k.store.ReleaseIdempotencyKey(ctx, key)
+}
+
+// keyLocks serializes requests per key
+type keyLocks struct {
+	mu    sync.Mutex
+	locks map[string]*keyLock
+}
+
+// keyLock is the lock of a key with the number of requests holding or waiting for it
+type keyLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// lock locks key and returns the function unlocking it
+func (l *keyLocks) lock(key string) func() {
+	l.mu.Lock()
+
+	if l.locks == nil {
+		l.locks = make(map[string]*keyLock)
+	}
+
+	kl, ok := l.locks[key]
+	if !ok {
+		kl = &keyLock{}
+		l.locks[key] = kl
+	}
+
+	kl.refs++
+	l.mu.Unlock()
+
+	kl.mu.Lock()
+
+	return func() {
+		kl.mu.Unlock()
+
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		if kl.refs--; kl.refs == 0 {
+			delete(l.locks, key)
+		}
+	}
+}
+
+// idempotencyKey returns the Idempotency-Key of a request scoped to its API token and to scope,
+// such as the endpoint, so that clients never get each other's responses. It is empty when the
+// request has no key.
+func idempotencyKey(r *http.Request, scope string) string {
+	key := r.Header.Get(IdempotencyKeyHeader)
+	if key == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(server.TokenID(r) + "\x00" + scope + "\x00" + key))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// requestFingerprint returns a hash identifying the request body
+func requestFingerprint(req any) (string, error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,241 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+// memoryIdempotencyStore keeps idempotency keys in memory like the idempotency_keys table
+type memoryIdempotencyStore struct {
+	keys map[string]*store.IdempotencyKey
+	// setErr is returned by SetIdempotencyResponse when set
+	setErr error
+}
+
+func newMemoryIdempotencyStore() *memoryIdempotencyStore {
+	return &memoryIdempotencyStore{keys: make(map[string]*store.IdempotencyKey)}
+}
+
+func (s *memoryIdempotencyStore) ClaimIdempotencyKey(_ context.Context, key, fingerprint string, now, expires, staleBefore time.Time) (bool, error) {
+	if held, ok := s.keys[key]; ok && held.ExpiresAt.After(now) && (held.Response != nil || !held.CreatedAt.Before(staleBefore)) {
+		return false, nil
+	}
+
+	s.keys[key] = &store.IdempotencyKey{Key: key, Fingerprint: fingerprint, CreatedAt: now, ExpiresAt: expires}
+
+	return true, nil
+}
+
+func (s *memoryIdempotencyStore) GetIdempotencyKey(_ context.Context, key string) (*store.IdempotencyKey, error) {
+	held, ok := s.keys[key]
+	if !ok {
+		return nil, store.ErrNotFound
+	}
+
+	return held, nil
+}
+
+func (s *memoryIdempotencyStore) SetIdempotencyResponse(_ context.Context, key string, response []byte) error {
+	if s.setErr != nil {
+		return s.setErr
+	}
+
+	s.keys[key].Response = response
+	return nil
+}
+
+func (s *memoryIdempotencyStore) ReleaseIdempotencyKey(_ context.Context, key string) error {
+	if held, ok := s.keys[key]; ok && held.Response == nil {
+		delete(s.keys, key)
+	}
+
+	return nil
+}
+
+func TestIdempotencyKeys(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	keys := newIdempotencyKeys(newMemoryIdempotencyStore(), time.Hour)
+	keys.now = func() time.Time { return now }
+
+	calls := 0
+	declare := func() (*IncidentResponse, error) {
+		calls++
+		return &IncidentResponse{ID: "inc_1"}, nil
+	}
+
+	req := CreateIncidentRequest{Severity: "SEV1", Title: "deploy failed"}
+
+	resp, replayed, err := idempotent(keys, "key-1", req, declare)
+	if err != nil || replayed || resp.ID != "inc_1" {
+		t.Fatalf("first idempotent() = %v, %v, %v", resp, replayed, err)
+	}
+
+	resp, replayed, err = idempotent(keys, "key-1", req, declare)
+	if err != nil || !replayed || resp.ID != "inc_1" {
+		t.Fatalf("retried idempotent() = %v, %v, %v", resp, replayed, err)
+	}
+
+	if calls != 1 {
+		t.Errorf("declare called %d times, want 1", calls)
+	}
+
+	other := CreateIncidentRequest{Severity: "SEV1", Title: "something else"}
+	if _, _, err := idempotent(keys, "key-1", other, declare); !errors.Is(err, errIdempotencyKeyReused) {
+		t.Errorf("idempotent() with different request error = %v, want %v", err, errIdempotencyKeyReused)
+	}
+
+	now = now.Add(2 * time.Hour)
+
+	if _, replayed, _ := idempotent(keys, "key-1", req, declare); replayed || calls != 2 {
+		t.Errorf("idempotent() after expiry replayed = %v, calls = %d, want a new declaration", replayed, calls)
+	}
+}
+
+func TestIdempotencyKeysFailuresNotRemembered(t *testing.T) {
+	keys := newIdempotencyKeys(newMemoryIdempotencyStore(), time.Hour)
+	req := CreateIncidentRequest{Severity: "SEV2", Title: "support escalation"}
+
+	_, _, err := idempotent(keys, "key-2", req, func() (*IncidentResponse, error) {
+		return nil, errors.New("slack unavailable")
+	})
+	if err == nil {
+		t.Fatalf("idempotent() expected error from failing declaration")
+	}
+
+	resp, replayed, err := idempotent(keys, "key-2", req, func() (*IncidentResponse, error) {
+		return &IncidentResponse{ID: "inc_2"}, nil
+	})
+	if err != nil || replayed || resp.ID != "inc_2" {
+		t.Errorf("idempotent() after failure = %v, %v, %v, want a new declaration", resp, replayed, err)
+	}
+}
+
+func TestIdempotencyKeysResponseNotRemembered(t *testing.T) {
+	st := newMemoryIdempotencyStore()
+	st.setErr = errors.New("database unavailable")
+
+	keys := newIdempotencyKeys(st, time.Hour)
+	req := CreateIncidentRequest{Severity: "SEV1", Title: "deploy failed"}
+
+	resp, replayed, err := idempotent(keys, "key-5", req, func() (*IncidentResponse, error) {
+		return &IncidentResponse{ID: "inc_5"}, nil
+	})
+	if !errors.Is(err, errIdempotencyResponseNotRemembered) || replayed || resp == nil || resp.ID != "inc_5" {
+		t.Errorf("idempotent() = %v, %v, %v, want the declared incident with %v", resp, replayed, err, errIdempotencyResponseNotRemembered)
+	}
+}
+
+func TestIdempotencyKeysInProgress(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	st := newMemoryIdempotencyStore()
+	keys := newIdempotencyKeys(st, time.Hour)
+	keys.now = func() time.Time { return now }
+
+	req := CreateIncidentRequest{Severity: "SEV1", Title: "deploy failed"}
+
+	fingerprint, err := requestFingerprint(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Another replica claimed the key and is still declaring the incident
+	if _, err := st.ClaimIdempotencyKey(context.Background(), "key-4", fingerprint, now, now.Add(time.Hour), now); err != nil {
+		t.Fatal(err)
+	}
+
+	declare := func() (*IncidentResponse, error) {
+		return &IncidentResponse{ID: "inc_4"}, nil
+	}
+
+	if _, _, err := idempotent(keys, "key-4", req, declare); !errors.Is(err, errIdempotencyKeyInProgress) {
+		t.Errorf("idempotent() while in progress error = %v, want %v", err, errIdempotencyKeyInProgress)
+	}
+
+	now = now.Add(idempotencyClaimTimeout + time.Minute)
+
+	if resp, replayed, err := idempotent(keys, "key-4", req, declare); err != nil || replayed || resp.ID != "inc_4" {
+		t.Errorf("idempotent() after a stale claim = %v, %v, %v, want a new declaration", resp, replayed, err)
+	}
+}
+
+func TestIdempotencyKeysWithoutKey(t *testing.T) {
+	keys := newIdempotencyKeys(newMemoryIdempotencyStore(), time.Hour)
+	req := CreateIncidentRequest{Severity: "SEV3", Title: "maintenance"}
+
+	calls := 0
+	declare := func() (*IncidentResponse, error) {
+		calls++
+		return &IncidentResponse{ID: "inc_3"}, nil
+	}
+
+	for range 2 {
+		if _, replayed, err := idempotent(keys, "", req, declare); err != nil || replayed {
+			t.Fatalf("idempotent() without key replayed = %v, err = %v", replayed, err)
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("declare called %d times, want 2", calls)
+	}
+}
+
+func TestIdempotencyKeyScopedToToken(t *testing.T) {
+	request := func(token, key string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/incidents", nil)
+		r.Header.Set("Authorization", "Bearer "+token)
+
+		if key != "" {
+			r.Header.Set(IdempotencyKeyHeader, key)
+		}
+
+		return r
+	}
+
+	if got := idempotencyKey(request("token-a", ""), "incidents"); got != "" {
+		t.Errorf("idempotencyKey() without a key = %q, want none", got)
+	}
+
+	first := idempotencyKey(request("token-a", "deploy-4711"), "incidents")
+
+	if again := idempotencyKey(request("token-a", "deploy-4711"), "incidents"); again != first {
+		t.Errorf("idempotencyKey() = %q for a retry, want %q", again, first)
+	}
+
+	if other := idempotencyKey(request("token-b", "deploy-4711"), "incidents"); other == first {
+		t.Errorf("idempotencyKey() = %q for another token, want a different key", other)
+	}
+
+	if other := idempotencyKey(request("token-a", "deploy-4711"), "events"); other == first {
+		t.Errorf("idempotencyKey() = %q for another scope, want a different key", other)
+	}
+}
+
+func TestIdempotencyKeysReplayEvents(t *testing.T) {
+	keys := newIdempotencyKeys(newMemoryIdempotencyStore(), time.Hour)
+	req := CreateEventRequest{Text: "deploy v1.2.3 rolled back", Source: "ci"}
+
+	calls := 0
+	add := func() (*EventResponse, error) {
+		calls++
+		return &EventResponse{ID: "api_1", IncidentID: "inc_6", Text: req.Text}, nil
+	}
+
+	if _, replayed, err := idempotent(keys, "key-6", req, add); err != nil || replayed {
+		t.Fatalf("first idempotent() replayed = %v, err = %v", replayed, err)
+	}
+
+	resp, replayed, err := idempotent(keys, "key-6", req, add)
+	if err != nil || !replayed || resp.ID != "api_1" || resp.Text != req.Text {
+		t.Errorf("retried idempotent() = %+v, %v, %v, want the first entry replayed", resp, replayed, err)
+	}
+
+	if calls != 1 {
+		t.Errorf("add called %d times, want 1", calls)
+	}
+}
@@ -0,0 +1,374 @@
+package api
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+// spec holds the OpenAPI description of the API
+//
+//go:embed openapi.yaml
+var spec embed.FS
+
+// IncidentDetails describes a stored incident
+type IncidentDetails struct {
+	ID              string     `json:"id"`
+	Status          string     `json:"status"`
+	SubStatus       string     `json:"sub_status,omitempty"`
+	Severity        string     `json:"severity"`
+	Title           string     `json:"title"`
+	Service         string     `json:"service,omitempty"`
+	Services        []string   `json:"services"`
+	Team            string     `json:"team,omitempty"`
+	Type            string     `json:"type,omitempty"`
+	Private         bool       `json:"private"`
+	Description     string     `json:"description,omitempty"`
+	ChannelID       string     `json:"channel_id"`
+	ChannelName     string     `json:"channel_name,omitempty"`
+	StartedBy       string     `json:"started_by"`
+	StartedByUserID string     `json:"started_by_user_id,omitempty"`
+	StartedAt       time.Time  `json:"started_at"`
+	ResolvedBy      string     `json:"resolved_by,omitempty"`
+	ResolvedAt      *time.Time `json:"resolved_at,omitempty"`
+	LastUpdated     time.Time  `json:"last_updated"`
+	NextUpdateDue   *time.Time `json:"next_update_due,omitempty"`
+	Roles           []RoleInfo `json:"roles,omitempty"`
+	ExportURL       string     `json:"export_url,omitempty"`
+	DurationSeconds *int64     `json:"duration_seconds,omitempty"`
+	TimelineURL     string     `json:"timeline_url"`
+}
+
+// RoleInfo describes a role assignment on an incident
+type RoleInfo struct {
+	Role        string    `json:"role"`
+	SlackUserID string    `json:"slack_user_id"`
+	AssignedAt  time.Time `json:"assigned_at"`
+}
+
+// IncidentList is a page of incidents
+type IncidentList struct {
+	Incidents []*IncidentDetails `json:"incidents"`
+	Total     int                `json:"total"`
+	Limit     int                `json:"limit"`
+	Offset    int                `json:"offset"`
+	// NextOffset is the offset of the next page, omitted on the last page
+	NextOffset *int `json:"next_offset,omitempty"`
+}
+
+// TimelineEntry is a stored timeline entry
+type TimelineEntry struct {
+	ID             string          `json:"id"`
+	Type           string          `json:"type"`
+	Timestamp      time.Time       `json:"timestamp"`
+	SlackUserID    string          `json:"slack_user_id,omitempty"`
+	Username       string          `json:"username,omitempty"`
+	Content        string          `json:"content"`
+	SlackMessageTS string          `json:"slack_message_ts,omitempty"`
+	Metadata       json.RawMessage `json:"metadata"`
+}
+
+// TimelineResponse is the timeline of an incident
+type TimelineResponse struct {
+	IncidentID string           `json:"incident_id"`
+	Entries    []*TimelineEntry `json:"entries"`
+}
+
+// ListIncidents lists incidents matching the query parameters, newest first
+func (h *Handler) ListIncidents(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseIncidentFilter(r)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	incidents, total, err := h.store.ListIncidents(r.Context(), filter)
+	if err != nil {
+		h.logger.Error("Failed to list incidents", "error", err)
+		h.writeError(w, http.StatusInternalServerError, "failed to list incidents")
+
+		return
+	}
+
+	resp := &IncidentList{
+		Incidents: make([]*IncidentDetails, 0, len(incidents)),
+		Total:     total,
+		Limit:     filter.Limit,
+		Offset:    filter.Offset,
+	}
+
+	for _, inc := range incidents {
+		resp.Incidents = append(resp.Incidents, newIncidentDetails(inc))
+	}
+
+	if next := filter.Offset + len(incidents); next < total {
+		resp.NextOffset = &next
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// GetIncident returns a single incident with its roles
+func (h *Handler) GetIncident(w http.ResponseWriter, r *http.Request) {
+	inc, ok := h.lookupIncident(w, r)
+	if !ok {
+		return
+	}
+
+	roles, err := h.store.ListRoles(r.Context(), inc.ID)
+	if err != nil {
+		h.logger.Error("Failed to list incident roles", "error", err, "incident_id", inc.ID)
+		h.writeError(w, http.StatusInternalServerError, "failed to get incident")
+
+		return
+	}
+
+	details := newIncidentDetails(inc)
+	for _, role := range roles {
+		details.Roles = append(details.Roles, RoleInfo{
+			Role:        role.Role,
+			SlackUserID: role.SlackUserID,
+			AssignedAt:  role.AssignedAt,
+		})
+	}
+
+	h.writeJSON(w, http.StatusOK, details)
+}
+
+// GetTimeline returns the timeline of an incident in chronological order
+func (h *Handler) GetTimeline(w http.ResponseWriter, r *http.Request) {
+	inc, ok := h.lookupIncident(w, r)
+	if !ok {
+		return
+	}
+
+	// Only the members of its channel may read the timeline of a private incident
+	if inc.Private {
+		h.writeError(w, http.StatusForbidden, fmt.Sprintf("incident is private: %s", inc.ID))
+		return
+	}
+
+	events, err := h.store.ListTimelineEvents(r.Context(), inc.ID)
+	if err != nil {
+		h.logger.Error("Failed to list timeline events", "error", err, "incident_id", inc.ID)
+		h.writeError(w, http.StatusInternalServerError, "failed to get timeline")
+
+		return
+	}
+
+	resp := &TimelineResponse{
+		IncidentID: inc.ID,
+		Entries:    make([]*TimelineEntry, 0, len(events)),
+	}
+
+	for _, event := range events {
+		entry := &TimelineEntry{
+			ID:          event.EntryKey,
+			Type:        event.EventType,
+			Timestamp:   event.Timestamp,
+			SlackUserID: event.SlackUserID,
+			Username:    event.SlackUsername,
+			Content:     event.Content,
+			Metadata:    event.Metadata,
+		}
+
+		if event.SlackMessageTS != nil {
+			entry.SlackMessageTS = *event.SlackMessageTS
+		}
+
+		resp.Entries = append(resp.Entries, entry)
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// ServeOpenAPISpec serves the OpenAPI description of the API
+func ServeOpenAPISpec(w http.ResponseWriter, _ *http.Request) {
+	data, err := spec.ReadFile("openapi.yaml")
+	if err != nil {
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/yaml")
+	_, _ = w.Write(data)
+}
+
+// lookupIncident loads the incident named in the request path, writing an error response if it can't
+func (h *Handler) lookupIncident(w http.ResponseWriter, r *http.Request) (*store.Incident, bool) {
+	incidentID := r.PathValue("id")
+
+	inc, err := h.store.GetIncident(r.Context(), incidentID)
+	if errors.Is(err, store.ErrNotFound) {
+		h.writeError(w, http.StatusNotFound, fmt.Sprintf("incident not found: %s", incidentID))
+		return nil, false
+	}
+
+	if err != nil {
+		h.logger.Error("Failed to get incident", "error", err, "incident_id", incidentID)
+		h.writeError(w, http.StatusInternalServerError, "failed to get incident")
+
+		return nil, false
+	}
+
+	return inc, true
+}
+
+// parseIncidentFilter reads the incident list filters from the query string
+func parseIncidentFilter(r *http.Request) (store.IncidentFilter, error) {
+	query := r.URL.Query()
+	filter := store.IncidentFilter{
+		Commander: query.Get("commander"),
+		Limit:     defaultPageSize,
+	}
+
+	if service := query.Get("service"); service != "" {
+		parsed, err := incident.ParseService(service)
+		if err != nil {
+			return filter, err
+		}
+
+		filter.Service = parsed
+	}
+
+	if status := query.Get("status"); status != "" {
+		switch incident.Status(status) {
+		case incident.StatusOpen, incident.StatusResolved, incident.StatusCancelled:
+			filter.Status = status
+		default:
+			return filter, fmt.Errorf("invalid status: %s", status)
+		}
+	}
+
+	if severity := query.Get("severity"); severity != "" {
+		parsed, err := incident.ParseSeverity(severity)
+		if err != nil {
+			return filter, err
+		}
+
+		filter.Severity = string(parsed)
+	}
+
+	var err error
+
+	if filter.From, err = parseTimeParam(query.Get("from")); err != nil {
+		return filter, fmt.Errorf("invalid from: %v", err)
+	}
+
+	if filter.To, err = parseTimeParam(query.Get("to")); err != nil {
+		return filter, fmt.Errorf("invalid to: %v", err)
+	}
+
+	if filter.Limit, err = parseIntParam(query.Get("limit"), defaultPageSize); err != nil || filter.Limit < 1 || filter.Limit > maxPageSize {
+		return filter, fmt.Errorf("limit must be between 1 and %d", maxPageSize)
+	}
+
+	if filter.Offset, err = parseIntParam(query.Get("offset"), 0); err != nil || filter.Offset < 0 {
+		return filter, fmt.Errorf("offset must be a non-negative integer")
+	}
+
+	// Matching a private incident by its services or commander would reveal them
+	filter.PublicOnly = filter.Service != "" || filter.Commander != ""
+
+	return filter, nil
+}
+
+// parseTimeParam parses an RFC 3339 timestamp or a YYYY-MM-DD date
+func parseTimeParam(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	return time.Parse(time.DateOnly, value)
+}
+
+// parseIntParam parses an integer query parameter with a default
+func parseIntParam(value string, defaultValue int) (int, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	return strconv.Atoi(value)
+}
+
+// newIncidentDetails converts a stored incident to its API representation
+func newIncidentDetails(inc *store.Incident) *IncidentDetails {
+	details := &IncidentDetails{
+		ID:            inc.ID,
+		Status:        inc.Status,
+		Severity:      inc.Severity,
+		Title:         inc.Title,
+		ChannelID:     inc.SlackChannelID,
+		StartedBy:     inc.StartedBy,
+		StartedAt:     inc.StartedAt,
+		ResolvedAt:    inc.ResolvedAt,
+		LastUpdated:   inc.LastUpdated,
+		NextUpdateDue: inc.NextUpdateDue,
+		TimelineURL:   fmt.Sprintf("/api/v1/incidents/%s/timeline", inc.ID),
+	}
+
+	details.Description = deref(inc.Description)
+	details.ChannelName = deref(inc.SlackChannelName)
+	details.StartedByUserID = deref(inc.StartedByUserID)
+	details.ResolvedBy = deref(inc.ResolvedBy)
+	details.ExportURL = deref(inc.ExportURL)
+	details.SubStatus = deref(inc.SubStatus)
+	details.Services = inc.Services
+
+	// Service is the first affected service, for clients predating Services
+	if len(inc.Services) > 0 {
+		details.Service = inc.Services[0]
+	}
+
+	details.Team = deref(inc.Team)
+	details.Type = deref(inc.IncidentType)
+
+	if inc.ResolvedAt != nil {
+		duration := int64(inc.ResolvedAt.Sub(inc.StartedAt).Seconds())
+		details.DurationSeconds = &duration
+	}
+
+	if inc.Private {
+		redact(details)
+	}
+
+	return details
+}
+
+// redact removes the details of a private incident that are only shown to the members of its channel
+func redact(details *IncidentDetails) {
+	details.Private = true
+	details.Title = store.PrivateTitle
+	details.Description = ""
+	details.ChannelName = ""
+	details.Service = ""
+	details.Services = []string{}
+	details.ExportURL = ""
+	// Types such as security or hr tell what a private incident is about
+	details.Type = ""
+}
+
+// deref returns the value of s or an empty string when s is nil
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
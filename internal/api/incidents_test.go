@@ -0,0 +1,69 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+func TestNewIncidentDetailsRedactsPrivateIncidents(t *testing.T) {
+	description := "Payroll export shared with the wrong vendor"
+	channelName := "_inc-20250602-100000-payroll-data-exposed"
+	incidentType := "hr"
+
+	inc := &store.Incident{
+		ID:               "11111111-1111-1111-1111-111111111111",
+		SlackChannelID:   "G1",
+		SlackChannelName: &channelName,
+		Status:           "open",
+		Severity:         "SEV1",
+		Title:            "Payroll data exposed",
+		Description:      &description,
+		Services:         []string{"payroll"},
+		IncidentType:     &incidentType,
+		Private:          true,
+		StartedAt:        time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC),
+	}
+
+	details := newIncidentDetails(inc)
+
+	if !details.Private || details.Title != store.PrivateTitle {
+		t.Errorf("newIncidentDetails() private = %v, title = %q, want the private placeholder", details.Private, details.Title)
+	}
+
+	if details.Description != "" || details.ChannelName != "" || details.Service != "" || len(details.Services) != 0 || details.Type != "" {
+		t.Errorf("newIncidentDetails() = %+v, want the details of a private incident left out", details)
+	}
+
+	if details.Severity != "SEV1" || details.ChannelID != "G1" {
+		t.Errorf("newIncidentDetails() severity = %q, channel = %q, want them kept", details.Severity, details.ChannelID)
+	}
+}
+
+func TestParseIncidentFilterLeavesOutPrivateIncidents(t *testing.T) {
+	tests := []struct {
+		query          string
+		wantPublicOnly bool
+	}{
+		{query: "", wantPublicOnly: false},
+		{query: "status=open&severity=SEV1", wantPublicOnly: false},
+		{query: "service=payroll", wantPublicOnly: true},
+		{query: "commander=U0123", wantPublicOnly: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			filter, err := parseIncidentFilter(httptest.NewRequest(http.MethodGet, "/api/v1/incidents?"+tt.query, nil))
+			if err != nil {
+				t.Fatalf("parseIncidentFilter() error = %v", err)
+			}
+
+			if filter.PublicOnly != tt.wantPublicOnly {
+				t.Errorf("parseIncidentFilter() PublicOnly = %v, want %v", filter.PublicOnly, tt.wantPublicOnly)
+			}
+		})
+	}
+}
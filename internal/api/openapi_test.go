@@ -0,0 +1,56 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// documentedOperations returns the "METHOD /path" operations listed in openapi.yaml
+func documentedOperations(t *testing.T) map[string]bool {
+	t.Helper()
+
+	data, err := spec.ReadFile("openapi.yaml")
+	if err != nil {
+		t.Fatalf("failed to read openapi.yaml: %v", err)
+	}
+
+	operations := map[string]bool{}
+	inPaths := false
+	path := ""
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case line == "paths:":
+			inPaths = true
+		case !strings.HasPrefix(line, " "):
+			inPaths = false
+		case inPaths && strings.HasPrefix(line, "  /"):
+			path = strings.TrimSuffix(strings.TrimSpace(line), ":")
+		case inPaths && strings.HasPrefix(line, "    ") && !strings.HasPrefix(line, "     "):
+			method := strings.TrimSuffix(strings.TrimSpace(line), ":")
+			operations[strings.ToUpper(method)+" "+path] = true
+		}
+	}
+
+	return operations
+}
+
+func TestOpenAPISpecDocumentsRoutes(t *testing.T) {
+	operations := documentedOperations(t)
+	h := &Handler{}
+
+	for _, rt := range h.routes() {
+		if !operations[rt.method+" "+rt.path] {
+			t.Errorf("route %s %s is not documented in openapi.yaml", rt.method, rt.path)
+		}
+	}
+
+	if len(operations) != len(h.routes())+1 {
+		t.Errorf("openapi.yaml documents %d operations, want %d routes plus the spec itself", len(operations), len(h.routes()))
+	}
+}
@@ -0,0 +1,198 @@
+// Package calendar renders incidents as an iCalendar (RFC 5545) feed.
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+const (
+	// DefaultPeriod is how far back the feed goes when no start is given
+	DefaultPeriod = 90 * 24 * time.Hour
+
+	// ContentType is the media type of the feed
+	ContentType = "text/calendar; charset=utf-8"
+
+	// maxLineLength is the longest content line in octets, excluding the line break
+	maxLineLength = 75
+
+	// timeFormat is the UTC date-time format of iCalendar properties
+	timeFormat = "20060102T150405Z"
+)
+
+// Load returns the incidents active at any time in [from, to): started before to and still
+// open or resolved at or after from
+func Load(ctx context.Context, st *store.Store, from, to time.Time) ([]*store.Incident, error) {
+	incidents, _, err := st.ListIncidents(ctx, store.IncidentFilter{
+		To:         to,
+		ActiveFrom: from,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return incidents, nil
+}
+
+// Write writes incidents as an iCalendar feed. Resolved incidents span their start to their
+// resolution; open incidents span their start to now and are marked as ongoing.
+func Write(w io.Writer, incidents []*store.Incident, now time.Time) error {
+	cw := &contentWriter{w: w}
+
+	cw.line("BEGIN:VCALENDAR")
+	cw.line("VERSION:2.0")
+	cw.line("PRODID:-//OhShift//Incidents//EN")
+	cw.line("CALSCALE:GREGORIAN")
+	cw.line("METHOD:PUBLISH")
+	cw.property("X-WR-CALNAME", "Incidents")
+
+	for _, inc := range incidents {
+		writeEvent(cw, inc, now)
+	}
+
+	cw.line("END:VCALENDAR")
+
+	return cw.err
+}
+
+// writeEvent writes the VEVENT of an incident
+func writeEvent(cw *contentWriter, inc *store.Incident, now time.Time) {
+	link := ChannelURL(inc.SlackChannelID)
+
+	cw.line("BEGIN:VEVENT")
+	cw.line("UID:" + inc.ID + "@ohshift")
+	cw.line("DTSTAMP:" + formatTime(now))
+	cw.line("DTSTART:" + formatTime(inc.StartedAt))
+
+	// Calendar clients show events without an end as instants, so open incidents end now
+	end := now
+	if inc.ResolvedAt != nil {
+		end = *inc.ResolvedAt
+	}
+
+	if end.After(inc.StartedAt) {
+		cw.line("DTEND:" + formatTime(end))
+	}
+
+	summary := fmt.Sprintf("[%s] %s", inc.Severity, inc.PublicTitle())
+	if incident.Status(inc.Status) == incident.StatusOpen {
+		summary += " (ongoing)"
+	}
+
+	cw.property("SUMMARY", summary)
+	cw.property("DESCRIPTION", description(inc, link))
+	cw.property("CATEGORIES", inc.Severity)
+	cw.line("URL:" + link)
+	cw.line("STATUS:" + eventStatus(incident.Status(inc.Status)))
+	cw.line("LAST-MODIFIED:" + formatTime(inc.LastUpdated))
+	cw.line("END:VEVENT")
+}
+
+// description describes an incident in the body of its event. The services and description of
+// private incidents are left out.
+func description(inc *store.Incident, link string) string {
+	lines := []string{
+		"Severity: " + inc.Severity,
+		"Status: " + inc.Status,
+	}
+
+	if inc.Private {
+		return strings.Join(append(lines, "", "Channel: "+link), "\n")
+	}
+
+	if len(inc.Services) > 0 {
+		lines = append(lines, "Services: "+strings.Join(inc.Services, ", "))
+	}
+
+	if inc.Description != nil && *inc.Description != "" {
+		lines = append(lines, "", *inc.Description)
+	}
+
+	lines = append(lines, "", "Channel: "+link)
+
+	return strings.Join(lines, "\n")
+}
+
+// eventStatus maps an incident status to the status of its event
+func eventStatus(status incident.Status) string {
+	if status == incident.StatusCancelled {
+		return "CANCELLED"
+	}
+
+	return "CONFIRMED"
+}
+
+// ChannelURL links to a Slack channel without needing the workspace domain
+func ChannelURL(channelID string) string {
+	return "https://slack.com/app_redirect?channel=" + channelID
+}
+
+// formatTime formats a time as a UTC iCalendar date-time
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeFormat)
+}
+
+// escapeText escapes a TEXT property value
+func escapeText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+// contentWriter writes folded, CRLF-terminated content lines and keeps the first error
+type contentWriter struct {
+	w   io.Writer
+	err error
+}
+
+// property writes a property with a TEXT value
+func (cw *contentWriter) property(name, value string) {
+	cw.line(name + ":" + escapeText(value))
+}
+
+// line writes a content line, folding it so that no line is longer than 75 octets
+func (cw *contentWriter) line(s string) {
+	if cw.err != nil {
+		return
+	}
+
+	_, cw.err = io.WriteString(cw.w, fold(s)+"\r\n")
+}
+
+// fold splits a content line into lines of at most 75 octets without splitting UTF-8
+// characters; continuation lines start with a space
+func fold(s string) string {
+	var (
+		b     strings.Builder
+		width int
+		limit = maxLineLength
+	)
+
+	for _, r := range s {
+		size := len(string(r))
+		if width+size > limit {
+			b.WriteString("\r\n ")
+
+			width = 0
+			// The leading space counts towards the length of continuation lines
+			limit = maxLineLength - 1
+		}
+
+		b.WriteRune(r)
+
+		width += size
+	}
+
+	return b.String()
+}
@@ -0,0 +1,135 @@
+package calendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+func TestWrite(t *testing.T) {
+	started := time.Date(2025, 6, 2, 10, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+	resolved := started.Add(90 * time.Minute)
+	description := "Card payments failing; retries, then timeouts"
+
+	incidents := []*store.Incident{
+		{
+			ID:             "11111111-1111-1111-1111-111111111111",
+			SlackChannelID: "C1",
+			Status:         "resolved",
+			Severity:       "SEV1",
+			Title:          "Payments down",
+			Description:    &description,
+			Services:       []string{"payments"},
+			StartedAt:      started,
+			ResolvedAt:     &resolved,
+			LastUpdated:    resolved,
+		},
+		{
+			ID:             "22222222-2222-2222-2222-222222222222",
+			SlackChannelID: "C2",
+			Status:         "open",
+			Severity:       "SEV3",
+			Title:          "Slow search",
+			StartedAt:      started,
+			LastUpdated:    started,
+		},
+		{
+			ID:             "33333333-3333-3333-3333-333333333333",
+			SlackChannelID: "C3",
+			Status:         "open",
+			Severity:       "SEV1",
+			Title:          "Leaked credentials",
+			Description:    &description,
+			Services:       []string{"auth"},
+			Private:        true,
+			StartedAt:      started,
+			LastUpdated:    started,
+		},
+	}
+
+	var b strings.Builder
+	if err := Write(&b, incidents, started.Add(3*time.Hour)); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	out := b.String()
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"UID:11111111-1111-1111-1111-111111111111@ohshift\r\n",
+		"DTSTART:20250602T080000Z\r\n",
+		"DTEND:20250602T093000Z\r\n",
+		"DTEND:20250602T110000Z\r\n",
+		"SUMMARY:[SEV1] Payments down\r\n",
+		"SUMMARY:[SEV3] Slow search (ongoing)\r\n",
+		"SUMMARY:[SEV1] 🔒 Private incident (ongoing)\r\n",
+		"URL:https://slack.com/app_redirect?channel=C1\r\n",
+		"STATUS:CONFIRMED\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Write() output is missing %q", want)
+		}
+	}
+
+	if got := strings.Count(out, "BEGIN:VEVENT"); got != 3 {
+		t.Errorf("Write() wrote %d events, want 3", got)
+	}
+
+	if got := strings.Count(out, "DTEND:20250602T110000Z"); got != 2 {
+		t.Errorf("Write() wrote %d DTEND properties at now, want 2 for the open incidents", got)
+	}
+
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n") {
+		if len(line) > maxLineLength {
+			t.Errorf("line longer than %d octets: %q", maxLineLength, line)
+		}
+	}
+
+	unfolded := strings.ReplaceAll(out, "\r\n ", "")
+	if want := `Card payments failing\; retries\, then timeouts`; !strings.Contains(unfolded, want) {
+		t.Errorf("Write() output is missing escaped description %q", want)
+	}
+
+	if strings.Contains(unfolded, "Leaked credentials") || strings.Contains(unfolded, "auth") {
+		t.Error("Write() output shows the details of a private incident")
+	}
+
+	if got := strings.Count(unfolded, "Card payments failing"); got != 1 {
+		t.Errorf("Write() wrote the description %d times, want 1 for the public incident only", got)
+	}
+}
+
+func TestFold(t *testing.T) {
+	line := "DESCRIPTION:" + strings.Repeat("é", 60)
+
+	folded := fold(line)
+	if got := strings.ReplaceAll(folded, "\r\n ", ""); got != line {
+		t.Errorf("unfolding fold() = %q, want %q", got, line)
+	}
+
+	for _, l := range strings.Split(folded, "\r\n") {
+		if len(l) > maxLineLength {
+			t.Errorf("fold() line of %d octets, want at most %d", len(l), maxLineLength)
+		}
+	}
+}
+
+func TestParseRange(t *testing.T) {
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+
+	from, to, err := ParseRange("", "", now)
+	if err != nil || !to.Equal(now) || !from.Equal(now.Add(-DefaultPeriod)) {
+		t.Errorf("ParseRange() = %v, %v, %v, want the default period before now", from, to, err)
+	}
+
+	if _, _, err := ParseRange("2025-07-01", "2025-06-01", now); err == nil {
+		t.Error("ParseRange() expected an error when from is after to")
+	}
+
+	if _, _, err := ParseRange("yesterday", "", now); err == nil {
+		t.Error("ParseRange() expected an error for an invalid from")
+	}
+}
@@ -0,0 +1,86 @@
+package calendar
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/logger"
+	"github.com/fishnix/ohshift/internal/report"
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+// Handler serves the incident feed over HTTP
+type Handler struct {
+	store  *store.Store
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewHandler creates a new handler serving the incidents in st
+func NewHandler(st *store.Store) *Handler {
+	return &Handler{
+		store:  st,
+		logger: logger.With("component", "calendar"),
+		now:    time.Now,
+	}
+}
+
+// ServeHTTP serves the incidents active in the range given by the from and to query
+// parameters, by default the last 90 days
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	now := h.now()
+
+	from, to, err := ParseRange(r.URL.Query().Get("from"), r.URL.Query().Get("to"), now)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	incidents, err := Load(r.Context(), h.store, from, to)
+	if err != nil {
+		h.logger.Error("Failed to load incidents for calendar", "error", err, "from", from, "to", to)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", ContentType)
+
+	if err := Write(w, incidents, now); err != nil {
+		h.logger.Warn("Failed to write calendar", "error", err)
+		return
+	}
+
+	h.logger.Debug("Calendar served",
+		"from", from,
+		"to", to,
+		"incidents", len(incidents))
+}
+
+// ParseRange parses the range of a feed. The end defaults to now and the start to
+// DefaultPeriod before the end.
+func ParseRange(fromValue, toValue string, now time.Time) (time.Time, time.Time, error) {
+	var err error
+
+	to := now
+	if toValue != "" {
+		if to, err = report.ParseTime(toValue); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid to: %w", err)
+		}
+	}
+
+	from := to.Add(-DefaultPeriod)
+	if fromValue != "" {
+		if from, err = report.ParseTime(fromValue); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid from: %w", err)
+		}
+	}
+
+	if !from.Before(to) {
+		return time.Time{}, time.Time{}, fmt.Errorf("from must be before to")
+	}
+
+	return from, to, nil
+}
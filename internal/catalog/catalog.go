@@ -0,0 +1,178 @@
+// Package catalog manages the service catalog: the services incidents affect, who owns them and
+// how to reach their on-call responders.
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/store"
+	"gopkg.in/yaml.v3"
+)
+
+// Entry is a service as described in a catalog file or on the command line
+type Entry struct {
+	Name      string `yaml:"name"`
+	OwnerTeam string `yaml:"owner_team"`
+	// OnCallUserGroup is the Slack user group ID of the service's on-call responders
+	OnCallUserGroup string `yaml:"oncall_usergroup"`
+	RunbookURL      string `yaml:"runbook_url"`
+	SlackChannel    string `yaml:"slack_channel"`
+}
+
+// file is the layout of a catalog file
+type file struct {
+	Services []Entry `yaml:"services"`
+}
+
+// Parse reads a catalog file, reporting every invalid service
+func Parse(r io.Reader) ([]*store.Service, error) {
+	var f file
+
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(&f); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid catalog file: %w", err)
+	}
+
+	var (
+		services []*store.Service
+		errs     []error
+		names    = make(map[string]bool)
+	)
+
+	for i, entry := range f.Services {
+		svc, err := NewService(entry)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("services[%d]: %w", i, err))
+			continue
+		}
+
+		if names[svc.Name] {
+			errs = append(errs, fmt.Errorf("services[%d]: duplicate service %q", i, svc.Name))
+			continue
+		}
+
+		names[svc.Name] = true
+		services = append(services, svc)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return services, nil
+}
+
+// NewService validates an entry and returns it as a row of the catalog
+func NewService(entry Entry) (*store.Service, error) {
+	name, err := incident.ParseService(strings.TrimSpace(entry.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	svc := &store.Service{
+		Name:            name,
+		OnCallUserGroup: optional(entry.OnCallUserGroup),
+		SlackChannel:    optional(strings.TrimPrefix(strings.TrimSpace(entry.SlackChannel), "#")),
+	}
+
+	if owner := strings.TrimSpace(entry.OwnerTeam); owner != "" {
+		team, err := incident.ParseTeam(owner)
+		if err != nil {
+			return nil, err
+		}
+
+		svc.OwnerTeam = &team
+	}
+
+	if runbook := strings.TrimSpace(entry.RunbookURL); runbook != "" {
+		u, err := url.Parse(runbook)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return nil, fmt.Errorf("invalid runbook URL %q, expected an http or https URL", runbook)
+		}
+
+		svc.RunbookURL = &runbook
+	}
+
+	return svc, nil
+}
+
+// Import saves services to the catalog. With prune, the services missing from them are removed
+// from the catalog; their names are returned.
+func Import(ctx context.Context, st *store.Store, services []*store.Service, prune bool) ([]string, error) {
+	names := make(map[string]bool, len(services))
+
+	for _, svc := range services {
+		if err := st.SaveService(ctx, svc); err != nil {
+			return nil, fmt.Errorf("service %s: %w", svc.Name, err)
+		}
+
+		names[svc.Name] = true
+	}
+
+	if !prune {
+		return nil, nil
+	}
+
+	existing, err := st.ListServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []string
+
+	for _, svc := range existing {
+		if names[svc.Name] {
+			continue
+		}
+
+		if err := st.DeleteService(ctx, svc.Name); err != nil {
+			return removed, fmt.Errorf("service %s: %w", svc.Name, err)
+		}
+
+		removed = append(removed, svc.Name)
+	}
+
+	return removed, nil
+}
+
+// Write prints the services as a table
+func Write(w io.Writer, services []*store.Service) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "NAME\tOWNER\tON-CALL\tCHANNEL\tRUNBOOK")
+
+	for _, svc := range services {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", svc.Name, dash(svc.OwnerTeam), dash(svc.OnCallUserGroup),
+			dash(svc.SlackChannel), dash(svc.RunbookURL))
+	}
+
+	return tw.Flush()
+}
+
+// optional returns a pointer to the trimmed value, or nil when it is empty
+func optional(value string) *string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
+
+// dash returns the value of an optional field, or "-" when it is unset
+func dash(value *string) string {
+	if value == nil {
+		return "-"
+	}
+
+	return *value
+}
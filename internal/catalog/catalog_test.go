@@ -0,0 +1,72 @@
+package catalog
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParseExample(t *testing.T) {
+	f, err := os.Open("../../services.example.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer f.Close()
+
+	if _, err := Parse(f); err != nil {
+		t.Errorf("Parse() error = %v", err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	services, err := Parse(strings.NewReader(`
+services:
+  - name: Payments
+    owner_team: payments
+    oncall_usergroup: S0123456789
+    runbook_url: https://runbooks.example.com/payments
+    slack_channel: "#payments-oncall"
+  - name: checkout
+`))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if len(services) != 2 {
+		t.Fatalf("Parse() = %d services, want 2", len(services))
+	}
+
+	payments := services[0]
+	if payments.Name != "payments" || *payments.OwnerTeam != "payments" || *payments.SlackChannel != "payments-oncall" {
+		t.Errorf("Parse() payments = %+v", payments)
+	}
+
+	if checkout := services[1]; checkout.OwnerTeam != nil || checkout.RunbookURL != nil {
+		t.Errorf("Parse() checkout = %+v, want no details", checkout)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	_, err := Parse(strings.NewReader(`
+services:
+  - name: bad service
+  - name: payments
+    runbook_url: runbooks/payments
+  - name: checkout
+  - name: Checkout
+`))
+	if err == nil {
+		t.Fatal("Parse() expected an error")
+	}
+
+	for _, want := range []string{"services[0]", "services[1]", "services[3]"} {
+		if !strings.Contains(err.Error(), want+":") {
+			t.Errorf("Parse() error is missing %s:\n%v", want, err)
+		}
+	}
+
+	if _, err := Parse(strings.NewReader("services:\n  - name: payments\n    owner: payments\n")); err == nil {
+		t.Error("Parse() accepted an unknown field")
+	}
+}
@@ -0,0 +1,179 @@
+// Package checklist builds incident checklists and flags their unfinished required items.
+package checklist
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/logger"
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+// pollInterval is how often due checklist reminders are looked for
+const pollInterval = time.Minute
+
+// Poster posts messages to Slack
+type Poster interface {
+	PostMessage(channelID, text string) error
+}
+
+// Build returns the items of the checklist of an incident: the configured checklist followed by
+// the checklist of its type. An item listed in both is kept once, required if either requires it.
+func Build(checklist, typed []config.ChecklistItem) []*store.ChecklistItem {
+	items := make([]*store.ChecklistItem, 0, len(checklist)+len(typed))
+	byText := make(map[string]*store.ChecklistItem)
+
+	for _, configured := range append(append([]config.ChecklistItem(nil), checklist...), typed...) {
+		text := strings.TrimSpace(configured.Text)
+		key := strings.ToLower(text)
+
+		if item, ok := byText[key]; ok {
+			item.Required = item.Required || configured.Required
+			continue
+		}
+
+		item := &store.ChecklistItem{Position: len(items) + 1, Text: text, Required: configured.Required}
+		byText[key] = item
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// Unfinished returns the required items that are not done yet
+func Unfinished(items []*store.ChecklistItem) []*store.ChecklistItem {
+	var unfinished []*store.ChecklistItem
+
+	for _, item := range items {
+		if item.Required && !item.Done() {
+			unfinished = append(unfinished, item)
+		}
+	}
+
+	return unfinished
+}
+
+// ParseInterval parses how often unfinished required items are flagged
+func ParseInterval(value string) (time.Duration, error) {
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval < time.Minute {
+		return 0, fmt.Errorf("checklist reminder interval: invalid duration %q, expected at least 1m", value)
+	}
+
+	return interval, nil
+}
+
+// Reminder flags the unfinished required checklist items of open incidents in their channels
+// until they are done. Reminder times are kept in the database so they survive restarts.
+type Reminder struct {
+	interval time.Duration
+	store    *store.Store
+	poster   Poster
+	logger   *slog.Logger
+	now      func() time.Time
+}
+
+// New creates a new checklist reminder using the configured interval
+func New(cfg *config.Config, st *store.Store, poster Poster) (*Reminder, error) {
+	interval, err := ParseInterval(cfg.ChecklistReminderInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reminder{
+		interval: interval,
+		store:    st,
+		poster:   poster,
+		logger:   logger.With("component", "checklist_reminder"),
+		now:      time.Now,
+	}, nil
+}
+
+// Run flags unfinished required items of due checklists until ctx is done
+func (r *Reminder) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	r.logger.Info("Checklist reminders started", "interval", r.interval)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.remindDue(ctx)
+		}
+	}
+}
+
+// remindDue flags the unfinished required items of every checklist whose reminder is due
+func (r *Reminder) remindDue(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, store.DefaultTimeout)
+	defer cancel()
+
+	now := r.now()
+
+	checklists, err := r.store.ListDueChecklists(ctx, now)
+	if err != nil {
+		r.logger.Error("Failed to list due checklists", "error", err)
+		return
+	}
+
+	for _, checklist := range checklists {
+		r.remind(ctx, checklist, now)
+	}
+}
+
+// remind flags the unfinished required items of a checklist in its incident channel
+func (r *Reminder) remind(ctx context.Context, checklist *store.Checklist, now time.Time) {
+	// Claiming the reminder keeps replicas from sending it twice
+	claimed, err := r.store.ClaimChecklistReminder(ctx, checklist.IncidentID, *checklist.NextReminder, now.Add(r.interval))
+	if err != nil {
+		r.logger.Error("Failed to claim checklist reminder", "error", err, "incident_id", checklist.IncidentID)
+		return
+	}
+
+	if !claimed {
+		return
+	}
+
+	_, items, err := r.store.GetChecklist(ctx, checklist.IncidentID)
+	if err != nil {
+		r.logger.Error("Failed to get checklist", "error", err, "incident_id", checklist.IncidentID)
+		return
+	}
+
+	unfinished := Unfinished(items)
+	if len(unfinished) == 0 {
+		return
+	}
+
+	if err := r.poster.PostMessage(checklist.SlackChannelID, ReminderMessage(unfinished)); err != nil {
+		r.logger.Error("Failed to post checklist reminder", "error", err, "incident_id", checklist.IncidentID)
+		return
+	}
+
+	r.logger.Info("Checklist reminder sent",
+		"incident_id", checklist.IncidentID,
+		"unfinished", len(unfinished),
+		"channel_id", checklist.SlackChannelID)
+}
+
+// ReminderMessage flags unfinished required items
+func ReminderMessage(unfinished []*store.ChecklistItem) string {
+	var message strings.Builder
+
+	message.WriteString("⚠️ *Required checklist items are still open:*\n")
+
+	for _, item := range unfinished {
+		fmt.Fprintf(&message, "☐ %s\n", item.Text)
+	}
+
+	message.WriteString("Tick them in the checklist once they are done.")
+
+	return message.String()
+}
@@ -0,0 +1,59 @@
+package checklist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+func TestBuild(t *testing.T) {
+	items := Build(
+		[]config.ChecklistItem{{Text: "Open status page", Required: true}, {Text: "Notify support"}},
+		[]config.ChecklistItem{{Text: "Page database on-call", Required: true}, {Text: " notify support ", Required: true}},
+	)
+
+	want := []store.ChecklistItem{
+		{Position: 1, Text: "Open status page", Required: true},
+		{Position: 2, Text: "Notify support", Required: true},
+		{Position: 3, Text: "Page database on-call", Required: true},
+	}
+
+	if len(items) != len(want) {
+		t.Fatalf("Build() returned %d items, want %d", len(items), len(want))
+	}
+
+	for i, item := range items {
+		if *item != want[i] {
+			t.Errorf("Build()[%d] = %+v, want %+v", i, *item, want[i])
+		}
+	}
+}
+
+func TestUnfinished(t *testing.T) {
+	done := time.Now()
+
+	items := []*store.ChecklistItem{
+		{Position: 1, Text: "Open status page", Required: true, CompletedAt: &done},
+		{Position: 2, Text: "Notify support"},
+		{Position: 3, Text: "Page database on-call", Required: true},
+	}
+
+	unfinished := Unfinished(items)
+	if len(unfinished) != 1 || unfinished[0].Position != 3 {
+		t.Errorf("Unfinished() = %+v", unfinished)
+	}
+}
+
+func TestParseInterval(t *testing.T) {
+	if got, err := ParseInterval("30m"); err != nil || got != 30*time.Minute {
+		t.Errorf("ParseInterval(30m) = %v, %v", got, err)
+	}
+
+	for _, value := range []string{"", "soon", "30s"} {
+		if _, err := ParseInterval(value); err == nil {
+			t.Errorf("ParseInterval(%q) expected error", value)
+		}
+	}
+}
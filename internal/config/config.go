@@ -2,103 +2,353 @@
 package config
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"log/slog"
 	"os"
+	"reflect"
 	"strings"
+
+	"gopkg.in/yaml.v3"
 )
 
 // Config holds all configuration for the OhShift bot
 type Config struct {
-	DBURI                    string
-	SlackBotToken            string
-	SlackSigningSecret       string
-	SlackAppToken            string
-	SlashCommand             string
-	NotificationsChannel     string
-	Port                     string
-	LogLevel                 slog.Level
-	AddAllMessagesToTimeline bool
-}
-
-// Load loads configuration from environment variables
-func Load() *Config {
-	config := &Config{
-		DBURI:                    getEnv("DB_URI", ""),
-		SlackBotToken:            getEnv("SLACK_BOT_TOKEN", ""),
-		SlackSigningSecret:       getEnv("SLACK_SIGNING_SECRET", ""),
-		SlackAppToken:            getEnv("SLACK_APP_TOKEN", ""),
-		SlashCommand:             getEnv("SLASH_COMMAND", "/shift"),
-		NotificationsChannel:     getEnv("NOTIFICATIONS_CHANNEL", "general"),
-		Port:                     getEnv("PORT", "8080"),
-		LogLevel:                 parseLogLevel(getEnv("LOG_LEVEL", "info")),
-		AddAllMessagesToTimeline: getEnvBool("ADD_ALL_MESSAGES_TO_TIMELINE", false),
-	}
+	DBURI              string `yaml:"db_uri"`
+	SlackBotToken      string `yaml:"slack_bot_token"`
+	SlackSigningSecret string `yaml:"slack_signing_secret"`
+	SlackAppToken      string `yaml:"slack_app_token"`
+	// SlackMode is how Slack delivers events: SlackModeSocket or SlackModeHTTP
+	SlackMode            string `yaml:"slack_mode"`
+	SlashCommand         string `yaml:"slash_command"`
+	NotificationsChannel string `yaml:"notifications_channel"`
+	// WorkspaceNotificationsChannels maps Slack team IDs to the notifications channel of their workspace
+	WorkspaceNotificationsChannels map[string]string `yaml:"workspace_notifications_channels"`
+	// SlackClientID, SlackClientSecret and SlackRedirectURL enable installing the bot in more
+	// workspaces through the OAuth flow
+	SlackClientID            string             `yaml:"slack_client_id"`
+	SlackClientSecret        string             `yaml:"slack_client_secret"`
+	SlackRedirectURL         string             `yaml:"slack_redirect_url"`
+	Port                     string             `yaml:"port"`
+	LogLevel                 slog.Level         `yaml:"log_level"`
+	AddAllMessagesToTimeline bool               `yaml:"add_all_messages_to_timeline"`
+	AlertmanagerToken        string             `yaml:"alertmanager_token"`
+	AlertmanagerRules        []AlertRule        `yaml:"alertmanager_rules"`
+	OnCallRoutes             []OnCallRoute      `yaml:"oncall_routes"`
+	EscalationPolicies       []EscalationPolicy `yaml:"escalation_policies"`
+	OnCallHandoffs           []OnCallHandoff    `yaml:"oncall_handoffs"`
+	APITokens                []string           `yaml:"api_tokens"`
+	CalendarTokens           []string           `yaml:"calendar_tokens"`
+	DigestChannel            string             `yaml:"digest_channel"`
+	DigestSchedule           string             `yaml:"digest_schedule"`
+	// StaleIncidentAfter is how long an open incident can go without activity before it is stale, e.g. "72h"
+	StaleIncidentAfter    string `yaml:"stale_incident_after"`
+	StaleIncidentSchedule string `yaml:"stale_incident_schedule"`
+	// StatusUpdateCadences maps severities to how often status updates are expected, e.g. "SEV0": "15m"
+	StatusUpdateCadences map[string]string `yaml:"status_update_cadences"`
+	// NotificationRoutes pick the channels notified about incidents of the default workspace
+	NotificationRoutes []NotificationRoute `yaml:"notification_routes"`
+	// IncidentTypes are picked with --type when declaring an incident
+	IncidentTypes []IncidentType `yaml:"incident_types"`
+	// Checklist is posted in every incident channel, followed by the checklist of the incident type
+	Checklist []ChecklistItem `yaml:"checklist"`
+	// ChecklistReminderInterval is how often unfinished required checklist items are flagged, e.g. "30m"
+	ChecklistReminderInterval string `yaml:"checklist_reminder_interval"`
 
-	return config
+	// secretPaths are the files secrets were read from
+	secretPaths []string
+	// loadErrors collects problems found while reading the configuration file and the environment
+	loadErrors []error
 }
 
-// parseLogLevel parses a log level string into slog.Level
-func parseLogLevel(level string) slog.Level {
-	switch strings.ToLower(level) {
-	case "debug":
-		return slog.LevelDebug
-	case "info":
-		return slog.LevelInfo
-	case "warn":
-		return slog.LevelWarn
-	case "error":
-		return slog.LevelError
-	default:
-		return slog.LevelInfo
+// NotificationsChannelFor returns the notifications channel of a Slack workspace
+func (c *Config) NotificationsChannelFor(teamID string) string {
+	if channel := c.WorkspaceNotificationsChannels[teamID]; channel != "" {
+		return channel
 	}
+
+	return c.NotificationsChannel
+}
+
+// OAuthEnabled reports whether the bot can be installed in more workspaces through the OAuth flow
+func (c *Config) OAuthEnabled() bool {
+	return c.SlackClientID != ""
+}
+
+// Slack modes
+const (
+	// SlackModeSocket receives events over a Socket Mode connection opened with the app token
+	SlackModeSocket = "socket"
+	// SlackModeHTTP receives events as HTTP requests verified with the signing secret
+	SlackModeHTTP = "http"
+)
+
+// AlertRule maps Alertmanager alerts to an incident severity and title
+type AlertRule struct {
+	// Match lists label values that must all be present on the alert
+	Match map[string]string `json:"match" yaml:"match"`
+	// Severity is the incident severity (SEV0-SEV3) for matching alerts
+	Severity string `json:"severity" yaml:"severity"`
+	// Title is a text/template rendered against the alert
+	Title string `json:"title" yaml:"title"`
+}
+
+// OnCallRoute pages responders when an incident of a matching severity and service is declared
+type OnCallRoute struct {
+	// Severities lists the matching severities; empty matches all of them
+	Severities []string `json:"severities" yaml:"severities"`
+	// Services lists the matching services; empty matches all incidents, with or without a service
+	Services []string `json:"services" yaml:"services"`
+	// UserGroups lists Slack user group IDs whose members are paged
+	UserGroups []string `json:"usergroups" yaml:"usergroups"`
+	// Schedules lists on-call schedules whose current responder is paged
+	Schedules []string `json:"schedules" yaml:"schedules"`
+	// Rotation lists Slack user IDs taking turns weekly, handing off on Mondays at 00:00 UTC
+	Rotation []string `json:"rotation" yaml:"rotation"`
+}
+
+// NotificationRoute notifies channels about incidents of a matching severity, service, team and declaring user.
+// Every matching route is used; the notifications channel only gets incidents no route matches.
+type NotificationRoute struct {
+	// Severities lists the matching severities; empty matches all of them
+	Severities []string `json:"severities" yaml:"severities"`
+	// Services lists the matching services; empty matches all incidents, with or without a service
+	Services []string `json:"services" yaml:"services"`
+	// Teams lists the matching team tags; empty matches all incidents, with or without a team
+	Teams []string `json:"teams" yaml:"teams"`
+	// UserGroups lists Slack user group IDs, one of which the person declaring the incident must be in
+	UserGroups []string `json:"usergroups" yaml:"usergroups"`
+	// Channels lists the channels to notify
+	Channels []string `json:"channels" yaml:"channels"`
 }
 
-// Validate checks if required configuration is present
-func (c *Config) Validate() error {
-	if c.SlackBotToken == "" {
-		return &Error{Field: "SLACK_BOT_TOKEN", Message: "Slack bot token is required"}
+// IncidentType holds the defaults and first steps of a kind of incident, e.g. security incidents
+type IncidentType struct {
+	// Name is given with --type
+	Name string `json:"name" yaml:"name"`
+	// Severity is used for incidents declared without one
+	Severity string `json:"severity" yaml:"severity"`
+	// Private incidents get a private channel
+	Private bool `json:"private" yaml:"private"`
+	// Roles lists the roles to fill besides the commander, e.g. "scribe"
+	Roles []string `json:"roles" yaml:"roles"`
+	// Checklist lists the first tasks of the response
+	Checklist []ChecklistItem `json:"checklist" yaml:"checklist"`
+	// Runbooks are bookmarked in the incident channel
+	Runbooks []Runbook `json:"runbooks" yaml:"runbooks"`
+	// InitialMessage is a text/template replacing the message posted in the incident channel
+	InitialMessage string `json:"initial_message" yaml:"initial_message"`
+}
+
+// ChecklistItem is a task of the checklist posted in incident channels
+type ChecklistItem struct {
+	Text string `json:"text" yaml:"text"`
+	// Required items are flagged in the incident channel until they are done
+	Required bool `json:"required" yaml:"required"`
+}
+
+// Runbook is a link bookmarked in incident channels
+type Runbook struct {
+	Title string `json:"title" yaml:"title"`
+	URL   string `json:"url" yaml:"url"`
+}
+
+// EscalationPolicy pages its levels in turn until someone acknowledges an incident of a
+// matching severity and service
+type EscalationPolicy struct {
+	// Name identifies the policy in logs and on the timeline
+	Name string `json:"name" yaml:"name"`
+	// Severities lists the matching severities; empty matches all of them
+	Severities []string `json:"severities" yaml:"severities"`
+	// Services lists the matching services; empty matches all incidents, with or without a service
+	Services []string `json:"services" yaml:"services"`
+	// Levels are paged in order
+	Levels []EscalationLevel `json:"levels" yaml:"levels"`
+}
+
+// EscalationLevel is a level of an escalation policy
+type EscalationLevel struct {
+	// Users lists Slack user IDs to page
+	Users []string `json:"users" yaml:"users"`
+	// UserGroups lists Slack user group IDs whose members are paged
+	UserGroups []string `json:"usergroups" yaml:"usergroups"`
+	// Schedules lists on-call schedules whose current responder is paged
+	Schedules []string `json:"schedules" yaml:"schedules"`
+	// Timeout is how long to wait for an acknowledgement before paging the next level, e.g. "10m"
+	Timeout string `json:"timeout" yaml:"timeout"`
+}
+
+// OnCallHandoff is a weekly on-call rotation whose handoffs are announced with a summary of the shift
+type OnCallHandoff struct {
+	// Name identifies the rotation in /shift oncall note
+	Name string `json:"name" yaml:"name"`
+	// Participants lists Slack user IDs taking turns in order
+	Participants []string `json:"participants" yaml:"participants"`
+	// Day is the weekday of the handoff, e.g. "monday"
+	Day string `json:"day" yaml:"day"`
+	// Time is the local time of the handoff, HH:MM
+	Time string `json:"time" yaml:"time"`
+	// Timezone is an IANA timezone name; UTC when empty
+	Timezone string `json:"timezone" yaml:"timezone"`
+	// Channel is where handoff summaries are posted
+	Channel string `json:"channel" yaml:"channel"`
+}
+
+// Load loads configuration from the YAML file at path, if any, environment variables and
+// secret providers. Environment variables and secrets win over the file. Problems are reported by Validate.
+func Load(path string) *Config {
+	config := &Config{
+		SlashCommand:              "/shift",
+		SlackMode:                 SlackModeSocket,
+		NotificationsChannel:      "general",
+		Port:                      "8080",
+		LogLevel:                  slog.LevelInfo,
+		DigestSchedule:            "0 9 * * 1",
+		StaleIncidentSchedule:     "0 * * * *",
+		ChecklistReminderInterval: "30m",
+	}
+
+	if path != "" {
+		if err := config.loadFile(path); err != nil {
+			config.loadErrors = append(config.loadErrors, err)
+		}
 	}
 
-	if c.SlackSigningSecret == "" {
-		return &Error{Field: "SLACK_SIGNING_SECRET", Message: "Slack signing secret is required"}
+	config.loadEnv()
+
+	return config
+}
+
+// loadFile reads the YAML configuration file at path over the defaults
+func (c *Config) loadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return &Error{Field: path, Message: fmt.Sprintf("failed to read configuration file: %v", err)}
 	}
 
-	if c.SlackAppToken == "" {
-		return &Error{Field: "SLACK_APP_TOKEN", Message: "Slack app token is required for Socket Mode"}
+	defer f.Close()
+
+	decoder := yaml.NewDecoder(f)
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return &Error{Field: path, Message: fmt.Sprintf("invalid configuration file: %v", err)}
 	}
 
 	return nil
 }
 
-// getEnv gets an environment variable with a default value
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+// loadEnv overrides the configuration with the environment variables that are set
+func (c *Config) loadEnv() {
+	envString("DB_URI", &c.DBURI)
+	envString("SLACK_BOT_TOKEN", &c.SlackBotToken)
+	envString("SLACK_SIGNING_SECRET", &c.SlackSigningSecret)
+	envString("SLACK_APP_TOKEN", &c.SlackAppToken)
+	envString("SLACK_MODE", &c.SlackMode)
+	envString("SLACK_CLIENT_ID", &c.SlackClientID)
+	envString("SLACK_CLIENT_SECRET", &c.SlackClientSecret)
+	envString("SLACK_REDIRECT_URL", &c.SlackRedirectURL)
+	envString("SLASH_COMMAND", &c.SlashCommand)
+	envString("NOTIFICATIONS_CHANNEL", &c.NotificationsChannel)
+	envString("PORT", &c.Port)
+	envString("ALERTMANAGER_TOKEN", &c.AlertmanagerToken)
+	envString("DIGEST_CHANNEL", &c.DigestChannel)
+	envString("DIGEST_SCHEDULE", &c.DigestSchedule)
+	envString("STALE_INCIDENT_AFTER", &c.StaleIncidentAfter)
+	envString("STALE_INCIDENT_SCHEDULE", &c.StaleIncidentSchedule)
+	envString("CHECKLIST_REMINDER_INTERVAL", &c.ChecklistReminderInterval)
+	envBool("ADD_ALL_MESSAGES_TO_TIMELINE", &c.AddAllMessagesToTimeline)
+	envList("API_TOKENS", &c.APITokens)
+	envList("CALENDAR_TOKENS", &c.CalendarTokens)
+
+	if value := os.Getenv("LOG_LEVEL"); value != "" {
+		if err := c.LogLevel.UnmarshalText([]byte(value)); err != nil {
+			c.loadErrors = append(c.loadErrors, &Error{Field: "LOG_LEVEL", Message: fmt.Sprintf("invalid log level %q", value)})
+		}
+	}
+
+	c.envJSON("ALERTMANAGER_RULES", &c.AlertmanagerRules)
+	c.envJSON("ONCALL_ROUTES", &c.OnCallRoutes)
+	c.envJSON("NOTIFICATION_ROUTES", &c.NotificationRoutes)
+	c.envJSON("INCIDENT_TYPES", &c.IncidentTypes)
+	c.envJSON("CHECKLIST", &c.Checklist)
+	c.envJSON("ESCALATION_POLICIES", &c.EscalationPolicies)
+	c.envJSON("ONCALL_HANDOFFS", &c.OnCallHandoffs)
+	c.envJSON("STATUS_UPDATE_CADENCES", &c.StatusUpdateCadences)
+	c.envJSON("WORKSPACE_NOTIFICATIONS_CHANNELS", &c.WorkspaceNotificationsChannels)
+
+	c.loadSecrets()
+}
+
+// envJSON replaces target with the JSON value of an environment variable, if set
+func (c *Config) envJSON(key string, target any) {
+	if err := getEnvJSON(key, target); err != nil {
+		c.loadErrors = append(c.loadErrors, err)
 	}
-	return defaultValue
 }
 
-// getEnvBool gets an environment variable and parses it as a boolean
-func getEnvBool(key string, defaultValue bool) bool {
+// envString sets target to an environment variable, if set
+func envString(key string, target *string) {
 	if value := os.Getenv(key); value != "" {
-		if strings.ToLower(value) == "true" {
-			return true
-		}
+		*target = value
+	}
+}
+
+// envBool sets target to an environment variable parsed as a boolean, if set to true or false
+func envBool(key string, target *bool) {
+	switch strings.ToLower(os.Getenv(key)) {
+	case "true":
+		*target = true
+	case "false":
+		*target = false
+	}
+}
 
-		if strings.ToLower(value) == "false" {
-			return false
+// envList sets target to a comma-separated environment variable, skipping empty items, if set
+func envList(key string, target *[]string) {
+	var list []string
+
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
 		}
 	}
 
-	return defaultValue
+	if len(list) > 0 {
+		*target = list
+	}
+}
+
+// getEnvJSON gets an environment variable and decodes it as JSON into target, replacing
+// any value read from the configuration file
+func getEnvJSON(key string, target any) error {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil
+	}
+
+	// Decode into a zero value so that maps from the file aren't merged with the variable
+	fresh := reflect.New(reflect.TypeOf(target).Elem())
+
+	if err := json.Unmarshal([]byte(value), fresh.Interface()); err != nil {
+		return &Error{Field: key, Message: fmt.Sprintf("must be valid JSON: %v", err)}
+	}
+
+	reflect.ValueOf(target).Elem().Set(fresh.Elem())
+
+	return nil
 }
 
 // Error represents a configuration error
 type Error struct {
+	// Field is the path of the bad field in the configuration file, e.g. oncall_routes[0].severities[1],
+	// or the environment variable it was read from
 	Field   string
 	Message string
 }
 
 func (e *Error) Error() string {
-	return e.Message
+	return e.Field + ": " + e.Message
 }
@@ -0,0 +1,346 @@
+package config
+
+import (
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// clearEnv unsets the environment variables read by Load for the duration of the test
+func clearEnv(t *testing.T) {
+	t.Helper()
+
+	for _, field := range reflect.VisibleFields(reflect.TypeOf(Config{})) {
+		if tag := field.Tag.Get("yaml"); tag != "" {
+			t.Setenv(strings.ToUpper(tag), "")
+		}
+	}
+
+	for _, secret := range secretVars {
+		for suffix := range secretProviders {
+			t.Setenv(secret.key+suffix, "")
+		}
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	clearEnv(t)
+
+	path := filepath.Join(t.TempDir(), "ohshift.yaml")
+	data := `
+slack_bot_token: xoxb-file
+notifications_channel: incidents
+log_level: debug
+api_tokens: [one, two]
+status_update_cadences:
+  SEV0: 15m
+  SEV1: 30m
+escalation_policies:
+  - name: critical
+    levels:
+      - users: [U1]
+        timeout: 5m
+`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("SLACK_BOT_TOKEN", "xoxb-env")
+	t.Setenv("STATUS_UPDATE_CADENCES", `{"SEV2": "1h"}`)
+
+	cfg := Load(path)
+
+	if len(cfg.loadErrors) > 0 {
+		t.Fatalf("Load() errors = %v", cfg.loadErrors)
+	}
+
+	if cfg.SlackBotToken != "xoxb-env" {
+		t.Errorf("SlackBotToken = %q, want the environment to win", cfg.SlackBotToken)
+	}
+
+	if cfg.NotificationsChannel != "incidents" || cfg.LogLevel != slog.LevelDebug {
+		t.Errorf("NotificationsChannel, LogLevel = %q, %v, want the values from the file", cfg.NotificationsChannel, cfg.LogLevel)
+	}
+
+	if cfg.SlashCommand != "/shift" {
+		t.Errorf("SlashCommand = %q, want the default", cfg.SlashCommand)
+	}
+
+	if want := []string{"one", "two"}; !reflect.DeepEqual(cfg.APITokens, want) {
+		t.Errorf("APITokens = %v, want %v", cfg.APITokens, want)
+	}
+
+	if want := map[string]string{"SEV2": "1h"}; !reflect.DeepEqual(cfg.StatusUpdateCadences, want) {
+		t.Errorf("StatusUpdateCadences = %v, want the environment to replace the file's %v", cfg.StatusUpdateCadences, want)
+	}
+
+	if len(cfg.EscalationPolicies) != 1 || cfg.EscalationPolicies[0].Levels[0].Timeout != "5m" {
+		t.Errorf("EscalationPolicies = %+v", cfg.EscalationPolicies)
+	}
+}
+
+func TestLoadFileUnknownField(t *testing.T) {
+	clearEnv(t)
+
+	path := filepath.Join(t.TempDir(), "ohshift.yaml")
+	if err := os.WriteFile(path, []byte("slack_bot_tokn: typo\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Load(path).Validate(); err == nil || !strings.Contains(err.Error(), "slack_bot_tokn") {
+		t.Errorf("Validate() error = %v, want the unknown field reported", err)
+	}
+}
+
+func TestExampleConfig(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("SLACK_BOT_TOKEN", "xoxb")
+	t.Setenv("SLACK_SIGNING_SECRET", "secret")
+	t.Setenv("SLACK_APP_TOKEN", "xapp")
+
+	if err := Load("../../ohshift.example.yaml").Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cfg := &Config{
+		SlackSigningSecret:    "secret",
+		SlackAppToken:         "xapp",
+		Port:                  "8080",
+		DigestSchedule:        "0 9 * * 1",
+		StaleIncidentSchedule: "every hour",
+		StatusUpdateCadences:  map[string]string{"SEV9": "15m"},
+		OnCallRoutes:          []OnCallRoute{{Severities: []string{"SEV0", "SEV5"}, Rotation: []string{"U1"}}},
+		NotificationRoutes:    []NotificationRoute{{Teams: []string{"pay ments"}}},
+		IncidentTypes: []IncidentType{
+			{Name: "security", Severity: "SEV1", Runbooks: []Runbook{{Title: "Runbook", URL: "ftp://runbooks"}}},
+			{Name: "security", Roles: []string{"comms lead"}, InitialMessage: "{{ .Title"},
+		},
+		Checklist: []ChecklistItem{{Text: "Notify support"}, {Required: true}},
+		EscalationPolicies: []EscalationPolicy{
+			{Name: "critical", Levels: []EscalationLevel{{Users: []string{"U1"}, Timeout: "5m"}, {Timeout: "10s"}}},
+			{Name: "critical"},
+		},
+		OnCallHandoffs: []OnCallHandoff{{Name: "platform", Participants: []string{"U1"}, Day: "funday", Time: "09:00", Channel: "C1"}},
+	}
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() expected errors")
+	}
+
+	for _, path := range []string{
+		"slack_bot_token",
+		"slack_mode",
+		"stale_incident_schedule",
+		"status_update_cadences.SEV9",
+		"oncall_routes[0].severities[1]",
+		"notification_routes[0].teams[0]",
+		"notification_routes[0].channels",
+		"incident_types[0].runbooks[0].url",
+		"incident_types[1].name",
+		"incident_types[1].roles[0]",
+		"incident_types[1].initial_message",
+		"checklist[1].text",
+		"escalation_policies[0].levels[1]",
+		"escalation_policies[0].levels[1].timeout",
+		"escalation_policies[1].name",
+		"escalation_policies[1].levels",
+		"oncall_handoffs[0].day",
+	} {
+		if !strings.Contains(err.Error(), path+":") {
+			t.Errorf("Validate() error is missing %s:\n%v", path, err)
+		}
+	}
+
+	if strings.Contains(err.Error(), "oncall_routes[0].severities[0]") || strings.Contains(err.Error(), "incident_types[0].severity") {
+		t.Errorf("Validate() reported a valid field:\n%v", err)
+	}
+}
+
+func TestSchemaCoversConfig(t *testing.T) {
+	data, err := os.ReadFile("../../docs/config.schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var schema struct {
+		Properties map[string]json.RawMessage `json:"properties"`
+	}
+
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("invalid schema: %v", err)
+	}
+
+	for _, field := range reflect.VisibleFields(reflect.TypeOf(Config{})) {
+		tag := field.Tag.Get("yaml")
+		if tag == "" {
+			continue
+		}
+
+		if _, ok := schema.Properties[tag]; !ok {
+			t.Errorf("docs/config.schema.json is missing %s", tag)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	old := &Config{NotificationsChannel: "general", SlackBotToken: "xoxb-old", Port: "8080", AlertmanagerToken: "old"}
+	updated := &Config{NotificationsChannel: "incidents", SlackBotToken: "xoxb-new", Port: "8080", AlertmanagerToken: "new"}
+
+	changes := Diff(old, updated)
+
+	want := []Change{
+		{Key: "slack_bot_token", From: "<redacted>", To: "<redacted>", Reloadable: true},
+		{Key: "notifications_channel", From: `"general"`, To: `"incidents"`, Reloadable: true},
+		{Key: "alertmanager_token", From: "<redacted>", To: "<redacted>"},
+	}
+
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("Diff() = %+v, want %+v", changes, want)
+	}
+}
+
+func TestApplyReloadable(t *testing.T) {
+	current := &Config{NotificationsChannel: "general", Port: "8080", APITokens: []string{"old"}}
+	updated := &Config{NotificationsChannel: "incidents", Port: "9090", APITokens: []string{"new"}}
+
+	applied := ApplyReloadable(current, updated)
+
+	if applied.NotificationsChannel != "incidents" {
+		t.Errorf("ApplyReloadable() notifications_channel = %q, want the reloaded value", applied.NotificationsChannel)
+	}
+
+	if applied.Port != "8080" || !reflect.DeepEqual(applied.APITokens, []string{"old"}) {
+		t.Errorf("ApplyReloadable() port = %q, api_tokens = %v, want the running values", applied.Port, applied.APITokens)
+	}
+
+	if current.NotificationsChannel != "general" {
+		t.Errorf("ApplyReloadable() changed the current configuration")
+	}
+}
+
+func TestWatcherReload(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("SLACK_BOT_TOKEN", "xoxb")
+	t.Setenv("SLACK_SIGNING_SECRET", "secret")
+	t.Setenv("SLACK_APP_TOKEN", "xapp")
+
+	path := filepath.Join(t.TempDir(), "ohshift.yaml")
+	write := func(data string) {
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("notifications_channel: general\n")
+
+	var reloaded []*Config
+
+	w := NewWatcher(path, Load(path))
+	w.OnReload(func(c *Config) { reloaded = append(reloaded, c) })
+
+	write("notifications_channel: incidents\n")
+	w.Reload(false)
+
+	if len(reloaded) != 1 || reloaded[0].NotificationsChannel != "incidents" {
+		t.Fatalf("Reload() passed %v to listeners, want the new configuration", reloaded)
+	}
+
+	write("notifications_channel: incidents\nport: none\n")
+	w.Reload(false)
+
+	write("notifications_channel: incidents\n")
+	w.Reload(true)
+
+	if len(reloaded) != 1 {
+		t.Errorf("Reload() passed %d configurations to listeners, want invalid and unchanged ones skipped", len(reloaded))
+	}
+
+	write("notifications_channel: incidents\nslash_command: /incident\n")
+	w.Reload(false)
+
+	if len(reloaded) != 1 {
+		t.Errorf("Reload() passed %d configurations to listeners, want changes needing a restart skipped", len(reloaded))
+	}
+
+	write("notifications_channel: war-room\nslash_command: /incident\n")
+	w.Reload(false)
+
+	if len(reloaded) != 2 || reloaded[1].NotificationsChannel != "war-room" || reloaded[1].SlashCommand != "/shift" {
+		t.Errorf("Reload() passed %+v to listeners, want only the reloadable settings changed", reloaded[len(reloaded)-1])
+	}
+}
+
+func TestLoadSecretFiles(t *testing.T) {
+	clearEnv(t)
+
+	dir := t.TempDir()
+	write := func(name, data string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		return path
+	}
+
+	t.Setenv("SLACK_BOT_TOKEN_FILE", write("bot-token", "xoxb-file\n"))
+	t.Setenv("SLACK_APP_TOKEN_FILE", write("app-token", "xapp-file"))
+	t.Setenv("SLACK_SIGNING_SECRET", "secret")
+	t.Setenv("DB_URI_FILE", filepath.Join(dir, "missing"))
+
+	cfg := Load("")
+
+	if cfg.SlackBotToken != "xoxb-file" || cfg.SlackAppToken != "xapp-file" || cfg.SlackSigningSecret != "secret" {
+		t.Errorf("Load() tokens = %q, %q, %q, want them read from files without trailing newlines",
+			cfg.SlackBotToken, cfg.SlackAppToken, cfg.SlackSigningSecret)
+	}
+
+	if len(cfg.SecretPaths()) != 3 {
+		t.Errorf("SecretPaths() = %v, want the 3 secret files", cfg.SecretPaths())
+	}
+
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "DB_URI_FILE:") {
+		t.Errorf("Validate() = %v, want an error for the missing DB_URI_FILE", err)
+	}
+
+	t.Setenv("SLACK_SIGNING_SECRET_FILE", write("signing-secret", "other"))
+
+	if err := Load("").Validate(); err == nil || !strings.Contains(err.Error(), "SLACK_SIGNING_SECRET_FILE: conflicts with SLACK_SIGNING_SECRET") {
+		t.Errorf("Validate() = %v, want a conflict between SLACK_SIGNING_SECRET and its file", err)
+	}
+}
+
+func TestWatcherReloadSecrets(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("SLACK_SIGNING_SECRET", "secret")
+	t.Setenv("SLACK_APP_TOKEN", "xapp")
+
+	path := filepath.Join(t.TempDir(), "bot-token")
+	if err := os.WriteFile(path, []byte("xoxb-old\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("SLACK_BOT_TOKEN_FILE", path)
+
+	var reloaded []*Config
+
+	w := NewWatcher("", Load(""))
+	w.OnReload(func(c *Config) { reloaded = append(reloaded, c) })
+
+	if err := os.WriteFile(path, []byte("xoxb-new\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	w.Reload(false)
+
+	if len(reloaded) != 1 || reloaded[0].SlackBotToken != "xoxb-new" {
+		t.Errorf("Reload() passed %v to listeners, want the rotated token", reloaded)
+	}
+}
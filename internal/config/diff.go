@@ -0,0 +1,98 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// reloadableKeys are the settings that take effect without a restart when the configuration is reloaded
+var reloadableKeys = map[string]bool{
+	// Used for new database connections, e.g. once rotated credentials are mounted
+	"db_uri":                           true,
+	"slack_bot_token":                  true,
+	"slack_signing_secret":             true,
+	"slack_app_token":                  true,
+	"notifications_channel":            true,
+	"workspace_notifications_channels": true,
+	"add_all_messages_to_timeline":     true,
+	"incident_types":                   true,
+	"checklist":                        true,
+	"log_level":                        true,
+}
+
+// secretKeys are the settings whose values are never logged
+var secretKeys = map[string]bool{
+	"db_uri":               true,
+	"slack_bot_token":      true,
+	"slack_signing_secret": true,
+	"slack_app_token":      true,
+	"slack_client_secret":  true,
+	"alertmanager_token":   true,
+	"api_tokens":           true,
+	"calendar_tokens":      true,
+}
+
+// Change is a setting that differs between two configurations
+type Change struct {
+	// Key is the name of the setting in the configuration file
+	Key string
+	// From and To are the old and new values, "<redacted>" for secrets
+	From string
+	To   string
+	// Reloadable reports whether the change takes effect without a restart
+	Reloadable bool
+}
+
+// Diff returns the settings that differ between old and updated, in the order of Config's fields
+func Diff(old, updated *Config) []Change {
+	var changes []Change
+
+	oldValue, updatedValue := reflect.ValueOf(*old), reflect.ValueOf(*updated)
+
+	for _, field := range reflect.VisibleFields(oldValue.Type()) {
+		key := field.Tag.Get("yaml")
+		if key == "" {
+			continue
+		}
+
+		from, to := oldValue.FieldByIndex(field.Index).Interface(), updatedValue.FieldByIndex(field.Index).Interface()
+		if reflect.DeepEqual(from, to) {
+			continue
+		}
+
+		change := Change{Key: key, From: "<redacted>", To: "<redacted>", Reloadable: reloadableKeys[key]}
+		if !secretKeys[key] {
+			change.From, change.To = formatValue(from), formatValue(to)
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// ApplyReloadable returns a copy of current with the reloadable settings of updated. The other
+// settings keep their current values until a restart.
+func ApplyReloadable(current, updated *Config) *Config {
+	applied := *current
+
+	appliedValue, updatedValue := reflect.ValueOf(&applied).Elem(), reflect.ValueOf(*updated)
+
+	for _, field := range reflect.VisibleFields(appliedValue.Type()) {
+		if reloadableKeys[field.Tag.Get("yaml")] {
+			appliedValue.FieldByIndex(field.Index).Set(updatedValue.FieldByIndex(field.Index))
+		}
+	}
+
+	return &applied
+}
+
+// formatValue formats a setting for the log
+func formatValue(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "?"
+	}
+
+	return string(data)
+}
@@ -0,0 +1,119 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// SecretProvider reads secrets from a backend such as mounted files or a secret store
+type SecretProvider interface {
+	// Secret returns the current value of the secret at ref, e.g. a file path
+	Secret(ref string) (string, error)
+}
+
+// WatchedSecretProvider is a SecretProvider whose secrets live in files that are watched
+// for changes when the configuration is reloaded
+type WatchedSecretProvider interface {
+	SecretProvider
+	// Paths returns the files holding the secret at ref
+	Paths(ref string) []string
+}
+
+// FileProvider reads secrets from files, e.g. Kubernetes secrets mounted in a volume
+type FileProvider struct{}
+
+// Secret returns the contents of the file at ref without its trailing newline
+func (FileProvider) Secret(ref string) (string, error) {
+	data, err := os.ReadFile(ref)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// Paths returns the file holding the secret
+func (FileProvider) Paths(ref string) []string {
+	return []string{ref}
+}
+
+// secretProviders maps environment variable suffixes to the provider resolving them, e.g.
+// SLACK_BOT_TOKEN_FILE names a file read by the FileProvider
+var secretProviders = map[string]SecretProvider{
+	"_FILE": FileProvider{},
+}
+
+// RegisterSecretProvider makes provider resolve the secret settings from environment variables
+// ending with suffix, e.g. "_VAULT" for SLACK_BOT_TOKEN_VAULT. It must be called before Load.
+func RegisterSecretProvider(suffix string, provider SecretProvider) {
+	secretProviders[suffix] = provider
+}
+
+// secretVars lists the environment variables of the settings that can be read from a secret provider
+var secretVars = []struct {
+	key    string
+	target func(*Config) *string
+}{
+	{"DB_URI", func(c *Config) *string { return &c.DBURI }},
+	{"SLACK_BOT_TOKEN", func(c *Config) *string { return &c.SlackBotToken }},
+	{"SLACK_SIGNING_SECRET", func(c *Config) *string { return &c.SlackSigningSecret }},
+	{"SLACK_APP_TOKEN", func(c *Config) *string { return &c.SlackAppToken }},
+	{"SLACK_CLIENT_SECRET", func(c *Config) *string { return &c.SlackClientSecret }},
+}
+
+// loadSecrets reads the secret settings referenced by environment variables from their providers
+func (c *Config) loadSecrets() {
+	suffixes := make([]string, 0, len(secretProviders))
+	for suffix := range secretProviders {
+		suffixes = append(suffixes, suffix)
+	}
+
+	sort.Strings(suffixes)
+
+	for _, secret := range secretVars {
+		var source string
+
+		for _, suffix := range suffixes {
+			ref := os.Getenv(secret.key + suffix)
+			if ref == "" {
+				continue
+			}
+
+			if os.Getenv(secret.key) != "" || source != "" {
+				conflict := secret.key
+				if source != "" {
+					conflict = source
+				}
+
+				c.loadErrors = append(c.loadErrors, &Error{
+					Field:   secret.key + suffix,
+					Message: fmt.Sprintf("conflicts with %s, set only one of them", conflict),
+				})
+
+				continue
+			}
+
+			source = secret.key + suffix
+			provider := secretProviders[suffix]
+
+			if watched, ok := provider.(WatchedSecretProvider); ok {
+				c.secretPaths = append(c.secretPaths, watched.Paths(ref)...)
+			}
+
+			value, err := provider.Secret(ref)
+			if err != nil {
+				c.loadErrors = append(c.loadErrors, &Error{Field: source, Message: fmt.Sprintf("failed to read secret: %v", err)})
+				continue
+			}
+
+			*secret.target(c) = value
+		}
+	}
+}
+
+// SecretPaths returns the files secrets were read from, which are watched for changes
+func (c *Config) SecretPaths() []string {
+	return c.secretPaths
+}
@@ -0,0 +1,302 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/fishnix/ohshift/internal/incident"
+)
+
+// validator collects the problems found in a configuration
+type validator struct {
+	errs []error
+}
+
+// fail records a problem with the field at path
+func (v *validator) fail(path, format string, args ...any) {
+	v.errs = append(v.errs, &Error{Field: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// Validate checks the whole configuration and reports every bad field with its path in the
+// configuration file, e.g. escalation_policies[0].levels[1].timeout
+func (c *Config) Validate() error {
+	v := &validator{errs: append([]error(nil), c.loadErrors...)}
+
+	v.required("slack_bot_token", c.SlackBotToken, "Slack bot token is required")
+
+	switch c.SlackMode {
+	case SlackModeSocket:
+		v.required("slack_app_token", c.SlackAppToken, "Slack app token is required for Socket Mode")
+	case SlackModeHTTP:
+		v.required("slack_signing_secret", c.SlackSigningSecret, "Slack signing secret is required for HTTP mode")
+	default:
+		v.fail("slack_mode", "invalid mode %q, expected %s or %s", c.SlackMode, SlackModeSocket, SlackModeHTTP)
+	}
+
+	if c.OAuthEnabled() {
+		v.required("slack_client_secret", c.SlackClientSecret, "Slack client secret is required for OAuth installs")
+
+		if u, err := url.Parse(c.SlackRedirectURL); err != nil || u.Scheme != "https" || u.Host == "" {
+			v.fail("slack_redirect_url", "invalid redirect URL %q, expected an https URL", c.SlackRedirectURL)
+		}
+	}
+
+	if port, err := strconv.Atoi(c.Port); err != nil || port < 1 || port > 65535 {
+		v.fail("port", "invalid port %q", c.Port)
+	}
+
+	v.cron("digest_schedule", c.DigestSchedule)
+	v.cron("stale_incident_schedule", c.StaleIncidentSchedule)
+
+	if c.StaleIncidentAfter != "" {
+		v.duration("stale_incident_after", c.StaleIncidentAfter, time.Hour)
+	}
+
+	for name, cadence := range c.StatusUpdateCadences {
+		path := fmt.Sprintf("status_update_cadences.%s", name)
+
+		v.severity(path, name)
+		v.duration(path, cadence, time.Minute)
+	}
+
+	for i, rule := range c.AlertmanagerRules {
+		v.validateAlertRule(fmt.Sprintf("alertmanager_rules[%d]", i), rule)
+	}
+
+	for i, route := range c.OnCallRoutes {
+		v.validateOnCallRoute(fmt.Sprintf("oncall_routes[%d]", i), route)
+	}
+
+	for i, route := range c.NotificationRoutes {
+		v.validateNotificationRoute(fmt.Sprintf("notification_routes[%d]", i), route)
+	}
+
+	v.validateIncidentTypes(c.IncidentTypes)
+	v.validateChecklist("checklist", c.Checklist)
+	v.duration("checklist_reminder_interval", c.ChecklistReminderInterval, time.Minute)
+	v.validateEscalationPolicies(c.EscalationPolicies)
+	v.validateOnCallHandoffs(c.OnCallHandoffs)
+
+	return errors.Join(v.errs...)
+}
+
+// validateAlertRule checks an Alertmanager rule
+func (v *validator) validateAlertRule(path string, rule AlertRule) {
+	v.severity(path+".severity", rule.Severity)
+
+	if rule.Title != "" {
+		if _, err := template.New("title").Parse(rule.Title); err != nil {
+			v.fail(path+".title", "invalid template: %v", err)
+		}
+	}
+}
+
+// validateOnCallRoute checks an on-call route
+func (v *validator) validateOnCallRoute(path string, route OnCallRoute) {
+	v.match(path, route.Severities, route.Services)
+
+	if len(route.UserGroups) == 0 && len(route.Schedules) == 0 && len(route.Rotation) == 0 {
+		v.fail(path, "usergroups, schedules or rotation is required")
+	}
+}
+
+// validateNotificationRoute checks a notification route
+func (v *validator) validateNotificationRoute(path string, route NotificationRoute) {
+	v.match(path, route.Severities, route.Services)
+
+	for i, team := range route.Teams {
+		if _, err := incident.ParseTeam(team); err != nil {
+			v.fail(fmt.Sprintf("%s.teams[%d]", path, i), "%v", err)
+		}
+	}
+
+	if len(route.Channels) == 0 {
+		v.fail(path+".channels", "at least one channel is required")
+	}
+
+	for i, channel := range route.Channels {
+		v.required(fmt.Sprintf("%s.channels[%d]", path, i), channel, "channel is required")
+	}
+}
+
+// validateIncidentTypes checks the incident types, their runbooks and initial message templates
+func (v *validator) validateIncidentTypes(types []IncidentType) {
+	names := make(map[string]bool)
+
+	for i, t := range types {
+		path := fmt.Sprintf("incident_types[%d]", i)
+
+		v.unique(path+".name", t.Name, names)
+
+		if t.Name != "" {
+			if _, err := incident.ParseType(t.Name); err != nil {
+				v.fail(path+".name", "%v", err)
+			}
+		}
+
+		if t.Severity != "" {
+			v.severity(path+".severity", t.Severity)
+		}
+
+		for j, role := range t.Roles {
+			if _, err := incident.ParseRole(role); err != nil {
+				v.fail(fmt.Sprintf("%s.roles[%d]", path, j), "%v", err)
+			}
+		}
+
+		v.validateChecklist(path+".checklist", t.Checklist)
+
+		for j, runbook := range t.Runbooks {
+			runbookPath := fmt.Sprintf("%s.runbooks[%d]", path, j)
+
+			v.required(runbookPath+".title", runbook.Title, "title is required")
+
+			if u, err := url.Parse(runbook.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				v.fail(runbookPath+".url", "invalid URL %q, expected an http or https URL", runbook.URL)
+			}
+		}
+
+		if t.InitialMessage != "" {
+			if _, err := template.New("initial_message").Parse(t.InitialMessage); err != nil {
+				v.fail(path+".initial_message", "invalid template: %v", err)
+			}
+		}
+	}
+}
+
+// validateChecklist checks the items of a checklist
+func (v *validator) validateChecklist(path string, items []ChecklistItem) {
+	for i, item := range items {
+		v.required(fmt.Sprintf("%s[%d].text", path, i), item.Text, "text is required")
+	}
+}
+
+// validateEscalationPolicies checks the escalation policies and their levels
+func (v *validator) validateEscalationPolicies(policies []EscalationPolicy) {
+	names := make(map[string]bool)
+
+	for i, policy := range policies {
+		path := fmt.Sprintf("escalation_policies[%d]", i)
+
+		v.unique(path+".name", policy.Name, names)
+		v.match(path, policy.Severities, policy.Services)
+
+		if len(policy.Levels) == 0 {
+			v.fail(path+".levels", "at least one level is required")
+		}
+
+		for j, level := range policy.Levels {
+			levelPath := fmt.Sprintf("%s.levels[%d]", path, j)
+
+			if len(level.Users) == 0 && len(level.UserGroups) == 0 && len(level.Schedules) == 0 {
+				v.fail(levelPath, "users, usergroups or schedules is required")
+			}
+
+			v.duration(levelPath+".timeout", level.Timeout, time.Minute)
+		}
+	}
+}
+
+// validateOnCallHandoffs checks the handoff rotations
+func (v *validator) validateOnCallHandoffs(handoffs []OnCallHandoff) {
+	names := make(map[string]bool)
+
+	for i, handoff := range handoffs {
+		path := fmt.Sprintf("oncall_handoffs[%d]", i)
+
+		v.unique(path+".name", handoff.Name, names)
+		v.required(path+".channel", handoff.Channel, "channel is required")
+
+		if len(handoff.Participants) == 0 {
+			v.fail(path+".participants", "at least one participant is required")
+		}
+
+		if !isWeekday(handoff.Day) {
+			v.fail(path+".day", "invalid day %q, expected a weekday such as monday", handoff.Day)
+		}
+
+		if _, err := time.Parse("15:04", handoff.Time); err != nil {
+			v.fail(path+".time", "invalid time %q, expected HH:MM", handoff.Time)
+		}
+
+		if handoff.Timezone != "" {
+			if _, err := time.LoadLocation(handoff.Timezone); err != nil {
+				v.fail(path+".timezone", "invalid timezone %q", handoff.Timezone)
+			}
+		}
+	}
+}
+
+// required checks that a field is set
+func (v *validator) required(path, value, message string) {
+	if value == "" {
+		v.fail(path, "%s", message)
+	}
+}
+
+// unique checks that a name is set and not used by an earlier entry
+func (v *validator) unique(path, name string, names map[string]bool) {
+	switch {
+	case name == "":
+		v.fail(path, "name is required")
+	case names[name]:
+		v.fail(path, "duplicate name %q", name)
+	}
+
+	names[name] = true
+}
+
+// severity checks a severity name
+func (v *validator) severity(path, value string) {
+	if _, err := incident.ParseSeverity(value); err != nil {
+		v.fail(path, "%v", err)
+	}
+}
+
+// match checks the severities and services an incident is matched against
+func (v *validator) match(path string, severities, services []string) {
+	for i, severity := range severities {
+		v.severity(fmt.Sprintf("%s.severities[%d]", path, i), severity)
+	}
+
+	for i, service := range services {
+		if _, err := incident.ParseService(service); err != nil {
+			v.fail(fmt.Sprintf("%s.services[%d]", path, i), "%v", err)
+		}
+	}
+}
+
+// duration checks a duration of at least minimum
+func (v *validator) duration(path, value string, minimum time.Duration) {
+	if d, err := time.ParseDuration(value); err != nil || d < minimum {
+		v.fail(path, "invalid duration %q, expected at least %s", value, minimum)
+	}
+}
+
+// cron checks a cron expression as accepted by the scheduler
+func (v *validator) cron(path, spec string) {
+	parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+	if _, err := parser.Parse(spec); err != nil {
+		v.fail(path, "invalid cron expression %q: %v", spec, err)
+	}
+}
+
+// isWeekday reports whether value names a weekday, e.g. "monday" or "mon"
+func isWeekday(value string) bool {
+	value = strings.ToLower(value)
+
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if name := strings.ToLower(day.String()); value == name || value == name[:3] {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,215 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/fishnix/ohshift/internal/logger"
+)
+
+// reloadDelay lets editors and config map updates finish writing before the file is read
+const reloadDelay = 500 * time.Millisecond
+
+// Watcher reloads the configuration on SIGHUP or when the configuration file or a secret file
+// changes. Invalid configurations are rejected and the last good one stays in use.
+type Watcher struct {
+	path      string
+	mu        sync.Mutex
+	current   *Config
+	contents  []byte
+	listeners []func(*Config)
+	logger    *slog.Logger
+}
+
+// NewWatcher creates a watcher of the configuration file at path, if any, and the secret files
+// of current, the configuration loaded from them
+func NewWatcher(path string, current *Config) *Watcher {
+	w := &Watcher{
+		path:    path,
+		current: current,
+		logger:  logger.With("component", "config"),
+	}
+
+	w.contents, _ = w.read()
+
+	return w
+}
+
+// read returns the contents of the configuration file followed by the secret files. Missing
+// secret files are left to Load to report.
+func (w *Watcher) read() ([]byte, error) {
+	var contents []byte
+
+	if w.path != "" {
+		data, err := os.ReadFile(w.path)
+		if err != nil {
+			return nil, err
+		}
+
+		contents = append(contents, data...)
+	}
+
+	for _, path := range w.current.SecretPaths() {
+		data, _ := os.ReadFile(path)
+		contents = append(contents, 0)
+		contents = append(contents, data...)
+	}
+
+	return contents, nil
+}
+
+// dirs returns the directories holding the configuration file and the secret files
+func (w *Watcher) dirs() []string {
+	paths := w.current.SecretPaths()
+	if w.path != "" {
+		paths = append([]string{w.path}, paths...)
+	}
+
+	var dirs []string
+
+	for _, path := range paths {
+		if dir := filepath.Dir(path); !slices.Contains(dirs, dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+
+	return dirs
+}
+
+// OnReload registers a listener that is called with every new valid configuration.
+// Listeners must be registered before Run.
+func (w *Watcher) OnReload(listener func(*Config)) {
+	w.listeners = append(w.listeners, listener)
+}
+
+// Run reloads the configuration on SIGHUP and file changes until ctx is done
+func (w *Watcher) Run(ctx context.Context) error {
+	fsWatcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	defer fsWatcher.Close()
+
+	// Watch the directories rather than the files, which editors and Kubernetes config maps
+	// and secrets replace instead of writing in place
+	for _, dir := range w.dirs() {
+		if err := fsWatcher.Add(dir); err != nil {
+			return err
+		}
+	}
+
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+
+	defer signal.Stop(hup)
+
+	w.logger.Info("Watching configuration", "path", w.path, "secret_files", len(w.current.SecretPaths()))
+
+	var (
+		timer   *time.Timer
+		pending <-chan time.Time
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-hup:
+			w.logger.Info("Received SIGHUP, reloading configuration", "path", w.path)
+			w.Reload(true)
+		case event, ok := <-fsWatcher.Events:
+			if !ok {
+				return nil
+			}
+
+			w.logger.Debug("Configuration or secret directory changed", "event", event.String())
+
+			// Several events usually arrive for one save, reload once they stop
+			if timer == nil {
+				timer = time.NewTimer(reloadDelay)
+			} else {
+				timer.Reset(reloadDelay)
+			}
+
+			pending = timer.C
+		case <-pending:
+			pending = nil
+
+			w.Reload(false)
+		case err, ok := <-fsWatcher.Errors:
+			if !ok {
+				return nil
+			}
+
+			w.logger.Warn("Configuration file watcher error", "error", err)
+		}
+	}
+}
+
+// Reload reads and validates the configuration and hands its reloadable settings to the
+// listeners when it is valid. Unless forced, it is not reloaded when no file changed.
+func (w *Watcher) Reload(force bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	contents, err := w.read()
+	if err != nil {
+		w.logger.Error("Failed to read configuration file, keeping the current configuration", "error", err, "path", w.path)
+		return
+	}
+
+	if !force && bytes.Equal(contents, w.contents) {
+		return
+	}
+
+	updated := Load(w.path)
+	if err := updated.Validate(); err != nil {
+		w.logger.Error("Invalid configuration, keeping the current configuration", "error", err, "path", w.path)
+		return
+	}
+
+	// Only a valid configuration is remembered, so that an invalid one is reported until it is fixed
+	w.contents = contents
+
+	changes := Diff(w.current, updated)
+	if len(changes) == 0 {
+		w.logger.Info("Configuration reloaded without changes", "path", w.path)
+		return
+	}
+
+	applied := 0
+
+	for _, change := range changes {
+		if change.Reloadable {
+			w.logger.Info("Configuration changed", "key", change.Key, "from", change.From, "to", change.To)
+
+			applied++
+		} else {
+			w.logger.Warn("Configuration changed, restart to apply it", "key", change.Key, "from", change.From, "to", change.To)
+		}
+	}
+
+	if applied == 0 {
+		return
+	}
+
+	// Settings that need a restart keep their running values
+	w.current = ApplyReloadable(w.current, updated)
+
+	for _, listener := range w.listeners {
+		listener(w.current)
+	}
+
+	w.logger.Info("Configuration reloaded", "path", w.path, "changes", applied)
+}
@@ -0,0 +1,219 @@
+// Package digest posts a weekly summary of incidents to a Slack channel.
+package digest
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/logger"
+	"github.com/fishnix/ohshift/internal/report"
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+const (
+	// JobName identifies the digest in the scheduler
+	JobName = "weekly_digest"
+
+	// maxListed is the number of incidents listed per section before the rest are summarized
+	maxListed = 10
+)
+
+// Poster posts messages to Slack
+type Poster interface {
+	PostMessage(channelID, text string) error
+}
+
+// Digest builds and posts the weekly incident digest
+type Digest struct {
+	channel string
+	store   *store.Store
+	poster  Poster
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+// New creates a new digest posting to the configured digest channel
+func New(cfg *config.Config, st *store.Store, poster Poster) *Digest {
+	return &Digest{
+		channel: cfg.DigestChannel,
+		store:   st,
+		poster:  poster,
+		logger:  logger.With("component", "digest"),
+		now:     time.Now,
+	}
+}
+
+// Summary is the content of a digest
+type Summary struct {
+	From time.Time
+	To   time.Time
+	// Current covers the incidents started in [From, To), Previous the week before
+	Current  *report.Report
+	Previous *report.Report
+	Opened   []*store.Incident
+	Resolved []*store.Incident
+	Open     []*store.Incident
+}
+
+// Run posts the digest for the seven days before today
+func (d *Digest) Run(ctx context.Context) error {
+	now := d.now()
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	from := to.AddDate(0, 0, -7)
+
+	summary, err := d.Build(ctx, from, to)
+	if err != nil {
+		return err
+	}
+
+	if err := d.poster.PostMessage(d.channel, summary.Message()); err != nil {
+		return err
+	}
+
+	d.logger.Info("Weekly digest posted",
+		"channel", d.channel,
+		"from", from,
+		"to", to,
+		"opened", len(summary.Opened),
+		"resolved", len(summary.Resolved),
+		"open", len(summary.Open))
+
+	return nil
+}
+
+// Build gathers the digest for [from, to) and the week before it
+func (d *Digest) Build(ctx context.Context, from, to time.Time) (*Summary, error) {
+	summary := &Summary{From: from, To: to}
+
+	var err error
+
+	if summary.Current, err = report.Generate(ctx, d.store, from, to, ""); err != nil {
+		return nil, err
+	}
+
+	if summary.Previous, err = report.Generate(ctx, d.store, from.AddDate(0, 0, -7), from, ""); err != nil {
+		return nil, err
+	}
+
+	if summary.Opened, _, err = d.store.ListIncidents(ctx, store.IncidentFilter{From: from, To: to}); err != nil {
+		return nil, err
+	}
+
+	if summary.Resolved, _, err = d.store.ListIncidents(ctx, store.IncidentFilter{ResolvedFrom: from, ResolvedTo: to}); err != nil {
+		return nil, err
+	}
+
+	summary.Open, _, err = d.store.ListIncidents(ctx, store.IncidentFilter{Status: string(incident.StatusOpen)})
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// Message formats the summary as a Slack message
+func (s *Summary) Message() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "🗓️ *Weekly incident digest: %s to %s*\n\n",
+		s.From.Format(time.DateOnly), s.To.AddDate(0, 0, -1).Format(time.DateOnly))
+
+	cur, prev := &s.Current.Total, &s.Previous.Total
+
+	fmt.Fprintf(&b, "*Incidents opened:* %d (%s)\n", cur.Incidents, countTrend(cur.Incidents, prev.Incidents))
+
+	for _, sev := range s.Current.Severities {
+		if sev.Incidents > 0 {
+			fmt.Fprintf(&b, "  • %s: %d\n", sev.Severity, sev.Incidents)
+		}
+	}
+
+	fmt.Fprintf(&b, "*Incidents resolved:* %d\n", len(s.Resolved))
+	fmt.Fprintf(&b, "*Still open:* %d\n", len(s.Open))
+
+	if cur.TimeToResolve.Count > 0 {
+		fmt.Fprintf(&b, "*Time to resolve:* mean %s (%s), p90 %s\n",
+			report.FormatDuration(cur.TimeToResolve.Mean),
+			durationTrend(&cur.TimeToResolve, &prev.TimeToResolve),
+			report.FormatDuration(cur.TimeToResolve.P90))
+	}
+
+	if cur.TimeToFirstResponse.Count > 0 {
+		fmt.Fprintf(&b, "*Time to first responder:* mean %s (%s)\n",
+			report.FormatDuration(cur.TimeToFirstResponse.Mean),
+			durationTrend(&cur.TimeToFirstResponse, &prev.TimeToFirstResponse))
+	}
+
+	writeSection(&b, "🚨 Opened", s.Opened, nil)
+	writeSection(&b, "✅ Resolved", s.Resolved, func(inc *store.Incident) string {
+		if inc.ResolvedAt == nil {
+			return ""
+		}
+
+		return fmt.Sprintf(" (resolved in %s)", report.FormatDuration(inc.ResolvedAt.Sub(inc.StartedAt)))
+	})
+	writeSection(&b, "🔥 Still open", s.Open, func(inc *store.Incident) string {
+		return fmt.Sprintf(" (open for %s)", report.FormatDuration(s.To.Sub(inc.StartedAt)))
+	})
+
+	return b.String()
+}
+
+// writeSection lists incidents under a heading; suffix adds details to each line
+func writeSection(b *strings.Builder, heading string, incidents []*store.Incident, suffix func(*store.Incident) string) {
+	if len(incidents) == 0 {
+		return
+	}
+
+	fmt.Fprintf(b, "\n*%s*\n", heading)
+
+	for i, inc := range incidents {
+		if i == maxListed {
+			fmt.Fprintf(b, "…and %d more\n", len(incidents)-maxListed)
+			break
+		}
+
+		fmt.Fprintf(b, "• *%s* %s <#%s>", inc.Severity, inc.PublicTitle(), inc.SlackChannelID)
+
+		if suffix != nil {
+			b.WriteString(suffix(inc))
+		}
+
+		b.WriteString("\n")
+	}
+}
+
+// countTrend describes the change of a count compared with the previous week
+func countTrend(current, previous int) string {
+	switch {
+	case current > previous:
+		return fmt.Sprintf("▲ %d vs previous week", current-previous)
+	case current < previous:
+		return fmt.Sprintf("▼ %d vs previous week", previous-current)
+	default:
+		return "same as previous week"
+	}
+}
+
+// durationTrend describes the change of a mean duration compared with the previous week
+func durationTrend(current, previous *report.Durations) string {
+	if previous.Count == 0 {
+		return "no data for previous week"
+	}
+
+	diff := current.Mean - previous.Mean
+
+	switch {
+	case diff.Round(time.Minute) > 0:
+		return fmt.Sprintf("▲ %s vs previous week", report.FormatDuration(diff))
+	case diff.Round(time.Minute) < 0:
+		return fmt.Sprintf("▼ %s vs previous week", report.FormatDuration(-diff))
+	default:
+		return "same as previous week"
+	}
+}
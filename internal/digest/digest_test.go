@@ -0,0 +1,80 @@
+package digest
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/report"
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+func TestSummaryMessage(t *testing.T) {
+	to := time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)
+	from := to.AddDate(0, 0, -7)
+
+	startedAt := from.Add(24 * time.Hour)
+	resolvedAt := startedAt.Add(2 * time.Hour)
+
+	current := []*store.ReportIncident{
+		{Severity: "SEV1", Status: "resolved", StartedBy: "alice", StartedAt: startedAt, ResolvedAt: &resolvedAt},
+		{Severity: "SEV2", Status: "open", StartedBy: "bob", StartedAt: startedAt},
+	}
+
+	previousResolved := startedAt.Add(-7*24*time.Hour + time.Hour)
+	previous := []*store.ReportIncident{
+		{Severity: "SEV1", Status: "resolved", StartedBy: "alice", StartedAt: startedAt.AddDate(0, 0, -7), ResolvedAt: &previousResolved},
+	}
+
+	summary := &Summary{
+		From:     from,
+		To:       to,
+		Current:  report.Build(from, to, current),
+		Previous: report.Build(from.AddDate(0, 0, -7), from, previous),
+		Opened: []*store.Incident{
+			{Severity: "SEV1", Title: "checkout down", SlackChannelID: "C1", StartedAt: startedAt, ResolvedAt: &resolvedAt},
+			{Severity: "SEV2", Title: "slow search", SlackChannelID: "C2", StartedAt: startedAt},
+		},
+		Resolved: []*store.Incident{
+			{Severity: "SEV1", Title: "checkout down", SlackChannelID: "C1", StartedAt: startedAt, ResolvedAt: &resolvedAt},
+		},
+		Open: []*store.Incident{
+			{Severity: "SEV2", Title: "slow search", SlackChannelID: "C2", StartedAt: startedAt},
+		},
+	}
+
+	message := summary.Message()
+
+	expected := []string{
+		"2025-06-02 to 2025-06-08",
+		"*Incidents opened:* 2 (▲ 1 vs previous week)",
+		"*Incidents resolved:* 1",
+		"*Still open:* 1",
+		"mean 2h0m (▲ 1h0m vs previous week)",
+		"• *SEV1* checkout down <#C1> (resolved in 2h0m)",
+		"• *SEV2* slow search <#C2> (open for 6d0h)",
+	}
+
+	for _, content := range expected {
+		if !strings.Contains(message, content) {
+			t.Errorf("Message() missing %q in:\n%s", content, message)
+		}
+	}
+}
+
+func TestCountTrend(t *testing.T) {
+	tests := []struct {
+		current, previous int
+		want              string
+	}{
+		{3, 1, "▲ 2 vs previous week"},
+		{1, 3, "▼ 2 vs previous week"},
+		{2, 2, "same as previous week"},
+	}
+
+	for _, tt := range tests {
+		if got := countTrend(tt.current, tt.previous); got != tt.want {
+			t.Errorf("countTrend(%d, %d) = %q, want %q", tt.current, tt.previous, got, tt.want)
+		}
+	}
+}
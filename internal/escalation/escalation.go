@@ -0,0 +1,379 @@
+// Package escalation pages the levels of an escalation policy in turn until someone
+// acknowledges the incident.
+package escalation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/logger"
+	"github.com/fishnix/ohshift/internal/paging"
+	"github.com/fishnix/ohshift/internal/queue"
+	"github.com/fishnix/ohshift/internal/report"
+	"github.com/fishnix/ohshift/internal/store"
+	"github.com/fishnix/ohshift/internal/timeline"
+)
+
+const (
+	// JobKind identifies escalation jobs in the job queue
+	JobKind = "escalate"
+
+	// minTimeout is the shortest acknowledgement timeout of a level
+	minTimeout = time.Minute
+)
+
+// Notifier pages responders and posts escalation updates to Slack
+type Notifier interface {
+	paging.GroupResolver
+	PostMessage(channelID, text string) error
+	// PageForAcknowledgement invites responders to the incident channel and sends each of
+	// them a direct message with a button to acknowledge the incident. It returns an error
+	// when none of them could be paged.
+	PageForAcknowledgement(incidentID, channelID, text string, responders []paging.Responder) error
+}
+
+// policy is a validated escalation policy
+type policy struct {
+	name       string
+	severities []incident.Severity
+	services   []string
+	levels     []level
+}
+
+// level is a validated level of an escalation policy
+type level struct {
+	targets paging.Targets
+	timeout time.Duration
+}
+
+// job is the payload of an escalation job
+type job struct {
+	IncidentID string `json:"incident_id"`
+	Level      int    `json:"level"`
+}
+
+// Escalator escalates incidents through the configured escalation policies
+type Escalator struct {
+	policies []policy
+	store    *store.Store
+	queue    *queue.Queue
+	notifier Notifier
+	timeline *timeline.Manager
+	resolver *paging.Resolver
+	logger   *slog.Logger
+	now      func() time.Time
+}
+
+// New creates a new escalator using the configured escalation policies and registers
+// its jobs with q
+func New(cfg *config.Config, st *store.Store, q *queue.Queue, notifier Notifier, timelineMgr *timeline.Manager) (*Escalator, error) {
+	policies, err := parsePolicies(cfg.EscalationPolicies)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Escalator{
+		policies: policies,
+		store:    st,
+		queue:    q,
+		notifier: notifier,
+		timeline: timelineMgr,
+		resolver: paging.NewResolver(st, notifier),
+		logger:   logger.With("component", "escalation"),
+		now:      time.Now,
+	}
+
+	q.Handle(JobKind, e.escalate)
+
+	return e, nil
+}
+
+// parsePolicies validates the configured escalation policies
+func parsePolicies(configured []config.EscalationPolicy) ([]policy, error) {
+	policies := make([]policy, 0, len(configured))
+	names := make(map[string]bool)
+
+	for i, c := range configured {
+		p, err := parsePolicy(c)
+		if err != nil {
+			return nil, fmt.Errorf("escalation policy %d: %w", i, err)
+		}
+
+		if names[p.name] {
+			return nil, fmt.Errorf("escalation policy %d: duplicate name %q", i, p.name)
+		}
+
+		names[p.name] = true
+
+		policies = append(policies, p)
+	}
+
+	return policies, nil
+}
+
+// parsePolicy validates a configured escalation policy
+func parsePolicy(c config.EscalationPolicy) (policy, error) {
+	if c.Name == "" {
+		return policy{}, fmt.Errorf("name is required")
+	}
+
+	if len(c.Levels) == 0 {
+		return policy{}, fmt.Errorf("at least one level is required")
+	}
+
+	severities, services, err := paging.ParseMatch(c.Severities, c.Services)
+	if err != nil {
+		return policy{}, err
+	}
+
+	p := policy{name: c.Name, severities: severities, services: services}
+
+	for i, l := range c.Levels {
+		targets, err := paging.ParseTargets(l.Users, l.UserGroups, l.Schedules, nil)
+		if err != nil {
+			return policy{}, fmt.Errorf("level %d: %w", i+1, err)
+		}
+
+		timeout, err := time.ParseDuration(l.Timeout)
+		if err != nil || timeout < minTimeout {
+			return policy{}, fmt.Errorf("level %d: invalid timeout %q, expected at least %s", i+1, l.Timeout, minTimeout)
+		}
+
+		p.levels = append(p.levels, level{targets: targets, timeout: timeout})
+	}
+
+	return p, nil
+}
+
+// Enabled reports whether any escalation policy is configured
+func (e *Escalator) Enabled() bool {
+	return len(e.policies) > 0
+}
+
+// Start escalates a new incident through the first policy matching its severity and services
+func (e *Escalator) Start(ctx context.Context, inc *incident.Incident) error {
+	p, ok := e.match(inc.Severity, inc.Services)
+	if !ok {
+		e.logger.Debug("No escalation policy matches incident",
+			"incident_id", inc.ID,
+			"severity", inc.Severity,
+			"services", inc.Services)
+
+		return nil
+	}
+
+	if err := e.store.CreateEscalation(ctx, inc.ID, p.name); err != nil {
+		return err
+	}
+
+	if err := e.queue.Enqueue(ctx, JobKind, job{IncidentID: inc.ID, Level: 1}, e.now()); err != nil {
+		return err
+	}
+
+	e.logger.Info("Incident escalation started",
+		"incident_id", inc.ID,
+		"policy", p.name,
+		"levels", len(p.levels))
+
+	return nil
+}
+
+// match returns the first policy matching an incident's severity and services
+func (e *Escalator) match(severity incident.Severity, services []string) (policy, bool) {
+	for _, p := range e.policies {
+		if paging.Matches(p.severities, p.services, severity, services) {
+			return p, true
+		}
+	}
+
+	return policy{}, false
+}
+
+// policy returns the policy with a name
+func (e *Escalator) policy(name string) (policy, bool) {
+	for _, p := range e.policies {
+		if p.name == name {
+			return p, true
+		}
+	}
+
+	return policy{}, false
+}
+
+// escalate pages a level of an incident's escalation policy and schedules the next one.
+// Retried jobs don't page a level twice.
+func (e *Escalator) escalate(ctx context.Context, payload json.RawMessage) error {
+	var j job
+	if err := json.Unmarshal(payload, &j); err != nil {
+		return fmt.Errorf("invalid escalation job: %w", err)
+	}
+
+	escalation, err := e.store.GetEscalation(ctx, j.IncidentID)
+	if errors.Is(err, store.ErrNotFound) {
+		e.logger.Warn("Escalation not found", "incident_id", j.IncidentID)
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if escalation.AcknowledgedAt != nil || escalation.Level > j.Level {
+		return nil
+	}
+
+	inc, err := e.store.GetIncident(ctx, j.IncidentID)
+	if err != nil {
+		return err
+	}
+
+	if incident.Status(inc.Status) != incident.StatusOpen {
+		e.logger.Info("Escalation stopped, incident is no longer open",
+			"incident_id", inc.ID,
+			"status", inc.Status)
+
+		return nil
+	}
+
+	p, ok := e.policy(escalation.Policy)
+	if !ok {
+		e.logger.Warn("Escalation stopped, policy is no longer configured",
+			"incident_id", inc.ID,
+			"policy", escalation.Policy)
+
+		return nil
+	}
+
+	now := e.now()
+
+	if j.Level > len(p.levels) {
+		claimed, err := e.store.ClaimEscalationLevel(ctx, inc.ID, j.Level, now)
+		if err != nil {
+			return err
+		}
+
+		if claimed {
+			e.exhausted(inc, p)
+		}
+
+		return nil
+	}
+
+	current := p.levels[j.Level-1]
+
+	// The level is claimed only once it was paged, so that a failed page fails the job and is
+	// retried with it. A retried job whose level was already claimed doesn't page it again.
+	if escalation.Level < j.Level {
+		if err := e.page(ctx, inc, p, j.Level, current); err != nil {
+			return err
+		}
+
+		if _, err := e.store.ClaimEscalationLevel(ctx, inc.ID, j.Level, now); err != nil {
+			return err
+		}
+	}
+
+	// Scheduling the next level is repeated when a retried job gets here, the level claim
+	// keeps the duplicate from paging anyone
+	return e.queue.Enqueue(ctx, JobKind, job{IncidentID: inc.ID, Level: j.Level + 1}, now.Add(current.timeout))
+}
+
+// page pages the responders of a level and records them on the timeline. It returns an error
+// when none of them could be paged.
+func (e *Escalator) page(ctx context.Context, inc *store.Incident, p policy, n int, l level) error {
+	responders := e.resolver.Resolve(ctx, l.targets)
+	if len(responders) == 0 {
+		e.logger.Warn("No responders to page for escalation level",
+			"incident_id", inc.ID,
+			"policy", p.name,
+			"level", n)
+
+		return nil
+	}
+
+	text := pageMessage(inc, p.name, n, l.timeout)
+	if err := e.notifier.PageForAcknowledgement(inc.ID, inc.SlackChannelID, text, responders); err != nil {
+		return fmt.Errorf("failed to page level %d of %s: %w", n, p.name, err)
+	}
+
+	userIDs := make([]string, 0, len(responders))
+	for _, r := range responders {
+		userIDs = append(userIDs, r.UserID)
+	}
+
+	summary := fmt.Sprintf("Escalated to level %d of %s: %s", n, p.name, paging.Summary(responders))
+	if err := e.timeline.AddEscalatedEntry(inc.ID, p.name, n, userIDs, summary); err != nil {
+		e.logger.Warn("Failed to add escalation to timeline", "error", err, "incident_id", inc.ID)
+	}
+
+	e.logger.Info("Incident escalated",
+		"incident_id", inc.ID,
+		"policy", p.name,
+		"level", n,
+		"paged", len(responders))
+
+	return nil
+}
+
+// exhausted tells the incident channel that every level was paged without an acknowledgement
+func (e *Escalator) exhausted(inc *store.Incident, p policy) {
+	text := fmt.Sprintf("🔺 Nobody acknowledged this incident after paging all %d levels of the *%s* escalation policy.",
+		len(p.levels), p.name)
+
+	if err := e.notifier.PostMessage(inc.SlackChannelID, text); err != nil {
+		e.logger.Error("Failed to post escalation exhausted message", "error", err, "incident_id", inc.ID)
+	}
+
+	e.logger.Warn("Escalation policy exhausted without acknowledgement",
+		"incident_id", inc.ID,
+		"policy", p.name)
+}
+
+// Acknowledge stops the escalation of an incident on behalf of a Slack user. It returns
+// false when the incident isn't being escalated or was already acknowledged.
+func (e *Escalator) Acknowledge(ctx context.Context, incidentID, userID string) (bool, error) {
+	now := e.now()
+
+	acknowledged, err := e.store.AcknowledgeEscalation(ctx, incidentID, userID, now)
+	if err != nil || !acknowledged {
+		return false, err
+	}
+
+	inc, err := e.store.GetIncident(ctx, incidentID)
+	if err != nil {
+		return true, err
+	}
+
+	after := now.Sub(inc.StartedAt)
+
+	if err := e.timeline.AddAcknowledgedEntry(incidentID, userID, after); err != nil {
+		e.logger.Warn("Failed to add acknowledgement to timeline", "error", err, "incident_id", incidentID)
+	}
+
+	text := fmt.Sprintf("🙋 <@%s> acknowledged the incident after %s. Escalation has stopped.",
+		userID, report.FormatDuration(after))
+	if err := e.notifier.PostMessage(inc.SlackChannelID, text); err != nil {
+		e.logger.Error("Failed to post acknowledgement message", "error", err, "incident_id", incidentID)
+	}
+
+	e.logger.Info("Incident acknowledged",
+		"incident_id", incidentID,
+		"user_id", userID,
+		"time_to_acknowledge", after)
+
+	return true, nil
+}
+
+// pageMessage is the direct message paging a level of an escalation policy
+func pageMessage(inc *store.Incident, policyName string, n int, timeout time.Duration) string {
+	return fmt.Sprintf("📟 *%s Incident:* %s in <#%s>\n"+
+		"You are paged by level %d of the *%s* escalation policy. "+
+		"Acknowledge within %s or the next level will be paged.",
+		inc.Severity, inc.Title, inc.SlackChannelID, n, policyName, report.FormatDuration(timeout))
+}
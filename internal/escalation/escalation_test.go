@@ -0,0 +1,72 @@
+package escalation
+
+import (
+	"testing"
+
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/incident"
+)
+
+func TestParsePolicies(t *testing.T) {
+	level := config.EscalationLevel{Users: []string{"U1"}, Timeout: "10m"}
+
+	tests := []struct {
+		name     string
+		policies []config.EscalationPolicy
+		wantErr  bool
+	}{
+		{"valid", []config.EscalationPolicy{{Name: "default", Levels: []config.EscalationLevel{level}}}, false},
+		{"missing name", []config.EscalationPolicy{{Levels: []config.EscalationLevel{level}}}, true},
+		{"no levels", []config.EscalationPolicy{{Name: "default"}}, true},
+		{"no targets", []config.EscalationPolicy{{Name: "default", Levels: []config.EscalationLevel{{Timeout: "10m"}}}}, true},
+		{"short timeout", []config.EscalationPolicy{{Name: "default", Levels: []config.EscalationLevel{{Users: []string{"U1"}, Timeout: "30s"}}}}, true},
+		{"invalid timeout", []config.EscalationPolicy{{Name: "default", Levels: []config.EscalationLevel{{Users: []string{"U1"}}}}}, true},
+		{"invalid severity", []config.EscalationPolicy{{Name: "default", Severities: []string{"SEV9"}, Levels: []config.EscalationLevel{level}}}, true},
+		{"duplicate name", []config.EscalationPolicy{
+			{Name: "default", Levels: []config.EscalationLevel{level}},
+			{Name: "default", Levels: []config.EscalationLevel{level}},
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parsePolicies(tt.policies)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parsePolicies() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	level := config.EscalationLevel{UserGroups: []string{"S1"}, Timeout: "5m"}
+
+	policies, err := parsePolicies([]config.EscalationPolicy{
+		{Name: "payments", Services: []string{"payments"}, Levels: []config.EscalationLevel{level}},
+		{Name: "critical", Severities: []string{"SEV0", "SEV1"}, Levels: []config.EscalationLevel{level, level}},
+	})
+	if err != nil {
+		t.Fatalf("parsePolicies() error = %v", err)
+	}
+
+	e := &Escalator{policies: policies}
+
+	tests := []struct {
+		severity incident.Severity
+		services []string
+		want     string
+	}{
+		{incident.Severity0, []string{"payments"}, "payments"},
+		{incident.Severity0, []string{"search", "payments"}, "payments"},
+		{incident.Severity0, []string{"search"}, "critical"},
+		{incident.Severity1, nil, "critical"},
+		{incident.Severity2, nil, ""},
+	}
+
+	for _, tt := range tests {
+		p, _ := e.match(tt.severity, tt.services)
+		if p.name != tt.want {
+			t.Errorf("match(%s, %q) = %q, want %q", tt.severity, tt.services, p.name, tt.want)
+		}
+	}
+}
@@ -0,0 +1,296 @@
+// Package handoff posts a summary of the outgoing shift when a weekly on-call rotation hands off.
+package handoff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/logger"
+	"github.com/fishnix/ohshift/internal/oncall"
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+// rotationEpoch is the date from which rotations are counted; the first participant's first
+// shift starts on the first handoff day on or after it
+var rotationEpoch = time.Date(1970, 1, 5, 0, 0, 0, 0, time.UTC)
+
+// timeFormat formats shift boundaries in the rotation's timezone
+const timeFormat = "Mon 2006-01-02 15:04 MST"
+
+// ErrNoRotation is returned when a note can't be attached to a rotation
+var ErrNoRotation = errors.New("no rotation")
+
+// Poster posts handoff summaries to Slack
+type Poster interface {
+	// PostMessage posts to a channel, or sends a direct message when given a user ID
+	PostMessage(channelID, text string) error
+}
+
+// Rotation is a validated handoff rotation
+type Rotation struct {
+	Name     string
+	Channel  string
+	Schedule *oncall.Schedule
+	weekday  time.Weekday
+}
+
+// JobName identifies the handoff of the rotation in the scheduler
+func (r *Rotation) JobName() string {
+	return "handoff_" + r.Name
+}
+
+// Spec is the cron expression of the rotation's handoffs
+func (r *Rotation) Spec() string {
+	return fmt.Sprintf("CRON_TZ=%s %d %d * * %d",
+		r.Schedule.Location, r.Schedule.HandoffMinute, r.Schedule.HandoffHour, int(r.weekday))
+}
+
+// Handoffs announces the handoffs of the configured rotations
+type Handoffs struct {
+	rotations []*Rotation
+	store     *store.Store
+	poster    Poster
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+// New creates the handoffs of the configured rotations
+func New(cfg *config.Config, st *store.Store, poster Poster) (*Handoffs, error) {
+	h := &Handoffs{
+		store:  st,
+		poster: poster,
+		logger: logger.With("component", "handoff"),
+		now:    time.Now,
+	}
+
+	names := make(map[string]bool)
+
+	for i, c := range cfg.OnCallHandoffs {
+		r, err := parseRotation(c)
+		if err != nil {
+			return nil, fmt.Errorf("on-call handoff %d: %w", i, err)
+		}
+
+		if names[r.Name] {
+			return nil, fmt.Errorf("on-call handoff %d: duplicate name %q", i, r.Name)
+		}
+
+		names[r.Name] = true
+
+		h.rotations = append(h.rotations, r)
+	}
+
+	return h, nil
+}
+
+// parseRotation validates a configured handoff rotation
+func parseRotation(c config.OnCallHandoff) (*Rotation, error) {
+	name, err := oncall.ParseScheduleName(c.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.Participants) == 0 {
+		return nil, fmt.Errorf("participants are required")
+	}
+
+	if c.Channel == "" {
+		return nil, fmt.Errorf("channel is required")
+	}
+
+	weekday, err := parseWeekday(c.Day)
+	if err != nil {
+		return nil, err
+	}
+
+	hour, minute, err := oncall.ParseHandoff(c.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	timezone := c.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", c.Timezone, err)
+	}
+
+	// The first handoff day on or after the epoch
+	offset := (int(weekday) - int(rotationEpoch.Weekday()) + 7) % 7
+	start := time.Date(rotationEpoch.Year(), rotationEpoch.Month(), rotationEpoch.Day()+offset, 0, 0, 0, 0, loc)
+
+	return &Rotation{
+		Name:    name,
+		Channel: c.Channel,
+		weekday: weekday,
+		Schedule: &oncall.Schedule{
+			Name:          name,
+			Rotation:      oncall.RotationWeekly,
+			Participants:  c.Participants,
+			HandoffHour:   hour,
+			HandoffMinute: minute,
+			Location:      loc,
+			StartDate:     start,
+		},
+	}, nil
+}
+
+// parseWeekday parses a weekday name such as "monday" or "mon"
+func parseWeekday(value string) (time.Weekday, error) {
+	value = strings.ToLower(value)
+
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		if value == name || value == name[:3] {
+			return day, nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid handoff day %q, expected a weekday such as monday", value)
+}
+
+// Enabled reports whether any handoff rotation is configured
+func (h *Handoffs) Enabled() bool {
+	return len(h.rotations) > 0
+}
+
+// Rotations returns the configured rotations
+func (h *Handoffs) Rotations() []*Rotation {
+	return h.rotations
+}
+
+// Job returns the scheduled job announcing the handoffs of a rotation
+func (h *Handoffs) Job(r *Rotation) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		return h.Handoff(ctx, r, h.now())
+	}
+}
+
+// Handoff posts the summary of the shift ending at the handoff at or before now to the
+// rotation's channel and sends it to the incoming person
+func (h *Handoffs) Handoff(ctx context.Context, r *Rotation, now time.Time) error {
+	incoming := r.Schedule.ShiftAt(now)
+	outgoing := r.Schedule.ShiftAt(incoming.Start.Add(-time.Nanosecond))
+
+	incidents, _, err := h.store.ListIncidents(ctx, store.IncidentFilter{
+		From: outgoing.Start,
+		To:   incoming.Start,
+	})
+	if err != nil {
+		return err
+	}
+
+	notes, err := h.store.ListHandoffNotes(ctx, r.Name, outgoing.Start, incoming.Start)
+	if err != nil {
+		return err
+	}
+
+	text := summary(r, outgoing, incoming, incidents, notes)
+
+	if err := h.poster.PostMessage(r.Channel, text); err != nil {
+		return err
+	}
+
+	dm := fmt.Sprintf("📟 You are now on call for *%s* until %s.\n\n%s",
+		r.Name, incoming.End.In(r.Schedule.Location).Format(timeFormat), text)
+	if err := h.poster.PostMessage(incoming.UserID, dm); err != nil {
+		h.logger.Error("Failed to send handoff summary to incoming person",
+			"error", err,
+			"rotation", r.Name,
+			"user_id", incoming.UserID)
+	}
+
+	h.logger.Info("On-call handoff announced",
+		"rotation", r.Name,
+		"outgoing", outgoing.UserID,
+		"incoming", incoming.UserID,
+		"incidents", len(incidents),
+		"notes", len(notes))
+
+	return nil
+}
+
+// AddNote records a handoff note. The note goes to the named rotation, or when no name is
+// given to the rotations its author is on call for, or to the only configured rotation.
+// It returns the names of the rotations the note was added to.
+func (h *Handoffs) AddNote(ctx context.Context, rotation, userID, text string) ([]string, error) {
+	now := h.now()
+
+	var targets []*Rotation
+
+	for _, r := range h.rotations {
+		switch {
+		case rotation != "":
+			if r.Name == rotation {
+				targets = append(targets, r)
+			}
+		case r.Schedule.ShiftAt(now).UserID == userID:
+			targets = append(targets, r)
+		}
+	}
+
+	if rotation == "" && len(targets) == 0 && len(h.rotations) == 1 {
+		targets = h.rotations
+	}
+
+	if len(targets) == 0 {
+		return nil, ErrNoRotation
+	}
+
+	names := make([]string, 0, len(targets))
+
+	for _, r := range targets {
+		note := &store.HandoffNote{Rotation: r.Name, SlackUserID: userID, Note: text, CreatedAt: now}
+		if err := h.store.AddHandoffNote(ctx, note); err != nil {
+			return nil, err
+		}
+
+		names = append(names, r.Name)
+	}
+
+	h.logger.Info("Handoff note added",
+		"rotations", names,
+		"user_id", userID)
+
+	return names, nil
+}
+
+// summary describes the outgoing shift for the incoming person
+func summary(r *Rotation, outgoing, incoming oncall.Shift, incidents []*store.Incident, notes []*store.HandoffNote) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "🔁 *%s on-call handoff:* <@%s> → <@%s>\n", r.Name, outgoing.UserID, incoming.UserID)
+	fmt.Fprintf(&b, "Shift from %s to %s\n",
+		outgoing.Start.In(r.Schedule.Location).Format(timeFormat),
+		outgoing.End.In(r.Schedule.Location).Format(timeFormat))
+
+	if len(incidents) == 0 {
+		b.WriteString("\n*Incidents:* none 🎉\n")
+	} else {
+		fmt.Fprintf(&b, "\n*Incidents (%d):*\n", len(incidents))
+
+		for _, inc := range incidents {
+			fmt.Fprintf(&b, "• *%s* %s <#%s> (%s)\n", inc.Severity, inc.PublicTitle(), inc.SlackChannelID, inc.Status)
+		}
+	}
+
+	if len(notes) == 0 {
+		b.WriteString("\n*Notes:* none")
+	} else {
+		b.WriteString("\n*Notes:*")
+
+		for _, note := range notes {
+			fmt.Fprintf(&b, "\n• <@%s>: %s", note.SlackUserID, note.Note)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,119 @@
+package handoff
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/oncall"
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+func TestParseRotation(t *testing.T) {
+	valid := config.OnCallHandoff{
+		Name:         "platform",
+		Participants: []string{"U1", "U2"},
+		Day:          "Wed",
+		Time:         "09:30",
+		Timezone:     "Europe/Berlin",
+		Channel:      "C1",
+	}
+
+	r, err := parseRotation(valid)
+	if err != nil {
+		t.Fatalf("parseRotation() error = %v", err)
+	}
+
+	if want := "CRON_TZ=Europe/Berlin 30 9 * * 3"; r.Spec() != want {
+		t.Errorf("Spec() = %q, want %q", r.Spec(), want)
+	}
+
+	if r.Schedule.StartDate.Weekday() != time.Wednesday {
+		t.Errorf("StartDate = %v, want a Wednesday", r.Schedule.StartDate)
+	}
+
+	invalid := []func(c *config.OnCallHandoff){
+		func(c *config.OnCallHandoff) { c.Name = "Bad Name" },
+		func(c *config.OnCallHandoff) { c.Participants = nil },
+		func(c *config.OnCallHandoff) { c.Day = "someday" },
+		func(c *config.OnCallHandoff) { c.Time = "9am" },
+		func(c *config.OnCallHandoff) { c.Timezone = "Mars/Olympus" },
+		func(c *config.OnCallHandoff) { c.Channel = "" },
+	}
+
+	for i, modify := range invalid {
+		c := valid
+		modify(&c)
+
+		if _, err := parseRotation(c); err == nil {
+			t.Errorf("parseRotation() case %d expected an error", i)
+		}
+	}
+}
+
+func TestRotationShifts(t *testing.T) {
+	r, err := parseRotation(config.OnCallHandoff{
+		Name:         "platform",
+		Participants: []string{"U1", "U2", "U3"},
+		Day:          "monday",
+		Time:         "09:00",
+		Channel:      "C1",
+	})
+	if err != nil {
+		t.Fatalf("parseRotation() error = %v", err)
+	}
+
+	// Handoff at the scheduled time on Monday 2025-06-02 UTC
+	handoffAt := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+
+	incoming := r.Schedule.ShiftAt(handoffAt)
+	outgoing := r.Schedule.ShiftAt(incoming.Start.Add(-time.Nanosecond))
+
+	if !incoming.Start.Equal(handoffAt) || !outgoing.End.Equal(handoffAt) {
+		t.Errorf("shifts change at %v and %v, want %v", outgoing.End, incoming.Start, handoffAt)
+	}
+
+	if !outgoing.Start.Equal(handoffAt.AddDate(0, 0, -7)) {
+		t.Errorf("outgoing shift starts at %v, want a week before the handoff", outgoing.Start)
+	}
+
+	if outgoing.UserID == incoming.UserID {
+		t.Errorf("outgoing and incoming are both %s", outgoing.UserID)
+	}
+}
+
+func TestSummary(t *testing.T) {
+	r := &Rotation{Name: "platform", Schedule: &oncall.Schedule{Location: time.UTC}}
+	start := time.Date(2025, 5, 26, 9, 0, 0, 0, time.UTC)
+	outgoing := oncall.Shift{UserID: "U1", Start: start, End: start.AddDate(0, 0, 7)}
+	incoming := oncall.Shift{UserID: "U2", Start: outgoing.End, End: outgoing.End.AddDate(0, 0, 7)}
+
+	incidents := []*store.Incident{
+		{Severity: "SEV1", Title: "Payments down", SlackChannelID: "C1", Status: "resolved"},
+		{Severity: "SEV2", Title: "Payroll data exposed", SlackChannelID: "G1", Status: "open", Private: true},
+	}
+	notes := []*store.HandoffNote{{SlackUserID: "U1", Note: "watch the search cluster"}}
+
+	got := summary(r, outgoing, incoming, incidents, notes)
+
+	for _, want := range []string{
+		"<@U1> → <@U2>",
+		"Incidents (2)",
+		"*SEV1* Payments down <#C1> (resolved)",
+		"*SEV2* " + store.PrivateTitle + " <#G1> (open)",
+		"<@U1>: watch the search cluster",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("summary() = %q, missing %q", got, want)
+		}
+	}
+
+	if strings.Contains(got, "Payroll") {
+		t.Errorf("summary() = %q, shows the title of a private incident", got)
+	}
+
+	if got := summary(r, outgoing, incoming, nil, nil); !strings.Contains(got, "*Incidents:* none") {
+		t.Errorf("summary() without incidents = %q", got)
+	}
+}
@@ -0,0 +1,119 @@
+// Package health provides the readiness checks exposed over HTTP.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/logger"
+)
+
+// checkTimeout bounds how long a single readiness check may take
+const checkTimeout = 3 * time.Second
+
+// Check reports whether a dependency is ready, returning an error describing why not
+type Check func(ctx context.Context) error
+
+// namedCheck is a registered Check
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Readiness runs the registered checks to decide whether the bot can serve traffic
+type Readiness struct {
+	checks []namedCheck
+	logger *slog.Logger
+	mu     sync.RWMutex
+}
+
+// Report is the body of a readiness response
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+// NewReadiness creates a Readiness without any checks
+func NewReadiness() *Readiness {
+	return &Readiness{
+		logger: logger.With("component", "readiness"),
+	}
+}
+
+// Add registers a named check
+func (r *Readiness) Add(name string, check Check) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.checks = append(r.checks, namedCheck{name: name, check: check})
+}
+
+// Run runs every check concurrently and reports whether all of them passed
+func (r *Readiness) Run(ctx context.Context) (*Report, bool) {
+	r.mu.RLock()
+	checks := make([]namedCheck, len(r.checks))
+	copy(checks, r.checks)
+	r.mu.RUnlock()
+
+	results := make([]error, len(checks))
+
+	var wg sync.WaitGroup
+
+	for i, c := range checks {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+			defer cancel()
+
+			results[i] = c.check(checkCtx)
+		}()
+	}
+
+	wg.Wait()
+
+	report := &Report{Status: "ready", Checks: make(map[string]string, len(checks))}
+	ready := true
+
+	for i, c := range checks {
+		if results[i] != nil {
+			ready = false
+			report.Checks[c.name] = results[i].Error()
+
+			continue
+		}
+
+		report.Checks[c.name] = "ok"
+	}
+
+	if !ready {
+		report.Status = "not_ready"
+	}
+
+	return report, ready
+}
+
+// ServeHTTP responds 200 when every check passes and 503 otherwise
+func (r *Readiness) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	report, ready := r.Run(req.Context())
+
+	status := http.StatusOK
+	if !ready {
+		status = http.StatusServiceUnavailable
+
+		r.logger.Warn("Readiness check failed", "checks", report.Checks)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		r.logger.Error("Failed to encode readiness response", "error", err)
+	}
+}
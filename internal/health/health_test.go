@@ -0,0 +1,71 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestReadiness(t *testing.T) {
+	tests := []struct {
+		name       string
+		checks     map[string]Check
+		wantStatus int
+		wantChecks map[string]string
+	}{
+		{
+			name:       "no checks",
+			checks:     map[string]Check{},
+			wantStatus: http.StatusOK,
+			wantChecks: map[string]string{},
+		},
+		{
+			name: "all checks pass",
+			checks: map[string]Check{
+				"database":    func(context.Context) error { return nil },
+				"socket_mode": func(context.Context) error { return nil },
+			},
+			wantStatus: http.StatusOK,
+			wantChecks: map[string]string{"database": "ok", "socket_mode": "ok"},
+		},
+		{
+			name: "one check fails",
+			checks: map[string]Check{
+				"database":    func(context.Context) error { return nil },
+				"socket_mode": func(context.Context) error { return errors.New("not connected") },
+			},
+			wantStatus: http.StatusServiceUnavailable,
+			wantChecks: map[string]string{"database": "ok", "socket_mode": "not connected"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			readiness := NewReadiness()
+			for name, check := range tt.checks {
+				readiness.Add(name, check)
+			}
+
+			rec := httptest.NewRecorder()
+			readiness.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("ServeHTTP() status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+
+			var report Report
+			if err := json.Unmarshal(rec.Body.Bytes(), &report); err != nil {
+				t.Fatalf("failed to decode readiness report: %v", err)
+			}
+
+			for name, want := range tt.wantChecks {
+				if got := report.Checks[name]; got != want {
+					t.Errorf("check %s = %q, want %q", name, got, want)
+				}
+			}
+		})
+	}
+}
@@ -2,11 +2,14 @@
 package incident
 
 import (
-	"crypto/rand"
+	"errors"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
+
+	"github.com/gofrs/uuid/v5"
 )
 
 // Severity represents the incident severity level
@@ -23,15 +26,50 @@ const (
 	Severity3 Severity = "SEV3"
 )
 
+// Status represents the lifecycle state of an incident
+type Status string
+
+const (
+	// StatusOpen is an incident that is still being worked on
+	StatusOpen Status = "open"
+	// StatusResolved is an incident that has been resolved
+	StatusResolved Status = "resolved"
+	// StatusCancelled is an incident that was declared by mistake
+	StatusCancelled Status = "cancelled"
+)
+
+// tagPattern restricts service, team, type and role names so they are easy to type in commands
+var tagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,79}$`)
+
+// RoleCommander is the role of the person leading the incident response
+const RoleCommander = "commander"
+
+// ErrNoSeverity is returned for incidents declared without a severity when their type doesn't default one
+var ErrNoSeverity = errors.New("severity is required")
+
 // Incident represents an incident
 type Incident struct {
-	ID          string
-	Title       string
-	Description string
-	Severity    Severity
-	ChannelName string
-	StartedBy   string
-	StartedAt   time.Time
+	ID              string
+	Title           string
+	Description     string
+	Severity        Severity
+	Status          Status
+	ChannelID       string
+	ChannelName     string
+	StartedBy       string
+	StartedByUserID string
+	StartedAt       time.Time
+	// Services are the affected services
+	Services []string
+	// Team is the team the incident is tagged with, given with --team
+	Team string
+	// Type is the incident type given with --type, if any
+	Type string
+	// Private incidents get a private channel
+	Private bool
+	// TeamID and EnterpriseID identify the Slack workspace the incident was declared in
+	TeamID       string
+	EnterpriseID string
 }
 
 // Command represents a parsed slash command
@@ -42,12 +80,26 @@ type Command struct {
 	Description string
 	Username    string
 	UserID      string
+	// Services are the affected services given with --service, if any
+	Services []string
+	// Team is the team tag given with --team, if any
+	Team string
+	// Type is the incident type given with --type, if any. Its default severity is used when
+	// Severity is empty.
+	Type string
+	// Private declares the incident in a private channel
+	Private bool
+	// TeamID and EnterpriseID identify the Slack workspace to declare the incident in; the
+	// default workspace when empty
+	TeamID       string
+	EnterpriseID string
 }
 
-// ParseCommand parses a slash command string into a Command
+// ParseCommand parses a slash command string into a Command. The severity can be left out
+// when an incident type is given with --type.
 func ParseCommand(text string) (*Command, error) {
 	parts := strings.Fields(text)
-	if len(parts) < 4 {
+	if len(parts) < 3 {
 		return nil, fmt.Errorf("insufficient arguments")
 	}
 
@@ -55,17 +107,27 @@ func ParseCommand(text string) (*Command, error) {
 		return nil, fmt.Errorf("unknown action: %s", parts[0])
 	}
 
-	severity := Severity(strings.ToUpper(parts[1]))
-	if !isValidSeverity(severity) {
-		return nil, fmt.Errorf("invalid severity: %s", parts[1])
-	}
+	var severity Severity
+
+	if parts[1] != "incident" {
+		if len(parts) < 4 {
+			return nil, fmt.Errorf("insufficient arguments")
+		}
 
-	if parts[2] != "incident" {
-		return nil, fmt.Errorf("expected 'incident' keyword, got: %s", parts[2])
+		severity = Severity(strings.ToUpper(parts[1]))
+		if !isValidSeverity(severity) {
+			return nil, fmt.Errorf("invalid severity: %s", parts[1])
+		}
+
+		if parts[2] != "incident" {
+			return nil, fmt.Errorf("expected 'incident' keyword, got: %s", parts[2])
+		}
+
+		parts = parts[1:]
 	}
 
 	// Find the description separator
-	textAfterIncident := strings.Join(parts[3:], " ")
+	textAfterIncident := strings.Join(parts[2:], " ")
 	title := textAfterIncident
 	description := ""
 
@@ -76,6 +138,52 @@ func ParseCommand(text string) (*Command, error) {
 		description = strings.TrimSpace(parts[1])
 	}
 
+	title, serviceList, err := parseOption(title, "--service")
+	if err != nil {
+		return nil, err
+	}
+
+	var services []string
+	if serviceList != "" {
+		if services, err = ParseServices(serviceList); err != nil {
+			return nil, err
+		}
+	}
+
+	title, teamName, err := parseOption(title, "--team")
+	if err != nil {
+		return nil, err
+	}
+
+	var team string
+	if teamName != "" {
+		if team, err = ParseTeam(teamName); err != nil {
+			return nil, err
+		}
+	}
+
+	title, typeName, err := parseOption(title, "--type")
+	if err != nil {
+		return nil, err
+	}
+
+	var incidentType string
+	if typeName != "" {
+		if incidentType, err = ParseType(typeName); err != nil {
+			return nil, err
+		}
+	}
+
+	title, private := parseFlag(title, "--private")
+
+	// A misplaced --private must never leave the incident public
+	description, privateInDescription := parseFlag(description, "--private")
+	private = private || privateInDescription
+
+	if severity == "" && incidentType == "" {
+		return nil, ErrNoSeverity
+	}
+
 	if title == "" {
 		return nil, fmt.Errorf("incident title cannot be empty")
 	}
@@ -85,9 +193,130 @@ func ParseCommand(text string) (*Command, error) {
 		Severity:    severity,
 		Title:       title,
 		Description: description,
+		Services:    services,
+		Team:        team,
+		Type:        incidentType,
+		Private:     private,
 	}, nil
 }
 
+// escapedPattern matches a mention, channel, special mention or link in escaped slash command text
+var escapedPattern = regexp.MustCompile(`<([^<>]*)>`)
+
+// entityReplacer decodes the HTML entities Slack escapes &, < and > to
+var entityReplacer = strings.NewReplacer("&lt;", "<", "&gt;", ">", "&amp;", "&")
+
+// UnescapeText turns escaped slash command text back into what was typed. Mentions become
+// @name, channels #name and links their label or URL.
+func UnescapeText(text string) string {
+	text = escapedPattern.ReplaceAllStringFunc(text, func(match string) string {
+		ref, label, hasLabel := strings.Cut(match[1:len(match)-1], "|")
+
+		switch {
+		case strings.HasPrefix(ref, "@"), strings.HasPrefix(ref, "#"):
+			if hasLabel {
+				return ref[:1] + strings.TrimPrefix(label, ref[:1])
+			}
+
+			return ref
+		case strings.HasPrefix(ref, "!"):
+			if hasLabel {
+				return label
+			}
+
+			return "@" + ref[1:]
+		case hasLabel:
+			return label
+		default:
+			return ref
+		}
+	})
+
+	return entityReplacer.Replace(text)
+}
+
+// ParseService validates a service name and returns it in lower case
+func ParseService(name string) (string, error) {
+	return parseTag("service", name)
+}
+
+// ParseServices validates a comma-separated list of service names such as "payments,checkout"
+// and returns them in lower case without duplicates
+func ParseServices(list string) ([]string, error) {
+	var services []string
+
+	for _, name := range strings.Split(list, ",") {
+		service, err := ParseService(strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+
+		if !slices.Contains(services, service) {
+			services = append(services, service)
+		}
+	}
+
+	return services, nil
+}
+
+// ParseTeam validates a team name and returns it in lower case
+func ParseTeam(name string) (string, error) {
+	return parseTag("team", name)
+}
+
+// ParseType validates an incident type name and returns it in lower case
+func ParseType(name string) (string, error) {
+	return parseTag("incident type", name)
+}
+
+// ParseRole validates an incident role name such as "scribe" and returns it in lower case
+func ParseRole(name string) (string, error) {
+	return parseTag("role", name)
+}
+
+// parseTag validates the name of a kind of tag and returns it in lower case
+func parseTag(kind, name string) (string, error) {
+	tag := strings.ToLower(name)
+	if !tagPattern.MatchString(tag) {
+		return "", fmt.Errorf("invalid %s name: %s", kind, name)
+	}
+
+	return tag, nil
+}
+
+// parseOption removes an option such as "--service <name>" from an incident title and returns its value
+func parseOption(title, option string) (string, string, error) {
+	fields := strings.Fields(title)
+
+	for i, field := range fields {
+		if field != option {
+			continue
+		}
+
+		if i+1 >= len(fields) {
+			return "", "", fmt.Errorf("%s requires a name", option)
+		}
+
+		rest := append(fields[:i:i], fields[i+2:]...)
+
+		return strings.Join(rest, " "), fields[i+1], nil
+	}
+
+	return title, "", nil
+}
+
+// parseFlag removes a flag such as "--private" from an incident title or description and reports whether it was given
+func parseFlag(text, flag string) (string, bool) {
+	fields := strings.Fields(text)
+
+	i := slices.Index(fields, flag)
+	if i < 0 {
+		return text, false
+	}
+
+	return strings.Join(slices.Delete(fields, i, i+1), " "), true
+}
+
 // GenerateChannelName generates a Slack-compatible channel name for an incident
 func GenerateChannelName(incident *Incident) string {
 	// Format: _inc-YYYYMMDD-HHMMSS-title
@@ -135,10 +364,24 @@ func createSlug(s string) string {
 	return s
 }
 
+// ParseSeverity parses a case-insensitive severity such as "sev1" into a Severity
+func ParseSeverity(s string) (Severity, error) {
+	severity := Severity(strings.ToUpper(strings.TrimSpace(s)))
+	if !isValidSeverity(severity) {
+		return "", fmt.Errorf("invalid severity: %s", s)
+	}
+
+	return severity, nil
+}
+
+// Severities returns all valid severities from highest to lowest priority
+func Severities() []Severity {
+	return []Severity{Severity0, Severity1, Severity2, Severity3}
+}
+
 // isValidSeverity checks if a severity is valid
 func isValidSeverity(s Severity) bool {
-	validSeverities := []Severity{Severity0, Severity1, Severity2, Severity3}
-	for _, valid := range validSeverities {
+	for _, valid := range Severities() {
 		if s == valid {
 			return true
 		}
@@ -149,12 +392,19 @@ func isValidSeverity(s Severity) bool {
 
 // GetHelpMessage returns the help message for the slash command
 func GetHelpMessage() string {
-	return `Usage: /shift start <severity> incident <incident title> [-- <description>]
+	return `Usage: /shift start [<severity>] incident <incident title> [--service <service>[,<service>...]] [--team <team>] [--type <type>] [--private] [-- <description>]
 
 Examples:
   /shift start SEV0 incident the website is down
   /shift start SEV1 incident database connection issues -- Connection pool exhausted, affecting all users
   /shift start SEV2 incident slow response times -- API response times > 5s, investigating root cause
+  /shift start SEV1 incident card payments failing --service payments,checkout
+  /shift start SEV2 incident checkout latency --service checkout --team storefront
+  /shift start incident leaked credentials --type security
+  /shift start SEV1 incident payroll data exposed --private
+
+The severity can be left out when the incident type sets a default. Private incidents get a
+private channel and are announced without their details.
 
 Valid severities:
   SEV0: Major Customer Impact
@@ -162,17 +412,31 @@ Valid severities:
   SEV2: Low/No Customer Impact
   SEV3: Maintenance
 
-This will create an incident channel and post a notification.`
+This will create an incident channel and post a notification.
+
+Other commands:
+  /shift timeline             Show the timeline (in an incident channel)
+  /shift severity <severity>  Change the severity (in an incident channel)
+  /shift resolve              Resolve the incident (in an incident channel)
+  /shift role <role> [@user]  Assign a role such as scribe, to yourself by default (in an incident channel)
+  /shift service add|remove <service>
+                              Change the affected services (in an incident channel)
+  /shift update <status> -- <text>
+                              Post a status update for stakeholders (in an incident channel);
+                              status is investigating, identified, monitoring or resolved
+  /shift stats [30d] [--service <service>]
+                              Show incident statistics for the last 30 days, 2w, 12h, ...
+  /shift oncall [schedule]    Show who is on call now and next; /shift oncall help for schedules, overrides and handoff notes`
 }
 
-// GenerateIncidentID generates a unique incident ID
+// GenerateIncidentID generates a unique incident ID, which is also the incident's database primary key
 func GenerateIncidentID() string {
-	// Generate a random 8-byte ID
-	bytes := make([]byte, 8)
-	if _, err := rand.Read(bytes); err != nil {
-		// Fallback to timestamp-based ID if random generation fails
-		return fmt.Sprintf("inc_%d", time.Now().UnixNano())
+	// Time-ordered UUIDs keep the incidents index compact
+	id, err := uuid.NewV7()
+	if err != nil {
+		// Fallback to a random UUID if the time-ordered one can't be generated
+		return uuid.Must(uuid.NewV4()).String()
 	}
 
-	return fmt.Sprintf("inc_%x", bytes)
+	return id.String()
 }
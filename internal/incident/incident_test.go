@@ -1,6 +1,7 @@
 package incident
 
 import (
+	"slices"
 	"testing"
 	"time"
 )
@@ -56,6 +57,111 @@ func TestParseCommand(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid command with service",
+			text: "start SEV1 incident card payments --service Payments failing -- Declines spiking",
+			want: &Command{
+				Action:      "start",
+				Severity:    Severity1,
+				Title:       "card payments failing",
+				Description: "Declines spiking",
+				Services:    []string{"payments"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid command with service and team",
+			text: "start SEV2 incident checkout latency --team Storefront --service checkout",
+			want: &Command{
+				Action:   "start",
+				Severity: Severity2,
+				Title:    "checkout latency",
+				Services: []string{"checkout"},
+				Team:     "storefront",
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid command with services",
+			text: "start SEV0 incident checkout down --service payments,Checkout,payments",
+			want: &Command{
+				Action:   "start",
+				Severity: Severity0,
+				Title:    "checkout down",
+				Services: []string{"payments", "checkout"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid command with type",
+			text: "start SEV1 incident leaked credentials --type Security -- Token found in a public repo",
+			want: &Command{
+				Action:      "start",
+				Severity:    Severity1,
+				Title:       "leaked credentials",
+				Description: "Token found in a public repo",
+				Type:        "security",
+			},
+			wantErr: false,
+		},
+		{
+			name: "type without severity",
+			text: "start incident leaked credentials --type security",
+			want: &Command{
+				Action: "start",
+				Title:  "leaked credentials",
+				Type:   "security",
+			},
+			wantErr: false,
+		},
+		{
+			name: "private",
+			text: "start SEV1 incident payroll data exposed --private -- Spreadsheet shared publicly",
+			want: &Command{
+				Action:      "start",
+				Severity:    Severity1,
+				Title:       "payroll data exposed",
+				Description: "Spreadsheet shared publicly",
+				Private:     true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "private after the description separator",
+			text: "start SEV1 incident payroll data exposed -- Spreadsheet shared publicly --private",
+			want: &Command{
+				Action:      "start",
+				Severity:    Severity1,
+				Title:       "payroll data exposed",
+				Description: "Spreadsheet shared publicly",
+				Private:     true,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "no severity without type",
+			text:    "start incident leaked credentials",
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "invalid service in list",
+			text:    "start SEV0 incident checkout down --service payments,",
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "invalid team",
+			text:    "start SEV1 incident card payments failing --team pay/ments",
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "service without name",
+			text:    "start SEV1 incident card payments failing --service",
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name:    "insufficient arguments",
 			text:    "start SEV0",
@@ -122,6 +228,45 @@ func TestParseCommand(t *testing.T) {
 				if got.Description != tt.want.Description {
 					t.Errorf("ParseCommand() Description = %v, want %v", got.Description, tt.want.Description)
 				}
+
+				if !slices.Equal(got.Services, tt.want.Services) {
+					t.Errorf("ParseCommand() Services = %v, want %v", got.Services, tt.want.Services)
+				}
+
+				if got.Team != tt.want.Team {
+					t.Errorf("ParseCommand() Team = %v, want %v", got.Team, tt.want.Team)
+				}
+
+				if got.Type != tt.want.Type {
+					t.Errorf("ParseCommand() Type = %v, want %v", got.Type, tt.want.Type)
+				}
+
+				if got.Private != tt.want.Private {
+					t.Errorf("ParseCommand() Private = %v, want %v", got.Private, tt.want.Private)
+				}
+			}
+		})
+	}
+}
+
+func TestUnescapeText(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "plain text", text: "start SEV1 incident website down", want: "start SEV1 incident website down"},
+		{name: "entities", text: "db &lt;primary&gt; &amp; replica", want: "db <primary> & replica"},
+		{name: "user mention", text: "paged <@U0123|alice> and <@U0456>", want: "paged @alice and @U0456"},
+		{name: "channel", text: "see <#C0123|ops>", want: "see #ops"},
+		{name: "special mention", text: "<!here> and <!subteam^S0123|@sre>", want: "@here and @sre"},
+		{name: "links", text: "<https://status.example.com> and <https://example.com|dashboard>", want: "https://status.example.com and dashboard"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnescapeText(tt.text); got != tt.want {
+				t.Errorf("UnescapeText() = %q, want %q", got, tt.want)
 			}
 		})
 	}
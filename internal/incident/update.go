@@ -0,0 +1,71 @@
+package incident
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UpdateStatus is the stage of an incident reported by a status update
+type UpdateStatus string
+
+const (
+	// UpdateInvestigating means the cause is not known yet
+	UpdateInvestigating UpdateStatus = "investigating"
+	// UpdateIdentified means the cause is known and a fix is in progress
+	UpdateIdentified UpdateStatus = "identified"
+	// UpdateMonitoring means a fix is in place and its effect is being watched
+	UpdateMonitoring UpdateStatus = "monitoring"
+	// UpdateResolved means the incident is over
+	UpdateResolved UpdateStatus = "resolved"
+)
+
+// StatusUpdate is a parsed /shift update command
+type StatusUpdate struct {
+	Status UpdateStatus
+	Text   string
+}
+
+// UpdateStatuses returns all valid status update stages in lifecycle order
+func UpdateStatuses() []UpdateStatus {
+	return []UpdateStatus{UpdateInvestigating, UpdateIdentified, UpdateMonitoring, UpdateResolved}
+}
+
+// ParseStatusUpdate parses the arguments of /shift update, "<status> -- <text>"
+func ParseStatusUpdate(text string) (*StatusUpdate, error) {
+	statusText, updateText, found := strings.Cut(text, "--")
+	if !found {
+		return nil, fmt.Errorf("expected '<status> -- <text>'")
+	}
+
+	status := UpdateStatus(strings.ToLower(strings.TrimSpace(statusText)))
+	if !isValidUpdateStatus(status) {
+		return nil, fmt.Errorf("invalid status: %s", strings.TrimSpace(statusText))
+	}
+
+	updateText = strings.TrimSpace(updateText)
+	if updateText == "" {
+		return nil, fmt.Errorf("status update text cannot be empty")
+	}
+
+	return &StatusUpdate{Status: status, Text: updateText}, nil
+}
+
+// Title returns the status for display, e.g. "Identified"
+func (s UpdateStatus) Title() string {
+	if s == "" {
+		return ""
+	}
+
+	return strings.ToUpper(string(s[:1])) + string(s[1:])
+}
+
+// isValidUpdateStatus checks if a status update stage is valid
+func isValidUpdateStatus(s UpdateStatus) bool {
+	for _, valid := range UpdateStatuses() {
+		if s == valid {
+			return true
+		}
+	}
+
+	return false
+}
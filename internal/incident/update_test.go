@@ -0,0 +1,60 @@
+package incident
+
+import "testing"
+
+func TestParseStatusUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    *StatusUpdate
+		wantErr bool
+	}{
+		{
+			name: "valid update",
+			text: "identified -- bad deploy of the checkout service, rolling back",
+			want: &StatusUpdate{Status: UpdateIdentified, Text: "bad deploy of the checkout service, rolling back"},
+		},
+		{
+			name: "status is case-insensitive",
+			text: "  Monitoring --   error rates back to normal ",
+			want: &StatusUpdate{Status: UpdateMonitoring, Text: "error rates back to normal"},
+		},
+		{
+			name: "text may contain the separator",
+			text: "investigating -- checking db -- and cache",
+			want: &StatusUpdate{Status: UpdateInvestigating, Text: "checking db -- and cache"},
+		},
+		{
+			name:    "missing separator",
+			text:    "investigating still looking",
+			wantErr: true,
+		},
+		{
+			name:    "invalid status",
+			text:    "fixed -- all good",
+			wantErr: true,
+		},
+		{
+			name:    "empty text",
+			text:    "resolved --  ",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseStatusUpdate(tt.text)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseStatusUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if *got != *tt.want {
+				t.Errorf("ParseStatusUpdate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Package incidenttype applies the configured incident types to declared incidents.
+package incidenttype
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/incident"
+)
+
+// DefaultMessage is the initial message of incidents whose type doesn't set one
+const DefaultMessage = `🚨 *{{ .Severity }} Incident Started*
+
+*Severity:* {{ .Severity }}
+*Started by:* {{ .StartedBy }}
+*Title:* {{ .Title }}
+*Description:* {{ .Description }}
+{{ with .Services }}{{ . }}
+{{ end }}*Started at:* {{ .StartedAt.Format "2006-01-02 15:04:05" }}
+
+Please provide updates and coordinate the response in this channel.`
+
+// ErrUnknownType is returned for incident types missing from the configuration
+var ErrUnknownType = errors.New("unknown incident type")
+
+// MessageData is the data initial message templates are rendered against
+type MessageData struct {
+	Type     string
+	Severity incident.Severity
+	Title    string
+	// Description is the title for incidents declared without a description
+	Description string
+	// StartedBy mentions the person or names the integration that declared the incident
+	StartedBy string
+	StartedAt time.Time
+	// Services lists the affected services with their catalog details, empty when there are none
+	Services string
+	// Roles lists the roles to fill besides the commander
+	Roles []string
+}
+
+// Apply looks up the type of an incident command and fills in the severity and channel privacy
+// it defaults. Commands without a type get the zero IncidentType.
+func Apply(types []config.IncidentType, cmd *incident.Command) (config.IncidentType, error) {
+	var t config.IncidentType
+
+	if cmd.Type != "" {
+		found := false
+
+		for _, configured := range types {
+			if strings.EqualFold(configured.Name, cmd.Type) {
+				t, found = configured, true
+				break
+			}
+		}
+
+		if !found {
+			return t, fmt.Errorf("%w: %s", ErrUnknownType, cmd.Type)
+		}
+	}
+
+	if cmd.Severity == "" && t.Severity != "" {
+		severity, err := incident.ParseSeverity(t.Severity)
+		if err != nil {
+			return t, fmt.Errorf("incident type %s: %w", t.Name, err)
+		}
+
+		cmd.Severity = severity
+	}
+
+	if cmd.Severity == "" {
+		return t, incident.ErrNoSeverity
+	}
+
+	cmd.Private = cmd.Private || t.Private
+
+	return t, nil
+}
+
+// InitialMessage renders the message posted in the channel of an incident of type t
+func InitialMessage(t config.IncidentType, data MessageData) (string, error) {
+	text := t.InitialMessage
+	if text == "" {
+		text = DefaultMessage
+	}
+
+	tmpl, err := template.New("initial_message").Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid initial message of incident type %s: %w", t.Name, err)
+	}
+
+	var message strings.Builder
+	if err := tmpl.Execute(&message, data); err != nil {
+		return "", fmt.Errorf("failed to render initial message of incident type %s: %w", t.Name, err)
+	}
+
+	return strings.TrimSpace(message.String()), nil
+}
+
+// Roles returns the roles of incident type t formatted for the initial message, or an empty
+// string when it has none
+func Roles(t config.IncidentType) string {
+	if len(t.Roles) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("*Roles to fill:* %s. Assign them with `/shift role <role> @user`.", strings.Join(t.Roles, ", "))
+}
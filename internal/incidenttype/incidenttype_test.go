@@ -0,0 +1,109 @@
+package incidenttype
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/incident"
+)
+
+func TestApply(t *testing.T) {
+	types := []config.IncidentType{
+		{Name: "security", Severity: "SEV1", Private: true},
+		{Name: "database"},
+	}
+
+	tests := []struct {
+		name        string
+		cmd         incident.Command
+		wantType    string
+		wantSev     incident.Severity
+		wantPrivate bool
+		wantErr     error
+	}{
+		{
+			name:    "no type",
+			cmd:     incident.Command{Severity: incident.Severity2},
+			wantSev: incident.Severity2,
+		},
+		{
+			name:        "default severity",
+			cmd:         incident.Command{Type: "security"},
+			wantType:    "security",
+			wantSev:     incident.Severity1,
+			wantPrivate: true,
+		},
+		{
+			name:        "severity given",
+			cmd:         incident.Command{Type: "security", Severity: incident.Severity0},
+			wantType:    "security",
+			wantSev:     incident.Severity0,
+			wantPrivate: true,
+		},
+		{
+			name:    "type without default severity",
+			cmd:     incident.Command{Type: "database"},
+			wantErr: incident.ErrNoSeverity,
+		},
+		{
+			name:    "unknown type",
+			cmd:     incident.Command{Type: "hr", Severity: incident.Severity1},
+			wantErr: ErrUnknownType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := tt.cmd
+
+			got, err := Apply(types, &cmd)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Apply() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.Name != tt.wantType || cmd.Severity != tt.wantSev || cmd.Private != tt.wantPrivate {
+				t.Errorf("Apply() = %q, severity %s, private %v, want %q, %s, %v",
+					got.Name, cmd.Severity, cmd.Private, tt.wantType, tt.wantSev, tt.wantPrivate)
+			}
+		})
+	}
+}
+
+func TestInitialMessage(t *testing.T) {
+	data := MessageData{
+		Type:        "security",
+		Severity:    incident.Severity1,
+		Title:       "leaked credentials",
+		Description: "leaked credentials",
+		StartedBy:   "<@U1>",
+		StartedAt:   time.Date(2025, 6, 2, 9, 30, 0, 0, time.UTC),
+		Services:    "*Services:* payments",
+	}
+
+	message, err := InitialMessage(config.IncidentType{}, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{"🚨 *SEV1 Incident Started*", "*Started by:* <@U1>", "*Services:* payments\n", "2025-06-02 09:30:00"} {
+		if !strings.Contains(message, want) {
+			t.Errorf("InitialMessage() is missing %q:\n%s", want, message)
+		}
+	}
+
+	message, err = InitialMessage(config.IncidentType{InitialMessage: "🔒 {{ .Severity }} {{ .Type }}: {{ .Title }}\n"}, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if message != "🔒 SEV1 security: leaked credentials" {
+		t.Errorf("InitialMessage() = %q", message)
+	}
+}
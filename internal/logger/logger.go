@@ -6,20 +6,22 @@ import (
 	"os"
 )
 
-var logger *slog.Logger
+var (
+	logger *slog.Logger
+	// level is shared by all loggers, including those created with With before a level change
+	level = new(slog.LevelVar)
+)
 
 func init() {
 	// Create a structured logger with JSON output
 	logger = slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-		Level: slog.LevelInfo,
+		Level: level,
 	}))
 }
 
 // SetLevel sets the logging level
-func SetLevel(level slog.Level) {
-	logger = slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-		Level: level,
-	}))
+func SetLevel(l slog.Level) {
+	level.Set(l)
 }
 
 // Debug logs a debug message
@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/logger"
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+var openIncidentsDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(namespace, "", "open_incidents"),
+	"Incidents currently open, by severity.",
+	[]string{"severity"}, nil,
+)
+
+// OpenIncidentsCollector reports the number of open incidents from the database on every scrape,
+// so the gauge stays correct across restarts and replicas
+type OpenIncidentsCollector struct {
+	store *store.Store
+}
+
+// NewOpenIncidentsCollector creates a collector for open incidents
+func NewOpenIncidentsCollector(st *store.Store) *OpenIncidentsCollector {
+	return &OpenIncidentsCollector{store: st}
+}
+
+// Describe implements prometheus.Collector
+func (c *OpenIncidentsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- openIncidentsDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *OpenIncidentsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), store.DefaultTimeout)
+	defer cancel()
+
+	counts, err := c.store.CountOpenIncidents(ctx)
+	if err != nil {
+		logger.Error("Failed to count open incidents for metrics", "error", err)
+		ch <- prometheus.NewInvalidMetric(openIncidentsDesc, err)
+
+		return
+	}
+
+	for _, severity := range incident.Severities() {
+		ch <- prometheus.MustNewConstMetric(openIncidentsDesc, prometheus.GaugeValue,
+			float64(counts[string(severity)]), string(severity))
+	}
+}
@@ -0,0 +1,57 @@
+// Package metrics defines the Prometheus metrics exposed by the OhShift! bot.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "ohshift"
+
+// Slash command outcomes
+const (
+	// OutcomeSuccess is a command that did what was asked
+	OutcomeSuccess = "success"
+	// OutcomeInvalid is a command rejected because of bad input or the wrong channel
+	OutcomeInvalid = "invalid"
+	// OutcomeError is a command that failed
+	OutcomeError = "error"
+)
+
+var (
+	// SlashCommands counts slash commands by action and outcome
+	SlashCommands = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "slash_commands_total",
+		Help:      "Slash commands received, by action and outcome.",
+	}, []string{"action", "outcome"})
+
+	// IncidentsCreated counts declared incidents by severity
+	IncidentsCreated = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "incidents_created_total",
+		Help:      "Incidents declared, by severity.",
+	}, []string{"severity"})
+
+	// TimelineEntries counts entries added to incident timelines by type
+	TimelineEntries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "timeline_entries_total",
+		Help:      "Entries added to incident timelines, by entry type.",
+	}, []string{"type"})
+
+	// SlackAPIErrors counts failed Slack Web API calls by method
+	SlackAPIErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "slack_api_errors_total",
+		Help:      "Slack Web API calls that failed, by API method.",
+	}, []string{"method"})
+
+	// SlackAPILatency observes the duration of Slack Web API calls by method
+	SlackAPILatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "slack_api_request_duration_seconds",
+		Help:      "Duration of Slack Web API calls, by API method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+)
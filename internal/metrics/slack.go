@@ -0,0 +1,90 @@
+package metrics
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxInspectBytes bounds how much of a response body is buffered to look for Slack errors
+const maxInspectBytes = 4 << 20
+
+// SlackTransport is an http.RoundTripper that records latency and errors of Slack Web API calls
+type SlackTransport struct {
+	Base http.RoundTripper
+}
+
+// NewSlackClient returns an HTTP client for the Slack API that records metrics
+func NewSlackClient() *http.Client {
+	return &http.Client{Transport: &SlackTransport{Base: http.DefaultTransport}}
+}
+
+// RoundTrip performs the request and records its metrics
+func (t *SlackTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	method := apiMethod(req)
+	start := time.Now()
+
+	resp, err := t.Base.RoundTrip(req)
+
+	SlackAPILatency.WithLabelValues(method).Observe(time.Since(start).Seconds())
+
+	if err != nil {
+		SlackAPIErrors.WithLabelValues(method).Inc()
+		return nil, err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest || failedResponse(resp) {
+		SlackAPIErrors.WithLabelValues(method).Inc()
+	}
+
+	return resp, nil
+}
+
+// apiMethod returns the Slack API method (e.g. "chat.postMessage") a request calls
+func apiMethod(req *http.Request) string {
+	_, method, ok := strings.Cut(req.URL.Path, "/api/")
+	if !ok || method == "" {
+		return "unknown"
+	}
+
+	return method
+}
+
+// failedResponse reports whether a JSON Slack response has "ok": false. Slack reports
+// most errors this way with a 200 status, so the start of the body is read and put back
+// in front of the rest for the caller.
+func failedResponse(resp *http.Response) bool {
+	if resp.Body == nil || !strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		return false
+	}
+
+	if resp.ContentLength > maxInspectBytes {
+		return false
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxInspectBytes))
+	resp.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), resp.Body), Closer: resp.Body}
+
+	if err != nil {
+		return true
+	}
+
+	var result struct {
+		OK *bool `json:"ok"`
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil || result.OK == nil {
+		return false
+	}
+
+	return !*result.OK
+}
+
+// readCloser reads from one reader and closes another
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,213 @@
+// Package oncall works out who is on call from rotation schedules and overrides.
+package oncall
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+// Rotation is how often a schedule hands off to the next participant
+type Rotation string
+
+const (
+	// RotationDaily hands off every day at the handoff time
+	RotationDaily Rotation = "daily"
+	// RotationWeekly hands off every seven days at the handoff time, on the weekday of the start date
+	RotationWeekly Rotation = "weekly"
+)
+
+// Schedule is a rotation of participants with its overrides
+type Schedule struct {
+	ID           string
+	Name         string
+	Rotation     Rotation
+	Participants []string
+	// HandoffHour and HandoffMinute are the local time of day at which shifts change
+	HandoffHour   int
+	HandoffMinute int
+	Location      *time.Location
+	// StartDate is the local date on which the first participant's first shift starts
+	StartDate time.Time
+	Overrides []Override
+}
+
+// Override puts someone on call instead of the rotation, e.g. for a swap or a vacation
+type Override struct {
+	ID     int64
+	UserID string
+	Start  time.Time
+	End    time.Time
+}
+
+// Shift is a period during which one person is on call
+type Shift struct {
+	UserID   string
+	Start    time.Time
+	End      time.Time
+	Override bool
+}
+
+// NewSchedule builds a schedule from its stored row and overrides
+func NewSchedule(row *store.OnCallSchedule, overrides []*store.OnCallOverride) (*Schedule, error) {
+	loc, err := time.LoadLocation(row.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("schedule %s: invalid timezone %q: %w", row.Name, row.Timezone, err)
+	}
+
+	hour, minute, err := ParseHandoff(row.HandoffTime)
+	if err != nil {
+		return nil, fmt.Errorf("schedule %s: %w", row.Name, err)
+	}
+
+	s := &Schedule{
+		ID:            row.ID,
+		Name:          row.Name,
+		Rotation:      Rotation(row.Rotation),
+		Participants:  row.Participants,
+		HandoffHour:   hour,
+		HandoffMinute: minute,
+		Location:      loc,
+		StartDate:     time.Date(row.StartDate.Year(), row.StartDate.Month(), row.StartDate.Day(), 0, 0, 0, 0, loc),
+	}
+
+	for _, o := range overrides {
+		s.Overrides = append(s.Overrides, Override{ID: o.ID, UserID: o.SlackUserID, Start: o.StartsAt, End: o.EndsAt})
+	}
+
+	return s, nil
+}
+
+// Load returns the named schedule with its overrides ending after since
+func Load(ctx context.Context, st *store.Store, name string, since time.Time) (*Schedule, error) {
+	row, err := st.GetSchedule(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return load(ctx, st, row, since)
+}
+
+// LoadAll returns all schedules with their overrides ending after since
+func LoadAll(ctx context.Context, st *store.Store, since time.Time) ([]*Schedule, error) {
+	rows, err := st.ListSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := make([]*Schedule, 0, len(rows))
+
+	for _, row := range rows {
+		schedule, err := load(ctx, st, row, since)
+		if err != nil {
+			return nil, err
+		}
+
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules, nil
+}
+
+// load builds a schedule from its row and the overrides ending after since
+func load(ctx context.Context, st *store.Store, row *store.OnCallSchedule, since time.Time) (*Schedule, error) {
+	overrides, err := st.ListOverrides(ctx, row.ID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSchedule(row, overrides)
+}
+
+// ShiftAt returns who is on call at t. Overrides win over the rotation, and a rotation shift
+// is cut short by the overrides that start or end during it.
+func (s *Schedule) ShiftAt(t time.Time) Shift {
+	for _, o := range s.Overrides {
+		if !t.Before(o.Start) && t.Before(o.End) {
+			return Shift{UserID: o.UserID, Start: o.Start, End: o.End, Override: true}
+		}
+	}
+
+	shift := s.rotationShiftAt(t)
+
+	for _, o := range s.Overrides {
+		if o.Start.After(t) && o.Start.Before(shift.End) {
+			shift.End = o.Start
+		}
+
+		if !o.End.After(t) && o.End.After(shift.Start) {
+			shift.Start = o.End
+		}
+	}
+
+	return shift
+}
+
+// NextShift returns the shift following current
+func (s *Schedule) NextShift(current Shift) Shift {
+	return s.ShiftAt(current.End)
+}
+
+// rotationShiftAt returns the rotation shift containing t, ignoring overrides
+func (s *Schedule) rotationShiftAt(t time.Time) Shift {
+	length := s.shiftDays()
+
+	// The handoff on or before t, counted in local calendar days so that
+	// shifts keep their local handoff time across daylight saving changes
+	local := t.In(s.Location)
+	handoff := time.Date(local.Year(), local.Month(), local.Day(), s.HandoffHour, s.HandoffMinute, 0, 0, s.Location)
+
+	if local.Before(handoff) {
+		handoff = handoff.AddDate(0, 0, -1)
+	}
+
+	index := floorDiv(daysBetween(s.StartDate, handoff), length)
+
+	start := s.handoffOn(index * length)
+
+	shift := Shift{
+		Start: start,
+		End:   s.handoffOn((index + 1) * length),
+	}
+
+	if n := len(s.Participants); n > 0 {
+		shift.UserID = s.Participants[((index%n)+n)%n]
+	}
+
+	return shift
+}
+
+// handoffOn returns the handoff time the given number of days after the start date
+func (s *Schedule) handoffOn(days int) time.Time {
+	return time.Date(s.StartDate.Year(), s.StartDate.Month(), s.StartDate.Day()+days,
+		s.HandoffHour, s.HandoffMinute, 0, 0, s.Location)
+}
+
+// shiftDays returns the length of a shift in days
+func (s *Schedule) shiftDays() int {
+	if s.Rotation == RotationWeekly {
+		return 7
+	}
+
+	return 1
+}
+
+// daysBetween returns the number of calendar days from the date of a to the date of b
+func daysBetween(a, b time.Time) int {
+	from := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	to := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+
+	return int(to.Sub(from).Hours() / 24)
+}
+
+// floorDiv divides rounding towards negative infinity, for times before the start date
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+
+	return q
+}
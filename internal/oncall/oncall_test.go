@@ -0,0 +1,91 @@
+package oncall
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestSchedule(t *testing.T, rotation Rotation) *Schedule {
+	t.Helper()
+
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+
+	return &Schedule{
+		Name:          "primary",
+		Rotation:      rotation,
+		Participants:  []string{"U1", "U2", "U3"},
+		HandoffHour:   9,
+		HandoffMinute: 0,
+		Location:      loc,
+		// A Monday
+		StartDate: time.Date(2025, 6, 2, 0, 0, 0, 0, loc),
+	}
+}
+
+func TestShiftAtRotation(t *testing.T) {
+	weekly := newTestSchedule(t, RotationWeekly)
+	daily := newTestSchedule(t, RotationDaily)
+	loc := weekly.Location
+
+	tests := []struct {
+		name      string
+		schedule  *Schedule
+		at        time.Time
+		wantUser  string
+		wantStart time.Time
+	}{
+		{"weekly first shift", weekly, time.Date(2025, 6, 4, 12, 0, 0, 0, loc), "U1", time.Date(2025, 6, 2, 9, 0, 0, 0, loc)},
+		{"weekly before handoff", weekly, time.Date(2025, 6, 9, 8, 59, 0, 0, loc), "U1", time.Date(2025, 6, 2, 9, 0, 0, 0, loc)},
+		{"weekly at handoff", weekly, time.Date(2025, 6, 9, 9, 0, 0, 0, loc), "U2", time.Date(2025, 6, 9, 9, 0, 0, 0, loc)},
+		{"weekly wraps around", weekly, time.Date(2025, 6, 23, 10, 0, 0, 0, loc), "U1", time.Date(2025, 6, 23, 9, 0, 0, 0, loc)},
+		{"weekly before start date", weekly, time.Date(2025, 5, 28, 10, 0, 0, 0, loc), "U3", time.Date(2025, 5, 26, 9, 0, 0, 0, loc)},
+		{"daily", daily, time.Date(2025, 6, 4, 3, 0, 0, 0, loc), "U2", time.Date(2025, 6, 3, 9, 0, 0, 0, loc)},
+		{"daily across DST", daily, time.Date(2025, 10, 26, 9, 30, 0, 0, loc), "U3", time.Date(2025, 10, 26, 9, 0, 0, 0, loc)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shift := tt.schedule.ShiftAt(tt.at)
+
+			if shift.UserID != tt.wantUser {
+				t.Errorf("ShiftAt() user = %s, want %s", shift.UserID, tt.wantUser)
+			}
+
+			if !shift.Start.Equal(tt.wantStart) {
+				t.Errorf("ShiftAt() start = %s, want %s", shift.Start, tt.wantStart)
+			}
+		})
+	}
+}
+
+func TestShiftAtOverrides(t *testing.T) {
+	s := newTestSchedule(t, RotationWeekly)
+	loc := s.Location
+
+	vacationStart := time.Date(2025, 6, 4, 0, 0, 0, 0, loc)
+	vacationEnd := time.Date(2025, 6, 6, 0, 0, 0, 0, loc)
+	s.Overrides = []Override{{ID: 1, UserID: "U9", Start: vacationStart, End: vacationEnd}}
+
+	before := s.ShiftAt(time.Date(2025, 6, 3, 12, 0, 0, 0, loc))
+	if before.UserID != "U1" || !before.End.Equal(vacationStart) {
+		t.Errorf("ShiftAt() before override = %+v, want U1 until %s", before, vacationStart)
+	}
+
+	during := s.ShiftAt(time.Date(2025, 6, 5, 12, 0, 0, 0, loc))
+	if during.UserID != "U9" || !during.Override || !during.End.Equal(vacationEnd) {
+		t.Errorf("ShiftAt() during override = %+v, want U9 override until %s", during, vacationEnd)
+	}
+
+	next := s.NextShift(during)
+	if next.UserID != "U1" || !next.Start.Equal(vacationEnd) {
+		t.Errorf("NextShift() = %+v, want U1 from %s", next, vacationEnd)
+	}
+
+	afterNext := s.NextShift(next)
+	if afterNext.UserID != "U2" {
+		t.Errorf("NextShift() after override = %s, want U2", afterNext.UserID)
+	}
+}
@@ -0,0 +1,172 @@
+package oncall
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// scheduleNamePattern restricts schedule names so they are easy to type in commands
+var scheduleNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,79}$`)
+
+// mentionPattern matches an escaped Slack user mention, <@U0123456> or <@U0123456|name>, or a bare user ID
+var mentionPattern = regexp.MustCompile(`^(?:<@([UW][A-Z0-9]+)(?:\|[^>]*)?>|([UW][A-Z0-9]+))$`)
+
+// ScheduleSpec is a parsed /shift oncall schedule create command
+type ScheduleSpec struct {
+	Name         string
+	Rotation     Rotation
+	HandoffTime  string
+	Timezone     string
+	Participants []string
+	StartDate    time.Time
+}
+
+// ParseScheduleSpec parses the arguments of /shift oncall schedule create,
+// "<name> <daily|weekly> <HH:MM> <timezone> <@user>... [--start YYYY-MM-DD]".
+// The start date defaults to today in the schedule's timezone.
+func ParseScheduleSpec(args []string, now time.Time) (*ScheduleSpec, error) {
+	var startArg string
+
+	for i := 0; i < len(args); i++ {
+		if args[i] != "--start" {
+			continue
+		}
+
+		if i+1 >= len(args) {
+			return nil, fmt.Errorf("--start requires a date")
+		}
+
+		startArg = args[i+1]
+		args = append(args[:i:i], args[i+2:]...)
+
+		break
+	}
+
+	if len(args) < 5 {
+		return nil, fmt.Errorf("insufficient arguments")
+	}
+
+	name, err := ParseScheduleName(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	rotation, err := ParseRotation(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := ParseHandoff(args[2]); err != nil {
+		return nil, err
+	}
+
+	loc, err := time.LoadLocation(args[3])
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %s", args[3])
+	}
+
+	participants, err := ParseMentions(args[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	local := now.In(loc)
+	startDate := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+
+	if startArg != "" {
+		if startDate, err = time.Parse(time.DateOnly, startArg); err != nil {
+			return nil, fmt.Errorf("invalid start date %q, expected YYYY-MM-DD", startArg)
+		}
+	}
+
+	return &ScheduleSpec{
+		Name:         name,
+		Rotation:     rotation,
+		HandoffTime:  args[2],
+		Timezone:     loc.String(),
+		Participants: participants,
+		StartDate:    startDate,
+	}, nil
+}
+
+// ParseScheduleName validates a schedule name
+func ParseScheduleName(name string) (string, error) {
+	name = strings.ToLower(name)
+	if !scheduleNamePattern.MatchString(name) {
+		return "", fmt.Errorf("invalid schedule name %q, use lowercase letters, digits, - and _", name)
+	}
+
+	return name, nil
+}
+
+// ParseRotation parses a rotation kind
+func ParseRotation(value string) (Rotation, error) {
+	switch rotation := Rotation(strings.ToLower(value)); rotation {
+	case RotationDaily, RotationWeekly:
+		return rotation, nil
+	default:
+		return "", fmt.Errorf("invalid rotation %q, expected daily or weekly", value)
+	}
+}
+
+// ParseHandoff parses a handoff time of day, "HH:MM"
+func ParseHandoff(value string) (hour, minute int, err error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid handoff time %q, expected HH:MM", value)
+	}
+
+	return t.Hour(), t.Minute(), nil
+}
+
+// ParseMention returns the Slack user ID of a user mention
+func ParseMention(value string) (string, error) {
+	match := mentionPattern.FindStringSubmatch(value)
+	if match == nil {
+		return "", fmt.Errorf("invalid user %q, mention the user with @", value)
+	}
+
+	if match[1] != "" {
+		return match[1], nil
+	}
+
+	return match[2], nil
+}
+
+// ParseMentions returns the Slack user IDs of a list of user mentions
+func ParseMentions(values []string) ([]string, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("at least one participant is required")
+	}
+
+	userIDs := make([]string, 0, len(values))
+
+	for _, value := range values {
+		userID, err := ParseMention(value)
+		if err != nil {
+			return nil, err
+		}
+
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs, nil
+}
+
+// ParseTime parses the start or end of an override in a schedule's timezone: a date,
+// which means midnight, a date and time of day ("2006-01-02T15:04") or an RFC 3339 timestamp
+func ParseTime(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	for _, layout := range []string{"2006-01-02T15:04", time.DateOnly} {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q, expected YYYY-MM-DD or YYYY-MM-DDTHH:MM", value)
+}
@@ -0,0 +1,59 @@
+package oncall
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParseScheduleSpec(t *testing.T) {
+	now := time.Date(2025, 6, 4, 23, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    *ScheduleSpec
+		wantErr bool
+	}{
+		{
+			name: "start date defaults to today in the schedule timezone",
+			args: []string{"primary", "weekly", "09:00", "Europe/Berlin", "<@U1|alice>", "<@U2>"},
+			want: &ScheduleSpec{
+				Name: "primary", Rotation: RotationWeekly, HandoffTime: "09:00", Timezone: "Europe/Berlin",
+				Participants: []string{"U1", "U2"}, StartDate: time.Date(2025, 6, 5, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "explicit start date",
+			args: []string{"db-team", "daily", "17:30", "UTC", "--start", "2025-06-09", "U1"},
+			want: &ScheduleSpec{
+				Name: "db-team", Rotation: RotationDaily, HandoffTime: "17:30", Timezone: "UTC",
+				Participants: []string{"U1"}, StartDate: time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{name: "no participants", args: []string{"primary", "weekly", "09:00", "UTC"}, wantErr: true},
+		{name: "invalid rotation", args: []string{"primary", "monthly", "09:00", "UTC", "U1"}, wantErr: true},
+		{name: "invalid handoff", args: []string{"primary", "weekly", "9am", "UTC", "U1"}, wantErr: true},
+		{name: "invalid timezone", args: []string{"primary", "weekly", "09:00", "Mars/Olympus", "U1"}, wantErr: true},
+		{name: "unescaped mention", args: []string{"primary", "weekly", "09:00", "UTC", "@alice"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseScheduleSpec(tt.args, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseScheduleSpec() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.Name != tt.want.Name || got.Rotation != tt.want.Rotation || got.HandoffTime != tt.want.HandoffTime ||
+				got.Timezone != tt.want.Timezone || !got.StartDate.Equal(tt.want.StartDate) ||
+				!slices.Equal(got.Participants, tt.want.Participants) {
+				t.Errorf("ParseScheduleSpec() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,299 @@
+// Package paging works out who to page when an incident is declared.
+package paging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/logger"
+	"github.com/fishnix/ohshift/internal/oncall"
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+// rotationEpoch is the Monday from which weekly rotations are counted
+var rotationEpoch = time.Date(1970, 1, 5, 0, 0, 0, 0, time.UTC)
+
+// GroupResolver looks up the members of Slack user groups
+type GroupResolver interface {
+	UserGroupMembers(groupID string) ([]string, error)
+}
+
+// Responder is someone to page, with why they are paged
+type Responder struct {
+	UserID  string
+	Reasons []string
+}
+
+// Targets names who to page
+type Targets struct {
+	// Users lists Slack user IDs
+	Users []string
+	// UserGroups lists Slack user group IDs whose members are paged
+	UserGroups []string
+	// Schedules lists on-call schedules whose current responder is paged
+	Schedules []string
+	// Rotation lists Slack user IDs taking turns weekly, handing off on Mondays at 00:00 UTC
+	Rotation []string
+}
+
+// Empty reports whether the targets name nobody
+func (t *Targets) Empty() bool {
+	return len(t.Users) == 0 && len(t.UserGroups) == 0 && len(t.Schedules) == 0 && len(t.Rotation) == 0
+}
+
+// route is a validated on-call route
+type route struct {
+	severities []incident.Severity
+	services   []string
+	targets    Targets
+}
+
+// Resolver resolves targets to the people to page
+type Resolver struct {
+	store  *store.Store
+	groups GroupResolver
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewResolver creates a new resolver looking up schedules in st and user groups with groups
+func NewResolver(st *store.Store, groups GroupResolver) *Resolver {
+	return &Resolver{
+		store:  st,
+		groups: groups,
+		logger: logger.With("component", "paging"),
+		now:    time.Now,
+	}
+}
+
+// Pager resolves the responders of the configured on-call routes
+type Pager struct {
+	routes   []route
+	resolver *Resolver
+}
+
+// New creates a new pager using the configured on-call routes
+func New(cfg *config.Config, st *store.Store, groups GroupResolver) (*Pager, error) {
+	routes := make([]route, 0, len(cfg.OnCallRoutes))
+
+	for i, configured := range cfg.OnCallRoutes {
+		r, err := parseRoute(configured)
+		if err != nil {
+			return nil, fmt.Errorf("on-call route %d: %w", i, err)
+		}
+
+		routes = append(routes, r)
+	}
+
+	return &Pager{
+		routes:   routes,
+		resolver: NewResolver(st, groups),
+	}, nil
+}
+
+// parseRoute validates a configured on-call route
+func parseRoute(configured config.OnCallRoute) (route, error) {
+	severities, services, err := ParseMatch(configured.Severities, configured.Services)
+	if err != nil {
+		return route{}, err
+	}
+
+	targets, err := ParseTargets(nil, configured.UserGroups, configured.Schedules, configured.Rotation)
+	if err != nil {
+		return route{}, err
+	}
+
+	return route{severities: severities, services: services, targets: targets}, nil
+}
+
+// ParseMatch validates the severities and services an incident is matched against
+func ParseMatch(severityNames, serviceNames []string) ([]incident.Severity, []string, error) {
+	var (
+		severities []incident.Severity
+		services   []string
+	)
+
+	for _, name := range severityNames {
+		severity, err := incident.ParseSeverity(name)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		severities = append(severities, severity)
+	}
+
+	for _, name := range serviceNames {
+		service, err := incident.ParseService(name)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		services = append(services, service)
+	}
+
+	return severities, services, nil
+}
+
+// Matches reports whether an incident's severity and one of its affected services match; empty
+// lists match anything
+func Matches(severities []incident.Severity, services []string, severity incident.Severity, affected []string) bool {
+	if len(severities) > 0 && !slices.Contains(severities, severity) {
+		return false
+	}
+
+	if len(services) == 0 {
+		return true
+	}
+
+	for _, service := range affected {
+		if slices.Contains(services, service) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseTargets validates who to page; at least one target is required
+func ParseTargets(users, userGroups, schedules, rotation []string) (Targets, error) {
+	targets := Targets{
+		Users:      users,
+		UserGroups: userGroups,
+		Rotation:   rotation,
+	}
+
+	for _, name := range schedules {
+		schedule, err := oncall.ParseScheduleName(name)
+		if err != nil {
+			return Targets{}, err
+		}
+
+		targets.Schedules = append(targets.Schedules, schedule)
+	}
+
+	if targets.Empty() {
+		return Targets{}, fmt.Errorf("users, usergroups, schedules or rotation is required")
+	}
+
+	return targets, nil
+}
+
+// Enabled reports whether any on-call route is configured
+func (p *Pager) Enabled() bool {
+	return len(p.routes) > 0
+}
+
+// Responders returns who to page for an incident. Sources that can't be resolved are
+// logged and skipped so that the others are still paged.
+func (p *Pager) Responders(ctx context.Context, severity incident.Severity, services []string) []Responder {
+	var responders []Responder
+
+	for _, r := range p.matching(severity, services) {
+		responders = merge(responders, p.resolver.Resolve(ctx, r.targets))
+	}
+
+	return responders
+}
+
+// Resolve returns the people named by targets. Sources that can't be resolved are
+// logged and skipped so that the others are still paged.
+func (r *Resolver) Resolve(ctx context.Context, targets Targets) []Responder {
+	var responders []Responder
+
+	add := func(userID, reason string) {
+		responders = merge(responders, []Responder{{UserID: userID, Reasons: []string{reason}}})
+	}
+
+	now := r.now()
+
+	for _, userID := range targets.Users {
+		add(userID, "named in the escalation policy")
+	}
+
+	for _, groupID := range targets.UserGroups {
+		members, err := r.groups.UserGroupMembers(groupID)
+		if err != nil {
+			r.logger.Error("Failed to get user group members", "error", err, "usergroup", groupID)
+			continue
+		}
+
+		for _, userID := range members {
+			add(userID, "member of user group "+groupID)
+		}
+	}
+
+	for _, name := range targets.Schedules {
+		schedule, err := oncall.Load(ctx, r.store, name, now)
+		if err != nil {
+			r.logger.Error("Failed to load on-call schedule", "error", err, "schedule", name)
+			continue
+		}
+
+		if shift := schedule.ShiftAt(now); shift.UserID != "" {
+			add(shift.UserID, "on call for "+schedule.Name)
+		}
+	}
+
+	if userID := rotationUser(targets.Rotation, now); userID != "" {
+		add(userID, "on call this week")
+	}
+
+	return responders
+}
+
+// merge adds responders to a list, combining the reasons of people listed twice
+func merge(responders, more []Responder) []Responder {
+	for _, m := range more {
+		i := slices.IndexFunc(responders, func(r Responder) bool { return r.UserID == m.UserID })
+		if i < 0 {
+			responders = append(responders, Responder{UserID: m.UserID, Reasons: slices.Clone(m.Reasons)})
+			continue
+		}
+
+		responders[i].Reasons = append(responders[i].Reasons, m.Reasons...)
+	}
+
+	return responders
+}
+
+// matching returns the routes matching an incident's severity and service
+func (p *Pager) matching(severity incident.Severity, services []string) []route {
+	var matched []route
+
+	for _, r := range p.routes {
+		if Matches(r.severities, r.services, severity, services) {
+			matched = append(matched, r)
+		}
+	}
+
+	return matched
+}
+
+// rotationUser returns whose week it is in a weekly rotation
+func rotationUser(rotation []string, t time.Time) string {
+	n := len(rotation)
+	if n == 0 {
+		return ""
+	}
+
+	week := int(t.Sub(rotationEpoch).Hours()) / (7 * 24)
+
+	return rotation[((week%n)+n)%n]
+}
+
+// Summary describes who was paged and why, e.g. for the timeline
+func Summary(responders []Responder) string {
+	paged := make([]string, 0, len(responders))
+
+	for _, r := range responders {
+		paged = append(paged, fmt.Sprintf("<@%s> (%s)", r.UserID, strings.Join(r.Reasons, ", ")))
+	}
+
+	return "Paged " + strings.Join(paged, ", ")
+}
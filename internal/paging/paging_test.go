@@ -0,0 +1,82 @@
+package paging
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/incident"
+)
+
+func TestMatching(t *testing.T) {
+	cfg := &config.Config{
+		OnCallRoutes: []config.OnCallRoute{
+			{Severities: []string{"SEV0", "sev1"}, Rotation: []string{"U1"}},
+			{Services: []string{"Payments"}, UserGroups: []string{"S1"}},
+			{Severities: []string{"SEV2"}, Services: []string{"search"}, Schedules: []string{"search"}},
+		},
+	}
+
+	p, err := New(cfg, nil, nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	tests := []struct {
+		severity incident.Severity
+		services []string
+		want     int
+	}{
+		{incident.Severity0, nil, 1},
+		{incident.Severity1, []string{"payments"}, 2},
+		{incident.Severity2, []string{"search"}, 1},
+		{incident.Severity2, []string{"checkout", "search"}, 1},
+		{incident.Severity2, nil, 0},
+		{incident.Severity3, []string{"payments"}, 1},
+	}
+
+	for _, tt := range tests {
+		if got := len(p.matching(tt.severity, tt.services)); got != tt.want {
+			t.Errorf("matching(%s, %q) = %d routes, want %d", tt.severity, tt.services, got, tt.want)
+		}
+	}
+}
+
+func TestNewInvalidRoute(t *testing.T) {
+	routes := []config.OnCallRoute{
+		{Severities: []string{"SEV9"}, Rotation: []string{"U1"}},
+		{Services: []string{"bad service"}, Rotation: []string{"U1"}},
+		{Severities: []string{"SEV0"}},
+	}
+
+	for _, r := range routes {
+		if _, err := New(&config.Config{OnCallRoutes: []config.OnCallRoute{r}}, nil, nil); err == nil {
+			t.Errorf("New(%+v) expected an error", r)
+		}
+	}
+}
+
+func TestRotationUser(t *testing.T) {
+	rotation := []string{"U1", "U2", "U3"}
+
+	// 2025-06-02 is a Monday
+	monday := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	first := rotationUser(rotation, monday)
+
+	if got := rotationUser(rotation, monday.Add(7*24*time.Hour-time.Second)); got != first {
+		t.Errorf("rotationUser() on Sunday = %s, want %s", got, first)
+	}
+
+	second := rotationUser(rotation, monday.AddDate(0, 0, 7))
+	if second == first {
+		t.Errorf("rotationUser() didn't hand off on the next Monday")
+	}
+
+	if got := rotationUser(rotation, monday.AddDate(0, 0, 21)); got != first {
+		t.Errorf("rotationUser() three weeks later = %s, want %s", got, first)
+	}
+
+	if got := rotationUser(nil, monday); got != "" {
+		t.Errorf("rotationUser() of an empty rotation = %q", got)
+	}
+}
@@ -0,0 +1,122 @@
+// Package queue runs background jobs from a durable queue in Postgres, so that jobs
+// survive restarts and each one runs on a single bot replica.
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/logger"
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+const (
+	// pollInterval is how often the queue is checked for due jobs when it is empty
+	pollInterval = 5 * time.Second
+
+	// jobTimeout bounds a single run of a job
+	jobTimeout = time.Minute
+)
+
+// retryPolicy retries failing jobs after 30s, 1m, 2m and 4m before giving up
+var retryPolicy = store.RetryPolicy{MaxAttempts: 5, Backoff: 30 * time.Second}
+
+// Handler runs a job from its payload
+type Handler func(ctx context.Context, payload json.RawMessage) error
+
+// Queue enqueues jobs and runs them with the handlers registered for their kind
+type Queue struct {
+	store    *store.Store
+	handlers map[string]Handler
+	logger   *slog.Logger
+}
+
+// New creates a new queue stored in st
+func New(st *store.Store) *Queue {
+	return &Queue{
+		store:    st,
+		handlers: make(map[string]Handler),
+		logger:   logger.With("component", "queue"),
+	}
+}
+
+// Handle registers the handler of a kind of job. Handlers must be registered before Run.
+func (q *Queue) Handle(kind string, handler Handler) {
+	q.handlers[kind] = handler
+}
+
+// Enqueue adds a job to be run at or after runAt
+func (q *Queue) Enqueue(ctx context.Context, kind string, payload any, runAt time.Time) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s job: %w", kind, err)
+	}
+
+	if err := q.store.EnqueueJob(ctx, kind, data, runAt); err != nil {
+		return err
+	}
+
+	q.logger.Debug("Job enqueued", "kind", kind, "run_at", runAt)
+
+	return nil
+}
+
+// Run runs due jobs until ctx is done
+func (q *Queue) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	q.logger.Info("Job queue started", "kinds", len(q.handlers))
+
+	for {
+		q.drain(ctx)
+
+		select {
+		case <-ctx.Done():
+			q.logger.Info("Job queue stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// drain runs due jobs until none are left
+func (q *Queue) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		ran, err := q.runNext(ctx)
+		if err != nil {
+			q.logger.Error("Job failed", "error", err)
+		}
+
+		if !ran {
+			return
+		}
+	}
+}
+
+// runNext runs the next due job, if any
+func (q *Queue) runNext(ctx context.Context) (bool, error) {
+	return q.store.RunNextJob(ctx, retryPolicy, jobTimeout, func(ctx context.Context, job *store.QueuedJob) error {
+		handler, ok := q.handlers[job.Kind]
+		if !ok {
+			return fmt.Errorf("no handler for %s jobs", job.Kind)
+		}
+
+		start := time.Now()
+
+		if err := handler(ctx, job.Payload); err != nil {
+			return err
+		}
+
+		q.logger.Info("Job completed",
+			"kind", job.Kind,
+			"job_id", job.ID,
+			"delay", start.Sub(job.RunAt),
+			"duration", time.Since(start))
+
+		return nil
+	})
+}
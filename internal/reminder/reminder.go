@@ -0,0 +1,269 @@
+// Package reminder nudges incident commanders when the status update of an incident is overdue.
+package reminder
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/logger"
+	"github.com/fishnix/ohshift/internal/report"
+	"github.com/fishnix/ohshift/internal/store"
+	"github.com/fishnix/ohshift/internal/timeline"
+)
+
+// pollInterval is how often overdue status updates are looked for
+const pollInterval = time.Minute
+
+// Poster posts messages to Slack
+type Poster interface {
+	PostMessage(channelID, text string) error
+}
+
+// Reminder tracks when status updates are due and reminds commanders of overdue ones.
+// Due times are kept in the database so reminders survive restarts.
+type Reminder struct {
+	cadences map[incident.Severity]time.Duration
+	store    *store.Store
+	poster   Poster
+	logger   *slog.Logger
+	now      func() time.Time
+}
+
+// New creates a new reminder using the configured status update cadences
+func New(cfg *config.Config, st *store.Store, poster Poster) (*Reminder, error) {
+	cadences, err := parseCadences(cfg.StatusUpdateCadences)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reminder{
+		cadences: cadences,
+		store:    st,
+		poster:   poster,
+		logger:   logger.With("component", "reminder"),
+		now:      time.Now,
+	}, nil
+}
+
+// parseCadences validates the configured severities and durations
+func parseCadences(configured map[string]string) (map[incident.Severity]time.Duration, error) {
+	cadences := make(map[incident.Severity]time.Duration, len(configured))
+
+	for name, value := range configured {
+		severity, err := incident.ParseSeverity(name)
+		if err != nil {
+			return nil, fmt.Errorf("status update cadence: %w", err)
+		}
+
+		cadence, err := time.ParseDuration(value)
+		if err != nil || cadence < time.Minute {
+			return nil, fmt.Errorf("status update cadence for %s: invalid duration %q, expected at least 1m", severity, value)
+		}
+
+		cadences[severity] = cadence
+	}
+
+	return cadences, nil
+}
+
+// Enabled reports whether any severity has a status update cadence
+func (r *Reminder) Enabled() bool {
+	return len(r.cadences) > 0
+}
+
+// HandleEntry updates the next due status update when an incident starts, receives a
+// status update, changes severity or is resolved. It is registered as a timeline listener.
+func (r *Reminder) HandleEntry(incidentID string, entry timeline.Entry) {
+	switch entry.Type {
+	case "incident_start":
+		severity, _ := entry.Metadata["severity"].(incident.Severity)
+		r.setDue(incidentID, severity, entry.Timestamp)
+	case "status_update":
+		ctx, cancel := store.Context()
+		defer cancel()
+
+		inc, err := r.store.GetIncident(ctx, incidentID)
+		if err != nil {
+			r.logger.Error("Failed to get incident for status update", "error", err, "incident_id", incidentID)
+			return
+		}
+
+		r.setDue(incidentID, incident.Severity(inc.Severity), entry.Timestamp)
+	case "severity_change":
+		severity, _ := entry.Metadata["to"].(incident.Severity)
+		r.changeSeverity(incidentID, severity)
+	case "resolved":
+		r.clearDue(incidentID)
+	}
+}
+
+// setDue schedules the next status update one cadence after from
+func (r *Reminder) setDue(incidentID string, severity incident.Severity, from time.Time) {
+	cadence, ok := r.cadences[severity]
+	if !ok {
+		r.clearDue(incidentID)
+		return
+	}
+
+	due := from.Add(cadence)
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	if err := r.store.SetNextUpdateDue(ctx, incidentID, &due); err != nil {
+		r.logger.Error("Failed to schedule status update reminder", "error", err, "incident_id", incidentID)
+		return
+	}
+
+	r.logger.Debug("Status update reminder scheduled",
+		"incident_id", incidentID,
+		"severity", severity,
+		"due", due)
+}
+
+// changeSeverity brings the next status update forward when the new severity has a shorter cadence
+func (r *Reminder) changeSeverity(incidentID string, severity incident.Severity) {
+	cadence, ok := r.cadences[severity]
+	if !ok {
+		r.clearDue(incidentID)
+		return
+	}
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	inc, err := r.store.GetIncident(ctx, incidentID)
+	if err != nil {
+		r.logger.Error("Failed to get incident for severity change", "error", err, "incident_id", incidentID)
+		return
+	}
+
+	due := r.now().Add(cadence)
+	if inc.NextUpdateDue != nil && inc.NextUpdateDue.Before(due) {
+		return
+	}
+
+	if err := r.store.SetNextUpdateDue(ctx, incidentID, &due); err != nil {
+		r.logger.Error("Failed to reschedule status update reminder", "error", err, "incident_id", incidentID)
+	}
+}
+
+// clearDue stops status update reminders for an incident
+func (r *Reminder) clearDue(incidentID string) {
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	if err := r.store.SetNextUpdateDue(ctx, incidentID, nil); err != nil {
+		r.logger.Error("Failed to clear status update reminder", "error", err, "incident_id", incidentID)
+	}
+}
+
+// Run reminds commanders of overdue status updates until ctx is done
+func (r *Reminder) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	r.logger.Info("Status update reminders started", "cadences", len(r.cadences))
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.remindOverdue(ctx)
+		}
+	}
+}
+
+// remindOverdue sends a reminder for every incident whose status update is overdue
+func (r *Reminder) remindOverdue(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, store.DefaultTimeout)
+	defer cancel()
+
+	now := r.now()
+
+	incidents, err := r.store.ListOverdueUpdates(ctx, now)
+	if err != nil {
+		r.logger.Error("Failed to list overdue status updates", "error", err)
+		return
+	}
+
+	for _, inc := range incidents {
+		r.remind(ctx, inc, now)
+	}
+}
+
+// remind nudges the commander of an incident whose status update is overdue
+func (r *Reminder) remind(ctx context.Context, inc *store.Incident, now time.Time) {
+	severity := incident.Severity(inc.Severity)
+
+	cadence, ok := r.cadences[severity]
+	if !ok {
+		// The cadence was removed from the configuration
+		r.clearDue(inc.ID)
+		return
+	}
+
+	// A status update recorded where this replica's timeline listener didn't see it, e.g. by
+	// another replica, still counts
+	last, err := r.store.LastStatusUpdate(ctx, inc.ID)
+	if err != nil {
+		r.logger.Error("Failed to get last status update", "error", err, "incident_id", inc.ID)
+		return
+	}
+
+	if last != nil && last.Add(cadence).After(now) {
+		r.setDue(inc.ID, severity, *last)
+		return
+	}
+
+	// Claiming the reminder keeps replicas from sending it twice
+	claimed, err := r.store.ClaimOverdueUpdate(ctx, inc.ID, *inc.NextUpdateDue, now.Add(cadence))
+	if err != nil {
+		r.logger.Error("Failed to claim status update reminder", "error", err, "incident_id", inc.ID)
+		return
+	}
+
+	if !claimed {
+		return
+	}
+
+	message := fmt.Sprintf("⏰ %sNo status update has been posted for this %s incident in the last %s. "+
+		"Please post an update for stakeholders with `/shift update <status> -- <text>`.",
+		r.commanderMention(ctx, inc), severity, report.FormatDuration(cadence))
+
+	if err := r.poster.PostMessage(inc.SlackChannelID, message); err != nil {
+		r.logger.Error("Failed to post status update reminder", "error", err, "incident_id", inc.ID)
+		return
+	}
+
+	r.logger.Info("Status update reminder sent",
+		"incident_id", inc.ID,
+		"severity", severity,
+		"channel_id", inc.SlackChannelID)
+}
+
+// commanderMention returns a mention of the incident commander followed by a space, or
+// an empty string for incidents without one
+func (r *Reminder) commanderMention(ctx context.Context, inc *store.Incident) string {
+	roles, err := r.store.ListRoles(ctx, inc.ID)
+	if err != nil {
+		r.logger.Warn("Failed to get incident roles", "error", err, "incident_id", inc.ID)
+	}
+
+	for _, role := range roles {
+		if role.Role == incident.RoleCommander {
+			return fmt.Sprintf("<@%s> ", role.SlackUserID)
+		}
+	}
+
+	if inc.StartedByUserID != nil {
+		return fmt.Sprintf("<@%s> ", *inc.StartedByUserID)
+	}
+
+	return ""
+}
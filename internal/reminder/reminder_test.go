@@ -0,0 +1,31 @@
+package reminder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/incident"
+)
+
+func TestParseCadences(t *testing.T) {
+	got, err := parseCadences(map[string]string{"sev0": "15m", "SEV1": "1h"})
+	if err != nil {
+		t.Fatalf("parseCadences() error = %v", err)
+	}
+
+	if got[incident.Severity0] != 15*time.Minute || got[incident.Severity1] != time.Hour || len(got) != 2 {
+		t.Errorf("parseCadences() = %v", got)
+	}
+
+	invalid := []map[string]string{
+		{"SEV9": "15m"},
+		{"SEV0": "soon"},
+		{"SEV0": "30s"},
+	}
+
+	for _, cadences := range invalid {
+		if _, err := parseCadences(cadences); err == nil {
+			t.Errorf("parseCadences(%v) expected error", cadences)
+		}
+	}
+}
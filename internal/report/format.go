@@ -0,0 +1,225 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Format is an output format of a report
+type Format string
+
+const (
+	// FormatTable is a human readable table
+	FormatTable Format = "table"
+	// FormatCSV is one CSV row per severity followed by the totals
+	FormatCSV Format = "csv"
+	// FormatJSON is the report as a JSON document
+	FormatJSON Format = "json"
+)
+
+// ParseFormat parses an output format name
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case FormatTable, FormatCSV, FormatJSON:
+		return f, nil
+	default:
+		return "", fmt.Errorf("invalid format %q, expected table, csv or json", s)
+	}
+}
+
+// Write writes the report to w in the given format
+func (r *Report) Write(w io.Writer, format Format) error {
+	switch format {
+	case FormatTable:
+		return r.writeTable(w)
+	case FormatCSV:
+		return r.writeCSV(w)
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(r)
+	default:
+		return fmt.Errorf("invalid format %q", format)
+	}
+}
+
+// rows returns the per-severity statistics followed by the totals
+func (r *Report) rows() []*SeverityStats {
+	return append(slices.Clone(r.Severities), &r.Total)
+}
+
+// scope names the service the report is limited to after prefix, or returns nothing for all incidents
+func (r *Report) scope(prefix string) string {
+	if r.Service == "" {
+		return ""
+	}
+
+	return prefix + r.Service
+}
+
+// writeTable writes the report as aligned text
+func (r *Report) writeTable(w io.Writer) error {
+	fmt.Fprintf(w, "Incidents%s from %s to %s\n\n", r.scope(" affecting "), r.From.Format(time.RFC3339), r.To.Format(time.RFC3339))
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SEVERITY\tINCIDENTS\tRESOLVED\tOPEN\tESCALATIONS\tMEAN TTR\tP90 TTR\tMEAN TTFR\tP90 TTFR\tMEAN TTA\tP90 TTA")
+
+	for _, s := range r.rows() {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			strings.ToUpper(s.Severity), s.Incidents, s.Resolved, s.Open, s.Escalations,
+			formatStat(s.TimeToResolve.Count, s.TimeToResolve.Mean),
+			formatStat(s.TimeToResolve.Count, s.TimeToResolve.P90),
+			formatStat(s.TimeToFirstResponse.Count, s.TimeToFirstResponse.Mean),
+			formatStat(s.TimeToFirstResponse.Count, s.TimeToFirstResponse.P90),
+			formatStat(s.TimeToAcknowledge.Count, s.TimeToAcknowledge.Mean),
+			formatStat(s.TimeToAcknowledge.Count, s.TimeToAcknowledge.P90))
+	}
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w, "\nTTR: time to resolve, TTFR: time to first responder, TTA: time to acknowledge")
+
+	if len(r.TopStarters) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(w, "\nTop incident starters")
+
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, s := range r.TopStarters {
+		fmt.Fprintf(tw, "  %s\t%d\n", s.Name, s.Incidents)
+	}
+
+	return tw.Flush()
+}
+
+// writeCSV writes one row per severity followed by the totals; durations are in seconds
+func (r *Report) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	header := []string{
+		"from", "to", "severity", "incidents", "resolved", "open", "escalations",
+		"mean_ttr_seconds", "p90_ttr_seconds", "mean_ttfr_seconds", "p90_ttfr_seconds",
+		"mean_tta_seconds", "p90_tta_seconds",
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, s := range r.rows() {
+		err := cw.Write([]string{
+			r.From.Format(time.RFC3339),
+			r.To.Format(time.RFC3339),
+			s.Severity,
+			strconv.Itoa(s.Incidents),
+			strconv.Itoa(s.Resolved),
+			strconv.Itoa(s.Open),
+			strconv.Itoa(s.Escalations),
+			formatSeconds(s.TimeToResolve.Count, s.TimeToResolve.Mean),
+			formatSeconds(s.TimeToResolve.Count, s.TimeToResolve.P90),
+			formatSeconds(s.TimeToFirstResponse.Count, s.TimeToFirstResponse.Mean),
+			formatSeconds(s.TimeToFirstResponse.Count, s.TimeToFirstResponse.P90),
+			formatSeconds(s.TimeToAcknowledge.Count, s.TimeToAcknowledge.Mean),
+			formatSeconds(s.TimeToAcknowledge.Count, s.TimeToAcknowledge.P90),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// SlackMessage formats the report as a Slack message
+func (r *Report) SlackMessage() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "📊 *Incident stats%s from %s to %s*\n\n", r.scope(" for "), r.From.Format(time.DateOnly), r.To.Format(time.DateOnly))
+
+	if r.Total.Incidents == 0 {
+		b.WriteString("No incidents were declared in this period. 🎉")
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "*Incidents:* %d (%d resolved, %d open)\n", r.Total.Incidents, r.Total.Resolved, r.Total.Open)
+
+	for _, s := range r.Severities {
+		if s.Incidents == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&b, "• *%s:* %d, time to resolve mean %s / p90 %s\n", s.Severity, s.Incidents,
+			formatStat(s.TimeToResolve.Count, s.TimeToResolve.Mean),
+			formatStat(s.TimeToResolve.Count, s.TimeToResolve.P90))
+	}
+
+	fmt.Fprintf(&b, "*Time to resolve:* mean %s, p90 %s\n",
+		formatStat(r.Total.TimeToResolve.Count, r.Total.TimeToResolve.Mean),
+		formatStat(r.Total.TimeToResolve.Count, r.Total.TimeToResolve.P90))
+	fmt.Fprintf(&b, "*Time to first responder:* mean %s, p90 %s\n",
+		formatStat(r.Total.TimeToFirstResponse.Count, r.Total.TimeToFirstResponse.Mean),
+		formatStat(r.Total.TimeToFirstResponse.Count, r.Total.TimeToFirstResponse.P90))
+	if r.Total.TimeToAcknowledge.Count > 0 {
+		fmt.Fprintf(&b, "*Time to acknowledge:* mean %s, p90 %s\n",
+			FormatDuration(r.Total.TimeToAcknowledge.Mean), FormatDuration(r.Total.TimeToAcknowledge.P90))
+	}
+
+	fmt.Fprintf(&b, "*Severity escalations:* %d\n", r.Total.Escalations)
+
+	if len(r.TopStarters) > 0 {
+		starters := make([]string, 0, len(r.TopStarters))
+		for _, s := range r.TopStarters {
+			starters = append(starters, fmt.Sprintf("%s (%d)", s.Name, s.Incidents))
+		}
+
+		fmt.Fprintf(&b, "*Top incident starters:* %s\n", strings.Join(starters, ", "))
+	}
+
+	return b.String()
+}
+
+// formatStat formats a measured duration for display, or "-" when nothing was measured
+func formatStat(count int, d time.Duration) string {
+	if count == 0 {
+		return "-"
+	}
+
+	return FormatDuration(d)
+}
+
+// FormatDuration formats a duration rounded to the minute, such as "45m", "2h5m" or "3d4h"
+func FormatDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+
+	days, hours, minutes := int(d/(24*time.Hour)), int(d/time.Hour)%24, int(d/time.Minute)%60
+
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd%dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh%dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
+
+// formatSeconds formats a duration in whole seconds, or an empty string when nothing was measured
+func formatSeconds(count int, d time.Duration) string {
+	if count == 0 {
+		return ""
+	}
+
+	return strconv.FormatInt(int64(d.Round(time.Second)/time.Second), 10)
+}
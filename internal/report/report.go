@@ -0,0 +1,262 @@
+// Package report computes incident statistics such as counts and time to resolve over a period.
+package report
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+// DefaultPeriod is the period covered when none is given
+const DefaultPeriod = 30 * 24 * time.Hour
+
+// maxTopStarters is the number of people listed as top incident starters
+const maxTopStarters = 5
+
+// Report holds incident statistics for the incidents started in [From, To), only those affecting
+// Service when it is set
+type Report struct {
+	From        time.Time        `json:"from"`
+	To          time.Time        `json:"to"`
+	Service     string           `json:"service,omitempty"`
+	Total       SeverityStats    `json:"total"`
+	Severities  []*SeverityStats `json:"severities"`
+	TopStarters []*Starter       `json:"top_starters"`
+}
+
+// SeverityStats holds the statistics of the incidents of one severity, or of all of them
+type SeverityStats struct {
+	Severity  string `json:"severity"`
+	Incidents int    `json:"incidents"`
+	Resolved  int    `json:"resolved"`
+	Open      int    `json:"open"`
+	// Escalations is the number of times an incident's severity was raised
+	Escalations int `json:"escalations"`
+	// TimeToResolve runs from the start of an incident to its resolution
+	TimeToResolve Durations `json:"time_to_resolve"`
+	// TimeToFirstResponse runs from the start of an incident to the first timeline entry
+	// by someone other than the person who declared it
+	TimeToFirstResponse Durations `json:"time_to_first_response"`
+	// TimeToAcknowledge runs from the start of an incident to the acknowledgement of its escalation
+	TimeToAcknowledge Durations `json:"time_to_acknowledge"`
+}
+
+// Starter is a person or integration that declared incidents
+type Starter struct {
+	Name      string `json:"name"`
+	Incidents int    `json:"incidents"`
+}
+
+// Durations summarizes a set of durations
+type Durations struct {
+	Count int
+	Mean  time.Duration
+	P90   time.Duration
+}
+
+// MarshalJSON encodes the durations in seconds
+func (d Durations) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Count       int     `json:"count"`
+		MeanSeconds float64 `json:"mean_seconds"`
+		P90Seconds  float64 `json:"p90_seconds"`
+	}{d.Count, d.Mean.Seconds(), d.P90.Seconds()})
+}
+
+// Generate builds the report for the incidents started in [from, to), only those affecting
+// service when it is set
+func Generate(ctx context.Context, st *store.Store, from, to time.Time, service string) (*Report, error) {
+	incidents, err := st.ListReportIncidents(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if service == "" {
+		return Build(from, to, incidents), nil
+	}
+
+	r := Build(from, to, AffectingService(incidents, service))
+	r.Service = service
+
+	return r, nil
+}
+
+// AffectingService returns the incidents affecting a service
+func AffectingService(incidents []*store.ReportIncident, service string) []*store.ReportIncident {
+	var affecting []*store.ReportIncident
+
+	for _, inc := range incidents {
+		if slices.Contains(inc.Services, service) {
+			affecting = append(affecting, inc)
+		}
+	}
+
+	return affecting
+}
+
+// Build computes the report from the incidents started in [from, to)
+func Build(from, to time.Time, incidents []*store.ReportIncident) *Report {
+	r := &Report{
+		From:  from,
+		To:    to,
+		Total: SeverityStats{Severity: "total"},
+	}
+
+	bySeverity := make(map[string]*statsBuilder)
+	for _, severity := range incident.Severities() {
+		bySeverity[string(severity)] = &statsBuilder{stats: &SeverityStats{Severity: string(severity)}}
+	}
+
+	total := &statsBuilder{stats: &r.Total}
+	starters := make(map[string]int)
+
+	for _, inc := range incidents {
+		total.add(inc)
+
+		if b, ok := bySeverity[inc.Severity]; ok {
+			b.add(inc)
+		}
+
+		starters[inc.StartedBy]++
+	}
+
+	total.finish()
+
+	for _, severity := range incident.Severities() {
+		b := bySeverity[string(severity)]
+		b.finish()
+		r.Severities = append(r.Severities, b.stats)
+	}
+
+	r.TopStarters = topStarters(starters, maxTopStarters)
+
+	return r
+}
+
+// statsBuilder accumulates incidents into SeverityStats
+type statsBuilder struct {
+	stats         *SeverityStats
+	toResolve     []time.Duration
+	toFirstAnswer []time.Duration
+	toAcknowledge []time.Duration
+}
+
+// add accounts for an incident
+func (b *statsBuilder) add(inc *store.ReportIncident) {
+	b.stats.Incidents++
+	b.stats.Escalations += inc.Escalations
+
+	if inc.Status == string(incident.StatusOpen) {
+		b.stats.Open++
+	}
+
+	if inc.ResolvedAt != nil {
+		b.stats.Resolved++
+		b.toResolve = append(b.toResolve, inc.ResolvedAt.Sub(inc.StartedAt))
+	}
+
+	if inc.FirstResponseAt != nil && !inc.FirstResponseAt.Before(inc.StartedAt) {
+		b.toFirstAnswer = append(b.toFirstAnswer, inc.FirstResponseAt.Sub(inc.StartedAt))
+	}
+
+	if inc.AcknowledgedAt != nil && !inc.AcknowledgedAt.Before(inc.StartedAt) {
+		b.toAcknowledge = append(b.toAcknowledge, inc.AcknowledgedAt.Sub(inc.StartedAt))
+	}
+}
+
+// finish computes the duration summaries
+func (b *statsBuilder) finish() {
+	b.stats.TimeToResolve = summarize(b.toResolve)
+	b.stats.TimeToFirstResponse = summarize(b.toFirstAnswer)
+	b.stats.TimeToAcknowledge = summarize(b.toAcknowledge)
+}
+
+// summarize returns the mean and 90th percentile of durations
+func summarize(durations []time.Duration) Durations {
+	if len(durations) == 0 {
+		return Durations{}
+	}
+
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+
+	var sum time.Duration
+	for _, d := range sorted {
+		sum += d
+	}
+
+	// Nearest-rank percentile
+	rank := int(math.Ceil(0.9*float64(len(sorted)))) - 1
+
+	return Durations{
+		Count: len(sorted),
+		Mean:  sum / time.Duration(len(sorted)),
+		P90:   sorted[rank],
+	}
+}
+
+// topStarters returns the n people who declared the most incidents
+func topStarters(counts map[string]int, n int) []*Starter {
+	starters := make([]*Starter, 0, len(counts))
+	for name, count := range counts {
+		starters = append(starters, &Starter{Name: name, Incidents: count})
+	}
+
+	slices.SortFunc(starters, func(a, b *Starter) int {
+		if c := cmp.Compare(b.Incidents, a.Incidents); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	if len(starters) > n {
+		starters = starters[:n]
+	}
+
+	return starters
+}
+
+// ParsePeriod parses a period such as "30d", "2w" or "12h". An empty period is DefaultPeriod.
+func ParsePeriod(s string) (time.Duration, error) {
+	s = strings.TrimSpace(strings.ToLower(s))
+	if s == "" {
+		return DefaultPeriod, nil
+	}
+
+	units := map[byte]time.Duration{
+		'h': time.Hour,
+		'd': 24 * time.Hour,
+		'w': 7 * 24 * time.Hour,
+	}
+
+	unit, ok := units[s[len(s)-1]]
+	if !ok {
+		return 0, fmt.Errorf("invalid period %q, expected a number followed by h, d or w", s)
+	}
+
+	n, err := strconv.Atoi(s[:len(s)-1])
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid period %q, expected a number followed by h, d or w", s)
+	}
+
+	return time.Duration(n) * unit, nil
+}
+
+// ParseTime parses an RFC 3339 timestamp or a YYYY-MM-DD date
+func ParseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	return time.Parse(time.DateOnly, value)
+}
@@ -0,0 +1,108 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+func TestBuild(t *testing.T) {
+	start := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		t := start.Add(d)
+		return &t
+	}
+
+	incidents := []*store.ReportIncident{
+		{Severity: "SEV1", Status: "resolved", StartedBy: "alice", StartedAt: start, ResolvedAt: at(time.Hour), FirstResponseAt: at(5 * time.Minute)},
+		{Severity: "SEV1", Status: "resolved", StartedBy: "bob", StartedAt: start, ResolvedAt: at(3 * time.Hour), FirstResponseAt: at(15 * time.Minute), Escalations: 1},
+		{Severity: "SEV2", Status: "open", StartedBy: "alice", StartedAt: start, AcknowledgedAt: at(2 * time.Minute)},
+	}
+
+	r := Build(start, start.Add(24*time.Hour), incidents)
+
+	if r.Total.Incidents != 3 || r.Total.Resolved != 2 || r.Total.Open != 1 || r.Total.Escalations != 1 {
+		t.Errorf("Build() Total = %+v", r.Total)
+	}
+
+	if got := r.Total.TimeToResolve; got.Count != 2 || got.Mean != 2*time.Hour || got.P90 != 3*time.Hour {
+		t.Errorf("Build() TimeToResolve = %+v", got)
+	}
+
+	if got := r.Total.TimeToFirstResponse; got.Count != 2 || got.Mean != 10*time.Minute || got.P90 != 15*time.Minute {
+		t.Errorf("Build() TimeToFirstResponse = %+v", got)
+	}
+
+	if got := r.Total.TimeToAcknowledge; got.Count != 1 || got.Mean != 2*time.Minute {
+		t.Errorf("Build() TimeToAcknowledge = %+v", got)
+	}
+
+	if len(r.Severities) != 4 {
+		t.Fatalf("Build() returned %d severities, want 4", len(r.Severities))
+	}
+
+	if sev1 := r.Severities[1]; sev1.Severity != "SEV1" || sev1.Incidents != 2 {
+		t.Errorf("Build() SEV1 = %+v", sev1)
+	}
+
+	if len(r.TopStarters) != 2 || r.TopStarters[0].Name != "alice" || r.TopStarters[0].Incidents != 2 {
+		t.Errorf("Build() TopStarters = %+v", r.TopStarters)
+	}
+}
+
+func TestAffectingService(t *testing.T) {
+	incidents := []*store.ReportIncident{
+		{ID: "1", Services: []string{"payments"}},
+		{ID: "2"},
+		{ID: "3", Services: []string{"checkout", "payments"}},
+		{ID: "4", Services: []string{"checkout"}},
+	}
+
+	got := AffectingService(incidents, "payments")
+	if len(got) != 2 || got[0].ID != "1" || got[1].ID != "3" {
+		t.Errorf("AffectingService() = %+v, want incidents 1 and 3", got)
+	}
+}
+
+func TestSummarizeP90(t *testing.T) {
+	var durations []time.Duration
+	for i := 10; i >= 1; i-- {
+		durations = append(durations, time.Duration(i)*time.Minute)
+	}
+
+	got := summarize(durations)
+	if got.P90 != 9*time.Minute {
+		t.Errorf("summarize() P90 = %v, want %v", got.P90, 9*time.Minute)
+	}
+}
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{input: "", want: DefaultPeriod},
+		{input: "30d", want: 30 * 24 * time.Hour},
+		{input: "2W", want: 14 * 24 * time.Hour},
+		{input: "12h", want: 12 * time.Hour},
+		{input: "0d", wantErr: true},
+		{input: "30", wantErr: true},
+		{input: "d", wantErr: true},
+		{input: "1y", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParsePeriod(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePeriod() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("ParsePeriod() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Package routing works out which channels are notified about an incident.
+package routing
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/logger"
+	"github.com/fishnix/ohshift/internal/paging"
+)
+
+// route is a validated notification route
+type route struct {
+	severities []incident.Severity
+	services   []string
+	teams      []string
+	userGroups []string
+	channels   []string
+}
+
+// Router picks the channels of the configured notification routes
+type Router struct {
+	routes []route
+	groups paging.GroupResolver
+	logger *slog.Logger
+}
+
+// New creates a new router using the configured notification routes. The user groups of routes
+// are looked up with groups.
+func New(cfg *config.Config, groups paging.GroupResolver) (*Router, error) {
+	routes := make([]route, 0, len(cfg.NotificationRoutes))
+
+	for i, configured := range cfg.NotificationRoutes {
+		r, err := parseRoute(configured)
+		if err != nil {
+			return nil, fmt.Errorf("notification route %d: %w", i, err)
+		}
+
+		routes = append(routes, r)
+	}
+
+	return &Router{
+		routes: routes,
+		groups: groups,
+		logger: logger.With("component", "routing"),
+	}, nil
+}
+
+// parseRoute validates a configured notification route
+func parseRoute(configured config.NotificationRoute) (route, error) {
+	severities, services, err := paging.ParseMatch(configured.Severities, configured.Services)
+	if err != nil {
+		return route{}, err
+	}
+
+	var teams []string
+
+	for _, name := range configured.Teams {
+		team, err := incident.ParseTeam(name)
+		if err != nil {
+			return route{}, err
+		}
+
+		teams = append(teams, team)
+	}
+
+	if len(configured.Channels) == 0 {
+		return route{}, fmt.Errorf("at least one channel is required")
+	}
+
+	return route{
+		severities: severities,
+		services:   services,
+		teams:      teams,
+		userGroups: configured.UserGroups,
+		channels:   configured.Channels,
+	}, nil
+}
+
+// Enabled reports whether any notification route is configured
+func (r *Router) Enabled() bool {
+	return len(r.routes) > 0
+}
+
+// Channels returns the channels of the routes matching an incident, in the order they are
+// configured and without duplicates. It returns nil when no route matches.
+func (r *Router) Channels(inc *incident.Incident) []string {
+	var channels []string
+
+	memberships := make(map[string]bool)
+
+	for _, rt := range r.routes {
+		if !r.matches(rt, inc, memberships) {
+			continue
+		}
+
+		for _, channel := range rt.channels {
+			if !slices.Contains(channels, channel) {
+				channels = append(channels, channel)
+			}
+		}
+	}
+
+	return channels
+}
+
+// matches reports whether a route matches an incident. memberships caches whether the person
+// who declared the incident is in a user group across routes.
+func (r *Router) matches(rt route, inc *incident.Incident, memberships map[string]bool) bool {
+	if !paging.Matches(rt.severities, rt.services, inc.Severity, inc.Services) {
+		return false
+	}
+
+	if len(rt.teams) > 0 && !slices.Contains(rt.teams, inc.Team) {
+		return false
+	}
+
+	if len(rt.userGroups) == 0 {
+		return true
+	}
+
+	if inc.StartedByUserID == "" {
+		return false
+	}
+
+	for _, groupID := range rt.userGroups {
+		member, ok := memberships[groupID]
+		if !ok {
+			member = r.isMember(groupID, inc.StartedByUserID)
+			memberships[groupID] = member
+		}
+
+		if member {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isMember reports whether a user is in a user group. Groups that can't be looked up are
+// logged and treated as not matching.
+func (r *Router) isMember(groupID, userID string) bool {
+	members, err := r.groups.UserGroupMembers(groupID)
+	if err != nil {
+		r.logger.Warn("Failed to look up user group for notification routing", "error", err, "usergroup", groupID)
+		return false
+	}
+
+	return slices.Contains(members, userID)
+}
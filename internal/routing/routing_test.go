@@ -0,0 +1,75 @@
+package routing
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/incident"
+)
+
+// groups resolves user groups from a map
+type groups map[string][]string
+
+func (g groups) UserGroupMembers(groupID string) ([]string, error) {
+	members, ok := g[groupID]
+	if !ok {
+		return nil, errors.New("unknown user group")
+	}
+
+	return members, nil
+}
+
+func TestChannels(t *testing.T) {
+	cfg := &config.Config{
+		NotificationRoutes: []config.NotificationRoute{
+			{Severities: []string{"SEV0"}, Channels: []string{"exec-incidents", "incidents"}},
+			{Severities: []string{"SEV1", "SEV2"}, Channels: []string{"incidents"}},
+			{Services: []string{"Payments"}, Channels: []string{"payments-oncall"}},
+			{Teams: []string{"storefront"}, Channels: []string{"storefront"}},
+			{UserGroups: []string{"S1", "S2"}, Channels: []string{"sre"}},
+		},
+	}
+
+	r, err := New(cfg, groups{"S1": {"U1"}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		inc  *incident.Incident
+		want []string
+	}{
+		{"severity", &incident.Incident{Severity: incident.Severity0}, []string{"exec-incidents", "incidents"}},
+		{"severity and service", &incident.Incident{Severity: incident.Severity0, Services: []string{"payments"}},
+			[]string{"exec-incidents", "incidents", "payments-oncall"}},
+		{"team", &incident.Incident{Severity: incident.Severity2, Team: "storefront"}, []string{"incidents", "storefront"}},
+		{"user group", &incident.Incident{Severity: incident.Severity3, StartedByUserID: "U1"}, []string{"sre"}},
+		{"not in user group", &incident.Incident{Severity: incident.Severity3, StartedByUserID: "U2"}, nil},
+		{"no match", &incident.Incident{Severity: incident.Severity3}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.Channels(tt.inc); !slices.Equal(got, tt.want) {
+				t.Errorf("Channels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewInvalidRoute(t *testing.T) {
+	routes := []config.NotificationRoute{
+		{Severities: []string{"SEV9"}, Channels: []string{"incidents"}},
+		{Teams: []string{"bad team"}, Channels: []string{"incidents"}},
+		{Severities: []string{"SEV0"}},
+	}
+
+	for _, route := range routes {
+		if _, err := New(&config.Config{NotificationRoutes: []config.NotificationRoute{route}}, nil); err == nil {
+			t.Errorf("New(%+v) expected an error", route)
+		}
+	}
+}
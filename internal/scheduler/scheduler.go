@@ -0,0 +1,88 @@
+// Package scheduler runs periodic jobs on cron schedules, once across all bot replicas.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/fishnix/ohshift/internal/logger"
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+// DefaultJobTimeout bounds a single run of a job
+const DefaultJobTimeout = 5 * time.Minute
+
+// Job is a unit of scheduled work
+type Job func(ctx context.Context) error
+
+// Scheduler runs jobs on cron schedules. Each scheduled run is executed by a single
+// replica: the first one to take the job's advisory lock.
+type Scheduler struct {
+	cron   *cron.Cron
+	store  *store.Store
+	logger *slog.Logger
+	ctx    context.Context
+}
+
+// New creates a new scheduler that coordinates replicas through st
+func New(st *store.Store) *Scheduler {
+	return &Scheduler{
+		cron:   cron.New(cron.WithLogger(cron.DiscardLogger)),
+		store:  st,
+		logger: logger.With("component", "scheduler"),
+		ctx:    context.Background(),
+	}
+}
+
+// Add schedules job under name. spec is a five field cron expression, optionally
+// prefixed with CRON_TZ=<zone>, or a descriptor such as @daily or @every 1h.
+func (s *Scheduler) Add(name, spec string, job Job) error {
+	_, err := s.cron.AddFunc(spec, func() { s.run(name, job) })
+	if err != nil {
+		return fmt.Errorf("invalid schedule %q for job %s: %w", spec, name, err)
+	}
+
+	s.logger.Info("Job scheduled", "job", name, "schedule", spec)
+
+	return nil
+}
+
+// Run starts the scheduler and blocks until ctx is done, then waits for running jobs to finish
+func (s *Scheduler) Run(ctx context.Context) {
+	s.ctx = ctx
+
+	s.cron.Start()
+	s.logger.Info("Scheduler started", "jobs", len(s.cron.Entries()))
+
+	<-ctx.Done()
+
+	<-s.cron.Stop().Done()
+	s.logger.Info("Scheduler stopped")
+}
+
+// run executes one scheduled run of a job unless another replica has it
+func (s *Scheduler) run(name string, job Job) {
+	// Replicas fire the same schedule at about the same time, so the run is
+	// identified by the minute it was scheduled for.
+	scheduledAt := time.Now().Truncate(time.Minute)
+
+	ctx, cancel := context.WithTimeout(s.ctx, DefaultJobTimeout)
+	defer cancel()
+
+	start := time.Now()
+
+	ran, err := s.store.RunExclusive(ctx, name, scheduledAt, job)
+
+	switch {
+	case err != nil:
+		s.logger.Error("Job failed", "error", err, "job", name, "scheduled_at", scheduledAt)
+	case !ran:
+		s.logger.Info("Job skipped, another replica ran it", "job", name, "scheduled_at", scheduledAt)
+	default:
+		s.logger.Info("Job completed", "job", name, "scheduled_at", scheduledAt, "duration", time.Since(start))
+	}
+}
@@ -0,0 +1,156 @@
+// Package server provides the HTTP server the OhShift! bot exposes on the configured port.
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/logger"
+)
+
+const (
+	readHeaderTimeout = 10 * time.Second
+	shutdownTimeout   = 15 * time.Second
+)
+
+// Server wraps an http.Server and its routes
+type Server struct {
+	mux    *http.ServeMux
+	server *http.Server
+	logger *slog.Logger
+}
+
+// New creates a new HTTP server listening on the configured port
+func New(cfg *config.Config) *Server {
+	mux := http.NewServeMux()
+
+	return &Server{
+		mux: mux,
+		server: &http.Server{
+			Addr:              net.JoinHostPort("", cfg.Port),
+			Handler:           mux,
+			ReadHeaderTimeout: readHeaderTimeout,
+		},
+		logger: logger.With("component", "http_server"),
+	}
+}
+
+// Handle registers the handler for the given pattern
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+	s.logger.Debug("Registered HTTP route", "pattern", pattern)
+}
+
+// HandleFunc registers the handler function for the given pattern
+func (s *Server) HandleFunc(pattern string, handler http.HandlerFunc) {
+	s.Handle(pattern, handler)
+}
+
+// Run serves HTTP requests and blocks until ctx is done, then shuts the server down gracefully
+func (s *Server) Run(ctx context.Context) error {
+	errCh := make(chan error, 1)
+
+	go func() {
+		s.logger.Info("HTTP server listening", "addr", s.server.Addr)
+		errCh <- s.server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+
+		return err
+	case <-ctx.Done():
+	}
+
+	s.logger.Info("Shutting down HTTP server")
+
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+	defer cancel()
+
+	if err := s.server.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RequireToken wraps next so that requests must carry one of the given bearer tokens
+func RequireToken(tokens []string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !validToken(tokens, bearerToken(r)) {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// RequireTokenParam is RequireToken for clients that can't send headers, such as calendar
+// apps subscribing to a feed: the token can also be given in the token query parameter
+func RequireTokenParam(tokens []string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := bearerToken(r)
+		if token == "" {
+			token = r.URL.Query().Get("token")
+		}
+
+		if !validToken(tokens, token) {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// bearerToken extracts the bearer token from the Authorization header
+func bearerToken(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+
+	return strings.TrimSpace(token)
+}
+
+// TokenID identifies the bearer token a request was authenticated with without revealing it
+func TokenID(r *http.Request) string {
+	sum := sha256.Sum256([]byte(bearerToken(r)))
+	return hex.EncodeToString(sum[:8])
+}
+
+// validToken reports whether token matches any of the allowed tokens
+func validToken(tokens []string, token string) bool {
+	if token == "" {
+		return false
+	}
+
+	valid := false
+
+	for _, allowed := range tokens {
+		if allowed != "" && subtle.ConstantTimeCompare([]byte(allowed), []byte(token)) == 1 {
+			valid = true
+		}
+	}
+
+	return valid
+}
@@ -0,0 +1,182 @@
+package slack
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/checklist"
+	"github.com/fishnix/ohshift/internal/store"
+	"github.com/slack-go/slack"
+)
+
+const (
+	// checklistActionID is the action of the checkbox of a checklist item
+	checklistActionID = "checklist_item"
+	// checklistBlockPrefix is followed by the position of the item in the block ID of its checkbox
+	checklistBlockPrefix = "checklist_"
+)
+
+// postChecklist stores the checklist of an incident and posts it in the incident channel with a
+// checkbox for each item
+func (b *Bot) postChecklist(api *slack.Client, incidentID, channelID string, items []*store.ChecklistItem) {
+	if len(items) == 0 {
+		return
+	}
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	record := &store.Checklist{IncidentID: incidentID, SlackChannelID: channelID}
+
+	if interval, err := checklist.ParseInterval(b.cfg().ChecklistReminderInterval); err == nil {
+		next := time.Now().Add(interval)
+		record.NextReminder = &next
+	}
+
+	if err := b.store.CreateChecklist(ctx, record, items); err != nil {
+		b.logger.Error("Failed to store checklist", "error", err, "incident_id", incidentID)
+	}
+
+	_, ts, err := api.PostMessage(channelID,
+		slack.MsgOptionText(checklistText(items), false),
+		slack.MsgOptionBlocks(checklistBlocks(items)...))
+	if err != nil {
+		b.logger.Error("Failed to post checklist", "error", err, "channel_id", channelID)
+		return
+	}
+
+	if err := b.store.SetChecklistMessage(ctx, incidentID, ts); err != nil {
+		b.logger.Warn("Failed to record checklist message", "error", err, "incident_id", incidentID)
+	}
+}
+
+// handleChecklistToggle records an item of an incident checklist being ticked or unticked and
+// updates the checklist message
+func (b *Bot) handleChecklistToggle(callback slack.InteractionCallback, action *slack.BlockAction) {
+	position, err := strconv.Atoi(strings.TrimPrefix(action.BlockID, checklistBlockPrefix))
+	if err != nil {
+		b.logger.Warn("Invalid checklist block", "block_id", action.BlockID)
+		return
+	}
+
+	done := len(action.SelectedOptions) > 0
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	// The checklist is looked up in the store so that it can be ticked after a restart
+	record, err := b.store.GetChecklistByChannel(ctx, callback.Channel.ID)
+	if errors.Is(err, store.ErrNotFound) {
+		b.postInteractionResponse(callback, MsgCommandNotInIncidentChannel)
+		return
+	}
+
+	if err != nil {
+		b.logger.Error("Failed to get checklist", "error", err, "channel_id", callback.Channel.ID)
+		b.postInteractionResponse(callback, fmt.Sprintf("Failed to update the checklist: %v", err))
+
+		return
+	}
+
+	incidentID := record.IncidentID
+
+	changed, err := b.store.SetChecklistItemDone(ctx, incidentID, position, callback.User.ID, done)
+	if errors.Is(err, store.ErrNotFound) {
+		b.postInteractionResponse(callback, "❌ This checklist item no longer exists.")
+		return
+	}
+
+	if err != nil {
+		b.logger.Error("Failed to update checklist item", "error", err, "incident_id", incidentID, "position", position)
+		b.postInteractionResponse(callback, fmt.Sprintf("Failed to update the checklist: %v", err))
+
+		return
+	}
+
+	_, items, err := b.store.GetChecklist(ctx, incidentID)
+	if err != nil {
+		b.logger.Error("Failed to get checklist", "error", err, "incident_id", incidentID)
+		return
+	}
+
+	if changed {
+		for _, item := range items {
+			if item.Position != position {
+				continue
+			}
+
+			if err := b.timelineMgr.AddChecklistEntry(incidentID, callback.User.ID, item.Text, done); err != nil {
+				b.logger.Warn("Failed to add checklist item to timeline", "error", err, "incident_id", incidentID)
+			}
+		}
+
+		b.logger.Info("Checklist item updated",
+			"incident_id", incidentID,
+			"position", position,
+			"done", done,
+			"user_id", callback.User.ID)
+	}
+
+	// Show who ticked the item, and undo ticks that didn't apply
+	_, _, _, err = b.interactionClient(callback).UpdateMessage(callback.Channel.ID, callback.Container.MessageTs,
+		slack.MsgOptionText(checklistText(items), false),
+		slack.MsgOptionBlocks(checklistBlocks(items)...))
+	if err != nil {
+		b.logger.Warn("Failed to update checklist message", "error", err, "channel_id", callback.Channel.ID)
+	}
+}
+
+// checklistBlocks returns the blocks of a checklist message, with a checkbox for each item
+func checklistBlocks(items []*store.ChecklistItem) []slack.Block {
+	blocks := []slack.Block{
+		slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, "📋 *Checklist*", false, false), nil, nil),
+	}
+
+	for _, item := range items {
+		option := slack.NewOptionBlockObject(strconv.Itoa(item.Position),
+			slack.NewTextBlockObject(slack.MarkdownType, checklistItemText(item), false, false), nil)
+
+		checkbox := slack.NewCheckboxGroupsBlockElement(checklistActionID, option)
+		if item.Done() {
+			checkbox.InitialOptions = []*slack.OptionBlockObject{option}
+		}
+
+		blocks = append(blocks, slack.NewActionBlock(fmt.Sprintf("%s%d", checklistBlockPrefix, item.Position), checkbox))
+	}
+
+	return blocks
+}
+
+// checklistText returns the plain text of a checklist message, shown in notifications
+func checklistText(items []*store.ChecklistItem) string {
+	lines := make([]string, 0, len(items)+1)
+	lines = append(lines, "📋 Checklist")
+
+	for _, item := range items {
+		box := "☐"
+		if item.Done() {
+			box = "☑"
+		}
+
+		lines = append(lines, box+" "+item.Text)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// checklistItemText returns the label of the checkbox of a checklist item
+func checklistItemText(item *store.ChecklistItem) string {
+	switch {
+	case item.Done() && item.CompletedBy != nil:
+		return fmt.Sprintf("~%s~ done by <@%s>", item.Text, *item.CompletedBy)
+	case item.Done():
+		return fmt.Sprintf("~%s~", item.Text)
+	case item.Required:
+		return item.Text + " _(required)_"
+	default:
+		return item.Text
+	}
+}
@@ -0,0 +1,92 @@
+package slack
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/metrics"
+	"github.com/fishnix/ohshift/internal/report"
+	"github.com/fishnix/ohshift/internal/store"
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/socketmode"
+)
+
+// statsUsage is the usage of the /shift stats command
+const statsUsage = "Usage: /shift stats [30d] [--service <service>]"
+
+// handleStatsCommand handles the /shift stats [period] [--service <service>] command and returns its outcome
+func (b *Bot) handleStatsCommand(cmd slack.SlashCommand, args []string, client acker, evt *socketmode.Event) string {
+	b.logger.Info("Processing stats command",
+		"user", cmd.UserName,
+		"channel_id", cmd.ChannelID,
+		"args", args)
+
+	var service string
+
+	if i := slices.Index(args, "--service"); i >= 0 {
+		if i+1 >= len(args) {
+			b.sendEphemeral(client, evt, "❌ --service requires a value\n\n"+statsUsage)
+			return metrics.OutcomeInvalid
+		}
+
+		var err error
+
+		service, err = incident.ParseService(args[i+1])
+		if err != nil {
+			b.sendEphemeral(client, evt, fmt.Sprintf("❌ %v\n\n%s", err, statsUsage))
+			return metrics.OutcomeInvalid
+		}
+
+		args = slices.Delete(slices.Clone(args), i, i+2)
+	}
+
+	var periodArg string
+	if len(args) > 0 {
+		periodArg = args[0]
+	}
+
+	period, err := report.ParsePeriod(periodArg)
+	if err != nil {
+		b.sendEphemeral(client, evt, fmt.Sprintf("❌ %v\n\n%s", err, statsUsage))
+		return metrics.OutcomeInvalid
+	}
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	from, to := time.Now().Add(-period), time.Now()
+
+	incidents, err := b.store.ListReportIncidents(ctx, from, to)
+	if err != nil {
+		b.logger.Error("Failed to generate incident stats", "error", err, "period", period)
+		b.sendEphemeral(client, evt, fmt.Sprintf("Failed to generate incident stats: %v", err))
+
+		return metrics.OutcomeError
+	}
+
+	// Stats cover the incidents of the workspace the command was run in
+	incidents = slices.DeleteFunc(incidents, func(inc *store.ReportIncident) bool {
+		return !b.inWorkspace(cmd.TeamID, inc.TeamID)
+	})
+
+	if service != "" {
+		incidents = report.AffectingService(incidents, service)
+	}
+
+	r := report.Build(from, to, incidents)
+	r.Service = service
+
+	b.sendEphemeral(client, evt, r.SlackMessage())
+
+	return metrics.OutcomeSuccess
+}
+
+// sendEphemeral responds to a slash command with a message only the caller can see
+func (b *Bot) sendEphemeral(client acker, evt *socketmode.Event, text string) {
+	b.sendSlashResponse(client, evt, &slack.Msg{
+		ResponseType: "ephemeral",
+		Text:         text,
+	})
+}
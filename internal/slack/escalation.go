@@ -0,0 +1,107 @@
+package slack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fishnix/ohshift/internal/escalation"
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/paging"
+	"github.com/fishnix/ohshift/internal/store"
+	"github.com/slack-go/slack"
+)
+
+// acknowledgeActionID is the action of the button acknowledging a page
+const acknowledgeActionID = "acknowledge_incident"
+
+// SetEscalator makes the bot escalate new incidents through the configured escalation policies
+func (b *Bot) SetEscalator(escalator *escalation.Escalator) {
+	b.escalator = escalator
+}
+
+// startEscalation starts escalating a new incident. Failures are logged so that declaring
+// the incident goes ahead.
+func (b *Bot) startEscalation(inc *incident.Incident) {
+	if b.escalator == nil {
+		return
+	}
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	if err := b.escalator.Start(ctx, inc); err != nil {
+		b.logger.Error("Failed to start incident escalation", "error", err, "incident_id", inc.ID)
+	}
+}
+
+// PageForAcknowledgement invites responders to an incident channel and sends each of them a
+// direct message with a button to acknowledge the incident. It returns an error when none of
+// them could be paged.
+func (b *Bot) PageForAcknowledgement(incidentID, channelID, text string, responders []paging.Responder) error {
+	api := b.workspaces.ForChannel(channelID)
+	paged := 0
+
+	for _, r := range responders {
+		if _, err := api.InviteUsersToConversation(channelID, r.UserID); err != nil {
+			b.logger.Warn("Failed to invite escalation responder to incident channel",
+				"error", err,
+				"user_id", r.UserID,
+				"channel_id", channelID)
+		}
+
+		message := fmt.Sprintf("%s\n_Paged because: %s_", text, strings.Join(r.Reasons, ", "))
+
+		// Posting to a user ID sends a direct message from the bot
+		_, _, err := api.PostMessage(r.UserID,
+			slack.MsgOptionText(message, false),
+			slack.MsgOptionBlocks(messageWithButton(message, acknowledgeActionID, "🙋 Acknowledge", incidentID)...))
+		if err != nil {
+			b.logger.Warn("Failed to send escalation page",
+				"error", err,
+				"user_id", r.UserID,
+				"incident_id", incidentID)
+
+			continue
+		}
+
+		paged++
+	}
+
+	if paged == 0 {
+		return fmt.Errorf("failed to page any of %d responders", len(responders))
+	}
+
+	return nil
+}
+
+// handleAcknowledge acknowledges an incident on behalf of the user who clicked the button of a page
+func (b *Bot) handleAcknowledge(callback slack.InteractionCallback, incidentID string) {
+	if b.escalator == nil {
+		b.postInteractionResponse(callback, "Escalation is not enabled.")
+		return
+	}
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	acknowledged, err := b.escalator.Acknowledge(ctx, incidentID, callback.User.ID)
+	if err != nil {
+		b.logger.Error("Failed to acknowledge incident", "error", err, "incident_id", incidentID, "user_id", callback.User.ID)
+		b.postInteractionResponse(callback, fmt.Sprintf("Failed to acknowledge incident: %v", err))
+
+		return
+	}
+
+	outcome := "🙋 You acknowledged this incident."
+	if !acknowledged {
+		outcome = "This incident was already acknowledged."
+	}
+
+	// Replace the button with the outcome so the page can't be acknowledged twice
+	_, _, _, err = b.interactionClient(callback).UpdateMessage(callback.Channel.ID, callback.Message.Timestamp,
+		slack.MsgOptionText(outcome, false),
+		slack.MsgOptionBlocks(slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, outcome, false, false), nil, nil)))
+	if err != nil {
+		b.logger.Warn("Failed to update escalation page", "error", err, "channel_id", callback.Channel.ID)
+	}
+}
@@ -0,0 +1,180 @@
+package slack
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+	"github.com/slack-go/slack/socketmode"
+)
+
+const (
+	// maxRequestBytes limits the size of requests from Slack
+	maxRequestBytes = 1 << 20
+	// ackTimeout is how long a request waits for its handler's response; Slack gives up after 3s
+	ackTimeout = 2500 * time.Millisecond
+)
+
+// HandleEvents receives Events API requests in HTTP mode
+func (b *Bot) HandleEvents(w http.ResponseWriter, r *http.Request) {
+	body, ok := b.readVerified(w, r)
+	if !ok {
+		return
+	}
+
+	event, err := slackevents.ParseEvent(body, slackevents.OptionNoVerifyToken())
+	if err != nil {
+		b.logger.Warn("Failed to parse Events API request", "error", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+
+		return
+	}
+
+	if event.Type == slackevents.URLVerification {
+		var challenge slackevents.ChallengeResponse
+		if err := json.Unmarshal(body, &challenge); err != nil {
+			http.Error(w, "Bad Request", http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte(challenge.Challenge))
+
+		return
+	}
+
+	b.dispatch(w, &socketmode.Event{Type: socketmode.EventTypeEventsAPI, Data: event}, b.handleEventsAPI)
+}
+
+// HandleCommands receives slash commands in HTTP mode
+func (b *Bot) HandleCommands(w http.ResponseWriter, r *http.Request) {
+	body, ok := b.readVerified(w, r)
+	if !ok {
+		return
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	cmd, err := slack.SlashCommandParse(r)
+	if err != nil {
+		b.logger.Warn("Failed to parse slash command request", "error", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+
+		return
+	}
+
+	b.dispatch(w, &socketmode.Event{Type: socketmode.EventTypeSlashCommand, Data: cmd}, b.handleSlashCommand)
+}
+
+// HandleInteractions receives interactivity requests, such as button clicks, in HTTP mode
+func (b *Bot) HandleInteractions(w http.ResponseWriter, r *http.Request) {
+	body, ok := b.readVerified(w, r)
+	if !ok {
+		return
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	var callback slack.InteractionCallback
+	if err := json.Unmarshal([]byte(form.Get("payload")), &callback); err != nil {
+		b.logger.Warn("Failed to parse interaction request", "error", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+
+		return
+	}
+
+	b.dispatch(w, &socketmode.Event{Type: socketmode.EventTypeInteractive, Data: callback}, b.handleInteractive)
+}
+
+// readVerified reads the body of a request from Slack and checks its X-Slack-Signature with the
+// signing secret. It writes an error response and returns false when the request isn't genuine.
+func (b *Bot) readVerified(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	verifier, err := slack.NewSecretsVerifier(r.Header, b.cfg().SlackSigningSecret)
+	if err != nil {
+		b.logger.Warn("Rejected Slack request without a valid signature", "error", err, "path", r.URL.Path)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+
+		return nil, false
+	}
+
+	body, err := io.ReadAll(io.TeeReader(http.MaxBytesReader(w, r.Body, maxRequestBytes), &verifier))
+	if err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return nil, false
+	}
+
+	if err := verifier.Ensure(); err != nil {
+		b.logger.Warn("Rejected Slack request with a bad signature", "error", err, "path", r.URL.Path)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+
+		return nil, false
+	}
+
+	return body, true
+}
+
+// httpAcker acknowledges a request received over HTTP by handing the payload to the response
+type httpAcker struct {
+	once     sync.Once
+	payloads chan any
+}
+
+// Ack sends the payload, if any, as the response; later acknowledgements are ignored
+func (a *httpAcker) Ack(_ socketmode.Request, payload ...interface{}) {
+	a.once.Do(func() {
+		var p any
+		if len(payload) > 0 {
+			p = payload[0]
+		}
+
+		a.payloads <- p
+	})
+}
+
+// dispatch runs the handler of a request received over HTTP and responds with its acknowledgement.
+// The handler keeps running after the response, as it does in Socket Mode.
+func (b *Bot) dispatch(w http.ResponseWriter, evt *socketmode.Event, handle func(*socketmode.Event, acker)) {
+	evt.Request = &socketmode.Request{Type: string(evt.Type)}
+	ack := &httpAcker{payloads: make(chan any, 1)}
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		handle(evt, ack)
+	}()
+
+	var payload any
+
+	select {
+	case payload = <-ack.payloads:
+	case <-done:
+		// The handler returned without acknowledging or right after it
+		select {
+		case payload = <-ack.payloads:
+		default:
+		}
+	case <-time.After(ackTimeout):
+		b.logger.Warn("Slack request not acknowledged in time, responding without a payload", "event_type", evt.Type)
+	}
+
+	if payload == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(payload); err != nil {
+		b.logger.Error("Failed to encode Slack response", "error", err)
+	}
+}
@@ -0,0 +1,169 @@
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/slack-go/slack/socketmode"
+)
+
+const testSigningSecret = "8f742231b10e8888abcd99yyyzzz85a5"
+
+// newHTTPTestBot returns a bot receiving events over HTTP, without a store
+func newHTTPTestBot() *Bot {
+	return NewBot(&config.Config{
+		SlackMode:          config.SlackModeHTTP,
+		SlackSigningSecret: testSigningSecret,
+		SlashCommand:       "/shift",
+	}, nil)
+}
+
+// signedRequest returns a request to path signed with secret at ts, as Slack signs them
+func signedRequest(path, contentType, body, secret string, ts time.Time) *http.Request {
+	timestamp := strconv.FormatInt(ts.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("v0:" + timestamp + ":" + body))
+
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("X-Slack-Request-Timestamp", timestamp)
+	req.Header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+
+	return req
+}
+
+func TestHandleEventsVerification(t *testing.T) {
+	body := `{"token":"unused","challenge":"3eZbrw1aBm2rZgRNFdxV2595E9CY3gmdALWMmHkvFXO7tYXAYM8P","type":"url_verification"}`
+
+	tests := []struct {
+		name     string
+		secret   string
+		sentAt   time.Time
+		wantCode int
+		wantBody string
+	}{
+		{
+			name:     "valid signature answers the challenge",
+			secret:   testSigningSecret,
+			sentAt:   time.Now(),
+			wantCode: http.StatusOK,
+			wantBody: "3eZbrw1aBm2rZgRNFdxV2595E9CY3gmdALWMmHkvFXO7tYXAYM8P",
+		},
+		{
+			name:     "bad signature",
+			secret:   "not-the-signing-secret",
+			sentAt:   time.Now(),
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "stale timestamp",
+			secret:   testSigningSecret,
+			sentAt:   time.Now().Add(-10 * time.Minute),
+			wantCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newHTTPTestBot()
+			w := httptest.NewRecorder()
+
+			b.HandleEvents(w, signedRequest("/slack/events", "application/json", body, tt.secret, tt.sentAt))
+
+			if w.Code != tt.wantCode {
+				t.Fatalf("HandleEvents() status = %d, want %d", w.Code, tt.wantCode)
+			}
+
+			if tt.wantBody != "" && w.Body.String() != tt.wantBody {
+				t.Errorf("HandleEvents() body = %q, want %q", w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestHandleEventsUnsigned(t *testing.T) {
+	b := newHTTPTestBot()
+	w := httptest.NewRecorder()
+
+	req := httptest.NewRequest(http.MethodPost, "/slack/events", strings.NewReader(`{"type":"url_verification"}`))
+	b.HandleEvents(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("HandleEvents() status = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestHandleCommandsAcksWithPayload(t *testing.T) {
+	b := newHTTPTestBot()
+	w := httptest.NewRecorder()
+
+	form := url.Values{
+		"command":    {"/shift"},
+		"text":       {"help"},
+		"user_id":    {"U0123"},
+		"user_name":  {"alice"},
+		"channel_id": {"C0123"},
+		"team_id":    {"T0123"},
+	}
+
+	b.HandleCommands(w, signedRequest("/slack/commands", "application/x-www-form-urlencoded", form.Encode(), testSigningSecret, time.Now()))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("HandleCommands() status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	if got := w.Header().Get("Content-Type"); got != "application/json" {
+		t.Errorf("HandleCommands() Content-Type = %q, want application/json", got)
+	}
+
+	var payload struct {
+		ResponseType string `json:"response_type"`
+		Text         string `json:"text"`
+	}
+
+	if err := json.Unmarshal(w.Body.Bytes(), &payload); err != nil {
+		t.Fatalf("HandleCommands() body %q is not JSON: %v", w.Body.String(), err)
+	}
+
+	if payload.ResponseType != "ephemeral" || !strings.Contains(payload.Text, "/shift start") {
+		t.Errorf("HandleCommands() payload = %+v, want the ephemeral help message", payload)
+	}
+}
+
+func TestDispatchWithoutPayload(t *testing.T) {
+	b := newHTTPTestBot()
+	w := httptest.NewRecorder()
+
+	b.dispatch(w, &socketmode.Event{Type: socketmode.EventTypeEventsAPI}, func(_ *socketmode.Event, _ acker) {})
+
+	if w.Code != http.StatusOK || w.Body.Len() != 0 {
+		t.Errorf("dispatch() = %d %q, want an empty 200 response", w.Code, w.Body.String())
+	}
+}
+
+func TestHealthCheckReportsMode(t *testing.T) {
+	b := newHTTPTestBot()
+	w := httptest.NewRecorder()
+
+	b.HealthCheck(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	var body map[string]string
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("HealthCheck() body %q is not JSON: %v", w.Body.String(), err)
+	}
+
+	if body["mode"] != config.SlackModeHTTP {
+		t.Errorf("HealthCheck() mode = %q, want %q", body["mode"], config.SlackModeHTTP)
+	}
+}
@@ -0,0 +1,42 @@
+package slack
+
+import (
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/incidenttype"
+	"github.com/slack-go/slack"
+)
+
+// initialMessage renders the message posted in a new incident channel, followed by the roles
+// of its type. The default message is used when the type's template fails to render.
+func (b *Bot) initialMessage(kind config.IncidentType, data incidenttype.MessageData) string {
+	message, err := incidenttype.InitialMessage(kind, data)
+	if err != nil {
+		b.logger.Warn("Failed to render initial message, using the default", "error", err, "incident_type", kind.Name)
+
+		message, _ = incidenttype.InitialMessage(config.IncidentType{}, data)
+	}
+
+	if roles := incidenttype.Roles(kind); roles != "" {
+		message += "\n\n" + roles
+	}
+
+	return message
+}
+
+// addRunbooks bookmarks runbooks in an incident channel
+func (b *Bot) addRunbooks(api *slack.Client, channelID string, runbooks []config.Runbook) {
+	for _, runbook := range runbooks {
+		_, err := api.AddBookmark(channelID, slack.AddBookmarkParameters{
+			Title: runbook.Title,
+			Type:  "link",
+			Link:  runbook.URL,
+			Emoji: ":book:",
+		})
+		if err != nil {
+			b.logger.Warn("Failed to bookmark runbook",
+				"error", err,
+				"channel_id", channelID,
+				"runbook", runbook.URL)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package slack
+
+import (
+	"fmt"
+
+	"github.com/fishnix/ohshift/internal/store"
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/socketmode"
+)
+
+const (
+	// subscribeActionID is the action of the button subscribing to the status updates of an incident
+	subscribeActionID = "subscribe_incident"
+	// unsubscribeActionID is the action of the button unsubscribing from the status updates of an incident
+	unsubscribeActionID = "unsubscribe_incident"
+)
+
+// handleInteractive handles button clicks
+func (b *Bot) handleInteractive(evt *socketmode.Event, client acker) {
+	callback, ok := evt.Data.(slack.InteractionCallback)
+	if !ok {
+		b.logger.Debug("Failed to parse interactive event", "event_type", evt.Type)
+		return
+	}
+
+	client.Ack(*evt.Request)
+
+	if callback.Type != slack.InteractionTypeBlockActions {
+		b.logger.Debug("Unhandled interaction type", "type", callback.Type)
+		return
+	}
+
+	for _, action := range callback.ActionCallback.BlockActions {
+		b.logger.Info("Received block action",
+			"action_id", action.ActionID,
+			"user", callback.User.Name,
+			"channel_id", callback.Channel.ID)
+
+		switch action.ActionID {
+		case subscribeActionID:
+			b.handleSubscribe(callback, action.Value)
+		case unsubscribeActionID:
+			b.handleUnsubscribe(callback, action.Value)
+		case staleResolveActionID, staleKeepOpenActionID, staleSnoozeActionID:
+			b.handleStaleAction(callback, action.ActionID, action.Value)
+		case acknowledgeActionID:
+			b.handleAcknowledge(callback, action.Value)
+		case checklistActionID:
+			b.handleChecklistToggle(callback, action)
+		default:
+			b.logger.Debug("Unhandled block action", "action_id", action.ActionID)
+		}
+	}
+}
+
+// handleSubscribe subscribes the user who clicked a button to the status updates of an incident
+func (b *Bot) handleSubscribe(callback slack.InteractionCallback, incidentID string) {
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	subscribed, err := b.store.Subscribe(ctx, incidentID, callback.User.ID)
+	if err != nil {
+		b.logger.Error("Failed to subscribe to incident", "error", err, "incident_id", incidentID, "user_id", callback.User.ID)
+		b.postInteractionResponse(callback, fmt.Sprintf("Failed to subscribe to updates: %v", err))
+
+		return
+	}
+
+	if !subscribed {
+		b.postInteractionResponse(callback, "You are already subscribed to updates for this incident.")
+		return
+	}
+
+	b.logger.Info("User subscribed to incident",
+		"incident_id", incidentID,
+		"user_id", callback.User.ID)
+
+	b.postInteractionResponse(callback, "🔔 You will receive status updates for this incident by direct message.")
+}
+
+// handleUnsubscribe stops sending the status updates of an incident to the user who clicked a button
+func (b *Bot) handleUnsubscribe(callback slack.InteractionCallback, incidentID string) {
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	if err := b.store.Unsubscribe(ctx, incidentID, callback.User.ID); err != nil {
+		b.logger.Error("Failed to unsubscribe from incident", "error", err, "incident_id", incidentID, "user_id", callback.User.ID)
+		b.postInteractionResponse(callback, fmt.Sprintf("Failed to unsubscribe from updates: %v", err))
+
+		return
+	}
+
+	b.logger.Info("User unsubscribed from incident",
+		"incident_id", incidentID,
+		"user_id", callback.User.ID)
+
+	b.postInteractionResponse(callback, "🔕 You will no longer receive status updates for this incident.")
+}
+
+// postInteractionResponse replies to a button click with a message only the user who clicked can see
+func (b *Bot) postInteractionResponse(callback slack.InteractionCallback, text string) {
+	if _, err := b.interactionClient(callback).PostEphemeral(callback.Channel.ID, callback.User.ID, slack.MsgOptionText(text, false)); err != nil {
+		b.logger.Error("Failed to respond to interaction",
+			"error", err,
+			"channel_id", callback.Channel.ID,
+			"user_id", callback.User.ID)
+	}
+}
+
+// messageWithButton returns the blocks of a message followed by a single button
+func messageWithButton(text, actionID, label, value string) []slack.Block {
+	return []slack.Block{
+		slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, text, false, false), nil, nil),
+		slack.NewActionBlock("",
+			slack.NewButtonBlockElement(actionID, value, slack.NewTextBlockObject(slack.PlainTextType, label, true, false))),
+	}
+}
+
+// recordNotification stores the message announcing an incident so that status updates can be threaded under it
+func (b *Bot) recordNotification(incidentID, channelID, messageTS string) {
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	if err := b.store.AddNotification(ctx, incidentID, channelID, messageTS); err != nil {
+		b.logger.Error("Failed to record incident notification",
+			"error", err,
+			"incident_id", incidentID,
+			"channel_id", channelID)
+	}
+}
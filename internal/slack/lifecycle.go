@@ -0,0 +1,161 @@
+package slack
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/metrics"
+	"github.com/fishnix/ohshift/internal/store"
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/socketmode"
+)
+
+// handleResolveCommand handles the /shift resolve command and returns its outcome
+func (b *Bot) handleResolveCommand(cmd slack.SlashCommand, client acker, evt *socketmode.Event) string {
+	b.logger.Info("Processing resolve command",
+		"user", cmd.UserName,
+		"channel_id", cmd.ChannelID)
+
+	incidentID := b.findIncidentIDByChannel(cmd.ChannelID)
+	if incidentID == "" {
+		b.sendEphemeral(client, evt, MsgCommandNotInIncidentChannel)
+		return metrics.OutcomeInvalid
+	}
+
+	err := b.resolveIncident(incidentID, cmd.ChannelID, cmd.UserID, cmd.UserName)
+	if errors.Is(err, store.ErrNotFound) {
+		b.sendEphemeral(client, evt, "❌ This incident is not open.")
+		return metrics.OutcomeInvalid
+	}
+
+	if err != nil {
+		b.sendEphemeral(client, evt, fmt.Sprintf("Failed to resolve incident: %v", err))
+		return metrics.OutcomeError
+	}
+
+	b.sendEphemeral(client, evt, "Incident resolved.")
+
+	return metrics.OutcomeSuccess
+}
+
+// resolveIncident resolves an open incident and announces it. It returns store.ErrNotFound
+// when the incident is not open.
+func (b *Bot) resolveIncident(incidentID, channelID, userID, userName string) error {
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	resolvedAt := time.Now()
+
+	err := b.store.ResolveIncident(ctx, incidentID, userName, resolvedAt)
+	if errors.Is(err, store.ErrNotFound) {
+		return err
+	}
+
+	if err != nil {
+		b.logger.Error("Failed to resolve incident", "error", err, "incident_id", incidentID)
+		return err
+	}
+
+	if err := b.timelineMgr.AddResolvedEntry(incidentID, userID, resolvedAt); err != nil {
+		b.logger.Warn("Failed to add resolution to timeline", "error", err, "incident_id", incidentID)
+	}
+
+	api := b.workspaces.ForChannel(channelID)
+
+	message := fmt.Sprintf("✅ *Incident Resolved* by <@%s> at %s", userID, resolvedAt.Format("2006-01-02 15:04:05"))
+	if _, _, err := api.PostMessage(channelID, slack.MsgOptionText(message, false)); err != nil {
+		b.logger.Error("Failed to post resolution message", "error", err, "channel_id", channelID)
+	}
+
+	b.notifyUpdate(api, incidentID, channelID, fmt.Sprintf("✅ <@%s> resolved the incident in <#%s>", userID, channelID))
+
+	b.logger.Info("Incident resolved",
+		"incident_id", incidentID,
+		"user", userName,
+		"channel_id", channelID)
+
+	return nil
+}
+
+// handleSeverityCommand handles the /shift severity <severity> command and returns its outcome
+func (b *Bot) handleSeverityCommand(cmd slack.SlashCommand, args []string, client acker, evt *socketmode.Event) string {
+	b.logger.Info("Processing severity command",
+		"user", cmd.UserName,
+		"channel_id", cmd.ChannelID,
+		"args", args)
+
+	incidentID := b.findIncidentIDByChannel(cmd.ChannelID)
+	if incidentID == "" {
+		b.sendEphemeral(client, evt, MsgCommandNotInIncidentChannel)
+		return metrics.OutcomeInvalid
+	}
+
+	if len(args) != 1 {
+		b.sendEphemeral(client, evt, "Usage: /shift severity <SEV0|SEV1|SEV2|SEV3>")
+		return metrics.OutcomeInvalid
+	}
+
+	severity, err := incident.ParseSeverity(args[0])
+	if err != nil {
+		b.sendEphemeral(client, evt, fmt.Sprintf("❌ %v", err))
+		return metrics.OutcomeInvalid
+	}
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	inc, err := b.store.GetIncident(ctx, incidentID)
+	if err != nil {
+		b.logger.Error("Failed to get incident", "error", err, "incident_id", incidentID)
+		b.sendEphemeral(client, evt, fmt.Sprintf("Failed to change severity: %v", err))
+
+		return metrics.OutcomeError
+	}
+
+	previous := incident.Severity(inc.Severity)
+	if previous == severity {
+		b.sendEphemeral(client, evt, fmt.Sprintf("This incident is already %s.", severity))
+		return metrics.OutcomeInvalid
+	}
+
+	if err := b.store.UpdateSeverity(ctx, incidentID, severity); err != nil {
+		b.logger.Error("Failed to update incident severity", "error", err, "incident_id", incidentID)
+		b.sendEphemeral(client, evt, fmt.Sprintf("Failed to change severity: %v", err))
+
+		return metrics.OutcomeError
+	}
+
+	if err := b.timelineMgr.AddSeverityChangeEntry(incidentID, cmd.UserID, previous, severity); err != nil {
+		b.logger.Warn("Failed to add severity change to timeline", "error", err, "incident_id", incidentID)
+	}
+
+	api := b.workspaces.ForTeam(cmd.TeamID, cmd.EnterpriseID)
+
+	if _, err := api.SetTopicOfConversation(cmd.ChannelID, fmt.Sprintf("%s Incident: %s", severity, inc.Title)); err != nil {
+		b.logger.Warn("Failed to set channel topic", "error", err, "channel_id", cmd.ChannelID)
+	}
+
+	message := fmt.Sprintf("📈 <@%s> changed the severity from *%s* to *%s*", cmd.UserID, previous, severity)
+	if _, _, err := api.PostMessage(cmd.ChannelID, slack.MsgOptionText(message, false)); err != nil {
+		b.logger.Error("Failed to post severity change message", "error", err, "channel_id", cmd.ChannelID)
+	}
+
+	update := fmt.Sprintf("📈 <@%s> changed the severity of <#%s> from *%s* to *%s*",
+		cmd.UserID, cmd.ChannelID, previous, severity)
+	b.notifyUpdate(api, incidentID, cmd.ChannelID, update)
+
+	inc.Severity = string(severity)
+	b.announceSeverityChange(api, inc, previous, update)
+
+	b.logger.Info("Incident severity changed",
+		"incident_id", incidentID,
+		"from", previous,
+		"to", severity,
+		"user", cmd.UserName)
+
+	b.sendEphemeral(client, evt, fmt.Sprintf("Severity changed to %s.", severity))
+
+	return metrics.OutcomeSuccess
+}
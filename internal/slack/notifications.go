@@ -0,0 +1,146 @@
+package slack
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/routing"
+	"github.com/fishnix/ohshift/internal/store"
+	"github.com/slack-go/slack"
+)
+
+// SetRouter makes the bot notify the channels of the matching notification routes about incidents
+func (b *Bot) SetRouter(router *routing.Router) {
+	b.router = router
+}
+
+// notificationChannels returns the channels to notify about an incident. Routes only apply to the
+// default workspace, where their user groups live; other incidents go to their workspace's channel.
+func (b *Bot) notificationChannels(inc *incident.Incident) []string {
+	if b.router != nil && b.workspaces.IsDefault(inc.TeamID) {
+		if channels := b.router.Channels(inc); len(channels) > 0 {
+			return channels
+		}
+	}
+
+	return []string{b.cfg().NotificationsChannelFor(inc.TeamID)}
+}
+
+// announce posts a notification about an incident, with a button to subscribe to its status updates,
+// to each of channels and records it so that updates can be threaded under it. It returns how many
+// notifications were posted.
+func (b *Bot) announce(api *slack.Client, incidentID string, channels []string, text string) int {
+	blocks := messageWithButton(text, subscribeActionID, "🔔 Subscribe to updates", incidentID)
+	posted := 0
+
+	for _, channel := range channels {
+		channelID, ts, err := api.PostMessage(channel, slack.MsgOptionText(text, false), slack.MsgOptionBlocks(blocks...))
+		if err != nil {
+			b.logger.Error("Failed to post incident notification",
+				"error", err,
+				"incident_id", incidentID,
+				"channel", channel)
+
+			continue
+		}
+
+		b.recordNotification(incidentID, channelID, ts)
+
+		posted++
+	}
+
+	return posted
+}
+
+// announceSeverityChange notifies the channels that are routed an incident at its new severity but
+// weren't at the previous one. Private incidents aren't announced again.
+func (b *Bot) announceSeverityChange(api *slack.Client, row *store.Incident, previous incident.Severity, text string) {
+	if row.Private {
+		return
+	}
+
+	inc := incidentFromRow(row)
+
+	inc.Severity = previous
+	before := b.notificationChannels(inc)
+
+	inc.Severity = incident.Severity(row.Severity)
+
+	var channels []string
+
+	for _, channel := range b.notificationChannels(inc) {
+		if !slices.Contains(before, channel) {
+			channels = append(channels, channel)
+		}
+	}
+
+	if len(channels) == 0 {
+		return
+	}
+
+	message := fmt.Sprintf("%s\n*Title:* %s", text, row.Title)
+	if b.announce(api, row.ID, channels, message) > 0 {
+		b.logger.Info("Incident announced in newly routed channels", "incident_id", row.ID, "channels", channels)
+	}
+}
+
+// notifyUpdate replies to the notifications announcing an incident with an update about it. Updates
+// about private incidents stay in their channel.
+func (b *Bot) notifyUpdate(api *slack.Client, incidentID, channelID, message string) {
+	if b.isPrivateIncident(incidentID) {
+		return
+	}
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	notifications, err := b.store.ListNotifications(ctx, incidentID)
+	if err != nil {
+		b.logger.Error("Failed to list incident notifications", "error", err, "incident_id", incidentID)
+		return
+	}
+
+	if len(notifications) == 0 {
+		// The notification was never recorded, post the update on its own instead
+		b.notify(channelID, message)
+
+		return
+	}
+
+	for _, n := range notifications {
+		_, _, err := api.PostMessage(n.SlackChannelID,
+			slack.MsgOptionText(message, false),
+			slack.MsgOptionTS(n.SlackMessageTS))
+		if err != nil {
+			b.logger.Error("Failed to reply to incident notification",
+				"error", err,
+				"incident_id", incidentID,
+				"channel_id", n.SlackChannelID)
+		}
+	}
+}
+
+// incidentFromRow returns the routing details of a stored incident
+func incidentFromRow(row *store.Incident) *incident.Incident {
+	return &incident.Incident{
+		ID:              row.ID,
+		Title:           row.Title,
+		Severity:        incident.Severity(row.Severity),
+		ChannelID:       row.SlackChannelID,
+		StartedByUserID: stringValue(row.StartedByUserID),
+		Services:        row.Services,
+		Team:            stringValue(row.Team),
+		TeamID:          stringValue(row.TeamID),
+		EnterpriseID:    stringValue(row.EnterpriseID),
+	}
+}
+
+// stringValue returns the value of an optional column, empty when it is NULL
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
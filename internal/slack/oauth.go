@@ -0,0 +1,176 @@
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/store"
+	"github.com/slack-go/slack"
+)
+
+const (
+	// authorizeURL is where workspace admins approve the installation
+	authorizeURL = "https://slack.com/oauth/v2/authorize"
+	// stateTTL is how long an install link is valid
+	stateTTL = 10 * time.Minute
+)
+
+// botScopes are the bot token scopes requested when the bot is installed. They must match
+// slack.example.manifest.json, which TestBotScopesMatchManifest checks.
+var botScopes = []string{
+	"bookmarks:read", "bookmarks:write", "channels:history", "channels:join", "channels:manage",
+	"channels:read", "chat:write", "chat:write.public", "commands", "pins:write", "reactions:read",
+	"reactions:write", "usergroups:read", "users:read", "users:read.email", "files:read",
+	"groups:history", "groups:read", "groups:write", "mpim:read", "pins:read",
+}
+
+// HandleInstall redirects to Slack to install the bot in a workspace
+func (b *Bot) HandleInstall(w http.ResponseWriter, r *http.Request) {
+	cfg := b.cfg()
+
+	query := url.Values{
+		"client_id":    {cfg.SlackClientID},
+		"scope":        {strings.Join(botScopes, ",")},
+		"redirect_uri": {cfg.SlackRedirectURL},
+		"state":        {newOAuthState(cfg.SlackClientSecret, time.Now())},
+	}
+
+	http.Redirect(w, r, authorizeURL+"?"+query.Encode(), http.StatusFound)
+}
+
+// HandleOAuthCallback completes an installation: it exchanges the code Slack redirected with for
+// a bot token and stores it for the workspace
+func (b *Bot) HandleOAuthCallback(w http.ResponseWriter, r *http.Request) {
+	cfg := b.cfg()
+	query := r.URL.Query()
+
+	if errorCode := query.Get("error"); errorCode != "" {
+		b.logger.Info("Slack installation was not approved", "error", errorCode)
+		http.Error(w, "Installation cancelled: "+errorCode, http.StatusBadRequest)
+
+		return
+	}
+
+	if err := verifyOAuthState(cfg.SlackClientSecret, query.Get("state"), time.Now()); err != nil {
+		b.logger.Warn("Rejected Slack installation with an invalid state", "error", err)
+		http.Error(w, "Invalid or expired install link, please start the installation again", http.StatusBadRequest)
+
+		return
+	}
+
+	resp, err := slack.GetOAuthV2ResponseContext(r.Context(), b.workspaces.httpClient,
+		cfg.SlackClientID, cfg.SlackClientSecret, query.Get("code"), cfg.SlackRedirectURL)
+	if err != nil {
+		b.logger.Error("Failed to exchange the OAuth code for a bot token", "error", err)
+		http.Error(w, "Installation failed", http.StatusBadGateway)
+
+		return
+	}
+
+	inst := installationFromOAuth(resp)
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	if err := b.store.SaveInstallation(ctx, inst); err != nil {
+		b.logger.Error("Failed to save installation", "error", err, "installation_id", inst.ID)
+		http.Error(w, "Installation failed", http.StatusInternalServerError)
+
+		return
+	}
+
+	b.workspaces.Install(inst)
+
+	b.logger.Info("Bot installed in workspace",
+		"installation_id", inst.ID,
+		"team_id", resp.Team.ID,
+		"team", resp.Team.Name,
+		"enterprise_id", resp.Enterprise.ID,
+		"enterprise_install", resp.IsEnterpriseInstall,
+		"installed_by", resp.AuthedUser.ID)
+
+	name := resp.Team.Name
+	if resp.IsEnterpriseInstall {
+		name = resp.Enterprise.Name
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = fmt.Fprintf(w, "OhShift! is installed in %s. Declare incidents with %s.\n", name, cfg.SlashCommand)
+}
+
+// installationFromOAuth returns the installation granted by an OAuth response. Org-wide installs
+// are keyed by the enterprise ID and cover every workspace of the org.
+func installationFromOAuth(resp *slack.OAuthV2Response) *store.Installation {
+	inst := &store.Installation{
+		ID:                  resp.Team.ID,
+		TeamID:              nonEmpty(resp.Team.ID),
+		TeamName:            nonEmpty(resp.Team.Name),
+		EnterpriseID:        nonEmpty(resp.Enterprise.ID),
+		EnterpriseName:      nonEmpty(resp.Enterprise.Name),
+		IsEnterpriseInstall: resp.IsEnterpriseInstall,
+		BotToken:            resp.AccessToken,
+		BotUserID:           resp.BotUserID,
+		InstalledBy:         nonEmpty(resp.AuthedUser.ID),
+	}
+
+	if resp.IsEnterpriseInstall {
+		inst.ID = resp.Enterprise.ID
+	}
+
+	return inst
+}
+
+// newOAuthState returns the state of an install link: its creation time signed with the client
+// secret, so that callbacks can be checked without storing anything
+func newOAuthState(secret string, now time.Time) string {
+	ts := strconv.FormatInt(now.Unix(), 10)
+
+	return ts + "." + signOAuthState(secret, ts)
+}
+
+// verifyOAuthState checks the signature and age of the state of an install link
+func verifyOAuthState(secret, state string, now time.Time) error {
+	ts, signature, ok := strings.Cut(state, ".")
+	if !ok {
+		return fmt.Errorf("malformed state %q", state)
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(signOAuthState(secret, ts))) {
+		return fmt.Errorf("bad state signature")
+	}
+
+	seconds, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return fmt.Errorf("malformed state timestamp %q", ts)
+	}
+
+	if age := now.Sub(time.Unix(seconds, 0)); age < 0 || age > stateTTL {
+		return fmt.Errorf("state is %s old, expected at most %s", age.Round(time.Second), stateTTL)
+	}
+
+	return nil
+}
+
+// signOAuthState returns the hex HMAC-SHA256 of a state timestamp
+func signOAuthState(secret, ts string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("oauth-state:" + ts))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// nonEmpty returns a pointer to s, or nil when it is empty
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
@@ -0,0 +1,34 @@
+package slack
+
+import (
+	"encoding/json"
+	"os"
+	"slices"
+	"testing"
+)
+
+func TestBotScopesMatchManifest(t *testing.T) {
+	data, err := os.ReadFile("../../slack.example.manifest.json")
+	if err != nil {
+		t.Fatalf("failed to read manifest: %v", err)
+	}
+
+	var manifest struct {
+		OAuthConfig struct {
+			Scopes struct {
+				Bot []string `json:"bot"`
+			} `json:"scopes"`
+		} `json:"oauth_config"`
+	}
+
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		t.Fatalf("failed to parse manifest: %v", err)
+	}
+
+	want := slices.Sorted(slices.Values(manifest.OAuthConfig.Scopes.Bot))
+	got := slices.Sorted(slices.Values(botScopes))
+
+	if !slices.Equal(got, want) {
+		t.Errorf("botScopes = %v, want the manifest's bot scopes %v", got, want)
+	}
+}
@@ -0,0 +1,413 @@
+package slack
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/handoff"
+	"github.com/fishnix/ohshift/internal/metrics"
+	"github.com/fishnix/ohshift/internal/oncall"
+	"github.com/fishnix/ohshift/internal/store"
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/socketmode"
+)
+
+// shiftTimeFormat formats shift boundaries in the schedule's timezone
+const shiftTimeFormat = "Mon 2006-01-02 15:04 MST"
+
+// oncallUsage explains the /shift oncall commands
+const oncallUsage = `Usage:
+  /shift oncall [schedule]          Show who is on call now and next
+  /shift oncall schedule create <name> <daily|weekly> <HH:MM> <timezone> <@user>... [--start YYYY-MM-DD]
+  /shift oncall schedule participants <name> <@user>...
+  /shift oncall schedule list
+  /shift oncall schedule delete <name>
+  /shift oncall override add <schedule> <@user> <from> <to>
+  /shift oncall override list <schedule>
+  /shift oncall override delete <schedule> <id>
+  /shift oncall note [--rotation <name>] <text>   Leave a note for the next handoff
+Times are YYYY-MM-DD or YYYY-MM-DDTHH:MM in the schedule's timezone.`
+
+// handleOnCallCommand handles the /shift oncall commands and returns their outcome
+func (b *Bot) handleOnCallCommand(cmd slack.SlashCommand, args []string, client acker, evt *socketmode.Event) string {
+	b.logger.Info("Processing oncall command",
+		"user", cmd.UserName,
+		"channel_id", cmd.ChannelID,
+		"args", args)
+
+	var (
+		reply string
+		err   error
+	)
+
+	switch {
+	case len(args) == 1 && (args[0] == "help" || args[0] == "schedule" || args[0] == "override" || args[0] == "note"):
+		err = errInvalidOnCallCommand
+	case len(args) >= 2 && args[0] == "schedule":
+		reply, err = b.handleScheduleCommand(cmd, args[1], args[2:])
+	case len(args) >= 2 && args[0] == "override":
+		reply, err = b.handleOverrideCommand(cmd, args[1], args[2:])
+	case len(args) >= 2 && args[0] == "note":
+		reply, err = b.addHandoffNote(cmd, commandArgs(commandArgs(cmd.Text, oncallCommand), "note"))
+	case len(args) <= 1:
+		reply, err = b.whoIsOnCall(args)
+	default:
+		err = errInvalidOnCallCommand
+	}
+
+	return b.replyOnCall(client, evt, reply, err)
+}
+
+// errInvalidOnCallCommand is returned for /shift oncall commands that don't match the usage
+var errInvalidOnCallCommand = errors.New("invalid command")
+
+// onCallInputError is an invalid argument to a /shift oncall command
+type onCallInputError struct {
+	err error
+}
+
+func (e *onCallInputError) Error() string {
+	return e.err.Error()
+}
+
+// invalidInput marks an error as caused by the user's input
+func invalidInput(err error) error {
+	return &onCallInputError{err: err}
+}
+
+// replyOnCall responds to a /shift oncall command and returns its outcome
+func (b *Bot) replyOnCall(client acker, evt *socketmode.Event, reply string, err error) string {
+	var inputErr *onCallInputError
+
+	switch {
+	case err == nil:
+		b.sendEphemeral(client, evt, reply)
+		return metrics.OutcomeSuccess
+	case errors.Is(err, errInvalidOnCallCommand):
+		b.sendEphemeral(client, evt, oncallUsage)
+		return metrics.OutcomeInvalid
+	case errors.As(err, &inputErr):
+		b.sendEphemeral(client, evt, fmt.Sprintf("❌ %v\n\n%s", err, oncallUsage))
+		return metrics.OutcomeInvalid
+	case errors.Is(err, store.ErrNotFound):
+		b.sendEphemeral(client, evt, "❌ Schedule or override not found.")
+		return metrics.OutcomeInvalid
+	case errors.Is(err, store.ErrAlreadyExists):
+		b.sendEphemeral(client, evt, "❌ A schedule with this name already exists.")
+		return metrics.OutcomeInvalid
+	default:
+		b.logger.Error("On-call command failed", "error", err)
+		b.sendEphemeral(client, evt, fmt.Sprintf("On-call command failed: %v", err))
+
+		return metrics.OutcomeError
+	}
+}
+
+// whoIsOnCall describes who is on call now and next on one or all schedules
+func (b *Bot) whoIsOnCall(args []string) (string, error) {
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	now := time.Now()
+
+	var schedules []*oncall.Schedule
+
+	if len(args) == 1 {
+		schedule, err := oncall.Load(ctx, b.store, strings.ToLower(args[0]), now)
+		if err != nil {
+			return "", err
+		}
+
+		schedules = append(schedules, schedule)
+	} else {
+		var err error
+		if schedules, err = oncall.LoadAll(ctx, b.store, now); err != nil {
+			return "", err
+		}
+	}
+
+	if len(schedules) == 0 {
+		return "No on-call schedules yet. Create one with `/shift oncall schedule create`.", nil
+	}
+
+	var message strings.Builder
+
+	message.WriteString("📟 *On call*\n")
+
+	for _, schedule := range schedules {
+		current := schedule.ShiftAt(now)
+		next := schedule.NextShift(current)
+
+		fmt.Fprintf(&message, "\n*%s* (%s, hands off at %02d:%02d %s)\n", schedule.Name, schedule.Rotation,
+			schedule.HandoffHour, schedule.HandoffMinute, schedule.Location)
+		fmt.Fprintf(&message, "• Now: %s until %s\n", formatShiftUser(current), current.End.In(schedule.Location).Format(shiftTimeFormat))
+		fmt.Fprintf(&message, "• Next: %s until %s\n", formatShiftUser(next), next.End.In(schedule.Location).Format(shiftTimeFormat))
+	}
+
+	return message.String(), nil
+}
+
+// addHandoffNote records a note for the next handoff of a rotation
+func (b *Bot) addHandoffNote(cmd slack.SlashCommand, text string) (string, error) {
+	if b.handoffs == nil {
+		return "Handoff summaries are not enabled. Configure a rotation in `ONCALL_HANDOFFS` first.", nil
+	}
+
+	var rotation string
+
+	if rest, ok := strings.CutPrefix(text, "--rotation "); ok {
+		name, note, _ := strings.Cut(strings.TrimSpace(rest), " ")
+
+		rotation, text = strings.ToLower(name), strings.TrimSpace(note)
+	}
+
+	if text == "" {
+		return "", errInvalidOnCallCommand
+	}
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	names, err := b.handoffs.AddNote(ctx, rotation, cmd.UserID, text)
+	if errors.Is(err, handoff.ErrNoRotation) && rotation != "" {
+		return "", invalidInput(fmt.Errorf("unknown rotation %q", rotation))
+	}
+
+	if errors.Is(err, handoff.ErrNoRotation) {
+		return "", invalidInput(errors.New("you are not on call for any rotation, name one with --rotation"))
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("📝 Note added to the next handoff of %s.", strings.Join(names, ", ")), nil
+}
+
+// formatShiftUser mentions the person on call during a shift
+func formatShiftUser(shift oncall.Shift) string {
+	if shift.UserID == "" {
+		return "nobody"
+	}
+
+	if shift.Override {
+		return fmt.Sprintf("<@%s> (override)", shift.UserID)
+	}
+
+	return fmt.Sprintf("<@%s>", shift.UserID)
+}
+
+// handleScheduleCommand handles /shift oncall schedule <action>
+func (b *Bot) handleScheduleCommand(cmd slack.SlashCommand, action string, args []string) (string, error) {
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	switch action {
+	case "create":
+		spec, err := oncall.ParseScheduleSpec(args, time.Now())
+		if err != nil {
+			return "", invalidInput(err)
+		}
+
+		schedule := &store.OnCallSchedule{
+			Name:         spec.Name,
+			Rotation:     string(spec.Rotation),
+			Participants: spec.Participants,
+			HandoffTime:  spec.HandoffTime,
+			Timezone:     spec.Timezone,
+			StartDate:    spec.StartDate,
+			CreatedBy:    cmd.UserID,
+		}
+
+		if err := b.store.CreateSchedule(ctx, schedule); err != nil {
+			return "", err
+		}
+
+		b.logger.Info("On-call schedule created",
+			"schedule", schedule.Name,
+			"rotation", schedule.Rotation,
+			"participants", len(schedule.Participants),
+			"user", cmd.UserName)
+
+		return fmt.Sprintf("✅ Created the %s schedule *%s*.", schedule.Rotation, schedule.Name), nil
+	case "participants":
+		if len(args) < 2 {
+			return "", errInvalidOnCallCommand
+		}
+
+		participants, err := oncall.ParseMentions(args[1:])
+		if err != nil {
+			return "", invalidInput(err)
+		}
+
+		if err := b.store.SetScheduleParticipants(ctx, strings.ToLower(args[0]), participants); err != nil {
+			return "", err
+		}
+
+		b.logger.Info("On-call schedule participants updated",
+			"schedule", args[0],
+			"participants", len(participants),
+			"user", cmd.UserName)
+
+		return fmt.Sprintf("✅ Updated the participants of *%s*.", args[0]), nil
+	case "list":
+		return b.listSchedules()
+	case "delete":
+		if len(args) != 1 {
+			return "", errInvalidOnCallCommand
+		}
+
+		if err := b.store.DeleteSchedule(ctx, strings.ToLower(args[0])); err != nil {
+			return "", err
+		}
+
+		b.logger.Info("On-call schedule deleted", "schedule", args[0], "user", cmd.UserName)
+
+		return fmt.Sprintf("🗑️ Deleted the schedule *%s*.", args[0]), nil
+	default:
+		return "", errInvalidOnCallCommand
+	}
+}
+
+// listSchedules describes all on-call schedules
+func (b *Bot) listSchedules() (string, error) {
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	schedules, err := b.store.ListSchedules(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if len(schedules) == 0 {
+		return "No on-call schedules yet.", nil
+	}
+
+	var message strings.Builder
+
+	message.WriteString("📅 *On-call schedules*\n")
+
+	for _, s := range schedules {
+		participants := make([]string, 0, len(s.Participants))
+		for _, userID := range s.Participants {
+			participants = append(participants, fmt.Sprintf("<@%s>", userID))
+		}
+
+		fmt.Fprintf(&message, "• *%s*: %s at %s %s since %s: %s\n", s.Name, s.Rotation, s.HandoffTime, s.Timezone,
+			s.StartDate.Format(time.DateOnly), strings.Join(participants, ", "))
+	}
+
+	return message.String(), nil
+}
+
+// handleOverrideCommand handles /shift oncall override <action>
+func (b *Bot) handleOverrideCommand(cmd slack.SlashCommand, action string, args []string) (string, error) {
+	if len(args) == 0 {
+		return "", errInvalidOnCallCommand
+	}
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	now := time.Now()
+
+	schedule, err := oncall.Load(ctx, b.store, strings.ToLower(args[0]), now)
+	if err != nil {
+		return "", err
+	}
+
+	switch action {
+	case "add":
+		if len(args) != 4 {
+			return "", errInvalidOnCallCommand
+		}
+
+		override, err := parseOverride(schedule, args[1:])
+		if err != nil {
+			return "", invalidInput(err)
+		}
+
+		override.CreatedBy = cmd.UserID
+
+		if err := b.store.AddOverride(ctx, override); err != nil {
+			return "", err
+		}
+
+		b.logger.Info("On-call override added",
+			"schedule", schedule.Name,
+			"override_id", override.ID,
+			"user_id", override.SlackUserID,
+			"starts_at", override.StartsAt,
+			"ends_at", override.EndsAt)
+
+		return fmt.Sprintf("✅ <@%s> is on call for *%s* from %s until %s (override %d).", override.SlackUserID,
+			schedule.Name, override.StartsAt.In(schedule.Location).Format(shiftTimeFormat),
+			override.EndsAt.In(schedule.Location).Format(shiftTimeFormat), override.ID), nil
+	case "list":
+		if len(schedule.Overrides) == 0 {
+			return fmt.Sprintf("*%s* has no current or upcoming overrides.", schedule.Name), nil
+		}
+
+		var message strings.Builder
+
+		fmt.Fprintf(&message, "🔁 *Overrides for %s*\n", schedule.Name)
+
+		for _, o := range schedule.Overrides {
+			fmt.Fprintf(&message, "• %d: <@%s> from %s until %s\n", o.ID, o.UserID,
+				o.Start.In(schedule.Location).Format(shiftTimeFormat), o.End.In(schedule.Location).Format(shiftTimeFormat))
+		}
+
+		return message.String(), nil
+	case "delete":
+		if len(args) != 2 {
+			return "", errInvalidOnCallCommand
+		}
+
+		id, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return "", invalidInput(fmt.Errorf("invalid override ID %q", args[1]))
+		}
+
+		if err := b.store.DeleteOverride(ctx, schedule.ID, id); err != nil {
+			return "", err
+		}
+
+		b.logger.Info("On-call override deleted", "schedule", schedule.Name, "override_id", id, "user", cmd.UserName)
+
+		return fmt.Sprintf("🗑️ Deleted override %d of *%s*.", id, schedule.Name), nil
+	default:
+		return "", errInvalidOnCallCommand
+	}
+}
+
+// parseOverride parses "<@user> <from> <to>" in the schedule's timezone
+func parseOverride(schedule *oncall.Schedule, args []string) (*store.OnCallOverride, error) {
+	userID, err := oncall.ParseMention(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := oncall.ParseTime(args[1], schedule.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := oncall.ParseTime(args[2], schedule.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	if !start.Before(end) {
+		return nil, errors.New("the override must end after it starts")
+	}
+
+	return &store.OnCallOverride{
+		ScheduleID:  schedule.ID,
+		SlackUserID: userID,
+		StartsAt:    start,
+		EndsAt:      end,
+	}, nil
+}
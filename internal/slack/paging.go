@@ -0,0 +1,75 @@
+package slack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/paging"
+	"github.com/fishnix/ohshift/internal/store"
+	"github.com/slack-go/slack"
+)
+
+// pageResponders invites the on-call responders of a new incident to its channel, sends them
+// the incident details and records who was paged on the timeline
+func (b *Bot) pageResponders(inc *incident.Incident, details string) {
+	if b.pager == nil {
+		return
+	}
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	var responders []paging.Responder
+
+	// The person who declared the incident is already in the channel
+	for _, r := range b.pager.Responders(ctx, inc.Severity, inc.Services) {
+		if r.UserID != inc.StartedByUserID {
+			responders = append(responders, r)
+		}
+	}
+
+	if len(responders) == 0 {
+		b.logger.Info("No on-call responders to page",
+			"incident_id", inc.ID,
+			"severity", inc.Severity,
+			"services", inc.Services)
+
+		return
+	}
+
+	userIDs := make([]string, 0, len(responders))
+	api := b.workspaces.ForTeam(inc.TeamID, inc.EnterpriseID)
+
+	for _, r := range responders {
+		userIDs = append(userIDs, r.UserID)
+
+		if _, err := api.InviteUsersToConversation(inc.ChannelID, r.UserID); err != nil {
+			b.logger.Warn("Failed to invite on-call responder to incident channel",
+				"error", err,
+				"user_id", r.UserID,
+				"channel_id", inc.ChannelID)
+		}
+
+		message := fmt.Sprintf("📟 You have been paged for an incident in <#%s> (%s).\n\n%s",
+			inc.ChannelID, strings.Join(r.Reasons, ", "), details)
+
+		// Posting to a user ID sends a direct message from the bot
+		if _, _, err := api.PostMessage(r.UserID, slack.MsgOptionText(message, false)); err != nil {
+			b.logger.Warn("Failed to send page to on-call responder",
+				"error", err,
+				"user_id", r.UserID,
+				"incident_id", inc.ID)
+		}
+	}
+
+	if err := b.timelineMgr.AddPagedEntry(inc.ID, userIDs, paging.Summary(responders)); err != nil {
+		b.logger.Warn("Failed to add paged responders to timeline", "error", err, "incident_id", inc.ID)
+	}
+
+	b.logger.Info("On-call responders paged",
+		"incident_id", inc.ID,
+		"severity", inc.Severity,
+		"services", inc.Services,
+		"paged", len(responders))
+}
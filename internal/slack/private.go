@@ -0,0 +1,75 @@
+package slack
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/store"
+	"github.com/slack-go/slack"
+)
+
+// privateNotification announces a private incident without its details
+func privateNotification(severity incident.Severity) string {
+	return fmt.Sprintf("🔒 A private %s incident was declared", severity)
+}
+
+// announcePrivate posts the redacted notification of a private incident to each of channels. It
+// has no subscribe button and isn't recorded, so no updates are threaded under it. It returns how
+// many notifications were posted.
+func (b *Bot) announcePrivate(api *slack.Client, inc *incident.Incident, channels []string) int {
+	text := privateNotification(inc.Severity)
+	posted := 0
+
+	for _, channel := range channels {
+		if _, _, err := api.PostMessage(channel, slack.MsgOptionText(text, false)); err != nil {
+			b.logger.Error("Failed to post private incident notification",
+				"error", err,
+				"incident_id", inc.ID,
+				"channel", channel)
+
+			continue
+		}
+
+		posted++
+	}
+
+	return posted
+}
+
+// isPrivateIncident reports whether an incident was declared in a private channel. Incidents
+// that can't be looked up are treated as private so that their details stay in their channel.
+func (b *Bot) isPrivateIncident(incidentID string) bool {
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	inc, err := b.store.GetIncident(ctx, incidentID)
+	if err != nil {
+		b.logger.Error("Failed to get incident", "error", err, "incident_id", incidentID)
+		return true
+	}
+
+	return inc.Private
+}
+
+// isChannelMember reports whether a user is a member of a channel
+func (b *Bot) isChannelMember(api *slack.Client, channelID, userID string) (bool, error) {
+	params := &slack.GetUsersInConversationParameters{ChannelID: channelID, Limit: 1000}
+
+	for {
+		members, cursor, err := api.GetUsersInConversation(params)
+		if err != nil {
+			return false, fmt.Errorf("failed to list members of %s: %w", channelID, err)
+		}
+
+		if slices.Contains(members, userID) {
+			return true, nil
+		}
+
+		if cursor == "" {
+			return false, nil
+		}
+
+		params.Cursor = cursor
+	}
+}
@@ -0,0 +1,75 @@
+package slack
+
+import (
+	"fmt"
+
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/metrics"
+	"github.com/fishnix/ohshift/internal/oncall"
+	"github.com/fishnix/ohshift/internal/store"
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/socketmode"
+)
+
+const roleUsage = "Usage: /shift role <role> [@user]"
+
+// handleRoleCommand handles the /shift role <role> [@user] command and returns its outcome. The
+// role is assigned to the caller when no user is mentioned.
+func (b *Bot) handleRoleCommand(cmd slack.SlashCommand, args []string, client acker, evt *socketmode.Event) string {
+	b.logger.Info("Processing role command",
+		"user", cmd.UserName,
+		"channel_id", cmd.ChannelID,
+		"args", args)
+
+	incidentID := b.findIncidentIDByChannel(cmd.ChannelID)
+	if incidentID == "" {
+		b.sendEphemeral(client, evt, MsgCommandNotInIncidentChannel)
+		return metrics.OutcomeInvalid
+	}
+
+	if len(args) == 0 || len(args) > 2 {
+		b.sendEphemeral(client, evt, roleUsage)
+		return metrics.OutcomeInvalid
+	}
+
+	role, err := incident.ParseRole(args[0])
+	if err != nil {
+		b.sendEphemeral(client, evt, fmt.Sprintf("❌ %v\n\n%s", err, roleUsage))
+		return metrics.OutcomeInvalid
+	}
+
+	userID := cmd.UserID
+	if len(args) == 2 {
+		if userID, err = oncall.ParseMention(args[1]); err != nil {
+			b.sendEphemeral(client, evt, fmt.Sprintf("❌ %v\n\n%s", err, roleUsage))
+			return metrics.OutcomeInvalid
+		}
+	}
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	if err := b.store.AssignRole(ctx, incidentID, role, userID); err != nil {
+		b.logger.Error("Failed to assign incident role", "error", err, "incident_id", incidentID, "role", role)
+		b.sendEphemeral(client, evt, fmt.Sprintf("Failed to assign the role: %v", err))
+
+		return metrics.OutcomeError
+	}
+
+	api := b.workspaces.ForTeam(cmd.TeamID, cmd.EnterpriseID)
+
+	message := fmt.Sprintf("👤 <@%s> is the *%s*, assigned by <@%s>", userID, role, cmd.UserID)
+	if _, _, err := api.PostMessage(cmd.ChannelID, slack.MsgOptionText(message, false)); err != nil {
+		b.logger.Error("Failed to post role assignment message", "error", err, "channel_id", cmd.ChannelID)
+	}
+
+	b.logger.Info("Incident role assigned",
+		"incident_id", incidentID,
+		"role", role,
+		"user_id", userID,
+		"assigned_by", cmd.UserName)
+
+	b.sendEphemeral(client, evt, fmt.Sprintf("Assigned %s.", role))
+
+	return metrics.OutcomeSuccess
+}
@@ -0,0 +1,158 @@
+package slack
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/metrics"
+	"github.com/fishnix/ohshift/internal/store"
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/socketmode"
+)
+
+const serviceUsage = "Usage: /shift service <add|remove> <service>"
+
+// handleServiceCommand handles the /shift service add|remove <service> commands and returns their outcome
+func (b *Bot) handleServiceCommand(cmd slack.SlashCommand, args []string, client acker, evt *socketmode.Event) string {
+	b.logger.Info("Processing service command",
+		"user", cmd.UserName,
+		"channel_id", cmd.ChannelID,
+		"args", args)
+
+	incidentID := b.findIncidentIDByChannel(cmd.ChannelID)
+	if incidentID == "" {
+		b.sendEphemeral(client, evt, MsgCommandNotInIncidentChannel)
+		return metrics.OutcomeInvalid
+	}
+
+	if len(args) != 2 || (args[0] != "add" && args[0] != "remove") {
+		b.sendEphemeral(client, evt, serviceUsage)
+		return metrics.OutcomeInvalid
+	}
+
+	service, err := incident.ParseService(args[1])
+	if err != nil {
+		b.sendEphemeral(client, evt, fmt.Sprintf("❌ %v\n\n%s", err, serviceUsage))
+		return metrics.OutcomeInvalid
+	}
+
+	added := args[0] == "add"
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	if added {
+		var changed bool
+
+		changed, err = b.store.AddIncidentService(ctx, incidentID, service, cmd.UserID)
+		if err == nil && !changed {
+			b.sendEphemeral(client, evt, fmt.Sprintf("%s is already an affected service.", service))
+			return metrics.OutcomeInvalid
+		}
+	} else {
+		err = b.store.RemoveIncidentService(ctx, incidentID, service)
+		if errors.Is(err, store.ErrNotFound) {
+			b.sendEphemeral(client, evt, fmt.Sprintf("%s is not an affected service.", service))
+			return metrics.OutcomeInvalid
+		}
+	}
+
+	if err != nil {
+		b.logger.Error("Failed to change incident services", "error", err, "incident_id", incidentID)
+		b.sendEphemeral(client, evt, fmt.Sprintf("Failed to change the affected services: %v", err))
+
+		return metrics.OutcomeError
+	}
+
+	if err := b.timelineMgr.AddServiceChangeEntry(incidentID, cmd.UserID, service, added); err != nil {
+		b.logger.Warn("Failed to add service change to timeline", "error", err, "incident_id", incidentID)
+	}
+
+	api := b.workspaces.ForTeam(cmd.TeamID, cmd.EnterpriseID)
+
+	message := fmt.Sprintf("🧩 <@%s> removed *%s* from the affected services", cmd.UserID, service)
+	update := fmt.Sprintf("🧩 <@%s> removed *%s* from the affected services of <#%s>", cmd.UserID, service, cmd.ChannelID)
+
+	if added {
+		message = fmt.Sprintf("🧩 <@%s> added *%s* to the affected services", cmd.UserID, service)
+		update = fmt.Sprintf("🧩 <@%s> added *%s* to the affected services of <#%s>", cmd.UserID, service, cmd.ChannelID)
+
+		if details := b.serviceDetails([]string{service}); details != "" {
+			message += "\n" + details
+		}
+	}
+
+	if _, _, err := api.PostMessage(cmd.ChannelID, slack.MsgOptionText(message, false)); err != nil {
+		b.logger.Error("Failed to post service change message", "error", err, "channel_id", cmd.ChannelID)
+	}
+
+	b.notifyUpdate(api, incidentID, cmd.ChannelID, update)
+
+	b.logger.Info("Incident services changed",
+		"incident_id", incidentID,
+		"service", service,
+		"added", added,
+		"user", cmd.UserName)
+
+	b.sendEphemeral(client, evt, fmt.Sprintf("Affected services updated: %s %s.", args[0], service))
+
+	return metrics.OutcomeSuccess
+}
+
+// servicesText returns the affected services line of incident messages, followed by the catalog
+// details of the services, or an empty string when no services are affected
+func (b *Bot) servicesText(services []string) string {
+	if len(services) == 0 {
+		return ""
+	}
+
+	text := "*Services:* " + strings.Join(services, ", ")
+	if details := b.serviceDetails(services); details != "" {
+		text += "\n" + details
+	}
+
+	return text
+}
+
+// serviceDetails returns a line for each of the services found in the service catalog with its
+// owner team, on-call user group, runbook and channel. Services missing from the catalog are skipped.
+func (b *Bot) serviceDetails(services []string) string {
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	catalog, err := b.store.ListServices(ctx, services...)
+	if err != nil {
+		b.logger.Warn("Failed to look up services in the catalog", "error", err, "services", services)
+		return ""
+	}
+
+	lines := make([]string, 0, len(catalog))
+
+	for _, svc := range catalog {
+		var parts []string
+
+		if svc.OwnerTeam != nil {
+			parts = append(parts, "owned by "+*svc.OwnerTeam)
+		}
+
+		if svc.OnCallUserGroup != nil {
+			parts = append(parts, fmt.Sprintf("on-call <!subteam^%s>", *svc.OnCallUserGroup))
+		}
+
+		if svc.RunbookURL != nil {
+			parts = append(parts, fmt.Sprintf("<%s|runbook>", *svc.RunbookURL))
+		}
+
+		if svc.SlackChannel != nil {
+			parts = append(parts, "#"+*svc.SlackChannel)
+		}
+
+		if len(parts) > 0 {
+			lines = append(lines, fmt.Sprintf("• *%s*: %s", svc.Name, strings.Join(parts, " · ")))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
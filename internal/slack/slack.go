@@ -1,20 +1,30 @@
-// Package slack provides Slack API integration using Socket Mode for the OhShift! bot.
+// Package slack provides Slack API integration using Socket Mode or the Events API over HTTP for the OhShift! bot.
 package slack
 
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/fishnix/ohshift/internal/checklist"
 	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/escalation"
+	"github.com/fishnix/ohshift/internal/handoff"
 	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/incidenttype"
 	"github.com/fishnix/ohshift/internal/logger"
+	"github.com/fishnix/ohshift/internal/metrics"
+	"github.com/fishnix/ohshift/internal/paging"
+	"github.com/fishnix/ohshift/internal/routing"
+	"github.com/fishnix/ohshift/internal/store"
 	"github.com/fishnix/ohshift/internal/timeline"
 	"github.com/slack-go/slack"
 	"github.com/slack-go/slack/slackevents"
@@ -23,45 +33,116 @@ import (
 
 const (
 	timelineCommand = "timeline"
+	resolveCommand  = "resolve"
+	updateCommand   = "update"
+	severityCommand = "severity"
+	statsCommand    = "stats"
+	oncallCommand   = "oncall"
+	serviceCommand  = "service"
+	roleCommand     = "role"
 	// MsgCommandNotInIncidentChannel is the error message shown when timeline command is used outside incident channels
 	MsgCommandNotInIncidentChannel = "❌ This command can only be used in incident channels."
 	// MsgTimelineNotFound is the error message shown when timeline is not found for an incident
 	MsgTimelineNotFound = "❌ Timeline not found for this incident."
+	// MsgPrivateTimeline is the error message shown when someone outside a private incident channel asks for its timeline
+	MsgPrivateTimeline = "🔒 This incident is private. Only members of its channel can see its timeline."
 )
 
 // Bot represents the Slack bot
 type Bot struct {
-	api          *slack.Client
+	api *slack.Client
+	// workspaces routes API calls to the workspace of an incident, team or channel
+	workspaces   *Workspaces
 	socketClient *socketmode.Client
 	handler      *socketmode.SocketmodeHandler
-	config       *config.Config
-	logger       *slog.Logger
-	timelineMgr  *timeline.Manager
+	// mode is how Slack delivers events, config.SlackModeSocket or config.SlackModeHTTP
+	mode string
+	// config is swapped when the configuration is reloaded; read it with cfg
+	config      atomic.Pointer[config.Config]
+	logger      *slog.Logger
+	store       *store.Store
+	timelineMgr *timeline.Manager
 	// TODO: Replace this mapping of channel IDs to
 	// incident IDs with something more durable
 	channelToIncident map[string]string
 	mu                sync.RWMutex
+	// connected tracks whether the Socket Mode connection is established
+	connected atomic.Bool
+	// pager resolves who to page for new incidents; nil when no on-call routes are configured
+	pager *paging.Pager
+	// router picks the channels notified about incidents; nil when no notification routes are configured
+	router *routing.Router
+	// escalator escalates new incidents until they are acknowledged; nil when no escalation policies are configured
+	escalator *escalation.Escalator
+	// handoffs records handoff notes; nil when no handoff rotations are configured
+	handoffs *handoff.Handoffs
 }
 
-// NewBot creates a new Slack bot instance with Socket Mode
-func NewBot(cfg *config.Config) *Bot {
-	api := slack.New(cfg.SlackBotToken, slack.OptionAppLevelToken(cfg.SlackAppToken))
-	socketClient := socketmode.New(api)
-	handler := socketmode.NewSocketmodeHandler(socketClient)
-
-	return &Bot{
-		api:               api,
-		socketClient:      socketClient,
-		handler:           handler,
-		config:            cfg,
+// NewBot creates a new Slack bot instance receiving events in the configured mode
+func NewBot(cfg *config.Config, st *store.Store) *Bot {
+	b := &Bot{
 		logger:            logger.With("component", "slack_bot"),
-		timelineMgr:       timeline.NewManager(api),
+		store:             st,
+		mode:              cfg.SlackMode,
 		channelToIncident: make(map[string]string),
 	}
+
+	b.config.Store(cfg)
+
+	// Requests go out with the current tokens when secret files are rotated
+	httpClient := metrics.NewSlackClient()
+	httpClient.Transport = &tokenTransport{
+		base:     httpClient.Transport,
+		bot:      b,
+		botToken: cfg.SlackBotToken,
+		appToken: cfg.SlackAppToken,
+	}
+
+	b.api = slack.New(cfg.SlackBotToken,
+		slack.OptionAppLevelToken(cfg.SlackAppToken),
+		slack.OptionHTTPClient(httpClient))
+	b.socketClient = socketmode.New(b.api)
+	b.handler = socketmode.NewSocketmodeHandler(b.socketClient)
+	b.workspaces = NewWorkspaces(b.api, st)
+	b.timelineMgr = timeline.NewManager(b.workspaces, st, cfg)
+
+	return b
 }
 
-// Start starts the Socket Mode event loop and blocks until ctx is done
+// cfg returns the current configuration
+func (b *Bot) cfg() *config.Config {
+	return b.config.Load()
+}
+
+// SetConfig swaps in a reloaded configuration for the bot and its timeline manager
+func (b *Bot) SetConfig(cfg *config.Config) {
+	b.config.Store(cfg)
+	b.timelineMgr.SetConfig(cfg)
+}
+
+// Start starts the Socket Mode event loop and blocks until ctx is done. In HTTP mode events
+// arrive through the HTTP server instead, and Start only waits for ctx.
 func (b *Bot) Start(ctx context.Context) error {
+	if err := b.workspaces.IdentifyDefault(ctx); err != nil {
+		// Other workspaces would be mistaken for the default one and use its token
+		if b.cfg().OAuthEnabled() {
+			return fmt.Errorf("failed to identify the default workspace: %w", err)
+		}
+
+		b.logger.Warn("Failed to identify the default workspace, every team uses it", "error", err)
+	}
+
+	if b.mode == config.SlackModeHTTP {
+		b.logger.Info("Slack bot started with the Events API over HTTP",
+			"slash_command", b.cfg().SlashCommand,
+			"notifications_channel", b.cfg().NotificationsChannel)
+
+		<-ctx.Done()
+		b.logger.Info("Shutting down Slack bot")
+
+		return nil
+	}
+
 	b.setupEventHandlers()
 
 	// Run the event loop in a goroutine
@@ -72,8 +153,8 @@ func (b *Bot) Start(ctx context.Context) error {
 	}()
 
 	b.logger.Info("Slack bot started with Socket Mode",
-		"slash_command", b.config.SlashCommand,
-		"notifications_channel", b.config.NotificationsChannel)
+		"slash_command", b.cfg().SlashCommand,
+		"notifications_channel", b.cfg().NotificationsChannel)
 
 	select {
 	case <-ctx.Done():
@@ -86,12 +167,51 @@ func (b *Bot) Start(ctx context.Context) error {
 
 // setupEventHandlers sets up all the event handlers for the bot
 func (b *Bot) setupEventHandlers() {
-	b.handler.Handle(socketmode.EventTypeSlashCommand, b.handleSlashCommand)
-	b.handler.Handle(socketmode.EventTypeEventsAPI, b.handleEventsAPI)
+	b.handler.Handle(socketmode.EventTypeSlashCommand, socketHandler(b.handleSlashCommand))
+	b.handler.Handle(socketmode.EventTypeEventsAPI, socketHandler(b.handleEventsAPI))
+	b.handler.Handle(socketmode.EventTypeInteractive, socketHandler(b.handleInteractive))
+
+	// Track the connection state for readiness checks
+	b.handler.Handle(socketmode.EventTypeConnected, b.handleConnectionEvent)
+	b.handler.Handle(socketmode.EventTypeConnecting, b.handleConnectionEvent)
+	b.handler.Handle(socketmode.EventTypeConnectionError, b.handleConnectionEvent)
+	b.handler.Handle(socketmode.EventTypeInvalidAuth, b.handleConnectionEvent)
+	b.handler.Handle(socketmode.EventTypeDisconnect, b.handleConnectionEvent)
+}
+
+// acker acknowledges requests from Slack; it is the Socket Mode client or, in HTTP mode, the HTTP response
+type acker interface {
+	Ack(req socketmode.Request, payload ...interface{})
 }
 
-// handleSlashCommand handles incoming slash commands via Socket Mode
-func (b *Bot) handleSlashCommand(evt *socketmode.Event, client *socketmode.Client) {
+// socketHandler adapts a handler to Socket Mode
+func socketHandler(handle func(*socketmode.Event, acker)) socketmode.SocketmodeHandlerFunc {
+	return func(evt *socketmode.Event, client *socketmode.Client) {
+		handle(evt, client)
+	}
+}
+
+// handleConnectionEvent records Socket Mode connection state changes
+func (b *Bot) handleConnectionEvent(evt *socketmode.Event, _ *socketmode.Client) {
+	connected := evt.Type == socketmode.EventTypeConnected
+	if b.connected.Swap(connected) != connected {
+		b.logger.Info("Socket Mode connection state changed",
+			"event_type", evt.Type,
+			"connected", connected)
+	}
+}
+
+// CheckConnection returns an error unless the Socket Mode connection is established
+func (b *Bot) CheckConnection(_ context.Context) error {
+	if !b.connected.Load() {
+		return errors.New("socket mode is not connected")
+	}
+
+	return nil
+}
+
+// handleSlashCommand handles incoming slash commands
+func (b *Bot) handleSlashCommand(evt *socketmode.Event, client acker) {
 	cmd, ok := evt.Data.(slack.SlashCommand)
 	if !ok {
 		b.logger.Debug("Failed to parse slash command event", "event_type", evt.Type)
@@ -104,14 +224,39 @@ func (b *Bot) handleSlashCommand(evt *socketmode.Event, client *socketmode.Clien
 		"text", cmd.Text,
 		"channel_id", cmd.ChannelID)
 
-	// Check if this is the timeline command
-	if cmd.Text == timelineCommand {
-		b.handleTimelineCommand(cmd, client, evt)
-		return
+	fields := strings.Fields(cmd.Text)
+	action := slashAction(fields)
+
+	var outcome string
+
+	switch action {
+	case timelineCommand:
+		outcome = b.handleTimelineCommand(cmd, client, evt)
+	case resolveCommand:
+		outcome = b.handleResolveCommand(cmd, client, evt)
+	case severityCommand:
+		outcome = b.handleSeverityCommand(cmd, fields[1:], client, evt)
+	case updateCommand:
+		outcome = b.handleUpdateCommand(cmd, commandArgs(cmd.Text, updateCommand), client, evt)
+	case statsCommand:
+		outcome = b.handleStatsCommand(cmd, fields[1:], client, evt)
+	case oncallCommand:
+		outcome = b.handleOnCallCommand(cmd, fields[1:], client, evt)
+	case serviceCommand:
+		outcome = b.handleServiceCommand(cmd, fields[1:], client, evt)
+	case roleCommand:
+		outcome = b.handleRoleCommand(cmd, fields[1:], client, evt)
+	default:
+		outcome = b.handleStartCommand(cmd, client, evt)
 	}
 
+	metrics.SlashCommands.WithLabelValues(action, outcome).Inc()
+}
+
+// handleStartCommand handles the /shift start command and returns its outcome
+func (b *Bot) handleStartCommand(cmd slack.SlashCommand, client acker, evt *socketmode.Event) string {
 	// Parse the command for incident creation
-	incidentCmd, err := incident.ParseCommand(cmd.Text)
+	incidentCmd, err := incident.ParseCommand(incident.UnescapeText(cmd.Text))
 	if err != nil {
 		// Send help message
 		response := &slack.Msg{
@@ -120,15 +265,23 @@ func (b *Bot) handleSlashCommand(evt *socketmode.Event, client *socketmode.Clien
 		}
 		b.sendSlashResponse(client, evt, response)
 
-		return
+		return metrics.OutcomeInvalid
 	}
 
-	// Set user information
+	// Set user and workspace information
 	incidentCmd.UserID = cmd.UserID
 	incidentCmd.Username = cmd.UserName
+	incidentCmd.TeamID = cmd.TeamID
+	incidentCmd.EnterpriseID = cmd.EnterpriseID
 
 	// Create the incident
-	if err := b.createIncident(incidentCmd); err != nil {
+	_, err = b.createIncident(incidentCmd)
+	if errors.Is(err, incidenttype.ErrUnknownType) || errors.Is(err, incident.ErrNoSeverity) {
+		b.sendEphemeral(client, evt, fmt.Sprintf("Error: %v\n\n%s", err, incident.GetHelpMessage()))
+		return metrics.OutcomeInvalid
+	}
+
+	if err != nil {
 		b.logger.Error("Failed to create incident", "error", err, "user", cmd.UserName)
 
 		response := &slack.Msg{
@@ -138,7 +291,7 @@ func (b *Bot) handleSlashCommand(evt *socketmode.Event, client *socketmode.Clien
 
 		b.sendSlashResponse(client, evt, response)
 
-		return
+		return metrics.OutcomeError
 	}
 
 	// Send success response
@@ -148,10 +301,31 @@ func (b *Bot) handleSlashCommand(evt *socketmode.Event, client *socketmode.Clien
 	}
 
 	b.sendSlashResponse(client, evt, response)
+
+	return metrics.OutcomeSuccess
+}
+
+// slashAction returns the action of a slash command, keeping metric label values bounded
+func slashAction(fields []string) string {
+	if len(fields) == 0 {
+		return "none"
+	}
+
+	switch fields[0] {
+	case "start", timelineCommand, resolveCommand, severityCommand, statsCommand, updateCommand, oncallCommand, serviceCommand, roleCommand:
+		return fields[0]
+	default:
+		return "unknown"
+	}
 }
 
-// handleTimelineCommand handles the /shift timeline command
-func (b *Bot) handleTimelineCommand(cmd slack.SlashCommand, client *socketmode.Client, evt *socketmode.Event) {
+// commandArgs returns the text of a slash command following its action, keeping its spacing
+func commandArgs(text, action string) string {
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(text), action))
+}
+
+// handleTimelineCommand handles the /shift timeline command and returns its outcome
+func (b *Bot) handleTimelineCommand(cmd slack.SlashCommand, client acker, evt *socketmode.Event) string {
 	b.logger.Info("Processing timeline command",
 		"user", cmd.UserName,
 		"channel_id", cmd.ChannelID)
@@ -169,7 +343,20 @@ func (b *Bot) handleTimelineCommand(cmd slack.SlashCommand, client *socketmode.C
 		}
 		b.sendSlashResponse(client, evt, response)
 
-		return
+		return metrics.OutcomeInvalid
+	}
+
+	// Only the members of a private incident channel may see its timeline
+	if b.isPrivateIncident(incidentID) {
+		member, err := b.isChannelMember(b.workspaces.ForTeam(cmd.TeamID, cmd.EnterpriseID), cmd.ChannelID, cmd.UserID)
+		if err != nil {
+			b.logger.Error("Failed to check incident channel membership", "error", err, "channel_id", cmd.ChannelID)
+		}
+
+		if !member {
+			b.sendEphemeral(client, evt, MsgPrivateTimeline)
+			return metrics.OutcomeInvalid
+		}
 	}
 
 	// Get the timeline
@@ -187,7 +374,7 @@ func (b *Bot) handleTimelineCommand(cmd slack.SlashCommand, client *socketmode.C
 
 		b.sendSlashResponse(client, evt, response)
 
-		return
+		return metrics.OutcomeError
 	}
 
 	// Format the timeline nicely
@@ -205,6 +392,8 @@ func (b *Bot) handleTimelineCommand(cmd slack.SlashCommand, client *socketmode.C
 		"user", cmd.UserName,
 		"channel_id", cmd.ChannelID,
 		"entries_count", len(timeline.Entries))
+
+	return metrics.OutcomeSuccess
 }
 
 // formatTimelineForDisplay formats the timeline for nice display in Slack
@@ -306,13 +495,31 @@ func (b *Bot) getTimelineEntryIcon(entryType string) string {
 		return "👆"
 	case "bot_interaction":
 		return "🤖"
+	case "alert":
+		return "🔔"
+	case "severity_change":
+		return "📈"
+	case "resolved":
+		return "✅"
+	case "status_update":
+		return "📣"
+	case "paged":
+		return "📟"
+	case "escalated":
+		return "🔺"
+	case "acknowledged":
+		return "🙋"
+	case "service_change":
+		return "🧩"
+	case "checklist":
+		return "☑️"
 	default:
 		return "📝"
 	}
 }
 
-// sendSlashResponse sends a response to a slash command via Socket Mode
-func (b *Bot) sendSlashResponse(client *socketmode.Client, evt *socketmode.Event, response *slack.Msg) {
+// sendSlashResponse sends a response to a slash command
+func (b *Bot) sendSlashResponse(client acker, evt *socketmode.Event, response *slack.Msg) {
 	payload := map[string]interface{}{
 		"response_type": response.ResponseType,
 		"text":          response.Text,
@@ -320,33 +527,111 @@ func (b *Bot) sendSlashResponse(client *socketmode.Client, evt *socketmode.Event
 	client.Ack(*evt.Request, payload)
 }
 
-// createIncident creates a new incident
-func (b *Bot) createIncident(cmd *incident.Command) error {
+// DeclareIncident creates a new incident on behalf of an integration rather than a slash command
+func (b *Bot) DeclareIncident(cmd *incident.Command) (*incident.Incident, error) {
+	return b.createIncident(cmd)
+}
+
+// TimelineManager returns the timeline manager used by the bot
+func (b *Bot) TimelineManager() *timeline.Manager {
+	return b.timelineMgr
+}
+
+// SetPager makes the bot page on-call responders when incidents are declared
+func (b *Bot) SetPager(pager *paging.Pager) {
+	b.pager = pager
+}
+
+// SetHandoffs lets /shift oncall note add notes to the handoffs of the configured rotations
+func (b *Bot) SetHandoffs(handoffs *handoff.Handoffs) {
+	b.handoffs = handoffs
+}
+
+// UserGroupMembers returns the Slack user IDs in a user group of the default workspace, where
+// the user groups of on-call routes and escalation policies live
+func (b *Bot) UserGroupMembers(groupID string) ([]string, error) {
+	members, err := b.api.GetUserGroupMembers(groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get members of user group %s: %w", groupID, err)
+	}
+
+	return members, nil
+}
+
+// PostMessage posts a plain text message to a channel, in the workspace of its incident if any
+func (b *Bot) PostMessage(channelID, text string) error {
+	_, _, err := b.workspaces.ForChannel(channelID).PostMessage(channelID, slack.MsgOptionText(text, false))
+	if err != nil {
+		return fmt.Errorf("failed to post message to %s: %w", channelID, err)
+	}
+
+	return nil
+}
+
+// createIncident creates a new incident. It returns incidenttype.ErrUnknownType and
+// incident.ErrNoSeverity for commands with an unknown type or without a severity.
+func (b *Bot) createIncident(cmd *incident.Command) (*incident.Incident, error) {
+	// Fill in the defaults of the incident type
+	kind, err := incidenttype.Apply(b.cfg().IncidentTypes, cmd)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create incident object
 	inc := &incident.Incident{
-		ID:          incident.GenerateIncidentID(),
-		Title:       cmd.Title,
-		Description: cmd.Description,
-		Severity:    cmd.Severity,
-		StartedBy:   cmd.Username,
-		StartedAt:   time.Now(),
+		ID:              incident.GenerateIncidentID(),
+		Title:           cmd.Title,
+		Description:     cmd.Description,
+		Severity:        cmd.Severity,
+		Status:          incident.StatusOpen,
+		StartedBy:       cmd.Username,
+		StartedByUserID: cmd.UserID,
+		StartedAt:       time.Now(),
+		Services:        cmd.Services,
+		Team:            cmd.Team,
+		Type:            cmd.Type,
+		Private:         cmd.Private,
+		TeamID:          cmd.TeamID,
+		EnterpriseID:    cmd.EnterpriseID,
+	}
+
+	// Integrations declare incidents in the default workspace
+	if inc.TeamID == "" {
+		inc.TeamID = b.workspaces.DefaultTeam()
 	}
 
+	api := b.workspaces.ForTeam(inc.TeamID, inc.EnterpriseID)
+
 	// Generate channel name
 	channelName := incident.GenerateChannelName(inc)
 	inc.ChannelName = channelName
 
 	// Create the channel with description
-	channel, err := b.api.CreateConversation(slack.CreateConversationParams{
+	channel, err := api.CreateConversation(slack.CreateConversationParams{
 		ChannelName: channelName,
-		IsPrivate:   false,
+		IsPrivate:   inc.Private,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to create channel: %v", err)
+		return nil, fmt.Errorf("failed to create channel: %v", err)
 	}
 
+	inc.ChannelID = channel.ID
+	b.workspaces.SetChannel(channel.ID, inc.TeamID, inc.EnterpriseID)
+
+	// Persist the incident before anything else happens in its channel. An incident that isn't
+	// stored can't be managed, so its channel is archived instead of being left behind.
+	if err := b.persistIncident(inc); err != nil {
+		b.archiveChannel(api, channel.ID)
+		return nil, err
+	}
+
+	// Map the channel to the incident before anything else can fail, so that it is never orphaned
+	b.mu.Lock()
+	b.channelToIncident[channel.ID] = inc.ID
+	b.mu.Unlock()
+
 	// Set the channel topic and purpose after creation
-	_, err = b.api.SetTopicOfConversation(channel.ID, fmt.Sprintf("%s Incident: %s", cmd.Severity, cmd.Title))
+	_, err = api.SetTopicOfConversation(channel.ID, fmt.Sprintf("%s Incident: %s", cmd.Severity, cmd.Title))
 	if err != nil {
 		b.logger.Warn("Failed to set channel topic", "error", err, "channel_id", channel.ID)
 	}
@@ -357,25 +642,31 @@ func (b *Bot) createIncident(cmd *incident.Command) error {
 		channelPurpose = cmd.Title
 	}
 
-	_, err = b.api.SetPurposeOfConversation(channel.ID, channelPurpose)
+	_, err = api.SetPurposeOfConversation(channel.ID, channelPurpose)
 	if err != nil {
 		b.logger.Warn("Failed to set channel purpose", "error", err, "channel_id", channel.ID)
 	}
 
-	// Invite the user who created the incident to the channel
-	_, err = b.api.InviteUsersToConversation(channel.ID, cmd.UserID)
-	if err != nil {
-		b.logger.Warn("Failed to invite user to incident channel",
-			"error", err,
-			"user_id", cmd.UserID,
-			"channel_id", channel.ID)
-	} else {
-		b.logger.Info("User invited to incident channel",
-			"user_id", cmd.UserID,
-			"username", cmd.Username,
-			"channel_id", channel.ID)
+	// Invite the user who created the incident to the channel. Incidents declared
+	// by integrations have no Slack user to invite.
+	if cmd.UserID != "" {
+		_, err = api.InviteUsersToConversation(channel.ID, cmd.UserID)
+		if err != nil {
+			b.logger.Warn("Failed to invite user to incident channel",
+				"error", err,
+				"user_id", cmd.UserID,
+				"channel_id", channel.ID)
+		} else {
+			b.logger.Info("User invited to incident channel",
+				"user_id", cmd.UserID,
+				"username", cmd.Username,
+				"channel_id", channel.ID)
+		}
 	}
 
+	// Bookmark the runbooks of the incident type
+	b.addRunbooks(api, channel.ID, kind.Runbooks)
+
 	// Create timeline for the incident
 	_, err = b.timelineMgr.CreateTimeline(inc, channel.ID)
 	if err != nil {
@@ -388,35 +679,69 @@ func (b *Bot) createIncident(cmd *incident.Command) error {
 		descriptionText = cmd.Title
 	}
 
-	initialMessage := fmt.Sprintf("🚨 *%s Incident Started*\n\n"+
-		"*Severity:* %s\n"+
-		"*Started by:* <@%s>\n"+
-		"*Title:* %s\n"+
-		"*Description:* %s\n"+
-		"*Started at:* %s\n\n"+
-		"Please provide updates and coordinate the response in this channel.",
-		cmd.Severity, cmd.Severity, cmd.UserID, cmd.Title, descriptionText, inc.StartedAt.Format("2006-01-02 15:04:05"))
+	startedBy := startedByMention(cmd)
+
+	services := b.servicesText(cmd.Services)
+
+	initialMessage := b.initialMessage(kind, incidenttype.MessageData{
+		Type:        inc.Type,
+		Severity:    inc.Severity,
+		Title:       cmd.Title,
+		Description: descriptionText,
+		StartedBy:   startedBy,
+		StartedAt:   inc.StartedAt,
+		Services:    services,
+		Roles:       kind.Roles,
+	})
 
-	_, _, err = b.api.PostMessage(channel.ID, slack.MsgOptionText(initialMessage, false))
+	_, _, err = api.PostMessage(channel.ID, slack.MsgOptionText(initialMessage, false))
 	if err != nil {
 		b.logger.Error("Failed to post initial message", "error", err, "channel_id", channel.ID)
 	}
 
-	// Post notification in the notifications channel
+	// Post the checklist, followed by the checklist of the incident type
+	b.postChecklist(api, inc.ID, channel.ID, checklist.Build(b.cfg().Checklist, kind.Checklist))
+
+	// Page the on-call responders for the severity and service
+	b.pageResponders(inc, initialMessage)
+
+	// Page the first level of the matching escalation policy
+	b.startEscalation(inc)
+
+	// Post notifications in the routed channels; updates are threaded under them. Private incidents
+	// are announced without their details.
 	var notificationMessage string
 	if cmd.Description != "" {
-		notificationMessage = fmt.Sprintf("🚨 <@%s> started an incident: *%s*: <#%s>\n*Title:* %s\n*Description:* %s",
-			cmd.UserID, cmd.Severity, channel.ID, cmd.Title, cmd.Description)
+		notificationMessage = fmt.Sprintf("🚨 %s started an incident: *%s*: <#%s>\n*Title:* %s\n*Description:* %s",
+			startedBy, cmd.Severity, channel.ID, cmd.Title, cmd.Description)
 	} else {
-		notificationMessage = fmt.Sprintf("🚨 <@%s> started an incident: *%s*: <#%s>\n*Title:* %s",
-			cmd.UserID, cmd.Severity, channel.ID, cmd.Title)
+		notificationMessage = fmt.Sprintf("🚨 %s started an incident: *%s*: <#%s>\n*Title:* %s",
+			startedBy, cmd.Severity, channel.ID, cmd.Title)
 	}
 
-	_, _, err = b.api.PostMessage(b.config.NotificationsChannel, slack.MsgOptionText(notificationMessage, false))
-	if err != nil {
-		return fmt.Errorf("failed to post notification: %v", err)
+	if services != "" {
+		notificationMessage += "\n" + services
+	}
+
+	channels := b.notificationChannels(inc)
+
+	var posted int
+	if inc.Private {
+		posted = b.announcePrivate(api, inc, channels)
+	} else {
+		posted = b.announce(api, inc.ID, channels, notificationMessage)
+	}
+
+	// The incident is declared and its responders paged by now, so a failed notification
+	// doesn't fail the declaration
+	if posted == 0 {
+		b.logger.Error("Failed to post incident notification",
+			"incident_id", inc.ID,
+			"channels", strings.Join(channels, ", "))
 	}
 
+	metrics.IncidentsCreated.WithLabelValues(string(inc.Severity)).Inc()
+
 	b.logger.Info("Incident created successfully",
 		"incident_id", inc.ID,
 		"title", cmd.Title,
@@ -426,14 +751,74 @@ func (b *Bot) createIncident(cmd *incident.Command) error {
 		"channel_id", channel.ID,
 		"channel_name", channelName)
 
-	// Store the mapping
-	b.mu.Lock()
-	b.channelToIncident[channel.ID] = inc.ID
-	b.mu.Unlock()
+	return inc, nil
+}
+
+// persistIncident stores a newly declared incident and makes the person who declared it the commander.
+// Only a failure to store the incident is returned; the commander can still be assigned with /shift role.
+func (b *Bot) persistIncident(inc *incident.Incident) error {
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	if err := b.store.CreateIncident(ctx, inc); err != nil {
+		b.logger.Error("Failed to persist incident", "error", err, "incident_id", inc.ID)
+		return fmt.Errorf("failed to persist incident: %w", err)
+	}
+
+	if inc.StartedByUserID == "" {
+		return nil
+	}
+
+	if err := b.store.AssignRole(ctx, inc.ID, incident.RoleCommander, inc.StartedByUserID); err != nil {
+		b.logger.Error("Failed to assign incident commander",
+			"error", err,
+			"incident_id", inc.ID,
+			"user_id", inc.StartedByUserID)
+	}
 
 	return nil
 }
 
+// archiveChannel archives the channel of an incident that couldn't be declared
+func (b *Bot) archiveChannel(api *slack.Client, channelID string) {
+	if err := api.ArchiveConversation(channelID); err != nil {
+		b.logger.Error("Failed to archive the channel of an undeclared incident",
+			"error", err,
+			"channel_id", channelID)
+	}
+}
+
+// touchIncident records activity in an incident channel. Failures are logged since they only
+// affect stale incident detection.
+func (b *Bot) touchIncident(channelID string) {
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	if err := b.store.TouchIncidentByChannel(ctx, channelID, time.Now()); err != nil {
+		b.logger.Warn("Failed to record incident activity", "error", err, "channel_id", channelID)
+	}
+}
+
+// recordFirstResponse records activity by a person in an incident channel for the time to first
+// responder. Failures are logged since they only affect incident reports.
+func (b *Bot) recordFirstResponse(channelID, userID string) {
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	if err := b.store.RecordFirstResponse(ctx, channelID, userID, time.Now()); err != nil {
+		b.logger.Warn("Failed to record first response", "error", err, "channel_id", channelID)
+	}
+}
+
+// startedByMention returns how the person or integration that started an incident is shown in messages
+func startedByMention(cmd *incident.Command) string {
+	if cmd.UserID == "" {
+		return cmd.Username
+	}
+
+	return fmt.Sprintf("<@%s>", cmd.UserID)
+}
+
 // HealthCheck handles health check requests (kept for compatibility)
 func (b *Bot) HealthCheck(w http.ResponseWriter, _ *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
@@ -442,15 +827,15 @@ func (b *Bot) HealthCheck(w http.ResponseWriter, _ *http.Request) {
 	if err := json.NewEncoder(w).Encode(map[string]string{
 		"status": "healthy",
 		"bot":    "oh-shift",
-		"mode":   "socket",
+		"mode":   b.mode,
 	}); err != nil {
 		b.logger.Error("Failed to encode health check response", "error", err)
 		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
 	}
 }
 
-// handleEventsAPI handles all Events API events in Socket Mode
-func (b *Bot) handleEventsAPI(evt *socketmode.Event, client *socketmode.Client) {
+// handleEventsAPI handles all Events API events
+func (b *Bot) handleEventsAPI(evt *socketmode.Event, client acker) {
 	// Debug log for the full event body - show actual data, not just pointer
 	b.logger.Debug("Raw Events API event received",
 		"event_type", evt.Type,
@@ -523,14 +908,14 @@ func (b *Bot) handleMessageEvent(event *slackevents.EventsAPICallbackEvent) {
 		"message_length", len(msg.Text),
 		"timestamp", msg.TimeStamp)
 
-	// Check if this is an incident channel
-	if !strings.HasPrefix(msg.Channel, "C") {
-		b.logger.Debug("Skipping non-channel message", "channel", msg.Channel)
+	// Incident channels are public or private channels, not direct messages
+	if msg.ChannelType != slack.TYPE_CHANNEL && msg.ChannelType != slack.TYPE_GROUP {
+		b.logger.Debug("Skipping non-channel message", "channel", msg.Channel, "channel_type", msg.ChannelType)
 		return // Not a channel message
 	}
 
 	// Get channel info to check if it's an incident channel
-	channel, err := b.api.GetConversationInfo(&slack.GetConversationInfoInput{
+	channel, err := b.workspaces.ForTeam(event.TeamID, event.EnterpriseID).GetConversationInfo(&slack.GetConversationInfoInput{
 		ChannelID: msg.Channel,
 	})
 	if err != nil {
@@ -552,6 +937,16 @@ func (b *Bot) handleMessageEvent(event *slackevents.EventsAPICallbackEvent) {
 		return
 	}
 
+	// Messages from people keep the incident from going stale, even when its ID can't be looked up
+	if msg.BotID == "" {
+		b.touchIncident(msg.Channel)
+	}
+
+	// Joining the channel isn't a response, so only plain messages count
+	if msg.BotID == "" && msg.User != "" && msg.SubType == "" {
+		b.recordFirstResponse(msg.Channel, msg.User)
+	}
+
 	// Find the incident ID for this channel
 	incidentID := b.findIncidentIDByChannel(msg.Channel)
 	if incidentID == "" {
@@ -561,24 +956,8 @@ func (b *Bot) handleMessageEvent(event *slackevents.EventsAPICallbackEvent) {
 		return
 	}
 
-	// Check if we should add this message to the timeline
-	shouldAddMessage := b.config.AddAllMessagesToTimeline
-
-	// If not adding all messages, check if this message contains an image
-	if !shouldAddMessage {
-		// Check if the message contains an image (Slack image URLs)
-		if strings.Contains(msg.Text, "files.slack.com") && strings.Contains(msg.Text, "image") {
-			shouldAddMessage = true
-
-			b.logger.Debug("Message contains image, will add to timeline",
-				"incident_id", incidentID,
-				"channel_id", msg.Channel,
-				"user", msg.User)
-		}
-	}
-
-	// Only add to timeline if configured to do so
-	if !shouldAddMessage {
+	// Only add to timeline if configured to do so, or if the message contains an image
+	if !b.timelineMgr.ShouldAddMessage(msg.Text) {
 		b.logger.Debug("Skipping message (not configured to add all messages and no image detected)",
 			"incident_id", incidentID,
 			"channel_id", msg.Channel,
@@ -632,21 +1011,26 @@ func (b *Bot) handleReactionAddedEvent(event *slackevents.EventsAPICallbackEvent
 		"timestamp", reaction.Item.Timestamp,
 		"item_type", reaction.Item.Type)
 
-	// Check if this is a point_up or point_up_2 reaction
-	if reaction.Reaction != "point_up" && reaction.Reaction != "point_up_2" {
-		b.logger.Debug("Skipping unhandled reaction",
-			"reaction", reaction.Reaction)
-		return
-	}
-
 	// Check if this is an incident channel
 	incidentID := b.findIncidentIDByChannel(reaction.Item.Channel)
 	if incidentID == "" {
-		b.logger.Warn("No incident ID found for reaction channel",
+		b.logger.Debug("Skipping reaction outside an incident channel",
 			"channel_id", reaction.Item.Channel)
 		return
 	}
 
+	// Any reaction in an incident channel counts as a response
+	if reaction.User != "" {
+		b.recordFirstResponse(reaction.Item.Channel, reaction.User)
+	}
+
+	// Check if this is a point_up or point_up_2 reaction
+	if reaction.Reaction != "point_up" && reaction.Reaction != "point_up_2" {
+		b.logger.Debug("Skipping unhandled reaction",
+			"reaction", reaction.Reaction)
+		return
+	}
+
 	b.logger.Info("Processing point_up reaction for incident",
 		"incident_id", incidentID,
 		"channel_id", reaction.Item.Channel,
@@ -655,7 +1039,7 @@ func (b *Bot) handleReactionAddedEvent(event *slackevents.EventsAPICallbackEvent
 		"message_timestamp", reaction.Item.Timestamp)
 
 	// Get the message that was reacted to
-	msg, err := b.api.GetConversationHistory(&slack.GetConversationHistoryParameters{
+	msg, err := b.workspaces.ForTeam(event.TeamID, event.EnterpriseID).GetConversationHistory(&slack.GetConversationHistoryParameters{
 		ChannelID: reaction.Item.Channel,
 		Latest:    reaction.Item.Timestamp,
 		Limit:     1,
@@ -752,7 +1136,7 @@ func (b *Bot) handleFileSharedEvent(event *slackevents.EventsAPICallbackEvent) {
 		"file_id", file.FileID)
 
 	// Get file info to check if it's an image
-	fileInfo, _, _, err := b.api.GetFileInfo(file.FileID, 0, 0)
+	fileInfo, _, _, err := b.workspaces.ForTeam(event.TeamID, event.EnterpriseID).GetFileInfo(file.FileID, 0, 0)
 	if err != nil {
 		b.logger.Error("Failed to get file info",
 			"error", err,
@@ -797,12 +1181,17 @@ func (b *Bot) handleFileSharedEvent(event *slackevents.EventsAPICallbackEvent) {
 	}
 }
 
-// findIncidentIDByChannel finds the incident ID for a given channel
+// findIncidentIDByChannel finds the incident ID for a given channel. Channels missing from the
+// in-memory mapping, e.g. after a restart, are looked up in the database.
 func (b *Bot) findIncidentIDByChannel(channelID string) string {
 	b.mu.RLock()
 	incidentID := b.channelToIncident[channelID]
 	b.mu.RUnlock()
 
+	if incidentID == "" {
+		incidentID = b.loadIncidentIDByChannel(channelID)
+	}
+
 	if incidentID == "" {
 		b.logger.Debug("No incident ID mapping found for channel",
 			"channel_id", channelID)
@@ -814,3 +1203,25 @@ func (b *Bot) findIncidentIDByChannel(channelID string) string {
 
 	return incidentID
 }
+
+// loadIncidentIDByChannel looks up the incident of a channel in the database and caches the mapping
+func (b *Bot) loadIncidentIDByChannel(channelID string) string {
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	inc, err := b.store.GetIncidentByChannel(ctx, channelID)
+	if errors.Is(err, store.ErrNotFound) {
+		return ""
+	}
+
+	if err != nil {
+		b.logger.Error("Failed to look up incident by channel", "error", err, "channel_id", channelID)
+		return ""
+	}
+
+	b.mu.Lock()
+	b.channelToIncident[channelID] = inc.ID
+	b.mu.Unlock()
+
+	return inc.ID
+}
@@ -0,0 +1,100 @@
+package slack
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/report"
+	"github.com/fishnix/ohshift/internal/stale"
+	"github.com/fishnix/ohshift/internal/store"
+	"github.com/slack-go/slack"
+)
+
+const (
+	// staleResolveActionID is the action of the button resolving a stale incident
+	staleResolveActionID = "stale_resolve"
+	// staleKeepOpenActionID is the action of the button keeping a stale incident open
+	staleKeepOpenActionID = "stale_keep_open"
+	// staleSnoozeActionID is the action of the button snoozing stale prompts
+	staleSnoozeActionID = "stale_snooze"
+)
+
+// PostStalePrompt asks an incident channel whether to resolve, keep open or snooze a stale incident
+func (b *Bot) PostStalePrompt(incidentID, channelID, text string) error {
+	blocks := []slack.Block{
+		slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, text, false, false), nil, nil),
+		slack.NewActionBlock("",
+			slack.NewButtonBlockElement(staleResolveActionID, incidentID,
+				slack.NewTextBlockObject(slack.PlainTextType, "✅ Resolve", true, false)).WithStyle(slack.StylePrimary),
+			slack.NewButtonBlockElement(staleKeepOpenActionID, incidentID,
+				slack.NewTextBlockObject(slack.PlainTextType, "Keep open", false, false)),
+			slack.NewButtonBlockElement(staleSnoozeActionID, incidentID,
+				slack.NewTextBlockObject(slack.PlainTextType, "💤 Snooze "+report.FormatDuration(stale.SnoozeDuration), true, false)),
+		),
+	}
+
+	_, _, err := b.workspaces.ForChannel(channelID).PostMessage(channelID, slack.MsgOptionText(text, false), slack.MsgOptionBlocks(blocks...))
+	if err != nil {
+		return fmt.Errorf("failed to post stale incident prompt to %s: %w", channelID, err)
+	}
+
+	return nil
+}
+
+// handleStaleAction handles a click on one of the buttons of a stale incident prompt
+func (b *Bot) handleStaleAction(callback slack.InteractionCallback, actionID, incidentID string) {
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	var outcome string
+
+	switch actionID {
+	case staleResolveActionID:
+		err := b.resolveIncident(incidentID, callback.Channel.ID, callback.User.ID, callback.User.Name)
+		if errors.Is(err, store.ErrNotFound) {
+			outcome = "This incident is no longer open."
+			break
+		}
+
+		if err != nil {
+			b.postInteractionResponse(callback, fmt.Sprintf("Failed to resolve incident: %v", err))
+			return
+		}
+
+		outcome = fmt.Sprintf("✅ <@%s> resolved this incident.", callback.User.ID)
+	case staleKeepOpenActionID:
+		if err := b.store.TouchIncident(ctx, incidentID, time.Now()); err != nil {
+			b.logger.Error("Failed to keep stale incident open", "error", err, "incident_id", incidentID)
+			b.postInteractionResponse(callback, fmt.Sprintf("Failed to keep incident open: %v", err))
+
+			return
+		}
+
+		outcome = fmt.Sprintf("👍 <@%s> kept this incident open.", callback.User.ID)
+	case staleSnoozeActionID:
+		until := time.Now().Add(stale.SnoozeDuration)
+
+		if err := b.store.SnoozeStaleIncident(ctx, incidentID, until); err != nil {
+			b.logger.Error("Failed to snooze stale incident", "error", err, "incident_id", incidentID)
+			b.postInteractionResponse(callback, fmt.Sprintf("Failed to snooze reminder: %v", err))
+
+			return
+		}
+
+		outcome = fmt.Sprintf("💤 <@%s> snoozed this reminder until %s.", callback.User.ID, until.Format("2006-01-02 15:04"))
+	}
+
+	b.logger.Info("Stale incident prompt answered",
+		"incident_id", incidentID,
+		"action_id", actionID,
+		"user", callback.User.Name)
+
+	// Replace the buttons with the answer so the prompt can't be answered twice
+	_, _, _, err := b.interactionClient(callback).UpdateMessage(callback.Channel.ID, callback.Message.Timestamp,
+		slack.MsgOptionText(outcome, false),
+		slack.MsgOptionBlocks(slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, outcome, false, false), nil, nil)))
+	if err != nil {
+		b.logger.Warn("Failed to update stale incident prompt", "error", err, "channel_id", callback.Channel.ID)
+	}
+}
@@ -0,0 +1,59 @@
+package slack
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// tokenTransport sends Slack API requests with the current tokens. The Slack client keeps the
+// tokens it was created with, so this lets rotated secrets take effect without a restart.
+type tokenTransport struct {
+	base http.RoundTripper
+	bot  *Bot
+	// botToken and appToken are the tokens the client was created with
+	botToken string
+	appToken string
+}
+
+// RoundTrip replaces the tokens the client was created with in the Authorization header
+// and form body with the current ones
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	cfg := t.bot.cfg()
+	current := map[string]string{
+		t.botToken: cfg.SlackBotToken,
+		t.appToken: cfg.SlackAppToken,
+	}
+
+	if cfg.SlackBotToken == t.botToken && cfg.SlackAppToken == t.appToken {
+		return t.base.RoundTrip(req)
+	}
+
+	req = req.Clone(req.Context())
+
+	if token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer "); ok && token != "" && current[token] != "" {
+		req.Header.Set("Authorization", "Bearer "+current[token])
+	}
+
+	if req.Body != nil && req.Header.Get("Content-Type") == "application/x-www-form-urlencoded" {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			if token := values.Get("token"); token != "" && current[token] != "" {
+				values.Set("token", current[token])
+				body = []byte(values.Encode())
+			}
+		}
+
+		req.Body = io.NopCloser(strings.NewReader(string(body)))
+		req.ContentLength = int64(len(body))
+	}
+
+	return t.base.RoundTrip(req)
+}
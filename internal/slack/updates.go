@@ -0,0 +1,124 @@
+package slack
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/fishnix/ohshift/internal/metrics"
+	"github.com/fishnix/ohshift/internal/store"
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/socketmode"
+)
+
+// updateUsage explains the /shift update command
+const updateUsage = "Usage: /shift update <investigating|identified|monitoring|resolved> -- <text>"
+
+// handleUpdateCommand handles the /shift update <status> -- <text> command and returns its outcome
+func (b *Bot) handleUpdateCommand(cmd slack.SlashCommand, args string, client acker, evt *socketmode.Event) string {
+	b.logger.Info("Processing update command",
+		"user", cmd.UserName,
+		"channel_id", cmd.ChannelID)
+
+	incidentID := b.findIncidentIDByChannel(cmd.ChannelID)
+	if incidentID == "" {
+		b.sendEphemeral(client, evt, MsgCommandNotInIncidentChannel)
+		return metrics.OutcomeInvalid
+	}
+
+	update, err := incident.ParseStatusUpdate(args)
+	if err != nil {
+		b.sendEphemeral(client, evt, fmt.Sprintf("❌ %v\n\n%s", err, updateUsage))
+		return metrics.OutcomeInvalid
+	}
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	inc, err := b.store.GetIncident(ctx, incidentID)
+	if err != nil {
+		b.logger.Error("Failed to get incident", "error", err, "incident_id", incidentID)
+		b.sendEphemeral(client, evt, fmt.Sprintf("Failed to post status update: %v", err))
+
+		return metrics.OutcomeError
+	}
+
+	if inc.Status != string(incident.StatusOpen) {
+		b.sendEphemeral(client, evt, "❌ This incident is not open.")
+		return metrics.OutcomeInvalid
+	}
+
+	if err := b.store.SetSubStatus(ctx, incidentID, update.Status); err != nil {
+		b.logger.Error("Failed to set incident sub-status", "error", err, "incident_id", incidentID)
+		b.sendEphemeral(client, evt, fmt.Sprintf("Failed to post status update: %v", err))
+
+		return metrics.OutcomeError
+	}
+
+	if err := b.timelineMgr.AddStatusUpdateEntry(incidentID, cmd.UserID, update); err != nil {
+		b.logger.Warn("Failed to add status update to timeline", "error", err, "incident_id", incidentID)
+	}
+
+	message := formatStatusUpdate(inc, update, cmd.UserID)
+
+	api := b.workspaces.ForTeam(cmd.TeamID, cmd.EnterpriseID)
+
+	if _, _, err := api.PostMessage(cmd.ChannelID, slack.MsgOptionText(message, false)); err != nil {
+		b.logger.Error("Failed to post status update", "error", err, "channel_id", cmd.ChannelID)
+	}
+
+	b.notifyUpdate(api, incidentID, cmd.ChannelID, message)
+	b.sendStatusUpdateToSubscribers(api, incidentID, message)
+
+	b.logger.Info("Status update posted",
+		"incident_id", incidentID,
+		"status", update.Status,
+		"user", cmd.UserName)
+
+	if update.Status == incident.UpdateResolved {
+		err := b.resolveIncident(incidentID, cmd.ChannelID, cmd.UserID, cmd.UserName)
+		if err != nil && !errors.Is(err, store.ErrNotFound) {
+			b.sendEphemeral(client, evt, fmt.Sprintf("Status update posted, but failed to resolve incident: %v", err))
+			return metrics.OutcomeError
+		}
+	}
+
+	b.sendEphemeral(client, evt, "Status update posted.")
+
+	return metrics.OutcomeSuccess
+}
+
+// formatStatusUpdate formats a status update for stakeholders
+func formatStatusUpdate(inc *store.Incident, update *incident.StatusUpdate, userID string) string {
+	return fmt.Sprintf("📣 *Status update: %s*\n*Incident:* %s %s <#%s>\n*Posted by:* <@%s>\n\n%s",
+		update.Status.Title(), inc.Severity, inc.Title, inc.SlackChannelID, userID, update.Text)
+}
+
+// sendStatusUpdateToSubscribers sends a status update by direct message to everyone subscribed to an incident
+func (b *Bot) sendStatusUpdateToSubscribers(api *slack.Client, incidentID, message string) {
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	subscribers, err := b.store.ListSubscribers(ctx, incidentID)
+	if err != nil {
+		b.logger.Error("Failed to list incident subscribers", "error", err, "incident_id", incidentID)
+		return
+	}
+
+	blocks := messageWithButton(message, unsubscribeActionID, "🔕 Unsubscribe", incidentID)
+
+	for _, userID := range subscribers {
+		// Posting to a user ID sends a direct message from the bot
+		_, _, err := api.PostMessage(userID, slack.MsgOptionText(message, false), slack.MsgOptionBlocks(blocks...))
+		if err != nil {
+			b.logger.Warn("Failed to send status update to subscriber",
+				"error", err,
+				"incident_id", incidentID,
+				"user_id", userID)
+		}
+	}
+
+	b.logger.Debug("Status update sent to subscribers",
+		"incident_id", incidentID,
+		"subscribers", len(subscribers))
+}
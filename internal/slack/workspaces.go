@@ -0,0 +1,251 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/logger"
+	"github.com/fishnix/ohshift/internal/metrics"
+	"github.com/fishnix/ohshift/internal/store"
+	"github.com/slack-go/slack"
+)
+
+const (
+	// identifyAttempts is how many times the default workspace is looked up at startup
+	identifyAttempts = 5
+	// identifyBackoff is the delay before the first retry, doubled for each further one
+	identifyBackoff = time.Second
+)
+
+// Workspaces holds the Slack clients of the workspaces the bot is installed in. The workspace
+// of SLACK_BOT_TOKEN is the default one, used for teams without an OAuth installation and for
+// channels that don't belong to an incident.
+type Workspaces struct {
+	defaultClient *slack.Client
+	httpClient    *http.Client
+	store         *store.Store
+	logger        *slog.Logger
+	mu            sync.RWMutex
+	// defaultTeam is the team ID of the default workspace, once known
+	defaultTeam string
+	// clients maps team IDs to the clients of their installations
+	clients map[string]*slack.Client
+	// channels maps incident channel IDs to the workspace they were created in
+	channels map[string]workspace
+}
+
+// workspace identifies a Slack workspace
+type workspace struct {
+	teamID       string
+	enterpriseID string
+}
+
+// NewWorkspaces creates the workspace registry with the client of the default workspace
+func NewWorkspaces(defaultClient *slack.Client, st *store.Store) *Workspaces {
+	return &Workspaces{
+		defaultClient: defaultClient,
+		httpClient:    metrics.NewSlackClient(),
+		store:         st,
+		logger:        logger.With("component", "slack_workspaces"),
+		clients:       make(map[string]*slack.Client),
+		channels:      make(map[string]workspace),
+	}
+}
+
+// Default returns the client of the default workspace
+func (w *Workspaces) Default() *slack.Client {
+	return w.defaultClient
+}
+
+// DefaultTeam returns the team ID of the default workspace, empty until it is known
+func (w *Workspaces) DefaultTeam() string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	return w.defaultTeam
+}
+
+// SetDefaultTeam records the team ID of the default workspace
+func (w *Workspaces) SetDefaultTeam(teamID string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.defaultTeam = teamID
+}
+
+// IdentifyDefault looks up the team ID of the default workspace. Failed lookups are retried
+// identifyAttempts times with a growing delay.
+func (w *Workspaces) IdentifyDefault(ctx context.Context) error {
+	delay := identifyBackoff
+
+	for attempt := 1; ; attempt++ {
+		resp, err := w.defaultClient.AuthTestContext(ctx)
+		if err == nil {
+			w.SetDefaultTeam(resp.TeamID)
+			w.logger.Info("Identified the default workspace", "team_id", resp.TeamID, "team", resp.Team)
+
+			return nil
+		}
+
+		if attempt == identifyAttempts {
+			return fmt.Errorf("failed after %d attempts: %w", attempt, err)
+		}
+
+		w.logger.Warn("Failed to identify the default workspace, retrying", "error", err, "attempt", attempt, "delay", delay)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+	}
+}
+
+// IsDefault reports whether a team is the default workspace. Incidents without a team, declared
+// before multi-workspace support, belong to it too. Until the default workspace is identified
+// every team is treated as the default, which is only right when OAuth installs are disabled.
+func (w *Workspaces) IsDefault(teamID string) bool {
+	defaultTeam := w.DefaultTeam()
+
+	return teamID == "" || defaultTeam == "" || teamID == defaultTeam
+}
+
+// ForTeam returns the client of a workspace, falling back to the default workspace when the bot
+// wasn't installed in it through OAuth
+func (w *Workspaces) ForTeam(teamID, enterpriseID string) *slack.Client {
+	if w.IsDefault(teamID) {
+		return w.defaultClient
+	}
+
+	w.mu.RLock()
+	client, ok := w.clients[teamID]
+	w.mu.RUnlock()
+
+	if ok {
+		return client
+	}
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	inst, err := w.store.FindInstallation(ctx, teamID, enterpriseID)
+	if err != nil {
+		if !errors.Is(err, store.ErrNotFound) {
+			w.logger.Warn("Failed to look up installation, using the default workspace", "error", err, "team_id", teamID)
+		}
+
+		return w.defaultClient
+	}
+
+	return w.add(teamID, inst)
+}
+
+// ForChannel returns the client of the workspace a channel belongs to. Channels other than
+// incident channels, and user IDs for direct messages, use the default workspace.
+func (w *Workspaces) ForChannel(channelID string) *slack.Client {
+	ws := w.channel(channelID)
+
+	return w.ForTeam(ws.teamID, ws.enterpriseID)
+}
+
+// TeamForChannel returns the team ID of the workspace a channel belongs to, empty for the default workspace
+func (w *Workspaces) TeamForChannel(channelID string) string {
+	return w.channel(channelID).teamID
+}
+
+// channel returns the workspace of an incident channel, or the zero workspace for the default one
+func (w *Workspaces) channel(channelID string) workspace {
+	w.mu.RLock()
+	ws, ok := w.channels[channelID]
+	w.mu.RUnlock()
+
+	if ok {
+		return ws
+	}
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	teamID, enterpriseID, err := w.store.GetChannelWorkspace(ctx, channelID)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		w.logger.Warn("Failed to look up channel workspace, using the default workspace", "error", err, "channel_id", channelID)
+		return workspace{}
+	}
+
+	// Remember channels of the default workspace too; incident channels are recorded when they are created
+	w.SetChannel(channelID, teamID, enterpriseID)
+
+	return workspace{teamID: teamID, enterpriseID: enterpriseID}
+}
+
+// SetChannel records the workspace of an incident channel
+func (w *Workspaces) SetChannel(channelID, teamID, enterpriseID string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.channels[channelID] = workspace{teamID: teamID, enterpriseID: enterpriseID}
+}
+
+// Install makes a new installation's token the client of its workspace
+func (w *Workspaces) Install(inst *store.Installation) {
+	teamID := inst.ID
+	if inst.TeamID != nil {
+		teamID = *inst.TeamID
+	}
+
+	w.add(teamID, inst)
+}
+
+// add caches the client of an installation for a team
+func (w *Workspaces) add(teamID string, inst *store.Installation) *slack.Client {
+	client := slack.New(inst.BotToken, slack.OptionHTTPClient(w.httpClient))
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.clients[teamID] = client
+
+	w.logger.Debug("Loaded workspace installation", "team_id", teamID, "installation_id", inst.ID)
+
+	return client
+}
+
+// PostNotification posts to the notifications channel of the workspace of an incident channel
+func (b *Bot) PostNotification(channelID, text string) error {
+	notificationsChannel := b.cfg().NotificationsChannelFor(b.workspaces.TeamForChannel(channelID))
+
+	_, _, err := b.workspaces.ForChannel(channelID).PostMessage(notificationsChannel, slack.MsgOptionText(text, false))
+	if err != nil {
+		return fmt.Errorf("failed to post notification to %s: %w", notificationsChannel, err)
+	}
+
+	return nil
+}
+
+// notify posts a notification about the incident in channelID, logging failures
+func (b *Bot) notify(channelID, text string) {
+	if err := b.PostNotification(channelID, text); err != nil {
+		b.logger.Error("Failed to post notification", "error", err, "channel_id", channelID)
+	}
+}
+
+// inWorkspace reports whether an incident declared in incidentTeam belongs to the workspace of teamID
+func (b *Bot) inWorkspace(teamID string, incidentTeam *string) bool {
+	if incidentTeam == nil {
+		return b.workspaces.IsDefault(teamID)
+	}
+
+	return *incidentTeam == teamID
+}
+
+// interactionClient returns the client of the workspace a button was clicked in
+func (b *Bot) interactionClient(callback slack.InteractionCallback) *slack.Client {
+	return b.workspaces.ForTeam(callback.Team.ID, callback.Enterprise.ID)
+}
@@ -0,0 +1,167 @@
+// Package stale finds open incidents that have gone quiet and asks their channels whether to close them.
+package stale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/logger"
+	"github.com/fishnix/ohshift/internal/report"
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+const (
+	// JobName identifies the stale incident check in the scheduler
+	JobName = "stale_incidents"
+
+	// SnoozeDuration is how long the snooze button of a prompt stops further prompts
+	SnoozeDuration = 24 * time.Hour
+)
+
+// Prompter posts stale incident prompts and notifications to Slack
+type Prompter interface {
+	// PostNotification posts to the notifications channel of the workspace of an incident channel
+	PostNotification(channelID, text string) error
+	PostStalePrompt(incidentID, channelID, text string) error
+}
+
+// Detector finds open incidents without activity for longer than the configured period
+type Detector struct {
+	after    time.Duration
+	store    *store.Store
+	prompter Prompter
+	logger   *slog.Logger
+	now      func() time.Time
+}
+
+// New creates a new stale incident detector. It is disabled when no period is configured.
+func New(cfg *config.Config, st *store.Store, prompter Prompter) (*Detector, error) {
+	after, err := parseAfter(cfg.StaleIncidentAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Detector{
+		after:    after,
+		store:    st,
+		prompter: prompter,
+		logger:   logger.With("component", "stale"),
+		now:      time.Now,
+	}, nil
+}
+
+// parseAfter validates the configured stale period; an empty value disables detection
+func parseAfter(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	after, err := time.ParseDuration(value)
+	if err != nil || after < time.Hour {
+		return 0, fmt.Errorf("stale incident period: invalid duration %q, expected at least 1h", value)
+	}
+
+	return after, nil
+}
+
+// Enabled reports whether a stale period is configured
+func (d *Detector) Enabled() bool {
+	return d.after > 0
+}
+
+// Run prompts the channels of newly stale incidents and lists them in the notifications channel
+// of their workspace
+func (d *Detector) Run(ctx context.Context) error {
+	now := d.now()
+
+	incidents, err := d.store.ClaimStaleIncidents(ctx, now.Add(-d.after), now)
+	if err != nil {
+		return err
+	}
+
+	if len(incidents) == 0 {
+		d.logger.Debug("No stale incidents found")
+		return nil
+	}
+
+	slices.SortFunc(incidents, func(a, b *store.Incident) int {
+		return a.LastUpdated.Compare(b.LastUpdated)
+	})
+
+	for _, inc := range incidents {
+		text := promptMessage(now.Sub(inc.LastUpdated))
+
+		if err := d.prompter.PostStalePrompt(inc.ID, inc.SlackChannelID, text); err != nil {
+			d.logger.Error("Failed to prompt stale incident", "error", err, "incident_id", inc.ID)
+			continue
+		}
+
+		d.logger.Info("Stale incident prompted",
+			"incident_id", inc.ID,
+			"channel_id", inc.SlackChannelID,
+			"last_updated", inc.LastUpdated)
+	}
+
+	var errs []error
+
+	for _, group := range byWorkspace(incidents) {
+		if err := d.prompter.PostNotification(group[0].SlackChannelID, listMessage(group, now)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// byWorkspace groups incidents by the Slack workspace they were declared in, keeping their order
+func byWorkspace(incidents []*store.Incident) [][]*store.Incident {
+	var (
+		groups [][]*store.Incident
+		index  = make(map[string]int)
+	)
+
+	for _, inc := range incidents {
+		var teamID string
+		if inc.TeamID != nil {
+			teamID = *inc.TeamID
+		}
+
+		i, ok := index[teamID]
+		if !ok {
+			i = len(groups)
+			index[teamID] = i
+			groups = append(groups, nil)
+		}
+
+		groups[i] = append(groups[i], inc)
+	}
+
+	return groups
+}
+
+// promptMessage asks an incident channel what to do with the incident
+func promptMessage(idle time.Duration) string {
+	return fmt.Sprintf("🕸️ This incident has had no activity for %s. Is it still ongoing?\n"+
+		"Resolve it, keep it open, or snooze this reminder for %s.",
+		report.FormatDuration(idle), report.FormatDuration(SnoozeDuration))
+}
+
+// listMessage lists stale incidents for the notifications channel
+func listMessage(incidents []*store.Incident, now time.Time) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "🕸️ *%d stale incident(s)* are still open without recent activity:\n", len(incidents))
+
+	for _, inc := range incidents {
+		fmt.Fprintf(&b, "• *%s* %s <#%s> (no activity for %s)\n",
+			inc.Severity, inc.PublicTitle(), inc.SlackChannelID, report.FormatDuration(now.Sub(inc.LastUpdated)))
+	}
+
+	return b.String()
+}
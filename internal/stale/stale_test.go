@@ -0,0 +1,59 @@
+package stale
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/store"
+)
+
+func TestParseAfter(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"", 0, false},
+		{"72h", 72 * time.Hour, false},
+		{"30m", 0, true},
+		{"3d", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseAfter(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseAfter(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf("parseAfter(%q) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestListMessage(t *testing.T) {
+	now := time.Date(2025, 6, 9, 12, 0, 0, 0, time.UTC)
+
+	incidents := []*store.Incident{
+		{Severity: "SEV2", Title: "slow search", SlackChannelID: "C1", LastUpdated: now.Add(-80 * time.Hour)},
+		{Severity: "SEV3", Title: "flaky cron", SlackChannelID: "C2", LastUpdated: now.Add(-73 * time.Hour)},
+		{Severity: "SEV1", Title: "leaked credentials", SlackChannelID: "C3", Private: true, LastUpdated: now.Add(-72 * time.Hour)},
+	}
+
+	message := listMessage(incidents, now)
+
+	expected := []string{
+		"*3 stale incident(s)*",
+		"• *SEV2* slow search <#C1> (no activity for 3d8h)",
+		"• *SEV3* flaky cron <#C2> (no activity for 3d1h)",
+		"• *SEV1* 🔒 Private incident <#C3> (no activity for 3d0h)",
+	}
+
+	for _, content := range expected {
+		if !strings.Contains(message, content) {
+			t.Errorf("listMessage() missing %q in:\n%s", content, message)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/incident"
+)
+
+// AlertIncident returns the open incident declared for an alert fingerprint. It returns
+// ErrNotFound when there is none, including when the incident has been resolved since.
+func (s *Store) AlertIncident(ctx context.Context, fingerprint string) (string, error) {
+	var incidentID string
+
+	err := s.db.GetContext(ctx, &incidentID, `SELECT a.incident_id FROM alert_incidents a
+		JOIN incidents i ON i.id = a.incident_id
+		WHERE a.fingerprint = $1 AND i.status = $2`, fingerprint, string(incident.StatusOpen))
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrNotFound
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("failed to get alert incident: %w", err)
+	}
+
+	return incidentID, nil
+}
+
+// ClaimAlert claims the right to declare an incident for an alert fingerprint. A fingerprint can
+// be claimed when it has no incident yet, when its incident is no longer open, or when an earlier
+// claim without an incident was made before staleBefore. It returns false when another replica
+// holds the claim or the incident is still open.
+func (s *Store) ClaimAlert(ctx context.Context, fingerprint string, now, staleBefore time.Time) (bool, error) {
+	result, err := s.db.ExecContext(ctx, `INSERT INTO alert_incidents (fingerprint, claimed_at) VALUES ($1, $2)
+		ON CONFLICT (fingerprint) DO UPDATE SET incident_id = NULL, claimed_at = EXCLUDED.claimed_at
+		WHERE (alert_incidents.incident_id IS NULL AND alert_incidents.claimed_at < $3)
+			OR (alert_incidents.incident_id IS NOT NULL
+				AND NOT EXISTS (SELECT 1 FROM incidents i WHERE i.id = alert_incidents.incident_id AND i.status = $4))`,
+		fingerprint, now, staleBefore, string(incident.StatusOpen))
+	if err != nil {
+		return false, fmt.Errorf("failed to claim alert: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to read affected rows: %w", err)
+	}
+
+	return rows == 1, nil
+}
+
+// SetAlertIncident records the incident declared for a claimed alert fingerprint
+func (s *Store) SetAlertIncident(ctx context.Context, fingerprint, incidentID string) error {
+	result, err := s.db.ExecContext(ctx, `UPDATE alert_incidents SET incident_id = $2 WHERE fingerprint = $1`,
+		fingerprint, incidentID)
+	if err != nil {
+		return fmt.Errorf("failed to set alert incident: %w", err)
+	}
+
+	return expectRow(result)
+}
+
+// ReleaseAlert gives up a claim on an alert fingerprint for which no incident was declared
+func (s *Store) ReleaseAlert(ctx context.Context, fingerprint string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM alert_incidents WHERE fingerprint = $1 AND incident_id IS NULL`,
+		fingerprint)
+	if err != nil {
+		return fmt.Errorf("failed to release alert: %w", err)
+	}
+
+	return nil
+}
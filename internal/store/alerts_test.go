@@ -0,0 +1,39 @@
+package store
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClaimAlertWithinTimeout(t *testing.T) {
+	s := newTestStore(t)
+	ctx := t.Context()
+
+	const fingerprint = "test-claim-alert-within-timeout"
+
+	t.Cleanup(func() {
+		_, _ = s.db.Exec(`DELETE FROM alert_incidents WHERE fingerprint = $1`, fingerprint)
+	})
+
+	timeout := 5 * time.Minute
+	now := time.Now()
+
+	claimed, err := s.ClaimAlert(ctx, fingerprint, now, now.Add(-timeout))
+	if err != nil || !claimed {
+		t.Fatalf("first ClaimAlert() = %v, %v, want a claim", claimed, err)
+	}
+
+	later := now.Add(time.Minute)
+
+	claimed, err = s.ClaimAlert(ctx, fingerprint, later, later.Add(-timeout))
+	if err != nil || claimed {
+		t.Fatalf("second ClaimAlert() within the timeout = %v, %v, want no claim", claimed, err)
+	}
+
+	stale := now.Add(timeout + time.Minute)
+
+	claimed, err = s.ClaimAlert(ctx, fingerprint, stale, stale.Add(-timeout))
+	if err != nil || !claimed {
+		t.Errorf("ClaimAlert() after the timeout = %v, %v, want a claim", claimed, err)
+	}
+}
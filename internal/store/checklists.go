@@ -0,0 +1,194 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/lib/pq"
+)
+
+// Checklist is a row of the incident_checklists table
+type Checklist struct {
+	IncidentID     string  `db:"incident_id"`
+	SlackChannelID string  `db:"slack_channel_id"`
+	SlackMessageTS *string `db:"slack_message_ts"`
+	// NextReminder is when unfinished required items are flagged next
+	NextReminder *time.Time `db:"next_reminder"`
+}
+
+// ChecklistItem is a row of the incident_checklist_items table
+type ChecklistItem struct {
+	IncidentID  string     `db:"incident_id"`
+	Position    int        `db:"position"`
+	Text        string     `db:"text"`
+	Required    bool       `db:"required"`
+	CompletedBy *string    `db:"completed_by"`
+	CompletedAt *time.Time `db:"completed_at"`
+}
+
+// Done reports whether the item has been ticked
+func (item *ChecklistItem) Done() bool {
+	return item.CompletedAt != nil
+}
+
+// CreateChecklist stores the checklist of an incident with its items, numbered from 1 in order
+func (s *Store) CreateChecklist(ctx context.Context, checklist *Checklist, items []*ChecklistItem) error {
+	texts := make(pq.StringArray, 0, len(items))
+	required := make(pq.BoolArray, 0, len(items))
+
+	for _, item := range items {
+		texts = append(texts, item.Text)
+		required = append(required, item.Required)
+	}
+
+	// The items are inserted in the same statement
+	_, err := s.db.ExecContext(ctx, `WITH inserted AS (
+			INSERT INTO incident_checklists (incident_id, slack_channel_id, next_reminder)
+			VALUES ($1, $2, $3)
+			RETURNING incident_id
+		)
+		INSERT INTO incident_checklist_items (incident_id, position, text, required)
+		SELECT inserted.incident_id, i.position, i.text, i.required
+		FROM inserted, unnest($4::text[], $5::boolean[]) WITH ORDINALITY AS i(text, required, position)`,
+		checklist.IncidentID, checklist.SlackChannelID, checklist.NextReminder, texts, required)
+	if err != nil {
+		return fmt.Errorf("failed to insert checklist: %w", err)
+	}
+
+	return nil
+}
+
+// SetChecklistMessage records the message showing the checklist of an incident
+func (s *Store) SetChecklistMessage(ctx context.Context, incidentID, messageTS string) error {
+	result, err := s.db.ExecContext(ctx, `UPDATE incident_checklists SET slack_message_ts = $2 WHERE incident_id = $1`,
+		incidentID, messageTS)
+	if err != nil {
+		return fmt.Errorf("failed to set checklist message: %w", err)
+	}
+
+	return expectRow(result)
+}
+
+// GetChecklist returns the checklist of an incident with its items in order
+func (s *Store) GetChecklist(ctx context.Context, incidentID string) (*Checklist, []*ChecklistItem, error) {
+	var checklist Checklist
+
+	err := s.db.GetContext(ctx, &checklist, `SELECT incident_id, slack_channel_id, slack_message_ts, next_reminder
+		FROM incident_checklists WHERE incident_id = $1`, incidentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get checklist: %w", err)
+	}
+
+	items := []*ChecklistItem{}
+
+	err = s.db.SelectContext(ctx, &items, `SELECT incident_id, position, text, required, completed_by, completed_at
+		FROM incident_checklist_items WHERE incident_id = $1 ORDER BY position`, incidentID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list checklist items: %w", err)
+	}
+
+	return &checklist, items, nil
+}
+
+// GetChecklistByChannel returns the checklist posted in an incident channel
+func (s *Store) GetChecklistByChannel(ctx context.Context, channelID string) (*Checklist, error) {
+	var checklist Checklist
+
+	err := s.db.GetContext(ctx, &checklist, `SELECT incident_id, slack_channel_id, slack_message_ts, next_reminder
+		FROM incident_checklists WHERE slack_channel_id = $1`, channelID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get checklist: %w", err)
+	}
+
+	return &checklist, nil
+}
+
+// SetChecklistItemDone ticks or unticks an item of the checklist of an incident. It returns false
+// when the item was already in that state, and ErrNotFound when the item doesn't exist.
+func (s *Store) SetChecklistItemDone(ctx context.Context, incidentID string, position int, slackUserID string, done bool) (bool, error) {
+	query := `UPDATE incident_checklist_items SET completed_by = NULL, completed_at = NULL
+		WHERE incident_id = $1 AND position = $2 AND completed_at IS NOT NULL`
+	args := []any{incidentID, position}
+
+	if done {
+		query = `UPDATE incident_checklist_items SET completed_by = $3, completed_at = NOW()
+			WHERE incident_id = $1 AND position = $2 AND completed_at IS NULL`
+		args = append(args, nullString(slackUserID))
+	}
+
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to update checklist item: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to read affected rows: %w", err)
+	}
+
+	if rows == 1 {
+		return true, nil
+	}
+
+	var exists bool
+
+	err = s.db.GetContext(ctx, &exists, `SELECT EXISTS (SELECT 1 FROM incident_checklist_items
+		WHERE incident_id = $1 AND position = $2)`, incidentID, position)
+	if err != nil {
+		return false, fmt.Errorf("failed to look up checklist item: %w", err)
+	}
+
+	if !exists {
+		return false, ErrNotFound
+	}
+
+	return false, nil
+}
+
+// ListDueChecklists returns the checklists of open incidents whose reminder is due and that have
+// unfinished required items
+func (s *Store) ListDueChecklists(ctx context.Context, now time.Time) ([]*Checklist, error) {
+	checklists := []*Checklist{}
+
+	err := s.db.SelectContext(ctx, &checklists, `SELECT c.incident_id, c.slack_channel_id, c.slack_message_ts, c.next_reminder
+		FROM incident_checklists c
+		JOIN incidents i ON i.id = c.incident_id
+		WHERE i.status = $1 AND c.next_reminder <= $2
+			AND EXISTS (SELECT 1 FROM incident_checklist_items t
+				WHERE t.incident_id = c.incident_id AND t.required AND t.completed_at IS NULL)
+		ORDER BY c.next_reminder`, string(incident.StatusOpen), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due checklists: %w", err)
+	}
+
+	return checklists, nil
+}
+
+// ClaimChecklistReminder moves the next reminder of a checklist from due to next. It returns
+// false when the reminder was changed in the meantime, e.g. by another replica sending it.
+func (s *Store) ClaimChecklistReminder(ctx context.Context, incidentID string, due, next time.Time) (bool, error) {
+	result, err := s.db.ExecContext(ctx, `UPDATE incident_checklists SET next_reminder = $3
+		WHERE incident_id = $1 AND next_reminder = $2`, incidentID, due, next)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim checklist reminder: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to read affected rows: %w", err)
+	}
+
+	return rows == 1, nil
+}
@@ -0,0 +1,54 @@
+package store
+
+import (
+	"context"
+	"database/sql/driver"
+	"sync/atomic"
+
+	"github.com/lib/pq"
+)
+
+// Connector opens PostgreSQL connections with the current database URI, so that rotated
+// credentials are used for new connections without a restart
+type Connector struct {
+	uri atomic.Pointer[string]
+}
+
+// NewConnector returns a connector for the database at uri
+func NewConnector(uri string) (*Connector, error) {
+	if _, err := pq.NewConnector(uri); err != nil {
+		return nil, err
+	}
+
+	c := &Connector{}
+	c.uri.Store(&uri)
+
+	return c, nil
+}
+
+// SetURI changes the database URI of new connections. Invalid URIs are rejected and the
+// current one stays in use.
+func (c *Connector) SetURI(uri string) error {
+	if _, err := pq.NewConnector(uri); err != nil {
+		return err
+	}
+
+	c.uri.Store(&uri)
+
+	return nil
+}
+
+// Connect opens a connection with the current database URI
+func (c *Connector) Connect(ctx context.Context) (driver.Conn, error) {
+	connector, err := pq.NewConnector(*c.uri.Load())
+	if err != nil {
+		return nil, err
+	}
+
+	return connector.Connect(ctx)
+}
+
+// Driver returns the PostgreSQL driver
+func (c *Connector) Driver() driver.Driver {
+	return &pq.Driver{}
+}
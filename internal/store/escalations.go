@@ -0,0 +1,84 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Escalation is a row of the incident_escalations table
+type Escalation struct {
+	IncidentID     string     `db:"incident_id"`
+	Policy         string     `db:"policy"`
+	Level          int        `db:"level"`
+	EscalatedAt    *time.Time `db:"escalated_at"`
+	AcknowledgedBy *string    `db:"acknowledged_by"`
+	AcknowledgedAt *time.Time `db:"acknowledged_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+}
+
+// CreateEscalation starts escalating an incident with a policy. Incidents already being
+// escalated are left alone.
+func (s *Store) CreateEscalation(ctx context.Context, incidentID, policy string) error {
+	_, err := s.db.ExecContext(ctx, `INSERT INTO incident_escalations (incident_id, policy) VALUES ($1, $2)
+		ON CONFLICT (incident_id) DO NOTHING`, incidentID, policy)
+	if err != nil {
+		return fmt.Errorf("failed to create escalation: %w", err)
+	}
+
+	return nil
+}
+
+// GetEscalation returns the escalation of an incident
+func (s *Store) GetEscalation(ctx context.Context, incidentID string) (*Escalation, error) {
+	var escalation Escalation
+
+	err := s.db.GetContext(ctx, &escalation, `SELECT incident_id, policy, level, escalated_at,
+		acknowledged_by, acknowledged_at, created_at
+		FROM incident_escalations WHERE incident_id = $1`, incidentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get escalation: %w", err)
+	}
+
+	return &escalation, nil
+}
+
+// ClaimEscalationLevel moves an unacknowledged escalation up to level. It returns false when
+// the escalation was acknowledged or already reached the level, e.g. on a retried job.
+func (s *Store) ClaimEscalationLevel(ctx context.Context, incidentID string, level int, at time.Time) (bool, error) {
+	result, err := s.db.ExecContext(ctx, `UPDATE incident_escalations SET level = $2, escalated_at = $3
+		WHERE incident_id = $1 AND level < $2 AND acknowledged_at IS NULL`, incidentID, level, at)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim escalation level: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to read affected rows: %w", err)
+	}
+
+	return rows == 1, nil
+}
+
+// AcknowledgeEscalation stops the escalation of an incident. It returns false when the
+// incident isn't escalated or someone acknowledged it first.
+func (s *Store) AcknowledgeEscalation(ctx context.Context, incidentID, slackUserID string, at time.Time) (bool, error) {
+	result, err := s.db.ExecContext(ctx, `UPDATE incident_escalations SET acknowledged_by = $2, acknowledged_at = $3
+		WHERE incident_id = $1 AND acknowledged_at IS NULL`, incidentID, slackUserID, at)
+	if err != nil {
+		return false, fmt.Errorf("failed to acknowledge escalation: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to read affected rows: %w", err)
+	}
+
+	return rows == 1, nil
+}
@@ -0,0 +1,42 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// HandoffNote is a row of the oncall_handoff_notes table
+type HandoffNote struct {
+	ID          int64     `db:"id"`
+	Rotation    string    `db:"rotation"`
+	SlackUserID string    `db:"slack_user_id"`
+	Note        string    `db:"note"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// AddHandoffNote records a note for the next handoff of a rotation
+func (s *Store) AddHandoffNote(ctx context.Context, note *HandoffNote) error {
+	_, err := s.db.ExecContext(ctx, `INSERT INTO oncall_handoff_notes (rotation, slack_user_id, note, created_at)
+		VALUES ($1, $2, $3, $4)`, note.Rotation, note.SlackUserID, note.Note, note.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to add handoff note: %w", err)
+	}
+
+	return nil
+}
+
+// ListHandoffNotes returns the notes of a rotation created in [from, to), oldest first
+func (s *Store) ListHandoffNotes(ctx context.Context, rotation string, from, to time.Time) ([]*HandoffNote, error) {
+	notes := []*HandoffNote{}
+
+	err := s.db.SelectContext(ctx, &notes, `SELECT id, rotation, slack_user_id, note, created_at
+		FROM oncall_handoff_notes
+		WHERE rotation = $1 AND created_at >= $2 AND created_at < $3
+		ORDER BY created_at, id`, rotation, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list handoff notes: %w", err)
+	}
+
+	return notes, nil
+}
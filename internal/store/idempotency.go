@@ -0,0 +1,84 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// IdempotencyKey is a row of the idempotency_keys table
+type IdempotencyKey struct {
+	Key string `db:"key"`
+	// Fingerprint identifies the request that first used the key
+	Fingerprint string `db:"fingerprint"`
+	// Response is the JSON response of that request, nil while it is being processed
+	Response  []byte    `db:"response"`
+	CreatedAt time.Time `db:"created_at"`
+	ExpiresAt time.Time `db:"expires_at"`
+}
+
+// ClaimIdempotencyKey claims an idempotency key for the request identified by fingerprint until
+// expires. Expired keys and claims without a response made before staleBefore are claimed again.
+// It returns false when the key is held by another request.
+func (s *Store) ClaimIdempotencyKey(ctx context.Context, key, fingerprint string, now, expires, staleBefore time.Time) (bool, error) {
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= $1`, now); err != nil {
+		return false, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+
+	result, err := s.db.ExecContext(ctx, `INSERT INTO idempotency_keys (key, fingerprint, created_at, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (key) DO UPDATE SET fingerprint = EXCLUDED.fingerprint, response = NULL,
+			created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.response IS NULL AND idempotency_keys.created_at < $5`,
+		key, fingerprint, now, expires, staleBefore)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim idempotency key: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to read affected rows: %w", err)
+	}
+
+	return rows == 1, nil
+}
+
+// GetIdempotencyKey returns an idempotency key
+func (s *Store) GetIdempotencyKey(ctx context.Context, key string) (*IdempotencyKey, error) {
+	var k IdempotencyKey
+
+	err := s.db.GetContext(ctx, &k, `SELECT key, fingerprint, response, created_at, expires_at
+		FROM idempotency_keys WHERE key = $1`, key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+
+	return &k, nil
+}
+
+// SetIdempotencyResponse records the JSON response of the request holding an idempotency key
+func (s *Store) SetIdempotencyResponse(ctx context.Context, key string, response []byte) error {
+	result, err := s.db.ExecContext(ctx, `UPDATE idempotency_keys SET response = $2 WHERE key = $1`, key, string(response))
+	if err != nil {
+		return fmt.Errorf("failed to set idempotency response: %w", err)
+	}
+
+	return expectRow(result)
+}
+
+// ReleaseIdempotencyKey gives up the claim on an idempotency key whose request failed, so that it
+// can be retried
+func (s *Store) ReleaseIdempotencyKey(ctx context.Context, key string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE key = $1 AND response IS NULL`, key)
+	if err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+
+	return nil
+}
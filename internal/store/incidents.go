@@ -0,0 +1,350 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/lib/pq"
+)
+
+// incidentColumns is the column list selected for Incident
+const incidentColumns = `id, slack_channel_id, slack_channel_name, status, severity, title, description,
+	started_by, started_by_user_id, started_at, resolved_by, resolved_at, export_url, last_updated, next_update_due, sub_status,
+	team_id, enterprise_id, team, incident_type, private, ` + incidentServicesColumn
+
+// Incident is a row of the incidents table
+type Incident struct {
+	ID               string     `db:"id"`
+	SlackChannelID   string     `db:"slack_channel_id"`
+	SlackChannelName *string    `db:"slack_channel_name"`
+	Status           string     `db:"status"`
+	Severity         string     `db:"severity"`
+	Title            string     `db:"title"`
+	Description      *string    `db:"description"`
+	StartedBy        string     `db:"started_by"`
+	StartedByUserID  *string    `db:"started_by_user_id"`
+	StartedAt        time.Time  `db:"started_at"`
+	ResolvedBy       *string    `db:"resolved_by"`
+	ResolvedAt       *time.Time `db:"resolved_at"`
+	ExportURL        *string    `db:"export_url"`
+	LastUpdated      time.Time  `db:"last_updated"`
+	NextUpdateDue    *time.Time `db:"next_update_due"`
+	SubStatus        *string    `db:"sub_status"`
+	TeamID           *string    `db:"team_id"`
+	EnterpriseID     *string    `db:"enterprise_id"`
+	Team             *string    `db:"team"`
+	IncidentType     *string    `db:"incident_type"`
+	// Private incidents have a private channel; their details are only shown to its members
+	Private bool `db:"private"`
+	// Services are the affected services, in the order they were added
+	Services pq.StringArray `db:"services"`
+}
+
+// PrivateTitle stands in for the title of private incidents outside their channel
+const PrivateTitle = "🔒 Private incident"
+
+// PublicTitle returns the title of the incident, or PrivateTitle when the incident is private
+func (inc *Incident) PublicTitle() string {
+	if inc.Private {
+		return PrivateTitle
+	}
+
+	return inc.Title
+}
+
+// Role is a row of the incident_roles table
+type Role struct {
+	IncidentID  string    `db:"incident_id"`
+	Role        string    `db:"role"`
+	SlackUserID string    `db:"slack_user_id"`
+	AssignedAt  time.Time `db:"assigned_at"`
+}
+
+// IncidentFilter narrows down ListIncidents. Zero values don't filter.
+type IncidentFilter struct {
+	Status    string
+	Severity  string
+	From      time.Time
+	To        time.Time
+	Commander string
+	// Service keeps incidents affecting a service
+	Service string
+	// ResolvedFrom and ResolvedTo bound the resolution time
+	ResolvedFrom time.Time
+	ResolvedTo   time.Time
+	// ActiveFrom keeps incidents that are unresolved or were resolved at or after this time
+	ActiveFrom time.Time
+	// PublicOnly leaves out private incidents
+	PublicOnly bool
+	Limit      int
+	Offset     int
+}
+
+// CreateIncident inserts a newly declared incident
+func (s *Store) CreateIncident(ctx context.Context, inc *incident.Incident) error {
+	status := inc.Status
+	if status == "" {
+		status = incident.StatusOpen
+	}
+
+	// The affected services are inserted in the same statement
+	_, err := s.db.ExecContext(ctx, `WITH inserted AS (
+			INSERT INTO incidents
+			(id, slack_channel_id, slack_channel_name, status, severity, title, description,
+			 started_by, started_by_user_id, started_at, last_updated, team_id, enterprise_id, team, incident_type, private)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10, $11, $12, $13, $15, $16)
+			RETURNING id, started_by_user_id, started_at
+		)
+		INSERT INTO incident_services (incident_id, service, added_by, added_at)
+		SELECT inserted.id, s.service, inserted.started_by_user_id, inserted.started_at
+		FROM inserted, unnest($14::text[]) AS s(service)`,
+		inc.ID, inc.ChannelID, nullString(inc.ChannelName), string(status), toDBSeverity(inc.Severity),
+		inc.Title, nullString(inc.Description), inc.StartedBy, nullString(inc.StartedByUserID), inc.StartedAt,
+		nullString(inc.TeamID), nullString(inc.EnterpriseID), nullString(inc.Team), pq.StringArray(inc.Services),
+		nullString(inc.Type), inc.Private)
+	if err != nil {
+		return fmt.Errorf("failed to insert incident: %w", err)
+	}
+
+	return nil
+}
+
+// GetIncident returns the incident with the given ID
+func (s *Store) GetIncident(ctx context.Context, id string) (*Incident, error) {
+	var inc Incident
+
+	err := s.db.GetContext(ctx, &inc, `SELECT `+incidentColumns+` FROM incidents WHERE id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get incident: %w", err)
+	}
+
+	inc.Severity = fromDBSeverity(inc.Severity)
+
+	return &inc, nil
+}
+
+// GetIncidentByChannel returns the incident declared in a Slack channel
+func (s *Store) GetIncidentByChannel(ctx context.Context, channelID string) (*Incident, error) {
+	var inc Incident
+
+	err := s.db.GetContext(ctx, &inc, `SELECT `+incidentColumns+` FROM incidents
+		WHERE slack_channel_id = $1 ORDER BY started_at DESC LIMIT 1`, channelID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get incident by channel: %w", err)
+	}
+
+	inc.Severity = fromDBSeverity(inc.Severity)
+
+	return &inc, nil
+}
+
+// ListIncidents returns incidents matching filter, newest first, along with the total number of matches
+func (s *Store) ListIncidents(ctx context.Context, filter IncidentFilter) ([]*Incident, int, error) {
+	where, args := filter.where()
+
+	var total int
+	if err := s.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM incidents`+where, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to count incidents: %w", err)
+	}
+
+	query := `SELECT ` + incidentColumns + ` FROM incidents` + where + ` ORDER BY started_at DESC, id`
+
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+
+	if filter.Offset > 0 {
+		args = append(args, filter.Offset)
+		query += fmt.Sprintf(" OFFSET $%d", len(args))
+	}
+
+	incidents := []*Incident{}
+	if err := s.db.SelectContext(ctx, &incidents, query, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to list incidents: %w", err)
+	}
+
+	for _, inc := range incidents {
+		inc.Severity = fromDBSeverity(inc.Severity)
+	}
+
+	return incidents, total, nil
+}
+
+// where builds the WHERE clause and its arguments for the filter
+func (f *IncidentFilter) where() (string, []any) {
+	var (
+		conditions []string
+		args       []any
+	)
+
+	add := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if f.Status != "" {
+		add("status = $%d", f.Status)
+	}
+
+	if f.Severity != "" {
+		add("severity = $%d", toDBSeverity(incident.Severity(f.Severity)))
+	}
+
+	if !f.From.IsZero() {
+		add("started_at >= $%d", f.From)
+	}
+
+	if !f.To.IsZero() {
+		add("started_at < $%d", f.To)
+	}
+
+	if !f.ResolvedFrom.IsZero() {
+		add("resolved_at >= $%d", f.ResolvedFrom)
+	}
+
+	if !f.ResolvedTo.IsZero() {
+		add("resolved_at < $%d", f.ResolvedTo)
+	}
+
+	if !f.ActiveFrom.IsZero() {
+		add("(resolved_at IS NULL OR resolved_at >= $%d)", f.ActiveFrom)
+	}
+
+	if f.PublicOnly {
+		conditions = append(conditions, "NOT private")
+	}
+
+	if f.Commander != "" {
+		add(`EXISTS (SELECT 1 FROM incident_roles r
+			WHERE r.incident_id = incidents.id AND r.role = '`+incident.RoleCommander+`' AND r.slack_user_id = $%d)`, f.Commander)
+	}
+
+	if f.Service != "" {
+		add(`EXISTS (SELECT 1 FROM incident_services s WHERE s.incident_id = incidents.id AND s.service = $%d)`, f.Service)
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// CountOpenIncidents returns the number of open incidents by severity
+func (s *Store) CountOpenIncidents(ctx context.Context) (map[string]int, error) {
+	var rows []struct {
+		Severity string `db:"severity"`
+		Count    int    `db:"count"`
+	}
+
+	err := s.db.SelectContext(ctx, &rows, `SELECT severity, COUNT(*) AS count
+		FROM incidents WHERE status = $1 GROUP BY severity`, string(incident.StatusOpen))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count open incidents: %w", err)
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[fromDBSeverity(row.Severity)] = row.Count
+	}
+
+	return counts, nil
+}
+
+// AssignRole assigns role on an incident to a Slack user, replacing any previous assignee
+func (s *Store) AssignRole(ctx context.Context, incidentID, role, slackUserID string) error {
+	_, err := s.db.ExecContext(ctx, `INSERT INTO incident_roles (incident_id, role, slack_user_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (incident_id, role) DO UPDATE SET slack_user_id = EXCLUDED.slack_user_id, assigned_at = NOW()`,
+		incidentID, role, slackUserID)
+	if err != nil {
+		return fmt.Errorf("failed to assign incident role: %w", err)
+	}
+
+	return nil
+}
+
+// ListRoles returns the roles assigned on an incident
+func (s *Store) ListRoles(ctx context.Context, incidentID string) ([]*Role, error) {
+	roles := []*Role{}
+
+	err := s.db.SelectContext(ctx, &roles, `SELECT incident_id, role, slack_user_id, assigned_at
+		FROM incident_roles WHERE incident_id = $1 ORDER BY assigned_at, role`, incidentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list incident roles: %w", err)
+	}
+
+	return roles, nil
+}
+
+// ResolveIncident marks an open incident as resolved. ErrNotFound is returned when
+// no open incident has the given ID.
+func (s *Store) ResolveIncident(ctx context.Context, id, resolvedBy string, resolvedAt time.Time) error {
+	result, err := s.db.ExecContext(ctx, `UPDATE incidents
+		SET status = $2, resolved_by = $3, resolved_at = $4, last_updated = $4
+		WHERE id = $1 AND status = $5`,
+		id, string(incident.StatusResolved), resolvedBy, resolvedAt, string(incident.StatusOpen))
+	if err != nil {
+		return fmt.Errorf("failed to resolve incident: %w", err)
+	}
+
+	return expectRow(result)
+}
+
+// UpdateSeverity changes the severity of an incident
+func (s *Store) UpdateSeverity(ctx context.Context, id string, severity incident.Severity) error {
+	result, err := s.db.ExecContext(ctx, `UPDATE incidents SET severity = $2, last_updated = NOW() WHERE id = $1`,
+		id, toDBSeverity(severity))
+	if err != nil {
+		return fmt.Errorf("failed to update incident severity: %w", err)
+	}
+
+	return expectRow(result)
+}
+
+// expectRow returns ErrNotFound when an update matched no rows
+func expectRow(result sql.Result) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read affected rows: %w", err)
+	}
+
+	if rows == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// toDBSeverity converts a severity to the lower case form stored in the database
+func toDBSeverity(severity incident.Severity) string {
+	return strings.ToLower(string(severity))
+}
+
+// fromDBSeverity converts a stored severity back to its canonical form
+func fromDBSeverity(severity string) string {
+	return strings.ToUpper(severity)
+}
+
+// nullString returns nil for empty strings so they are stored as NULL
+func nullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
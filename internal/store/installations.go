@@ -0,0 +1,89 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Installation is a row of the slack_installations table
+type Installation struct {
+	// ID is the team ID, or the enterprise ID of an org-wide install
+	ID                  string    `db:"id"`
+	TeamID              *string   `db:"team_id"`
+	TeamName            *string   `db:"team_name"`
+	EnterpriseID        *string   `db:"enterprise_id"`
+	EnterpriseName      *string   `db:"enterprise_name"`
+	IsEnterpriseInstall bool      `db:"is_enterprise_install"`
+	BotToken            string    `db:"bot_token"`
+	BotUserID           string    `db:"bot_user_id"`
+	InstalledBy         *string   `db:"installed_by"`
+	InstalledAt         time.Time `db:"installed_at"`
+	UpdatedAt           time.Time `db:"updated_at"`
+}
+
+const installationColumns = `id, team_id, team_name, enterprise_id, enterprise_name, is_enterprise_install,
+	bot_token, bot_user_id, installed_by, installed_at, updated_at`
+
+// SaveInstallation stores the installation of the bot in a workspace, replacing the token of a
+// previous installation
+func (s *Store) SaveInstallation(ctx context.Context, inst *Installation) error {
+	_, err := s.db.ExecContext(ctx, `INSERT INTO slack_installations
+		(id, team_id, team_name, enterprise_id, enterprise_name, is_enterprise_install, bot_token, bot_user_id, installed_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (id) DO UPDATE SET team_id = $2, team_name = $3, enterprise_id = $4, enterprise_name = $5,
+			is_enterprise_install = $6, bot_token = $7, bot_user_id = $8, installed_by = $9, updated_at = NOW()`,
+		inst.ID, inst.TeamID, inst.TeamName, inst.EnterpriseID, inst.EnterpriseName, inst.IsEnterpriseInstall,
+		inst.BotToken, inst.BotUserID, inst.InstalledBy)
+	if err != nil {
+		return fmt.Errorf("failed to save installation: %w", err)
+	}
+
+	return nil
+}
+
+// FindInstallation returns the installation covering a workspace: its own, or else the org-wide
+// install of its Enterprise Grid org
+func (s *Store) FindInstallation(ctx context.Context, teamID, enterpriseID string) (*Installation, error) {
+	var inst Installation
+
+	err := s.db.GetContext(ctx, &inst, `SELECT `+installationColumns+` FROM slack_installations
+		WHERE id = $1 OR (is_enterprise_install AND id = $2)
+		ORDER BY is_enterprise_install LIMIT 1`, teamID, enterpriseID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to find installation: %w", err)
+	}
+
+	return &inst, nil
+}
+
+// GetChannelWorkspace returns the team and enterprise IDs of the incident with a Slack channel
+func (s *Store) GetChannelWorkspace(ctx context.Context, channelID string) (string, string, error) {
+	var workspace struct {
+		TeamID       *string `db:"team_id"`
+		EnterpriseID *string `db:"enterprise_id"`
+	}
+
+	err := s.db.GetContext(ctx, &workspace, `SELECT team_id, enterprise_id FROM incidents
+		WHERE slack_channel_id = $1 AND team_id IS NOT NULL LIMIT 1`, channelID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", "", ErrNotFound
+	}
+
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get channel workspace: %w", err)
+	}
+
+	var enterpriseID string
+	if workspace.EnterpriseID != nil {
+		enterpriseID = *workspace.EnterpriseID
+	}
+
+	return *workspace.TeamID, enterpriseID, nil
+}
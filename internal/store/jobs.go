@@ -0,0 +1,151 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// RunExclusive runs fn for the run of job scheduled at scheduledAt unless another replica
+// is running it or already ran it. A Postgres advisory lock keyed on the job name is held
+// while fn runs and the run is recorded in job_runs when fn succeeds. The returned bool
+// reports whether fn was run.
+func (s *Store) RunExclusive(ctx context.Context, job string, scheduledAt time.Time, fn func(ctx context.Context) error) (bool, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin job transaction: %w", err)
+	}
+
+	// Rolling back after a commit is a no-op
+	defer func() { _ = tx.Rollback() }()
+
+	// The transaction level lock is released on commit or rollback
+	var locked bool
+	if err := tx.GetContext(ctx, &locked, `SELECT pg_try_advisory_xact_lock(hashtext('ohshift_job'), hashtext($1))`, job); err != nil {
+		return false, fmt.Errorf("failed to acquire job lock: %w", err)
+	}
+
+	if !locked {
+		return false, nil
+	}
+
+	result, err := tx.ExecContext(ctx, `INSERT INTO job_runs (job, scheduled_at) VALUES ($1, $2)
+		ON CONFLICT (job, scheduled_at) DO NOTHING`, job, scheduledAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to record job run: %w", err)
+	}
+
+	err = expectRow(result)
+	if errors.Is(err, ErrNotFound) {
+		// Another replica already completed this run
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	if err := fn(ctx); err != nil {
+		return true, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return true, fmt.Errorf("failed to commit job run: %w", err)
+	}
+
+	return true, nil
+}
+
+// QueuedJob is a row of the job_queue table
+type QueuedJob struct {
+	ID       int64           `db:"id"`
+	Kind     string          `db:"kind"`
+	Payload  json.RawMessage `db:"payload"`
+	RunAt    time.Time       `db:"run_at"`
+	Attempts int             `db:"attempts"`
+}
+
+// RetryPolicy decides what happens to queued jobs that fail
+type RetryPolicy struct {
+	// MaxAttempts is the number of runs after which a failing job is given up
+	MaxAttempts int
+	// Backoff is the delay before the first retry, doubled for each further one
+	Backoff time.Duration
+}
+
+// EnqueueJob adds a job to the queue to be run at or after runAt
+func (s *Store) EnqueueJob(ctx context.Context, kind string, payload json.RawMessage, runAt time.Time) error {
+	_, err := s.db.ExecContext(ctx, `INSERT INTO job_queue (kind, payload, run_at) VALUES ($1, $2, $3)`,
+		kind, string(payload), runAt)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue %s job: %w", kind, err)
+	}
+
+	return nil
+}
+
+// RunNextJob runs fn for the next due job with a context bounded by timeout. The job's row
+// stays locked while fn runs, so other replicas skip it. A failing or timed out job is retried
+// according to retry. The returned bool reports whether a job was due.
+func (s *Store) RunNextJob(ctx context.Context, retry RetryPolicy, timeout time.Duration, fn func(ctx context.Context, job *QueuedJob) error) (bool, error) {
+	// The transaction outlives fn's context so that the outcome of a job that timed out, or
+	// was stopped by a shutdown, is still recorded rather than rolled back to run it again
+	txCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout+DefaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(txCtx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin job transaction: %w", err)
+	}
+
+	// Rolling back after a commit is a no-op
+	defer func() { _ = tx.Rollback() }()
+
+	var job QueuedJob
+
+	err = tx.GetContext(txCtx, &job, `SELECT id, kind, payload, run_at, attempts FROM job_queue
+		WHERE finished_at IS NULL AND run_at <= NOW()
+		ORDER BY run_at, id
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED`)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to get next job: %w", err)
+	}
+
+	jobCtx, cancelJob := context.WithTimeout(ctx, timeout)
+	jobErr := fn(jobCtx, &job)
+
+	cancelJob()
+
+	switch {
+	case jobErr == nil:
+		_, err = tx.ExecContext(txCtx, `UPDATE job_queue SET attempts = attempts + 1, finished_at = NOW() WHERE id = $1`, job.ID)
+	case job.Attempts+1 >= retry.MaxAttempts:
+		_, err = tx.ExecContext(txCtx, `UPDATE job_queue SET attempts = attempts + 1, last_error = $2, finished_at = NOW()
+			WHERE id = $1`, job.ID, jobErr.Error())
+	default:
+		_, err = tx.ExecContext(txCtx, `UPDATE job_queue SET attempts = attempts + 1, last_error = $2, run_at = $3
+			WHERE id = $1`, job.ID, jobErr.Error(), time.Now().Add(retry.Backoff<<job.Attempts))
+	}
+
+	if err != nil {
+		return true, fmt.Errorf("failed to update job %d: %w", job.ID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return true, fmt.Errorf("failed to commit job %d: %w", job.ID, err)
+	}
+
+	if jobErr != nil {
+		return true, fmt.Errorf("%s job %d failed on attempt %d: %w", job.Kind, job.ID, job.Attempts+1, jobErr)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,82 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Notification is a row of the incident_notifications table
+type Notification struct {
+	IncidentID     string    `db:"incident_id"`
+	SlackChannelID string    `db:"slack_channel_id"`
+	SlackMessageTS string    `db:"slack_message_ts"`
+	PostedAt       time.Time `db:"posted_at"`
+}
+
+// AddNotification records a message announcing an incident
+func (s *Store) AddNotification(ctx context.Context, incidentID, channelID, messageTS string) error {
+	_, err := s.db.ExecContext(ctx, `INSERT INTO incident_notifications (incident_id, slack_channel_id, slack_message_ts)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (incident_id, slack_channel_id) DO UPDATE SET slack_message_ts = EXCLUDED.slack_message_ts`,
+		incidentID, channelID, messageTS)
+	if err != nil {
+		return fmt.Errorf("failed to record incident notification: %w", err)
+	}
+
+	return nil
+}
+
+// ListNotifications returns the messages announcing an incident
+func (s *Store) ListNotifications(ctx context.Context, incidentID string) ([]*Notification, error) {
+	notifications := []*Notification{}
+
+	err := s.db.SelectContext(ctx, &notifications, `SELECT incident_id, slack_channel_id, slack_message_ts, posted_at
+		FROM incident_notifications WHERE incident_id = $1 ORDER BY posted_at`, incidentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list incident notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// Subscribe subscribes a Slack user to the status updates of an incident. It returns false
+// when the user was already subscribed.
+func (s *Store) Subscribe(ctx context.Context, incidentID, slackUserID string) (bool, error) {
+	result, err := s.db.ExecContext(ctx, `INSERT INTO incident_subscribers (incident_id, slack_user_id)
+		VALUES ($1, $2) ON CONFLICT (incident_id, slack_user_id) DO NOTHING`, incidentID, slackUserID)
+	if err != nil {
+		return false, fmt.Errorf("failed to subscribe to incident: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to read affected rows: %w", err)
+	}
+
+	return rows == 1, nil
+}
+
+// Unsubscribe stops sending the status updates of an incident to a Slack user
+func (s *Store) Unsubscribe(ctx context.Context, incidentID, slackUserID string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM incident_subscribers WHERE incident_id = $1 AND slack_user_id = $2`,
+		incidentID, slackUserID)
+	if err != nil {
+		return fmt.Errorf("failed to unsubscribe from incident: %w", err)
+	}
+
+	return nil
+}
+
+// ListSubscribers returns the Slack users subscribed to an incident
+func (s *Store) ListSubscribers(ctx context.Context, incidentID string) ([]string, error) {
+	subscribers := []string{}
+
+	err := s.db.SelectContext(ctx, &subscribers, `SELECT slack_user_id FROM incident_subscribers
+		WHERE incident_id = $1 ORDER BY subscribed_at`, incidentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list incident subscribers: %w", err)
+	}
+
+	return subscribers, nil
+}
@@ -0,0 +1,151 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// uniqueViolation is the Postgres error code for unique constraint violations
+const uniqueViolation = "23505"
+
+// OnCallSchedule is a row of the oncall_schedules table
+type OnCallSchedule struct {
+	ID           string         `db:"id"`
+	Name         string         `db:"name"`
+	Rotation     string         `db:"rotation"`
+	Participants pq.StringArray `db:"participants"`
+	HandoffTime  string         `db:"handoff_time"`
+	Timezone     string         `db:"timezone"`
+	StartDate    time.Time      `db:"start_date"`
+	CreatedBy    string         `db:"created_by"`
+	CreatedAt    time.Time      `db:"created_at"`
+}
+
+// OnCallOverride is a row of the oncall_overrides table
+type OnCallOverride struct {
+	ID          int64     `db:"id"`
+	ScheduleID  string    `db:"schedule_id"`
+	SlackUserID string    `db:"slack_user_id"`
+	StartsAt    time.Time `db:"starts_at"`
+	EndsAt      time.Time `db:"ends_at"`
+	CreatedBy   string    `db:"created_by"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// scheduleColumns is the column list selected for OnCallSchedule
+const scheduleColumns = `id, name, rotation, participants, handoff_time, timezone, start_date, created_by, created_at`
+
+// CreateSchedule inserts an on-call schedule and sets its ID. ErrAlreadyExists is returned
+// when a schedule with the same name exists.
+func (s *Store) CreateSchedule(ctx context.Context, schedule *OnCallSchedule) error {
+	err := s.db.GetContext(ctx, schedule, `INSERT INTO oncall_schedules
+		(name, rotation, participants, handoff_time, timezone, start_date, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING `+scheduleColumns,
+		schedule.Name, schedule.Rotation, schedule.Participants, schedule.HandoffTime, schedule.Timezone,
+		schedule.StartDate.Format(time.DateOnly), schedule.CreatedBy)
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return ErrAlreadyExists
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to create on-call schedule: %w", err)
+	}
+
+	return nil
+}
+
+// GetSchedule returns the on-call schedule with the given name
+func (s *Store) GetSchedule(ctx context.Context, name string) (*OnCallSchedule, error) {
+	var schedule OnCallSchedule
+
+	err := s.db.GetContext(ctx, &schedule, `SELECT `+scheduleColumns+` FROM oncall_schedules WHERE name = $1`, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get on-call schedule: %w", err)
+	}
+
+	return &schedule, nil
+}
+
+// ListSchedules returns all on-call schedules by name
+func (s *Store) ListSchedules(ctx context.Context) ([]*OnCallSchedule, error) {
+	schedules := []*OnCallSchedule{}
+
+	err := s.db.SelectContext(ctx, &schedules, `SELECT `+scheduleColumns+` FROM oncall_schedules ORDER BY name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list on-call schedules: %w", err)
+	}
+
+	return schedules, nil
+}
+
+// SetScheduleParticipants replaces the participants of an on-call schedule
+func (s *Store) SetScheduleParticipants(ctx context.Context, name string, participants []string) error {
+	result, err := s.db.ExecContext(ctx, `UPDATE oncall_schedules SET participants = $2 WHERE name = $1`,
+		name, pq.StringArray(participants))
+	if err != nil {
+		return fmt.Errorf("failed to update on-call schedule participants: %w", err)
+	}
+
+	return expectRow(result)
+}
+
+// DeleteSchedule deletes an on-call schedule along with its overrides
+func (s *Store) DeleteSchedule(ctx context.Context, name string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM oncall_schedules WHERE name = $1`, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete on-call schedule: %w", err)
+	}
+
+	return expectRow(result)
+}
+
+// AddOverride inserts an on-call override and sets its ID
+func (s *Store) AddOverride(ctx context.Context, override *OnCallOverride) error {
+	err := s.db.QueryRowxContext(ctx, `INSERT INTO oncall_overrides
+		(schedule_id, slack_user_id, starts_at, ends_at, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at`,
+		override.ScheduleID, override.SlackUserID, override.StartsAt, override.EndsAt, override.CreatedBy).
+		Scan(&override.ID, &override.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to add on-call override: %w", err)
+	}
+
+	return nil
+}
+
+// ListOverrides returns the overrides of a schedule that end after since, in start order
+func (s *Store) ListOverrides(ctx context.Context, scheduleID string, since time.Time) ([]*OnCallOverride, error) {
+	overrides := []*OnCallOverride{}
+
+	err := s.db.SelectContext(ctx, &overrides, `SELECT id, schedule_id, slack_user_id, starts_at, ends_at, created_by, created_at
+		FROM oncall_overrides WHERE schedule_id = $1 AND ends_at > $2
+		ORDER BY starts_at, id`, scheduleID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list on-call overrides: %w", err)
+	}
+
+	return overrides, nil
+}
+
+// DeleteOverride deletes an on-call override of a schedule
+func (s *Store) DeleteOverride(ctx context.Context, scheduleID string, id int64) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM oncall_overrides WHERE schedule_id = $1 AND id = $2`, scheduleID, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete on-call override: %w", err)
+	}
+
+	return expectRow(result)
+}
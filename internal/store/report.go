@@ -0,0 +1,80 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/incident"
+	"github.com/lib/pq"
+)
+
+// ReportIncident is an incident along with the timeline facts used for incident reports
+type ReportIncident struct {
+	ID         string     `db:"id"`
+	Severity   string     `db:"severity"`
+	Status     string     `db:"status"`
+	StartedBy  string     `db:"started_by"`
+	StartedAt  time.Time  `db:"started_at"`
+	ResolvedAt *time.Time `db:"resolved_at"`
+	// FirstResponseAt is when someone other than the person who declared the incident first posted or
+	// reacted in its channel, or acted on its timeline
+	FirstResponseAt *time.Time `db:"first_response_at"`
+	// Escalations is the number of times the severity was raised
+	Escalations int `db:"escalations"`
+	// AcknowledgedAt is when a responder acknowledged the incident's escalation
+	AcknowledgedAt *time.Time `db:"acknowledged_at"`
+	// TeamID is the Slack workspace the incident was declared in
+	TeamID *string `db:"team_id"`
+	// Services are the affected services
+	Services pq.StringArray `db:"services"`
+}
+
+// ListReportIncidents returns the incidents started in [from, to) in chronological order
+func (s *Store) ListReportIncidents(ctx context.Context, from, to time.Time) ([]*ReportIncident, error) {
+	incidents := []*ReportIncident{}
+
+	// Channel activity is recorded whether or not it made it to the timeline, and LEAST ignores NULLs.
+	// Severities are stored as sev0..sev3, so a lower value is a higher severity
+	err := s.db.SelectContext(ctx, &incidents, `SELECT i.id, i.severity, i.status, i.started_by, i.started_at, i.resolved_at,
+		LEAST(i.first_response_at, (SELECT MIN(e.timestamp) FROM timeline_events e
+			WHERE e.incident_id = i.id
+			AND e.event_type NOT IN ('incident_start', 'alert', 'paged', 'escalated')
+			AND e.slack_user_id <> ''
+			AND e.slack_user_id IS DISTINCT FROM i.started_by_user_id)) AS first_response_at,
+		(SELECT COUNT(*) FROM timeline_events e
+			WHERE e.incident_id = i.id
+			AND e.event_type = 'severity_change'
+			AND LOWER(e.metadata->>'to') < LOWER(e.metadata->>'from')) AS escalations,
+		ie.acknowledged_at, i.team_id,
+		ARRAY(SELECT s.service FROM incident_services s
+			WHERE s.incident_id = i.id ORDER BY s.added_at, s.service) AS services
+		FROM incidents i
+		LEFT JOIN incident_escalations ie ON ie.incident_id = i.id
+		WHERE i.started_at >= $1 AND i.started_at < $2
+		ORDER BY i.started_at, i.id`, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list incidents for report: %w", err)
+	}
+
+	for _, inc := range incidents {
+		inc.Severity = fromDBSeverity(inc.Severity)
+	}
+
+	return incidents, nil
+}
+
+// RecordFirstResponse records activity by a user in the channel of an open incident as its first
+// response, unless the user declared the incident or someone responded earlier
+func (s *Store) RecordFirstResponse(ctx context.Context, channelID, userID string, at time.Time) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE incidents SET first_response_at = $3
+		WHERE slack_channel_id = $1 AND status = $4
+			AND started_by_user_id IS DISTINCT FROM $2
+			AND (first_response_at IS NULL OR first_response_at > $3)`,
+		channelID, userID, at, string(incident.StatusOpen))
+	if err != nil {
+		return fmt.Errorf("failed to record first response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// incidentServicesColumn selects the affected services of an incident as an array
+const incidentServicesColumn = `ARRAY(SELECT s.service FROM incident_services s
+		WHERE s.incident_id = incidents.id ORDER BY s.added_at, s.service) AS services`
+
+// Service is a row of the services table, the service catalog
+type Service struct {
+	Name      string  `db:"name"`
+	OwnerTeam *string `db:"owner_team"`
+	// OnCallUserGroup is the Slack user group ID of the service's on-call responders
+	OnCallUserGroup *string `db:"oncall_usergroup"`
+	RunbookURL      *string `db:"runbook_url"`
+	// SlackChannel is the channel of the team running the service
+	SlackChannel *string   `db:"slack_channel"`
+	CreatedAt    time.Time `db:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at"`
+}
+
+const serviceColumns = `name, owner_team, oncall_usergroup, runbook_url, slack_channel, created_at, updated_at`
+
+// SaveService adds a service to the catalog or replaces its details
+func (s *Store) SaveService(ctx context.Context, svc *Service) error {
+	_, err := s.db.ExecContext(ctx, `INSERT INTO services (name, owner_team, oncall_usergroup, runbook_url, slack_channel)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (name) DO UPDATE SET owner_team = $2, oncall_usergroup = $3, runbook_url = $4, slack_channel = $5,
+			updated_at = NOW()`,
+		svc.Name, svc.OwnerTeam, svc.OnCallUserGroup, svc.RunbookURL, svc.SlackChannel)
+	if err != nil {
+		return fmt.Errorf("failed to save service: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteService removes a service from the catalog. Incidents keep it as an affected service.
+func (s *Store) DeleteService(ctx context.Context, name string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM services WHERE name = $1`, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete service: %w", err)
+	}
+
+	return expectRow(result)
+}
+
+// GetService returns a service of the catalog
+func (s *Store) GetService(ctx context.Context, name string) (*Service, error) {
+	var svc Service
+
+	err := s.db.GetContext(ctx, &svc, `SELECT `+serviceColumns+` FROM services WHERE name = $1`, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service: %w", err)
+	}
+
+	return &svc, nil
+}
+
+// ListServices returns the service catalog, or the services with the given names when any are given,
+// by name
+func (s *Store) ListServices(ctx context.Context, names ...string) ([]*Service, error) {
+	services := []*Service{}
+
+	query := `SELECT ` + serviceColumns + ` FROM services`
+
+	var args []any
+	if len(names) > 0 {
+		query += ` WHERE name = ANY($1)`
+		args = append(args, pq.StringArray(names))
+	}
+
+	if err := s.db.SelectContext(ctx, &services, query+` ORDER BY name`, args...); err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+
+	return services, nil
+}
+
+// AddIncidentService adds an affected service to an incident. It returns false when the
+// service was already affected.
+func (s *Store) AddIncidentService(ctx context.Context, incidentID, service, slackUserID string) (bool, error) {
+	result, err := s.db.ExecContext(ctx, `INSERT INTO incident_services (incident_id, service, added_by)
+		VALUES ($1, $2, $3) ON CONFLICT (incident_id, service) DO NOTHING`, incidentID, service, nullString(slackUserID))
+	if err != nil {
+		return false, fmt.Errorf("failed to add incident service: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to read affected rows: %w", err)
+	}
+
+	return rows == 1, nil
+}
+
+// RemoveIncidentService removes an affected service from an incident. It returns ErrNotFound
+// when the service wasn't affected.
+func (s *Store) RemoveIncidentService(ctx context.Context, incidentID, service string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM incident_services WHERE incident_id = $1 AND service = $2`,
+		incidentID, service)
+	if err != nil {
+		return fmt.Errorf("failed to remove incident service: %w", err)
+	}
+
+	return expectRow(result)
+}
@@ -0,0 +1,68 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/incident"
+)
+
+// TouchIncident records activity in the channel of an open incident at the given time
+func (s *Store) TouchIncident(ctx context.Context, id string, at time.Time) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE incidents SET last_updated = GREATEST(last_updated, $2)
+		WHERE id = $1 AND status = $3`, id, at, string(incident.StatusOpen))
+	if err != nil {
+		return fmt.Errorf("failed to record incident activity: %w", err)
+	}
+
+	return nil
+}
+
+// TouchIncidentByChannel records activity in the channel of an open incident at the given time
+func (s *Store) TouchIncidentByChannel(ctx context.Context, channelID string, at time.Time) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE incidents SET last_updated = GREATEST(last_updated, $2)
+		WHERE slack_channel_id = $1 AND status = $3`, channelID, at, string(incident.StatusOpen))
+	if err != nil {
+		return fmt.Errorf("failed to record incident activity: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimStaleIncidents returns the open incidents without activity since before, marking them as
+// prompted at now. Incidents prompted since before or snoozed past now are left out, so each
+// stale incident is claimed by one replica once per stale period.
+func (s *Store) ClaimStaleIncidents(ctx context.Context, before, now time.Time) ([]*Incident, error) {
+	incidents := []*Incident{}
+
+	err := s.db.SelectContext(ctx, &incidents, `UPDATE incidents SET stale_prompted_at = $3
+		WHERE id IN (
+			SELECT id FROM incidents
+			WHERE status = $1 AND last_updated < $2
+				AND (stale_prompted_at IS NULL OR stale_prompted_at < $2)
+				AND (stale_snoozed_until IS NULL OR stale_snoozed_until <= $3)
+			ORDER BY last_updated
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+incidentColumns, string(incident.StatusOpen), before, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim stale incidents: %w", err)
+	}
+
+	for _, inc := range incidents {
+		inc.Severity = fromDBSeverity(inc.Severity)
+	}
+
+	return incidents, nil
+}
+
+// SnoozeStaleIncident stops stale prompts for an incident until the given time
+func (s *Store) SnoozeStaleIncident(ctx context.Context, id string, until time.Time) error {
+	result, err := s.db.ExecContext(ctx, `UPDATE incidents SET stale_snoozed_until = $2 WHERE id = $1`, id, until)
+	if err != nil {
+		return fmt.Errorf("failed to snooze stale incident: %w", err)
+	}
+
+	return expectRow(result)
+}
@@ -0,0 +1,40 @@
+// Package store persists incidents and their timelines in Postgres.
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// DefaultTimeout bounds database calls made outside of a request context
+const DefaultTimeout = 5 * time.Second
+
+var (
+	// ErrNotFound is returned when a requested record does not exist
+	ErrNotFound = errors.New("not found")
+	// ErrAlreadyExists is returned when a record with the same unique name exists
+	ErrAlreadyExists = errors.New("already exists")
+)
+
+// Store provides access to the OhShift! database
+type Store struct {
+	db *sqlx.DB
+}
+
+// New creates a new Store using db
+func New(db *sqlx.DB) *Store {
+	return &Store{db: db}
+}
+
+// DB returns the underlying database handle
+func (s *Store) DB() *sqlx.DB {
+	return s.db
+}
+
+// Context returns a context bounded by DefaultTimeout for callers without a request context
+func Context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), DefaultTimeout)
+}
@@ -0,0 +1,47 @@
+package store
+
+import (
+	"io/fs"
+	"os"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"github.com/pressly/goose/v3"
+
+	dbm "github.com/fishnix/ohshift/db"
+)
+
+// newTestStore returns a store on the migrated database at TEST_DB_URI, skipping the test
+// when it isn't set
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+
+	uri := os.Getenv("TEST_DB_URI")
+	if uri == "" {
+		t.Skip("TEST_DB_URI is not set")
+	}
+
+	db, err := sqlx.Open("postgres", uri)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	migrations, err := fs.Sub(dbm.Migrations, "migrations")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	provider, err := goose.NewProvider(goose.DialectPostgres, db.DB, migrations)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := provider.Up(t.Context()); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+
+	return New(db)
+}
@@ -0,0 +1,64 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TimelineEvent is a row of the timeline_events table
+type TimelineEvent struct {
+	ID             string          `db:"id"`
+	IncidentID     string          `db:"incident_id"`
+	EntryKey       string          `db:"entry_key"`
+	Timestamp      time.Time       `db:"timestamp"`
+	EventType      string          `db:"event_type"`
+	SlackUserID    string          `db:"slack_user_id"`
+	SlackUsername  string          `db:"slack_username"`
+	SlackMessageTS *string         `db:"slack_message_ts"`
+	Content        string          `db:"content"`
+	Metadata       json.RawMessage `db:"metadata"`
+}
+
+// AddTimelineEvent inserts a timeline event and records it as activity on the incident unless
+// the bot recorded it on its own, like alerts and pages. Events whose entry key was already
+// recorded for the incident are ignored.
+func (s *Store) AddTimelineEvent(ctx context.Context, event *TimelineEvent) error {
+	metadata := event.Metadata
+	if len(metadata) == 0 {
+		metadata = json.RawMessage(`{}`)
+	}
+
+	_, err := s.db.ExecContext(ctx, `WITH inserted AS (
+			INSERT INTO timeline_events
+			(incident_id, entry_key, timestamp, event_type, slack_user_id, slack_username, slack_message_ts, content, metadata)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			ON CONFLICT (incident_id, entry_key) DO NOTHING
+			RETURNING incident_id, timestamp, event_type
+		)
+		UPDATE incidents SET last_updated = GREATEST(incidents.last_updated, inserted.timestamp)
+		FROM inserted WHERE incidents.id = inserted.incident_id
+			AND inserted.event_type NOT IN ('alert', 'paged', 'escalated')`,
+		event.IncidentID, event.EntryKey, event.Timestamp, event.EventType, event.SlackUserID,
+		event.SlackUsername, event.SlackMessageTS, event.Content, string(metadata))
+	if err != nil {
+		return fmt.Errorf("failed to insert timeline event: %w", err)
+	}
+
+	return nil
+}
+
+// ListTimelineEvents returns the timeline of an incident in chronological order
+func (s *Store) ListTimelineEvents(ctx context.Context, incidentID string) ([]*TimelineEvent, error) {
+	events := []*TimelineEvent{}
+
+	err := s.db.SelectContext(ctx, &events, `SELECT id, incident_id, entry_key, timestamp, event_type,
+		slack_user_id, slack_username, slack_message_ts, content, metadata
+		FROM timeline_events WHERE incident_id = $1 ORDER BY timestamp, id`, incidentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list timeline events: %w", err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,79 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fishnix/ohshift/internal/incident"
+)
+
+// SetNextUpdateDue sets when the next status update of an incident is due; nil clears it
+func (s *Store) SetNextUpdateDue(ctx context.Context, id string, due *time.Time) error {
+	result, err := s.db.ExecContext(ctx, `UPDATE incidents SET next_update_due = $2 WHERE id = $1`, id, due)
+	if err != nil {
+		return fmt.Errorf("failed to set next status update due time: %w", err)
+	}
+
+	return expectRow(result)
+}
+
+// ListOverdueUpdates returns the open incidents whose next status update was due at or before now
+func (s *Store) ListOverdueUpdates(ctx context.Context, now time.Time) ([]*Incident, error) {
+	incidents := []*Incident{}
+
+	err := s.db.SelectContext(ctx, &incidents, `SELECT `+incidentColumns+` FROM incidents
+		WHERE status = $1 AND next_update_due <= $2
+		ORDER BY next_update_due`, string(incident.StatusOpen), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list incidents with overdue status updates: %w", err)
+	}
+
+	for _, inc := range incidents {
+		inc.Severity = fromDBSeverity(inc.Severity)
+	}
+
+	return incidents, nil
+}
+
+// LastStatusUpdate returns when the latest status update of an incident was recorded, or nil
+// when it has none
+func (s *Store) LastStatusUpdate(ctx context.Context, incidentID string) (*time.Time, error) {
+	var last *time.Time
+
+	err := s.db.GetContext(ctx, &last, `SELECT MAX(timestamp) FROM timeline_events
+		WHERE incident_id = $1 AND event_type = 'status_update'`, incidentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last status update: %w", err)
+	}
+
+	return last, nil
+}
+
+// ClaimOverdueUpdate moves the next status update of an incident from due to next. It returns
+// false when the due time was changed in the meantime, e.g. by another replica sending the reminder.
+func (s *Store) ClaimOverdueUpdate(ctx context.Context, id string, due, next time.Time) (bool, error) {
+	result, err := s.db.ExecContext(ctx, `UPDATE incidents SET next_update_due = $3
+		WHERE id = $1 AND next_update_due = $2`, id, due, next)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim status update reminder: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to read affected rows: %w", err)
+	}
+
+	return rows == 1, nil
+}
+
+// SetSubStatus records the stage of an incident given by its latest status update
+func (s *Store) SetSubStatus(ctx context.Context, id string, subStatus incident.UpdateStatus) error {
+	result, err := s.db.ExecContext(ctx, `UPDATE incidents SET sub_status = $2, last_updated = NOW() WHERE id = $1`,
+		id, string(subStatus))
+	if err != nil {
+		return fmt.Errorf("failed to set incident sub-status: %w", err)
+	}
+
+	return expectRow(result)
+}
@@ -3,13 +3,19 @@ package timeline
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/fishnix/ohshift/internal/config"
 	"github.com/fishnix/ohshift/internal/incident"
 	"github.com/fishnix/ohshift/internal/logger"
+	"github.com/fishnix/ohshift/internal/metrics"
+	"github.com/fishnix/ohshift/internal/store"
 	"github.com/slack-go/slack"
 )
 
@@ -17,7 +23,7 @@ import (
 type Entry struct {
 	ID        string // Unique identifier to prevent duplicates
 	Timestamp time.Time
-	Type      string // "incident_start", "message", "image", "reaction", "bot_interaction"
+	Type      string // "incident_start", "message", "image", "reaction", "bot_interaction", "alert", "severity_change", "resolved", "status_update", "paged", "escalated", "acknowledged", "service_change", "checklist"
 	UserID    string // Slack user ID (e.g., "U0123456")
 	Username  string // Slack username (e.g., "thatopsguy")
 	Content   string
@@ -33,27 +39,81 @@ type Timeline struct {
 	mu          sync.RWMutex
 }
 
+// EntryListener is called after an entry has been added to an incident's timeline
+type EntryListener func(incidentID string, entry Entry)
+
+// Clients returns the Slack client of the workspace a channel belongs to
+type Clients interface {
+	ForChannel(channelID string) *slack.Client
+}
+
 // Manager handles timeline operations
 type Manager struct {
-	api       *slack.Client
+	clients   Clients
+	store     *store.Store
 	logger    *slog.Logger
 	timelines map[string]*Timeline
 	userCache map[string]string // userID -> username cache
+	listeners []EntryListener
 	mu        sync.RWMutex
+	// config is swapped when the configuration is reloaded
+	config atomic.Pointer[config.Config]
 }
 
-// NewManager creates a new timeline manager
-func NewManager(api *slack.Client) *Manager {
-	return &Manager{
-		api:       api,
+// NewManager creates a new timeline manager. Entries are persisted to st when it is not nil, and
+// timelines missing from memory are loaded from it.
+func NewManager(clients Clients, st *store.Store, cfg *config.Config) *Manager {
+	m := &Manager{
+		clients:   clients,
+		store:     st,
 		logger:    logger.With("component", "timeline_manager"),
 		timelines: make(map[string]*Timeline),
 		userCache: make(map[string]string),
 	}
+
+	m.config.Store(cfg)
+
+	return m
+}
+
+// SetConfig swaps in a reloaded configuration
+func (m *Manager) SetConfig(cfg *config.Config) {
+	m.config.Store(cfg)
+}
+
+// ShouldAddMessage reports whether a message in an incident channel belongs on the timeline:
+// every message when ADD_ALL_MESSAGES_TO_TIMELINE is set, otherwise only those with images
+func (m *Manager) ShouldAddMessage(text string) bool {
+	if m.config.Load().AddAllMessagesToTimeline {
+		return true
+	}
+
+	// Slack image URLs
+	return strings.Contains(text, "files.slack.com") && strings.Contains(text, "image")
+}
+
+// OnEntry registers a listener that is called after every entry added to a timeline,
+// including the incident_start entry of new timelines
+func (m *Manager) OnEntry(listener EntryListener) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.listeners = append(m.listeners, listener)
+}
+
+// notifyListeners calls the registered entry listeners
+func (m *Manager) notifyListeners(incidentID string, entry Entry) {
+	m.mu.RLock()
+	listeners := m.listeners
+	m.mu.RUnlock()
+
+	for _, listener := range listeners {
+		listener(incidentID, entry)
+	}
 }
 
-// resolveUsername resolves a user ID to a username using the Slack API
-func (m *Manager) resolveUsername(userID string) string {
+// resolveUsername resolves a user ID to a username using the Slack API of the workspace of channelID
+func (m *Manager) resolveUsername(channelID, userID string) string {
 	// Check cache first
 	m.mu.RLock()
 
@@ -65,7 +125,7 @@ func (m *Manager) resolveUsername(userID string) string {
 	m.mu.RUnlock()
 
 	// If not in cache, fetch from Slack API
-	user, err := m.api.GetUserInfo(userID)
+	user, err := m.clients.ForChannel(channelID).GetUserInfo(userID)
 	if err != nil {
 		m.logger.Warn("Failed to get user info, using user ID as fallback",
 			"error", err,
@@ -90,9 +150,100 @@ func (m *Manager) resolveUsername(userID string) string {
 	return user.Name
 }
 
+// channelOf returns the channel of an incident's timeline, empty when it has none
+func (m *Manager) channelOf(incidentID string) string {
+	if timeline, ok := m.timeline(incidentID); ok {
+		return timeline.ChannelID
+	}
+
+	return ""
+}
+
+// timeline returns the timeline of an incident. Timelines missing from memory, e.g. after a
+// restart, are loaded from the database.
+func (m *Manager) timeline(incidentID string) (*Timeline, bool) {
+	m.mu.RLock()
+	timeline, exists := m.timelines[incidentID]
+	m.mu.RUnlock()
+
+	if exists || m.store == nil {
+		return timeline, exists
+	}
+
+	timeline, err := m.load(incidentID)
+	if err != nil {
+		if !errors.Is(err, store.ErrNotFound) {
+			m.logger.Error("Failed to load timeline", "error", err, "incident_id", incidentID)
+		}
+
+		return nil, false
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Keep the timeline loaded or created in the meantime
+	if existing, ok := m.timelines[incidentID]; ok {
+		return existing, true
+	}
+
+	m.timelines[incidentID] = timeline
+
+	return timeline, true
+}
+
+// load reads the timeline of an incident from the database
+func (m *Manager) load(incidentID string) (*Timeline, error) {
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	inc, err := m.store.GetIncident(ctx, incidentID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := m.store.ListTimelineEvents(ctx, incidentID)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := &Timeline{
+		IncidentID:  incidentID,
+		ChannelID:   inc.SlackChannelID,
+		LastUpdated: inc.LastUpdated,
+		Entries:     make([]Entry, 0, len(events)),
+	}
+
+	for _, event := range events {
+		entry := Entry{
+			ID:        event.EntryKey,
+			Timestamp: event.Timestamp,
+			Type:      event.EventType,
+			UserID:    event.SlackUserID,
+			Username:  event.SlackUsername,
+			Content:   event.Content,
+		}
+
+		if err := json.Unmarshal(event.Metadata, &entry.Metadata); err != nil {
+			m.logger.Warn("Failed to unmarshal timeline entry metadata",
+				"error", err,
+				"incident_id", incidentID,
+				"entry_id", event.EntryKey)
+		}
+
+		timeline.Entries = append(timeline.Entries, entry)
+	}
+
+	m.logger.Info("Timeline loaded from the database",
+		"incident_id", incidentID,
+		"entries_count", len(timeline.Entries))
+
+	return timeline, nil
+}
+
 // resolveUserInfo resolves a user ID to both user ID and username
-func (m *Manager) resolveUserInfo(userID string) (string, string) {
-	username := m.resolveUsername(userID)
+func (m *Manager) resolveUserInfo(channelID, userID string) (string, string) {
+	username := m.resolveUsername(channelID, userID)
 	return userID, username
 }
 
@@ -105,7 +256,7 @@ func (m *Manager) CreateTimeline(inc *incident.Incident, channelID string) (*Tim
 		"title", inc.Title)
 
 	// Resolve user ID to username for the incident starter
-	userID, username := m.resolveUserInfo(inc.StartedBy)
+	userID, username := m.resolveUserInfo(channelID, inc.StartedBy)
 
 	initialEntry := Entry{
 		ID:        fmt.Sprintf("incident_start_%s", inc.ID),
@@ -136,6 +287,10 @@ func (m *Manager) CreateTimeline(inc *incident.Incident, channelID string) (*Tim
 		"incident_id", inc.ID,
 		"initial_entries", 1)
 
+	metrics.TimelineEntries.WithLabelValues(initialEntry.Type).Inc()
+	m.persistEntry(inc.ID, initialEntry)
+	m.notifyListeners(inc.ID, initialEntry)
+
 	// Don't post timeline message to channel initially since incident creation already displays the information
 	m.logger.Info("Timeline created successfully (not posted to channel initially)",
 		"incident_id", inc.ID,
@@ -146,10 +301,7 @@ func (m *Manager) CreateTimeline(inc *incident.Incident, channelID string) (*Tim
 
 // GetTimeline retrieves a timeline by incident ID
 func (m *Manager) GetTimeline(incidentID string) (*Timeline, bool) {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
-
-	timeline, exists := m.timelines[incidentID]
+	timeline, exists := m.timeline(incidentID)
 
 	if exists {
 		m.logger.Debug("Timeline retrieved",
@@ -173,10 +325,7 @@ func (m *Manager) AddEntry(incidentID string, entry Entry) error {
 		"user", entry.Username,
 		"timestamp", entry.Timestamp)
 
-	m.mu.Lock()
-	timeline, exists := m.timelines[incidentID]
-	m.mu.Unlock()
-
+	timeline, exists := m.timeline(incidentID)
 	if !exists {
 		m.logger.Error("Timeline not found for entry",
 			"incident_id", incidentID,
@@ -215,6 +364,10 @@ func (m *Manager) AddEntry(incidentID string, entry Entry) error {
 		"total_entries", entriesCount,
 		"user", entry.Username)
 
+	metrics.TimelineEntries.WithLabelValues(entry.Type).Inc()
+	m.persistEntry(incidentID, entry)
+	m.notifyListeners(incidentID, entry)
+
 	// Update timeline in channel
 	err := m.postTimelineToChannel(timeline)
 	if err != nil {
@@ -265,7 +418,7 @@ func (m *Manager) AddMessageEntry(incidentID, userID, message, messageID string)
 		"message_length", len(message))
 
 	// Resolve user ID to username
-	resolvedUserID, username := m.resolveUserInfo(userID)
+	resolvedUserID, username := m.resolveUserInfo(m.channelOf(incidentID), userID)
 
 	entry := Entry{
 		ID:        fmt.Sprintf("message_%s", messageID),
@@ -292,7 +445,7 @@ func (m *Manager) AddImageEntry(incidentID, userID, imageURL, caption, messageID
 		"caption", caption)
 
 	// Resolve user ID to username
-	resolvedUserID, username := m.resolveUserInfo(userID)
+	resolvedUserID, username := m.resolveUserInfo(m.channelOf(incidentID), userID)
 
 	entry := Entry{
 		ID:        fmt.Sprintf("image_%s", messageID),
@@ -320,7 +473,7 @@ func (m *Manager) AddReactionEntry(incidentID, userID, message, reaction, messag
 		"message_length", len(message))
 
 	// Resolve user ID to username
-	resolvedUserID, username := m.resolveUserInfo(userID)
+	resolvedUserID, username := m.resolveUserInfo(m.channelOf(incidentID), userID)
 
 	entry := Entry{
 		ID:        fmt.Sprintf("reaction_%s_%s", messageID, reaction),
@@ -346,7 +499,7 @@ func (m *Manager) AddBotInteractionEntry(incidentID, userID, interaction string)
 		"interaction", interaction)
 
 	// Resolve user ID to username
-	resolvedUserID, username := m.resolveUserInfo(userID)
+	resolvedUserID, username := m.resolveUserInfo(m.channelOf(incidentID), userID)
 
 	entry := Entry{
 		ID:        fmt.Sprintf("bot_interaction_%s_%d", userID, time.Now().UnixNano()),
@@ -371,7 +524,7 @@ func (m *Manager) AddHighlightedEntry(incidentID, userID, message, messageID str
 		"original_timestamp", originalTimestamp)
 
 	// Resolve user ID to username
-	resolvedUserID, username := m.resolveUserInfo(userID)
+	resolvedUserID, username := m.resolveUserInfo(m.channelOf(incidentID), userID)
 
 	entry := Entry{
 		ID:        fmt.Sprintf("highlighted_%s", messageID),
@@ -388,6 +541,288 @@ func (m *Manager) AddHighlightedEntry(incidentID, userID, message, messageID str
 	return m.AddEntry(incidentID, entry)
 }
 
+// AddAlertEntry adds a firing or resolved Alertmanager alert to the timeline
+func (m *Manager) AddAlertEntry(incidentID, status, alertName, summary, fingerprint string, startsAt time.Time) error {
+	m.logger.Debug("Adding alert entry to timeline",
+		"incident_id", incidentID,
+		"status", status,
+		"alert_name", alertName,
+		"fingerprint", fingerprint)
+
+	content := fmt.Sprintf("[%s] %s", strings.ToUpper(status), alertName)
+	if summary != "" {
+		content = fmt.Sprintf("%s: %s", content, summary)
+	}
+
+	// Alertmanager resends notifications for the same alert, so the entry ID
+	// is derived from the alert instance to let AddEntry drop the repeats.
+	entry := Entry{
+		ID:        fmt.Sprintf("alert_%s_%s_%d", fingerprint, status, startsAt.Unix()),
+		Timestamp: time.Now(),
+		Type:      "alert",
+		Username:  "alertmanager",
+		Content:   content,
+		Metadata: map[string]interface{}{
+			"status":      status,
+			"alert_name":  alertName,
+			"fingerprint": fingerprint,
+			"starts_at":   startsAt,
+		},
+	}
+
+	return m.AddEntry(incidentID, entry)
+}
+
+// AddSeverityChangeEntry records a change of the incident severity
+func (m *Manager) AddSeverityChangeEntry(incidentID, userID string, from, to incident.Severity) error {
+	m.logger.Debug("Adding severity change entry to timeline",
+		"incident_id", incidentID,
+		"user_id", userID,
+		"from", from,
+		"to", to)
+
+	resolvedUserID, username := m.resolveUserInfo(m.channelOf(incidentID), userID)
+
+	entry := Entry{
+		ID:        fmt.Sprintf("severity_change_%s_%d", to, time.Now().UnixNano()),
+		Timestamp: time.Now(),
+		Type:      "severity_change",
+		UserID:    resolvedUserID,
+		Username:  username,
+		Content:   fmt.Sprintf("Severity changed from %s to %s", from, to),
+		Metadata: map[string]interface{}{
+			"from": from,
+			"to":   to,
+		},
+	}
+
+	return m.AddEntry(incidentID, entry)
+}
+
+// AddResolvedEntry records the resolution of the incident
+func (m *Manager) AddResolvedEntry(incidentID, userID string, resolvedAt time.Time) error {
+	m.logger.Debug("Adding resolved entry to timeline",
+		"incident_id", incidentID,
+		"user_id", userID)
+
+	resolvedUserID, username := m.resolveUserInfo(m.channelOf(incidentID), userID)
+
+	entry := Entry{
+		ID:        fmt.Sprintf("resolved_%s", incidentID),
+		Timestamp: resolvedAt,
+		Type:      "resolved",
+		UserID:    resolvedUserID,
+		Username:  username,
+		Content:   "✅ Incident Resolved",
+		Metadata:  map[string]interface{}{},
+	}
+
+	return m.AddEntry(incidentID, entry)
+}
+
+// AddStatusUpdateEntry records a status update for stakeholders
+func (m *Manager) AddStatusUpdateEntry(incidentID, userID string, update *incident.StatusUpdate) error {
+	m.logger.Debug("Adding status update entry to timeline",
+		"incident_id", incidentID,
+		"user_id", userID,
+		"status", update.Status)
+
+	resolvedUserID, username := m.resolveUserInfo(m.channelOf(incidentID), userID)
+
+	entry := Entry{
+		ID:        fmt.Sprintf("status_update_%d", time.Now().UnixNano()),
+		Timestamp: time.Now(),
+		Type:      "status_update",
+		UserID:    resolvedUserID,
+		Username:  username,
+		Content:   fmt.Sprintf("[%s] %s", update.Status.Title(), update.Text),
+		Metadata: map[string]interface{}{
+			"status": update.Status,
+		},
+	}
+
+	return m.AddEntry(incidentID, entry)
+}
+
+// AddPagedEntry records the responders paged when an incident was declared
+func (m *Manager) AddPagedEntry(incidentID string, userIDs []string, summary string) error {
+	m.logger.Debug("Adding paged entry to timeline",
+		"incident_id", incidentID,
+		"paged", len(userIDs))
+
+	entry := Entry{
+		ID:        fmt.Sprintf("paged_%s", incidentID),
+		Timestamp: time.Now(),
+		Type:      "paged",
+		Username:  "ohshift",
+		Content:   summary,
+		Metadata: map[string]interface{}{
+			"user_ids": userIDs,
+		},
+	}
+
+	return m.AddEntry(incidentID, entry)
+}
+
+// AddEscalatedEntry records the responders paged by a level of an escalation policy
+func (m *Manager) AddEscalatedEntry(incidentID, policy string, level int, userIDs []string, summary string) error {
+	m.logger.Debug("Adding escalated entry to timeline",
+		"incident_id", incidentID,
+		"policy", policy,
+		"level", level)
+
+	entry := Entry{
+		ID:        fmt.Sprintf("escalated_%s_%d", incidentID, level),
+		Timestamp: time.Now(),
+		Type:      "escalated",
+		Username:  "ohshift",
+		Content:   summary,
+		Metadata: map[string]interface{}{
+			"policy":   policy,
+			"level":    level,
+			"user_ids": userIDs,
+		},
+	}
+
+	return m.AddEntry(incidentID, entry)
+}
+
+// AddAcknowledgedEntry records who acknowledged an incident and how long after it was declared
+func (m *Manager) AddAcknowledgedEntry(incidentID, userID string, after time.Duration) error {
+	m.logger.Debug("Adding acknowledged entry to timeline",
+		"incident_id", incidentID,
+		"user_id", userID)
+
+	resolvedUserID, username := m.resolveUserInfo(m.channelOf(incidentID), userID)
+
+	entry := Entry{
+		ID:        fmt.Sprintf("acknowledged_%s", incidentID),
+		Timestamp: time.Now(),
+		Type:      "acknowledged",
+		UserID:    resolvedUserID,
+		Username:  username,
+		Content:   "🙋 Incident acknowledged",
+		Metadata: map[string]interface{}{
+			"time_to_acknowledge_seconds": int64(after.Seconds()),
+		},
+	}
+
+	return m.AddEntry(incidentID, entry)
+}
+
+// AddServiceChangeEntry records a service being added to or removed from the affected services
+func (m *Manager) AddServiceChangeEntry(incidentID, userID, service string, added bool) error {
+	m.logger.Debug("Adding service change entry to timeline",
+		"incident_id", incidentID,
+		"user_id", userID,
+		"service", service,
+		"added", added)
+
+	resolvedUserID, username := m.resolveUserInfo(m.channelOf(incidentID), userID)
+
+	content := fmt.Sprintf("Service %s added to the affected services", service)
+	if !added {
+		content = fmt.Sprintf("Service %s removed from the affected services", service)
+	}
+
+	entry := Entry{
+		ID:        fmt.Sprintf("service_change_%s_%d", service, time.Now().UnixNano()),
+		Timestamp: time.Now(),
+		Type:      "service_change",
+		UserID:    resolvedUserID,
+		Username:  username,
+		Content:   content,
+		Metadata: map[string]interface{}{
+			"service": service,
+			"added":   added,
+		},
+	}
+
+	return m.AddEntry(incidentID, entry)
+}
+
+// AddChecklistEntry records who ticked or unticked an item of the incident checklist
+func (m *Manager) AddChecklistEntry(incidentID, userID, item string, done bool) error {
+	m.logger.Debug("Adding checklist entry to timeline",
+		"incident_id", incidentID,
+		"user_id", userID,
+		"done", done)
+
+	resolvedUserID, username := m.resolveUserInfo(m.channelOf(incidentID), userID)
+
+	content := "Checklist item done: " + item
+	if !done {
+		content = "Checklist item reopened: " + item
+	}
+
+	entry := Entry{
+		ID:        fmt.Sprintf("checklist_%d", time.Now().UnixNano()),
+		Timestamp: time.Now(),
+		Type:      "checklist",
+		UserID:    resolvedUserID,
+		Username:  username,
+		Content:   content,
+		Metadata: map[string]interface{}{
+			"item": item,
+			"done": done,
+		},
+	}
+
+	return m.AddEntry(incidentID, entry)
+}
+
+// persistEntry stores a timeline entry in the database. Failures are logged rather
+// than returned so the in-channel timeline keeps working while the database is unavailable.
+func (m *Manager) persistEntry(incidentID string, entry Entry) {
+	if m.store == nil {
+		return
+	}
+
+	metadata, err := json.Marshal(entry.Metadata)
+	if err != nil {
+		m.logger.Error("Failed to marshal timeline entry metadata",
+			"error", err,
+			"incident_id", incidentID,
+			"entry_id", entry.ID)
+
+		return
+	}
+
+	var messageTS *string
+	if messageID, ok := entry.Metadata["message_id"].(string); ok && messageID != "" {
+		messageTS = &messageID
+	}
+
+	ctx, cancel := store.Context()
+	defer cancel()
+
+	err = m.store.AddTimelineEvent(ctx, &store.TimelineEvent{
+		IncidentID:     incidentID,
+		EntryKey:       entry.ID,
+		Timestamp:      entry.Timestamp,
+		EventType:      entry.Type,
+		SlackUserID:    entry.UserID,
+		SlackUsername:  entry.Username,
+		SlackMessageTS: messageTS,
+		Content:        entry.Content,
+		Metadata:       metadata,
+	})
+	if err != nil {
+		m.logger.Error("Failed to persist timeline entry",
+			"error", err,
+			"incident_id", incidentID,
+			"entry_type", entry.Type,
+			"entry_id", entry.ID)
+
+		return
+	}
+
+	m.logger.Debug("Timeline entry persisted",
+		"incident_id", incidentID,
+		"entry_type", entry.Type,
+		"entry_id", entry.ID)
+}
+
 // postTimelineToChannel posts the timeline to the incident channel
 func (m *Manager) postTimelineToChannel(timeline *Timeline) error {
 	m.logger.Debug("Posting timeline to channel",
@@ -402,7 +837,7 @@ func (m *Manager) postTimelineToChannel(timeline *Timeline) error {
 	timeline.mu.RUnlock()
 
 	// Create timeline message
-	message := m.formatTimelineMessage(entries)
+	message := m.formatTimelineMessage(timeline.ChannelID, entries)
 	messageLength := len(message)
 
 	m.logger.Debug("Timeline message formatted",
@@ -411,7 +846,7 @@ func (m *Manager) postTimelineToChannel(timeline *Timeline) error {
 		"message_length", messageLength)
 
 	// Post to channel
-	_, _, err := m.api.PostMessage(timeline.ChannelID, slack.MsgOptionText(message, false))
+	_, _, err := m.clients.ForChannel(timeline.ChannelID).PostMessage(timeline.ChannelID, slack.MsgOptionText(message, false))
 	if err != nil {
 		m.logger.Error("Failed to post timeline message to channel",
 			"error", err,
@@ -431,7 +866,7 @@ func (m *Manager) postTimelineToChannel(timeline *Timeline) error {
 }
 
 // formatTimelineMessage formats the timeline entries into a readable message
-func (m *Manager) formatTimelineMessage(entries []Entry) string {
+func (m *Manager) formatTimelineMessage(channelID string, entries []Entry) string {
 	if len(entries) == 0 {
 		m.logger.Debug("Formatting empty timeline message")
 		return "📋 *Timeline*\nNo entries yet."
@@ -447,7 +882,7 @@ func (m *Manager) formatTimelineMessage(entries []Entry) string {
 		icon := m.getEntryIcon(entry.Type)
 
 		// Ensure we have a username to display (backward compatibility)
-		displayName := m.ensureUsername(entry)
+		displayName := m.ensureUsername(channelID, entry)
 
 		message += fmt.Sprintf("%s *%s* - @%s\n", icon, timestamp, displayName)
 		message += fmt.Sprintf("   %s\n", entry.Content)
@@ -472,7 +907,7 @@ func (m *Manager) formatTimelineMessage(entries []Entry) string {
 }
 
 // ensureUsername ensures we have a username to display, handling backward compatibility
-func (m *Manager) ensureUsername(entry Entry) string {
+func (m *Manager) ensureUsername(channelID string, entry Entry) string {
 	// If we already have a username, use it
 	if entry.Username != "" {
 		return entry.Username
@@ -480,7 +915,7 @@ func (m *Manager) ensureUsername(entry Entry) string {
 
 	// If we have a user ID, resolve it to a username
 	if entry.UserID != "" {
-		return m.resolveUsername(entry.UserID)
+		return m.resolveUsername(channelID, entry.UserID)
 	}
 
 	// Fallback to a generic name if neither is available
@@ -500,6 +935,24 @@ func (m *Manager) getEntryIcon(entryType string) string {
 		return "👆"
 	case "bot_interaction":
 		return "🤖"
+	case "alert":
+		return "🔔"
+	case "severity_change":
+		return "📈"
+	case "resolved":
+		return "✅"
+	case "status_update":
+		return "📣"
+	case "paged":
+		return "📟"
+	case "escalated":
+		return "🔺"
+	case "acknowledged":
+		return "🙋"
+	case "service_change":
+		return "🧩"
+	case "checklist":
+		return "☑️"
 	default:
 		return "📝"
 	}
@@ -580,7 +1033,7 @@ func (m *Manager) addReactionToMessage(channelID, messageTimestamp, reaction str
 		"message_timestamp", messageTimestamp,
 		"reaction", reaction)
 
-	err := m.api.AddReaction(reaction, slack.ItemRef{
+	err := m.clients.ForChannel(channelID).AddReaction(reaction, slack.ItemRef{
 		Channel:   channelID,
 		Timestamp: messageTimestamp,
 	})
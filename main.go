@@ -4,23 +4,53 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	_ "github.com/jackc/pgx/v5/stdlib" // import the postgres driver
 	"github.com/jmoiron/sqlx"
-	"github.com/lib/pq"
 	"github.com/pressly/goose/v3"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
 
 	dbm "github.com/fishnix/ohshift/db"
+	"github.com/fishnix/ohshift/internal/alertmanager"
+	"github.com/fishnix/ohshift/internal/api"
+	"github.com/fishnix/ohshift/internal/calendar"
+	"github.com/fishnix/ohshift/internal/catalog"
+	"github.com/fishnix/ohshift/internal/checklist"
 	"github.com/fishnix/ohshift/internal/config"
+	"github.com/fishnix/ohshift/internal/digest"
+	"github.com/fishnix/ohshift/internal/escalation"
+	"github.com/fishnix/ohshift/internal/handoff"
+	"github.com/fishnix/ohshift/internal/health"
+	"github.com/fishnix/ohshift/internal/incident"
 	"github.com/fishnix/ohshift/internal/logger"
+	"github.com/fishnix/ohshift/internal/metrics"
+	"github.com/fishnix/ohshift/internal/paging"
+	"github.com/fishnix/ohshift/internal/queue"
+	"github.com/fishnix/ohshift/internal/reminder"
+	"github.com/fishnix/ohshift/internal/report"
+	"github.com/fishnix/ohshift/internal/routing"
+	"github.com/fishnix/ohshift/internal/scheduler"
+	"github.com/fishnix/ohshift/internal/server"
 	"github.com/fishnix/ohshift/internal/slack"
+	"github.com/fishnix/ohshift/internal/stale"
+	"github.com/fishnix/ohshift/internal/store"
 )
 
-var cfg *config.Config
+var (
+	cfg *config.Config
+	// configPath is the YAML configuration file given with --config
+	configPath string
+	// dbConnector opens database connections with the DB URI of the current configuration
+	dbConnector *store.Connector
+)
 
 func main() {
 	rootCmd := &cobra.Command{
@@ -29,6 +59,9 @@ func main() {
 		Long:  `OhShift! is a Slack bot for managing incidents and on-call rotations.`,
 	}
 
+	rootCmd.PersistentFlags().StringVar(&configPath, "config", "",
+		"YAML configuration file, see ohshift.example.yaml; environment variables win over it")
+
 	botCmd := &cobra.Command{
 		Use:   "bot",
 		Short: "Start the Slack bot",
@@ -62,7 +95,40 @@ func main() {
 		},
 	}
 
-	rootCmd.AddCommand(botCmd, migrateCmd)
+	reportCmd := &cobra.Command{
+		Use:   "report",
+		Short: "Print incident statistics",
+		Long: `Print incident counts, time to resolve, time to first responder, severity escalations
+and the top incident starters for the incidents started in a date range.`,
+		Args: cobra.NoArgs,
+		RunE: runReport,
+	}
+
+	reportCmd.Flags().String("from", "", "start of the range, YYYY-MM-DD or RFC 3339 (default 30 days before --to)")
+	reportCmd.Flags().String("to", "", "exclusive end of the range, YYYY-MM-DD or RFC 3339 (default now)")
+	reportCmd.Flags().String("format", string(report.FormatTable), "output format: table, csv or json")
+	reportCmd.Flags().String("service", "", "only count the incidents affecting this service")
+
+	incidentsCmd := &cobra.Command{
+		Use:   "incidents",
+		Short: "Export incidents",
+	}
+
+	icsCmd := &cobra.Command{
+		Use:   "ics",
+		Short: "Print incidents as an iCalendar feed",
+		Long: `Print the incidents active in a date range as iCalendar (RFC 5545) events, spanning
+their start to their resolution. Open incidents have no end.`,
+		Args: cobra.NoArgs,
+		RunE: runIncidentsICS,
+	}
+
+	icsCmd.Flags().String("from", "", "start of the range, YYYY-MM-DD or RFC 3339 (default 90 days before --to)")
+	icsCmd.Flags().String("to", "", "exclusive end of the range, YYYY-MM-DD or RFC 3339 (default now)")
+
+	incidentsCmd.AddCommand(icsCmd)
+
+	rootCmd.AddCommand(botCmd, migrateCmd, reportCmd, incidentsCmd, newServicesCmd())
 
 	if err := rootCmd.Execute(); err != nil {
 		os.Exit(1)
@@ -71,7 +137,7 @@ func main() {
 
 func runBot(_ *cobra.Command, _ []string) error {
 	// Load configuration
-	cfg = config.Load()
+	cfg = config.Load(configPath)
 
 	// Set log level from configuration
 	logger.SetLevel(cfg.LogLevel)
@@ -85,8 +151,26 @@ func runBot(_ *cobra.Command, _ []string) error {
 	db := initDB()
 	runMigrationInternal(db.DB)
 
+	defer func() {
+		if err := db.Close(); err != nil {
+			logger.Error("failed to close DB", "error", err)
+		}
+	}()
+
+	st := store.New(db)
+
 	// Create Slack bot
-	bot := slack.NewBot(cfg)
+	bot := slack.NewBot(cfg, st)
+
+	// Page on-call responders when incidents are declared
+	setupPaging(bot, st)
+
+	// Notify the channels picked by the notification routes
+	setupRouting(bot)
+
+	// Escalate incidents until they are acknowledged, using the durable job queue
+	jobs := queue.New(st)
+	setupEscalation(bot, st, jobs)
 
 	// Set up context with cancel on SIGINT/SIGTERM
 	ctx, cancel := context.WithCancel(context.Background())
@@ -99,20 +183,539 @@ func runBot(_ *cobra.Command, _ []string) error {
 		cancel()
 	}()
 
+	// Create the HTTP server
+	srv := newHTTPServer(bot, st)
+
+	// Run the HTTP server until shutdown
+	srvDone := make(chan struct{})
+
+	go func() {
+		defer close(srvDone)
+
+		if err := srv.Run(ctx); err != nil {
+			logger.Error("HTTP server error", "error", err)
+			cancel()
+		}
+	}()
+
+	// Remind commanders of overdue status updates
+	startReminders(ctx, bot, st)
+
+	// Flag unfinished required checklist items
+	startChecklistReminders(ctx, bot, st)
+
+	// Run scheduled jobs until shutdown
+	sched := newScheduler(bot, st)
+	schedDone := make(chan struct{})
+
+	go func() {
+		defer close(schedDone)
+		sched.Run(ctx)
+	}()
+
+	// Reload the configuration file on SIGHUP or when it changes
+	watchDone := watchConfig(ctx, bot)
+
+	// Run queued jobs until shutdown
+	jobsDone := make(chan struct{})
+
+	go func() {
+		defer close(jobsDone)
+		jobs.Run(ctx)
+	}()
+
 	// Start the bot (blocks until shutdown)
 	if err := bot.Start(ctx); err != nil {
 		logger.Fatal("Bot error", "error", err)
 		return err
 	}
 
+	// Wait for in-flight HTTP requests and jobs to finish
+	<-srvDone
+	<-schedDone
+	<-jobsDone
+	<-watchDone
+
 	logger.Info("Bot exited cleanly")
 
 	return nil
 }
 
+// watchConfig reloads the configuration file given with --config and the secret files until
+// ctx is done, and returns a channel closed when it stops
+func watchConfig(ctx context.Context, bot *slack.Bot) <-chan struct{} {
+	done := make(chan struct{})
+
+	if configPath == "" && len(cfg.SecretPaths()) == 0 {
+		logger.Info("Configuration reload disabled, start with --config or *_FILE secrets to enable it")
+		close(done)
+
+		return done
+	}
+
+	watcher := config.NewWatcher(configPath, cfg)
+	watcher.OnReload(func(reloaded *config.Config) {
+		logger.SetLevel(reloaded.LogLevel)
+		bot.SetConfig(reloaded)
+
+		if err := dbConnector.SetURI(reloaded.DBURI); err != nil {
+			logger.Error("Invalid database URI, keeping the current one", "error", err)
+		}
+	})
+
+	go func() {
+		defer close(done)
+
+		if err := watcher.Run(ctx); err != nil {
+			logger.Error("Configuration watcher failed, reloading is disabled", "error", err)
+		}
+	}()
+
+	return done
+}
+
+// newHTTPServer creates the HTTP server and registers its routes
+func newHTTPServer(bot *slack.Bot, st *store.Store) *server.Server {
+	srv := server.New(cfg)
+
+	// Liveness
+	srv.HandleFunc("GET /health", bot.HealthCheck)
+
+	// Readiness
+	readiness := health.NewReadiness()
+	readiness.Add("database", st.DB().PingContext)
+
+	if cfg.SlackMode == config.SlackModeSocket {
+		readiness.Add("socket_mode", bot.CheckConnection)
+	}
+
+	readiness.Add("migrations", func(ctx context.Context) error {
+		return dbm.CheckVersion(ctx, st.DB().DB)
+	})
+	srv.Handle("GET /ready", readiness)
+
+	// Slack Events API, slash commands and interactivity in HTTP mode
+	if cfg.SlackMode == config.SlackModeHTTP {
+		srv.HandleFunc("POST /slack/events", bot.HandleEvents)
+		srv.HandleFunc("POST /slack/commands", bot.HandleCommands)
+		srv.HandleFunc("POST /slack/interactions", bot.HandleInteractions)
+	}
+
+	// OAuth installs in more workspaces
+	if cfg.OAuthEnabled() {
+		srv.HandleFunc("GET /slack/install", bot.HandleInstall)
+		srv.HandleFunc("GET /slack/oauth/callback", bot.HandleOAuthCallback)
+	} else {
+		logger.Info("Slack OAuth installs disabled, set SLACK_CLIENT_ID to enable them")
+	}
+
+	// Metrics
+	prometheus.MustRegister(metrics.NewOpenIncidentsCollector(st))
+	srv.Handle("GET /metrics", promhttp.Handler())
+
+	if cfg.AlertmanagerToken != "" {
+		receiver, err := alertmanager.NewReceiver(cfg, bot, bot.TimelineManager(), st)
+		if err != nil {
+			logger.Fatal("Invalid Alertmanager configuration", "error", err)
+		}
+
+		srv.Handle("POST /webhooks/alertmanager", server.RequireToken([]string{cfg.AlertmanagerToken}, receiver))
+	} else {
+		logger.Info("Alertmanager webhook disabled, set ALERTMANAGER_TOKEN to enable it")
+	}
+
+	if len(cfg.APITokens) > 0 {
+		api.NewHandler(cfg, bot, bot.TimelineManager(), st).Register(srv)
+	} else {
+		logger.Info("Incident API disabled, set API_TOKENS to enable it")
+	}
+
+	if len(cfg.CalendarTokens) > 0 {
+		srv.Handle("GET /calendar/incidents.ics", server.RequireTokenParam(cfg.CalendarTokens, calendar.NewHandler(st)))
+	} else {
+		logger.Info("Incident calendar feed disabled, set CALENDAR_TOKENS to enable it")
+	}
+
+	return srv
+}
+
+// setupPaging makes the bot page on-call responders when on-call routes are configured
+func setupPaging(bot *slack.Bot, st *store.Store) {
+	pager, err := paging.New(cfg, st, bot)
+	if err != nil {
+		logger.Fatal("Invalid on-call route configuration", "error", err)
+	}
+
+	if !pager.Enabled() {
+		logger.Info("On-call paging disabled, set ONCALL_ROUTES to enable it")
+		return
+	}
+
+	bot.SetPager(pager)
+}
+
+// setupRouting makes the bot notify the channels of the matching notification routes when they are configured
+func setupRouting(bot *slack.Bot) {
+	router, err := routing.New(cfg, bot)
+	if err != nil {
+		logger.Fatal("Invalid notification route configuration", "error", err)
+	}
+
+	if !router.Enabled() {
+		logger.Info("Notification routing disabled, set NOTIFICATION_ROUTES to enable it")
+		return
+	}
+
+	bot.SetRouter(router)
+}
+
+// setupEscalation makes the bot escalate new incidents when escalation policies are configured
+func setupEscalation(bot *slack.Bot, st *store.Store, jobs *queue.Queue) {
+	escalator, err := escalation.New(cfg, st, jobs, bot, bot.TimelineManager())
+	if err != nil {
+		logger.Fatal("Invalid escalation policy configuration", "error", err)
+	}
+
+	if !escalator.Enabled() {
+		logger.Info("Escalation disabled, set ESCALATION_POLICIES to enable it")
+		return
+	}
+
+	bot.SetEscalator(escalator)
+}
+
+// startReminders starts the status update reminders when update cadences are configured
+func startReminders(ctx context.Context, bot *slack.Bot, st *store.Store) {
+	rem, err := reminder.New(cfg, st, bot)
+	if err != nil {
+		logger.Fatal("Invalid status update configuration", "error", err)
+	}
+
+	if !rem.Enabled() {
+		logger.Info("Status update reminders disabled, set STATUS_UPDATE_CADENCES to enable them")
+		return
+	}
+
+	bot.TimelineManager().OnEntry(rem.HandleEntry)
+
+	go rem.Run(ctx)
+}
+
+// startChecklistReminders starts flagging the unfinished required checklist items of open incidents
+func startChecklistReminders(ctx context.Context, bot *slack.Bot, st *store.Store) {
+	rem, err := checklist.New(cfg, st, bot)
+	if err != nil {
+		logger.Fatal("Invalid checklist configuration", "error", err)
+	}
+
+	go rem.Run(ctx)
+}
+
+// newScheduler creates the scheduler and registers the enabled jobs
+func newScheduler(bot *slack.Bot, st *store.Store) *scheduler.Scheduler {
+	sched := scheduler.New(st)
+
+	if cfg.DigestChannel != "" {
+		if err := sched.Add(digest.JobName, cfg.DigestSchedule, digest.New(cfg, st, bot).Run); err != nil {
+			logger.Fatal("Invalid digest configuration", "error", err)
+		}
+	} else {
+		logger.Info("Weekly digest disabled, set DIGEST_CHANNEL to enable it")
+	}
+
+	detector, err := stale.New(cfg, st, bot)
+	if err != nil {
+		logger.Fatal("Invalid stale incident configuration", "error", err)
+	}
+
+	if detector.Enabled() {
+		if err := sched.Add(stale.JobName, cfg.StaleIncidentSchedule, detector.Run); err != nil {
+			logger.Fatal("Invalid stale incident configuration", "error", err)
+		}
+	} else {
+		logger.Info("Stale incident detection disabled, set STALE_INCIDENT_AFTER to enable it")
+	}
+
+	handoffs, err := handoff.New(cfg, st, bot)
+	if err != nil {
+		logger.Fatal("Invalid on-call handoff configuration", "error", err)
+	}
+
+	if handoffs.Enabled() {
+		for _, r := range handoffs.Rotations() {
+			if err := sched.Add(r.JobName(), r.Spec(), handoffs.Job(r)); err != nil {
+				logger.Fatal("Invalid on-call handoff configuration", "error", err)
+			}
+		}
+
+		bot.SetHandoffs(handoffs)
+	} else {
+		logger.Info("On-call handoff summaries disabled, set ONCALL_HANDOFFS to enable them")
+	}
+
+	return sched
+}
+
+func runReport(cmd *cobra.Command, _ []string) error {
+	fromFlag, _ := cmd.Flags().GetString("from")
+	toFlag, _ := cmd.Flags().GetString("to")
+	formatFlag, _ := cmd.Flags().GetString("format")
+	serviceFlag, _ := cmd.Flags().GetString("service")
+
+	format, err := report.ParseFormat(formatFlag)
+	if err != nil {
+		return err
+	}
+
+	var service string
+	if serviceFlag != "" {
+		if service, err = incident.ParseService(serviceFlag); err != nil {
+			return fmt.Errorf("invalid --service: %w", err)
+		}
+	}
+
+	to := time.Now()
+	if toFlag != "" {
+		if to, err = report.ParseTime(toFlag); err != nil {
+			return fmt.Errorf("invalid --to: %w", err)
+		}
+	}
+
+	from := to.Add(-report.DefaultPeriod)
+	if fromFlag != "" {
+		if from, err = report.ParseTime(fromFlag); err != nil {
+			return fmt.Errorf("invalid --from: %w", err)
+		}
+	}
+
+	if !from.Before(to) {
+		return fmt.Errorf("--from must be before --to")
+	}
+
+	// Load configuration; only the database settings are needed
+	cfg = config.Load(configPath)
+	logger.SetLevel(cfg.LogLevel)
+
+	db := initDB()
+
+	defer func() {
+		if err := db.Close(); err != nil {
+			logger.Error("failed to close DB", "error", err)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), time.Minute)
+	defer cancel()
+
+	r, err := report.Generate(ctx, store.New(db), from, to, service)
+	if err != nil {
+		return err
+	}
+
+	return r.Write(os.Stdout, format)
+}
+
+// newServicesCmd returns the commands managing the service catalog
+func newServicesCmd() *cobra.Command {
+	servicesCmd := &cobra.Command{
+		Use:   "services",
+		Short: "Manage the service catalog",
+		Long: `Manage the catalog of services incidents affect, with their owner team, on-call Slack user
+group, runbook and Slack channel.`,
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the services in the catalog",
+		Args:  cobra.NoArgs,
+		RunE:  runServicesList,
+	}
+
+	addCmd := &cobra.Command{
+		Use:   "add <name>",
+		Short: "Add a service to the catalog or replace its details",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runServicesAdd,
+	}
+
+	addCmd.Flags().String("owner-team", "", "team owning the service")
+	addCmd.Flags().String("oncall-usergroup", "", "Slack user group ID of the on-call responders, e.g. S0123456789")
+	addCmd.Flags().String("runbook", "", "runbook URL")
+	addCmd.Flags().String("channel", "", "Slack channel of the team running the service")
+
+	removeCmd := &cobra.Command{
+		Use:   "remove <name>",
+		Short: "Remove a service from the catalog",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runServicesRemove,
+	}
+
+	importCmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Add or update the services of a YAML catalog file",
+		Long: `Add or update the services listed in a YAML catalog file, see services.example.yaml.
+With --prune, services missing from the file are removed from the catalog.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runServicesImport,
+	}
+
+	importCmd.Flags().Bool("prune", false, "remove the services missing from the file")
+
+	servicesCmd.AddCommand(listCmd, addCmd, removeCmd, importCmd)
+
+	return servicesCmd
+}
+
+func runServicesList(cmd *cobra.Command, _ []string) error {
+	st, closeDB := openStore()
+	defer closeDB()
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), time.Minute)
+	defer cancel()
+
+	services, err := st.ListServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	return catalog.Write(os.Stdout, services)
+}
+
+func runServicesAdd(cmd *cobra.Command, args []string) error {
+	entry := catalog.Entry{Name: args[0]}
+	entry.OwnerTeam, _ = cmd.Flags().GetString("owner-team")
+	entry.OnCallUserGroup, _ = cmd.Flags().GetString("oncall-usergroup")
+	entry.RunbookURL, _ = cmd.Flags().GetString("runbook")
+	entry.SlackChannel, _ = cmd.Flags().GetString("channel")
+
+	svc, err := catalog.NewService(entry)
+	if err != nil {
+		return err
+	}
+
+	st, closeDB := openStore()
+	defer closeDB()
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), time.Minute)
+	defer cancel()
+
+	if err := st.SaveService(ctx, svc); err != nil {
+		return err
+	}
+
+	fmt.Printf("Saved service %s\n", svc.Name)
+
+	return nil
+}
+
+func runServicesRemove(cmd *cobra.Command, args []string) error {
+	st, closeDB := openStore()
+	defer closeDB()
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), time.Minute)
+	defer cancel()
+
+	err := st.DeleteService(ctx, args[0])
+	if errors.Is(err, store.ErrNotFound) {
+		return fmt.Errorf("service %s is not in the catalog", args[0])
+	}
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Removed service %s\n", args[0])
+
+	return nil
+}
+
+func runServicesImport(cmd *cobra.Command, args []string) error {
+	prune, _ := cmd.Flags().GetBool("prune")
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	services, err := catalog.Parse(f)
+	if err != nil {
+		return fmt.Errorf("%s: %w", args[0], err)
+	}
+
+	st, closeDB := openStore()
+	defer closeDB()
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), time.Minute)
+	defer cancel()
+
+	removed, err := catalog.Import(ctx, st, services, prune)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Imported %d services\n", len(services))
+
+	for _, name := range removed {
+		fmt.Printf("Removed service %s\n", name)
+	}
+
+	return nil
+}
+
+// openStore connects to the database for a command that only needs the database settings, and
+// returns a function closing it
+func openStore() (*store.Store, func()) {
+	cfg = config.Load(configPath)
+	logger.SetLevel(cfg.LogLevel)
+
+	db := initDB()
+
+	return store.New(db), func() {
+		if err := db.Close(); err != nil {
+			logger.Error("failed to close DB", "error", err)
+		}
+	}
+}
+
+func runIncidentsICS(cmd *cobra.Command, _ []string) error {
+	fromFlag, _ := cmd.Flags().GetString("from")
+	toFlag, _ := cmd.Flags().GetString("to")
+
+	now := time.Now()
+
+	from, to, err := calendar.ParseRange(fromFlag, toFlag, now)
+	if err != nil {
+		return err
+	}
+
+	// Load configuration; only the database settings are needed
+	cfg = config.Load(configPath)
+	logger.SetLevel(cfg.LogLevel)
+
+	db := initDB()
+
+	defer func() {
+		if err := db.Close(); err != nil {
+			logger.Error("failed to close DB", "error", err)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), time.Minute)
+	defer cancel()
+
+	incidents, err := calendar.Load(ctx, store.New(db), from, to)
+	if err != nil {
+		return err
+	}
+
+	return calendar.Write(os.Stdout, incidents, now)
+}
+
 func runMigration(ctx context.Context, command string, args []string) error {
 	// Load configuration
-	cfg = config.Load()
+	cfg = config.Load(configPath)
 
 	// Set log level from configuration
 	logger.SetLevel(cfg.LogLevel)
@@ -158,11 +761,13 @@ func runMigrationInternal(db *sql.DB) {
 func initDB() *sqlx.DB {
 	dbDriverName := "postgres"
 
-	connector, err := pq.NewConnector(cfg.DBURI)
+	connector, err := store.NewConnector(cfg.DBURI)
 	if err != nil {
 		logger.Fatal("failed initializing sql connector", "error", err)
 	}
 
+	dbConnector = connector
+
 	db := sqlx.NewDb(sql.OpenDB(connector), dbDriverName)
 
 	if err := db.PingContext(context.Background()); err != nil {
@@ -0,0 +1,646 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// AlertIncident is an object representing the database table.
+type AlertIncident struct {
+	Fingerprint string              `db:"fingerprint,pk" `
+	IncidentID  sql.Null[uuid.UUID] `db:"incident_id" `
+	ClaimedAt   time.Time           `db:"claimed_at" `
+
+	R alertIncidentR `db:"-" `
+}
+
+// AlertIncidentSlice is an alias for a slice of pointers to AlertIncident.
+// This should almost always be used instead of []*AlertIncident.
+type AlertIncidentSlice []*AlertIncident
+
+// AlertIncidents contains methods to work with the alert_incidents table
+var AlertIncidents = psql.NewTablex[*AlertIncident, AlertIncidentSlice, *AlertIncidentSetter]("", "alert_incidents")
+
+// AlertIncidentsQuery is a query on the alert_incidents table
+type AlertIncidentsQuery = *psql.ViewQuery[*AlertIncident, AlertIncidentSlice]
+
+// alertIncidentR is where relationships are stored.
+type alertIncidentR struct {
+	Incident *Incident // alert_incidents.alert_incidents_incident_id_fkey
+}
+
+type alertIncidentColumnNames struct {
+	Fingerprint string
+	IncidentID  string
+	ClaimedAt   string
+}
+
+var AlertIncidentColumns = buildAlertIncidentColumns("alert_incidents")
+
+type alertIncidentColumns struct {
+	tableAlias  string
+	Fingerprint psql.Expression
+	IncidentID  psql.Expression
+	ClaimedAt   psql.Expression
+}
+
+func (c alertIncidentColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (alertIncidentColumns) AliasedAs(alias string) alertIncidentColumns {
+	return buildAlertIncidentColumns(alias)
+}
+
+func buildAlertIncidentColumns(alias string) alertIncidentColumns {
+	return alertIncidentColumns{
+		tableAlias:  alias,
+		Fingerprint: psql.Quote(alias, "fingerprint"),
+		IncidentID:  psql.Quote(alias, "incident_id"),
+		ClaimedAt:   psql.Quote(alias, "claimed_at"),
+	}
+}
+
+type alertIncidentWhere[Q psql.Filterable] struct {
+	Fingerprint psql.WhereMod[Q, string]
+	IncidentID  psql.WhereNullMod[Q, uuid.UUID]
+	ClaimedAt   psql.WhereMod[Q, time.Time]
+}
+
+func (alertIncidentWhere[Q]) AliasedAs(alias string) alertIncidentWhere[Q] {
+	return buildAlertIncidentWhere[Q](buildAlertIncidentColumns(alias))
+}
+
+func buildAlertIncidentWhere[Q psql.Filterable](cols alertIncidentColumns) alertIncidentWhere[Q] {
+	return alertIncidentWhere[Q]{
+		Fingerprint: psql.Where[Q, string](cols.Fingerprint),
+		IncidentID:  psql.WhereNull[Q, uuid.UUID](cols.IncidentID),
+		ClaimedAt:   psql.Where[Q, time.Time](cols.ClaimedAt),
+	}
+}
+
+var AlertIncidentErrors = &alertIncidentErrors{
+	ErrUniqueAlertIncidentsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "alert_incidents",
+		columns: []string{"fingerprint"},
+		s:       "alert_incidents_pkey",
+	},
+}
+
+type alertIncidentErrors struct {
+	ErrUniqueAlertIncidentsPkey *UniqueConstraintError
+}
+
+// AlertIncidentSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type AlertIncidentSetter struct {
+	Fingerprint *string              `db:"fingerprint,pk" `
+	IncidentID  *sql.Null[uuid.UUID] `db:"incident_id" `
+	ClaimedAt   *time.Time           `db:"claimed_at" `
+}
+
+func (s AlertIncidentSetter) SetColumns() []string {
+	vals := make([]string, 0, 3)
+	if s.Fingerprint != nil {
+		vals = append(vals, "fingerprint")
+	}
+
+	if s.IncidentID != nil {
+		vals = append(vals, "incident_id")
+	}
+
+	if s.ClaimedAt != nil {
+		vals = append(vals, "claimed_at")
+	}
+
+	return vals
+}
+
+func (s AlertIncidentSetter) Overwrite(t *AlertIncident) {
+	if s.Fingerprint != nil {
+		t.Fingerprint = *s.Fingerprint
+	}
+	if s.IncidentID != nil {
+		t.IncidentID = *s.IncidentID
+	}
+	if s.ClaimedAt != nil {
+		t.ClaimedAt = *s.ClaimedAt
+	}
+}
+
+func (s *AlertIncidentSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return AlertIncidents.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 3)
+		if s.Fingerprint != nil {
+			vals[0] = psql.Arg(*s.Fingerprint)
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.IncidentID != nil {
+			vals[1] = psql.Arg(*s.IncidentID)
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.ClaimedAt != nil {
+			vals[2] = psql.Arg(*s.ClaimedAt)
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s AlertIncidentSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s AlertIncidentSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 3)
+
+	if s.Fingerprint != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "fingerprint")...),
+			psql.Arg(s.Fingerprint),
+		}})
+	}
+
+	if s.IncidentID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "incident_id")...),
+			psql.Arg(s.IncidentID),
+		}})
+	}
+
+	if s.ClaimedAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "claimed_at")...),
+			psql.Arg(s.ClaimedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindAlertIncident retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindAlertIncident(ctx context.Context, exec bob.Executor, FingerprintPK string, cols ...string) (*AlertIncident, error) {
+	if len(cols) == 0 {
+		return AlertIncidents.Query(
+			SelectWhere.AlertIncidents.Fingerprint.EQ(FingerprintPK),
+		).One(ctx, exec)
+	}
+
+	return AlertIncidents.Query(
+		SelectWhere.AlertIncidents.Fingerprint.EQ(FingerprintPK),
+		sm.Columns(AlertIncidents.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// AlertIncidentExists checks the presence of a single record by primary key
+func AlertIncidentExists(ctx context.Context, exec bob.Executor, FingerprintPK string) (bool, error) {
+	return AlertIncidents.Query(
+		SelectWhere.AlertIncidents.Fingerprint.EQ(FingerprintPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after AlertIncident is retrieved from the database
+func (o *AlertIncident) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = AlertIncidents.AfterSelectHooks.RunHooks(ctx, exec, AlertIncidentSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = AlertIncidents.AfterInsertHooks.RunHooks(ctx, exec, AlertIncidentSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = AlertIncidents.AfterUpdateHooks.RunHooks(ctx, exec, AlertIncidentSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = AlertIncidents.AfterDeleteHooks.RunHooks(ctx, exec, AlertIncidentSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the AlertIncident
+func (o *AlertIncident) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.Fingerprint)
+}
+
+func (o *AlertIncident) pkEQ() dialect.Expression {
+	return psql.Quote("alert_incidents", "fingerprint").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the AlertIncident
+func (o *AlertIncident) Update(ctx context.Context, exec bob.Executor, s *AlertIncidentSetter) error {
+	v, err := AlertIncidents.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single AlertIncident record with an executor
+func (o *AlertIncident) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := AlertIncidents.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the AlertIncident using the executor
+func (o *AlertIncident) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := AlertIncidents.Query(
+		SelectWhere.AlertIncidents.Fingerprint.EQ(o.Fingerprint),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after AlertIncidentSlice is retrieved from the database
+func (o AlertIncidentSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = AlertIncidents.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = AlertIncidents.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = AlertIncidents.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = AlertIncidents.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o AlertIncidentSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("alert_incidents", "fingerprint").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o AlertIncidentSlice) copyMatchingRows(from ...*AlertIncident) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.Fingerprint != old.Fingerprint {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o AlertIncidentSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return AlertIncidents.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *AlertIncident:
+				o.copyMatchingRows(retrieved)
+			case []*AlertIncident:
+				o.copyMatchingRows(retrieved...)
+			case AlertIncidentSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a AlertIncident or a slice of AlertIncident
+				// then run the AfterUpdateHooks on the slice
+				_, err = AlertIncidents.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o AlertIncidentSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return AlertIncidents.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *AlertIncident:
+				o.copyMatchingRows(retrieved)
+			case []*AlertIncident:
+				o.copyMatchingRows(retrieved...)
+			case AlertIncidentSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a AlertIncident or a slice of AlertIncident
+				// then run the AfterDeleteHooks on the slice
+				_, err = AlertIncidents.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o AlertIncidentSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals AlertIncidentSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := AlertIncidents.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o AlertIncidentSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := AlertIncidents.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o AlertIncidentSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := AlertIncidents.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type alertIncidentJoins[Q dialect.Joinable] struct {
+	typ      string
+	Incident modAs[Q, incidentColumns]
+}
+
+func (j alertIncidentJoins[Q]) aliasedAs(alias string) alertIncidentJoins[Q] {
+	return buildAlertIncidentJoins[Q](buildAlertIncidentColumns(alias), j.typ)
+}
+
+func buildAlertIncidentJoins[Q dialect.Joinable](cols alertIncidentColumns, typ string) alertIncidentJoins[Q] {
+	return alertIncidentJoins[Q]{
+		typ: typ,
+		Incident: modAs[Q, incidentColumns]{
+			c: IncidentColumns,
+			f: func(to incidentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Incidents.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.IncidentID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
+
+// Incident starts a query for related objects on incidents
+func (o *AlertIncident) Incident(mods ...bob.Mod[*dialect.SelectQuery]) IncidentsQuery {
+	return Incidents.Query(append(mods,
+		sm.Where(IncidentColumns.ID.EQ(psql.Arg(o.IncidentID))),
+	)...)
+}
+
+func (os AlertIncidentSlice) Incident(mods ...bob.Mod[*dialect.SelectQuery]) IncidentsQuery {
+	pkIncidentID := make(pgtypes.Array[sql.Null[uuid.UUID]], len(os))
+	for i, o := range os {
+		pkIncidentID[i] = o.IncidentID
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkIncidentID), "uuid[]")),
+	))
+
+	return Incidents.Query(append(mods,
+		sm.Where(psql.Group(IncidentColumns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func (o *AlertIncident) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Incident":
+		rel, ok := retrieved.(*Incident)
+		if !ok {
+			return fmt.Errorf("alertIncident cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Incident = rel
+
+		if rel != nil {
+			rel.R.AlertIncidents = AlertIncidentSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("alertIncident has no relationship %q", name)
+	}
+}
+
+type alertIncidentPreloader struct {
+	Incident func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildAlertIncidentPreloader() alertIncidentPreloader {
+	return alertIncidentPreloader{
+		Incident: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Incident, IncidentSlice](orm.Relationship{
+				Name: "Incident",
+				Sides: []orm.RelSide{
+					{
+						From: TableNames.AlertIncidents,
+						To:   TableNames.Incidents,
+						FromColumns: []string{
+							ColumnNames.AlertIncidents.IncidentID,
+						},
+						ToColumns: []string{
+							ColumnNames.Incidents.ID,
+						},
+					},
+				},
+			}, Incidents.Columns().Names(), opts...)
+		},
+	}
+}
+
+type alertIncidentThenLoader[Q orm.Loadable] struct {
+	Incident func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildAlertIncidentThenLoader[Q orm.Loadable]() alertIncidentThenLoader[Q] {
+	type IncidentLoadInterface interface {
+		LoadIncident(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return alertIncidentThenLoader[Q]{
+		Incident: thenLoadBuilder[Q](
+			"Incident",
+			func(ctx context.Context, exec bob.Executor, retrieved IncidentLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadIncident(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadIncident loads the alertIncident's Incident into the .R struct
+func (o *AlertIncident) LoadIncident(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Incident = nil
+
+	related, err := o.Incident(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.AlertIncidents = AlertIncidentSlice{o}
+
+	o.R.Incident = related
+	return nil
+}
+
+// LoadIncident loads the alertIncident's Incident into the .R struct
+func (os AlertIncidentSlice) LoadIncident(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	incidents, err := os.Incident(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range incidents {
+			if o.IncidentID.V != rel.ID {
+				continue
+			}
+
+			rel.R.AlertIncidents = append(rel.R.AlertIncidents, o)
+
+			o.R.Incident = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func attachAlertIncidentIncident0(ctx context.Context, exec bob.Executor, count int, alertIncident0 *AlertIncident, incident1 *Incident) (*AlertIncident, error) {
+	setter := &AlertIncidentSetter{
+		IncidentID: func() *sql.Null[uuid.UUID] {
+			v := sql.Null[uuid.UUID]{V: incident1.ID, Valid: true}
+			return &v
+		}(),
+	}
+
+	err := alertIncident0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachAlertIncidentIncident0: %w", err)
+	}
+
+	return alertIncident0, nil
+}
+
+func (alertIncident0 *AlertIncident) InsertIncident(ctx context.Context, exec bob.Executor, related *IncidentSetter) error {
+	incident1, err := Incidents.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachAlertIncidentIncident0(ctx, exec, 1, alertIncident0, incident1)
+	if err != nil {
+		return err
+	}
+
+	alertIncident0.R.Incident = incident1
+
+	incident1.R.AlertIncidents = append(incident1.R.AlertIncidents, alertIncident0)
+
+	return nil
+}
+
+func (alertIncident0 *AlertIncident) AttachIncident(ctx context.Context, exec bob.Executor, incident1 *Incident) error {
+	var err error
+
+	_, err = attachAlertIncidentIncident0(ctx, exec, 1, alertIncident0, incident1)
+	if err != nil {
+		return err
+	}
+
+	alertIncident0.R.Incident = incident1
+
+	incident1.R.AlertIncidents = append(incident1.R.AlertIncidents, alertIncident0)
+
+	return nil
+}
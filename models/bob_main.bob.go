@@ -19,39 +19,221 @@ import (
 )
 
 var TableNames = struct {
-	GooseDBVersions string
-	Incidents       string
-	TimelineEvents  string
+	AlertIncidents         string
+	GooseDBVersions        string
+	IdempotencyKeys        string
+	IncidentChecklistItems string
+	IncidentChecklists     string
+	IncidentEscalations    string
+	IncidentNotifications  string
+	IncidentRoles          string
+	IncidentServices       string
+	IncidentSubscribers    string
+	Incidents              string
+	JobQueues              string
+	JobRuns                string
+	OncallHandoffNotes     string
+	OncallOverrides        string
+	OncallSchedules        string
+	Services               string
+	SlackInstallations     string
+	TimelineEvents         string
 }{
-	GooseDBVersions: "goose_db_version",
-	Incidents:       "incidents",
-	TimelineEvents:  "timeline_events",
+	AlertIncidents:         "alert_incidents",
+	GooseDBVersions:        "goose_db_version",
+	IdempotencyKeys:        "idempotency_keys",
+	IncidentChecklistItems: "incident_checklist_items",
+	IncidentChecklists:     "incident_checklists",
+	IncidentEscalations:    "incident_escalations",
+	IncidentNotifications:  "incident_notifications",
+	IncidentRoles:          "incident_roles",
+	IncidentServices:       "incident_services",
+	IncidentSubscribers:    "incident_subscribers",
+	Incidents:              "incidents",
+	JobQueues:              "job_queue",
+	JobRuns:                "job_runs",
+	OncallHandoffNotes:     "oncall_handoff_notes",
+	OncallOverrides:        "oncall_overrides",
+	OncallSchedules:        "oncall_schedules",
+	Services:               "services",
+	SlackInstallations:     "slack_installations",
+	TimelineEvents:         "timeline_events",
 }
 
 var ColumnNames = struct {
-	GooseDBVersions gooseDBVersionColumnNames
-	Incidents       incidentColumnNames
-	TimelineEvents  timelineEventColumnNames
+	AlertIncidents         alertIncidentColumnNames
+	GooseDBVersions        gooseDBVersionColumnNames
+	IdempotencyKeys        idempotencyKeyColumnNames
+	IncidentChecklistItems incidentChecklistItemColumnNames
+	IncidentChecklists     incidentChecklistColumnNames
+	IncidentEscalations    incidentEscalationColumnNames
+	IncidentNotifications  incidentNotificationColumnNames
+	IncidentRoles          incidentRoleColumnNames
+	IncidentServices       incidentServiceColumnNames
+	IncidentSubscribers    incidentSubscriberColumnNames
+	Incidents              incidentColumnNames
+	JobQueues              jobQueueColumnNames
+	JobRuns                jobRunColumnNames
+	OncallHandoffNotes     oncallHandoffNoteColumnNames
+	OncallOverrides        oncallOverrideColumnNames
+	OncallSchedules        oncallScheduleColumnNames
+	Services               serviceColumnNames
+	SlackInstallations     slackInstallationColumnNames
+	TimelineEvents         timelineEventColumnNames
 }{
+	AlertIncidents: alertIncidentColumnNames{
+		Fingerprint: "fingerprint",
+		IncidentID:  "incident_id",
+		ClaimedAt:   "claimed_at",
+	},
 	GooseDBVersions: gooseDBVersionColumnNames{
 		ID:        "id",
 		VersionID: "version_id",
 		IsApplied: "is_applied",
 		Tstamp:    "tstamp",
 	},
-	Incidents: incidentColumnNames{
-		ID:             "id",
+	IdempotencyKeys: idempotencyKeyColumnNames{
+		Key:         "key",
+		Fingerprint: "fingerprint",
+		Response:    "response",
+		CreatedAt:   "created_at",
+		ExpiresAt:   "expires_at",
+	},
+	IncidentChecklistItems: incidentChecklistItemColumnNames{
+		IncidentID:  "incident_id",
+		Position:    "position",
+		Text:        "text",
+		Required:    "required",
+		CompletedBy: "completed_by",
+		CompletedAt: "completed_at",
+	},
+	IncidentChecklists: incidentChecklistColumnNames{
+		IncidentID:     "incident_id",
 		SlackChannelID: "slack_channel_id",
-		Status:         "status",
-		Severity:       "severity",
-		Title:          "title",
-		Description:    "description",
-		StartedBy:      "started_by",
-		StartedAt:      "started_at",
-		ResolvedBy:     "resolved_by",
-		ResolvedAt:     "resolved_at",
-		ExportURL:      "export_url",
-		LastUpdated:    "last_updated",
+		SlackMessageTS: "slack_message_ts",
+		NextReminder:   "next_reminder",
+	},
+	IncidentEscalations: incidentEscalationColumnNames{
+		IncidentID:     "incident_id",
+		Policy:         "policy",
+		Level:          "level",
+		EscalatedAt:    "escalated_at",
+		AcknowledgedBy: "acknowledged_by",
+		AcknowledgedAt: "acknowledged_at",
+		CreatedAt:      "created_at",
+	},
+	IncidentNotifications: incidentNotificationColumnNames{
+		IncidentID:     "incident_id",
+		SlackChannelID: "slack_channel_id",
+		SlackMessageTS: "slack_message_ts",
+		PostedAt:       "posted_at",
+	},
+	IncidentRoles: incidentRoleColumnNames{
+		IncidentID:  "incident_id",
+		Role:        "role",
+		SlackUserID: "slack_user_id",
+		AssignedAt:  "assigned_at",
+	},
+	IncidentServices: incidentServiceColumnNames{
+		IncidentID: "incident_id",
+		Service:    "service",
+		AddedBy:    "added_by",
+		AddedAt:    "added_at",
+	},
+	IncidentSubscribers: incidentSubscriberColumnNames{
+		IncidentID:   "incident_id",
+		SlackUserID:  "slack_user_id",
+		SubscribedAt: "subscribed_at",
+	},
+	Incidents: incidentColumnNames{
+		ID:                "id",
+		SlackChannelID:    "slack_channel_id",
+		Status:            "status",
+		Severity:          "severity",
+		Title:             "title",
+		Description:       "description",
+		StartedBy:         "started_by",
+		StartedAt:         "started_at",
+		ResolvedBy:        "resolved_by",
+		ResolvedAt:        "resolved_at",
+		ExportURL:         "export_url",
+		LastUpdated:       "last_updated",
+		SlackChannelName:  "slack_channel_name",
+		StartedByUserID:   "started_by_user_id",
+		NextUpdateDue:     "next_update_due",
+		SubStatus:         "sub_status",
+		StalePromptedAt:   "stale_prompted_at",
+		StaleSnoozedUntil: "stale_snoozed_until",
+		TeamID:            "team_id",
+		EnterpriseID:      "enterprise_id",
+		Team:              "team",
+		IncidentType:      "incident_type",
+		Private:           "private",
+		FirstResponseAt:   "first_response_at",
+	},
+	JobQueues: jobQueueColumnNames{
+		ID:         "id",
+		Kind:       "kind",
+		Payload:    "payload",
+		RunAt:      "run_at",
+		Attempts:   "attempts",
+		LastError:  "last_error",
+		CreatedAt:  "created_at",
+		FinishedAt: "finished_at",
+	},
+	JobRuns: jobRunColumnNames{
+		Job:         "job",
+		ScheduledAt: "scheduled_at",
+		FinishedAt:  "finished_at",
+	},
+	OncallHandoffNotes: oncallHandoffNoteColumnNames{
+		ID:          "id",
+		Rotation:    "rotation",
+		SlackUserID: "slack_user_id",
+		Note:        "note",
+		CreatedAt:   "created_at",
+	},
+	OncallOverrides: oncallOverrideColumnNames{
+		ID:          "id",
+		ScheduleID:  "schedule_id",
+		SlackUserID: "slack_user_id",
+		StartsAt:    "starts_at",
+		EndsAt:      "ends_at",
+		CreatedBy:   "created_by",
+		CreatedAt:   "created_at",
+	},
+	OncallSchedules: oncallScheduleColumnNames{
+		ID:           "id",
+		Name:         "name",
+		Rotation:     "rotation",
+		Participants: "participants",
+		HandoffTime:  "handoff_time",
+		Timezone:     "timezone",
+		StartDate:    "start_date",
+		CreatedBy:    "created_by",
+		CreatedAt:    "created_at",
+	},
+	Services: serviceColumnNames{
+		Name:            "name",
+		OwnerTeam:       "owner_team",
+		OncallUsergroup: "oncall_usergroup",
+		RunbookURL:      "runbook_url",
+		SlackChannel:    "slack_channel",
+		CreatedAt:       "created_at",
+		UpdatedAt:       "updated_at",
+	},
+	SlackInstallations: slackInstallationColumnNames{
+		ID:                  "id",
+		TeamID:              "team_id",
+		TeamName:            "team_name",
+		EnterpriseID:        "enterprise_id",
+		EnterpriseName:      "enterprise_name",
+		IsEnterpriseInstall: "is_enterprise_install",
+		BotToken:            "bot_token",
+		BotUserID:           "bot_user_id",
+		InstalledBy:         "installed_by",
+		InstalledAt:         "installed_at",
+		UpdatedAt:           "updated_at",
 	},
 	TimelineEvents: timelineEventColumnNames{
 		ID:             "id",
@@ -61,6 +243,9 @@ var ColumnNames = struct {
 		SlackUserID:    "slack_user_id",
 		SlackMessageTS: "slack_message_ts",
 		Metadata:       "metadata",
+		EntryKey:       "entry_key",
+		SlackUsername:  "slack_username",
+		Content:        "content",
 	},
 }
 
@@ -72,32 +257,100 @@ var (
 )
 
 func Where[Q psql.Filterable]() struct {
-	GooseDBVersions gooseDBVersionWhere[Q]
-	Incidents       incidentWhere[Q]
-	TimelineEvents  timelineEventWhere[Q]
+	AlertIncidents         alertIncidentWhere[Q]
+	GooseDBVersions        gooseDBVersionWhere[Q]
+	IdempotencyKeys        idempotencyKeyWhere[Q]
+	IncidentChecklistItems incidentChecklistItemWhere[Q]
+	IncidentChecklists     incidentChecklistWhere[Q]
+	IncidentEscalations    incidentEscalationWhere[Q]
+	IncidentNotifications  incidentNotificationWhere[Q]
+	IncidentRoles          incidentRoleWhere[Q]
+	IncidentServices       incidentServiceWhere[Q]
+	IncidentSubscribers    incidentSubscriberWhere[Q]
+	Incidents              incidentWhere[Q]
+	JobQueues              jobQueueWhere[Q]
+	JobRuns                jobRunWhere[Q]
+	OncallHandoffNotes     oncallHandoffNoteWhere[Q]
+	OncallOverrides        oncallOverrideWhere[Q]
+	OncallSchedules        oncallScheduleWhere[Q]
+	Services               serviceWhere[Q]
+	SlackInstallations     slackInstallationWhere[Q]
+	TimelineEvents         timelineEventWhere[Q]
 } {
 	return struct {
-		GooseDBVersions gooseDBVersionWhere[Q]
-		Incidents       incidentWhere[Q]
-		TimelineEvents  timelineEventWhere[Q]
+		AlertIncidents         alertIncidentWhere[Q]
+		GooseDBVersions        gooseDBVersionWhere[Q]
+		IdempotencyKeys        idempotencyKeyWhere[Q]
+		IncidentChecklistItems incidentChecklistItemWhere[Q]
+		IncidentChecklists     incidentChecklistWhere[Q]
+		IncidentEscalations    incidentEscalationWhere[Q]
+		IncidentNotifications  incidentNotificationWhere[Q]
+		IncidentRoles          incidentRoleWhere[Q]
+		IncidentServices       incidentServiceWhere[Q]
+		IncidentSubscribers    incidentSubscriberWhere[Q]
+		Incidents              incidentWhere[Q]
+		JobQueues              jobQueueWhere[Q]
+		JobRuns                jobRunWhere[Q]
+		OncallHandoffNotes     oncallHandoffNoteWhere[Q]
+		OncallOverrides        oncallOverrideWhere[Q]
+		OncallSchedules        oncallScheduleWhere[Q]
+		Services               serviceWhere[Q]
+		SlackInstallations     slackInstallationWhere[Q]
+		TimelineEvents         timelineEventWhere[Q]
 	}{
-		GooseDBVersions: buildGooseDBVersionWhere[Q](GooseDBVersionColumns),
-		Incidents:       buildIncidentWhere[Q](IncidentColumns),
-		TimelineEvents:  buildTimelineEventWhere[Q](TimelineEventColumns),
+		AlertIncidents:         buildAlertIncidentWhere[Q](AlertIncidentColumns),
+		GooseDBVersions:        buildGooseDBVersionWhere[Q](GooseDBVersionColumns),
+		IdempotencyKeys:        buildIdempotencyKeyWhere[Q](IdempotencyKeyColumns),
+		IncidentChecklistItems: buildIncidentChecklistItemWhere[Q](IncidentChecklistItemColumns),
+		IncidentChecklists:     buildIncidentChecklistWhere[Q](IncidentChecklistColumns),
+		IncidentEscalations:    buildIncidentEscalationWhere[Q](IncidentEscalationColumns),
+		IncidentNotifications:  buildIncidentNotificationWhere[Q](IncidentNotificationColumns),
+		IncidentRoles:          buildIncidentRoleWhere[Q](IncidentRoleColumns),
+		IncidentServices:       buildIncidentServiceWhere[Q](IncidentServiceColumns),
+		IncidentSubscribers:    buildIncidentSubscriberWhere[Q](IncidentSubscriberColumns),
+		Incidents:              buildIncidentWhere[Q](IncidentColumns),
+		JobQueues:              buildJobQueueWhere[Q](JobQueueColumns),
+		JobRuns:                buildJobRunWhere[Q](JobRunColumns),
+		OncallHandoffNotes:     buildOncallHandoffNoteWhere[Q](OncallHandoffNoteColumns),
+		OncallOverrides:        buildOncallOverrideWhere[Q](OncallOverrideColumns),
+		OncallSchedules:        buildOncallScheduleWhere[Q](OncallScheduleColumns),
+		Services:               buildServiceWhere[Q](ServiceColumns),
+		SlackInstallations:     buildSlackInstallationWhere[Q](SlackInstallationColumns),
+		TimelineEvents:         buildTimelineEventWhere[Q](TimelineEventColumns),
 	}
 }
 
 var Preload = getPreloaders()
 
 type preloaders struct {
-	Incident      incidentPreloader
-	TimelineEvent timelineEventPreloader
+	AlertIncident         alertIncidentPreloader
+	IncidentChecklistItem incidentChecklistItemPreloader
+	IncidentChecklist     incidentChecklistPreloader
+	IncidentEscalation    incidentEscalationPreloader
+	IncidentNotification  incidentNotificationPreloader
+	IncidentRole          incidentRolePreloader
+	IncidentService       incidentServicePreloader
+	IncidentSubscriber    incidentSubscriberPreloader
+	Incident              incidentPreloader
+	OncallOverride        oncallOverridePreloader
+	OncallSchedule        oncallSchedulePreloader
+	TimelineEvent         timelineEventPreloader
 }
 
 func getPreloaders() preloaders {
 	return preloaders{
-		Incident:      buildIncidentPreloader(),
-		TimelineEvent: buildTimelineEventPreloader(),
+		AlertIncident:         buildAlertIncidentPreloader(),
+		IncidentChecklistItem: buildIncidentChecklistItemPreloader(),
+		IncidentChecklist:     buildIncidentChecklistPreloader(),
+		IncidentEscalation:    buildIncidentEscalationPreloader(),
+		IncidentNotification:  buildIncidentNotificationPreloader(),
+		IncidentRole:          buildIncidentRolePreloader(),
+		IncidentService:       buildIncidentServicePreloader(),
+		IncidentSubscriber:    buildIncidentSubscriberPreloader(),
+		Incident:              buildIncidentPreloader(),
+		OncallOverride:        buildOncallOverridePreloader(),
+		OncallSchedule:        buildOncallSchedulePreloader(),
+		TimelineEvent:         buildTimelineEventPreloader(),
 	}
 }
 
@@ -108,14 +361,34 @@ var (
 )
 
 type thenLoaders[Q orm.Loadable] struct {
-	Incident      incidentThenLoader[Q]
-	TimelineEvent timelineEventThenLoader[Q]
+	AlertIncident         alertIncidentThenLoader[Q]
+	IncidentChecklistItem incidentChecklistItemThenLoader[Q]
+	IncidentChecklist     incidentChecklistThenLoader[Q]
+	IncidentEscalation    incidentEscalationThenLoader[Q]
+	IncidentNotification  incidentNotificationThenLoader[Q]
+	IncidentRole          incidentRoleThenLoader[Q]
+	IncidentService       incidentServiceThenLoader[Q]
+	IncidentSubscriber    incidentSubscriberThenLoader[Q]
+	Incident              incidentThenLoader[Q]
+	OncallOverride        oncallOverrideThenLoader[Q]
+	OncallSchedule        oncallScheduleThenLoader[Q]
+	TimelineEvent         timelineEventThenLoader[Q]
 }
 
 func getThenLoaders[Q orm.Loadable]() thenLoaders[Q] {
 	return thenLoaders[Q]{
-		Incident:      buildIncidentThenLoader[Q](),
-		TimelineEvent: buildTimelineEventThenLoader[Q](),
+		AlertIncident:         buildAlertIncidentThenLoader[Q](),
+		IncidentChecklistItem: buildIncidentChecklistItemThenLoader[Q](),
+		IncidentChecklist:     buildIncidentChecklistThenLoader[Q](),
+		IncidentEscalation:    buildIncidentEscalationThenLoader[Q](),
+		IncidentNotification:  buildIncidentNotificationThenLoader[Q](),
+		IncidentRole:          buildIncidentRoleThenLoader[Q](),
+		IncidentService:       buildIncidentServiceThenLoader[Q](),
+		IncidentSubscriber:    buildIncidentSubscriberThenLoader[Q](),
+		Incident:              buildIncidentThenLoader[Q](),
+		OncallOverride:        buildOncallOverrideThenLoader[Q](),
+		OncallSchedule:        buildOncallScheduleThenLoader[Q](),
+		TimelineEvent:         buildTimelineEventThenLoader[Q](),
 	}
 }
 
@@ -160,8 +433,18 @@ func (j joinSet[Q]) AliasedAs(alias string) joinSet[Q] {
 }
 
 type joins[Q dialect.Joinable] struct {
-	Incidents      joinSet[incidentJoins[Q]]
-	TimelineEvents joinSet[timelineEventJoins[Q]]
+	AlertIncidents         joinSet[alertIncidentJoins[Q]]
+	IncidentChecklistItems joinSet[incidentChecklistItemJoins[Q]]
+	IncidentChecklists     joinSet[incidentChecklistJoins[Q]]
+	IncidentEscalations    joinSet[incidentEscalationJoins[Q]]
+	IncidentNotifications  joinSet[incidentNotificationJoins[Q]]
+	IncidentRoles          joinSet[incidentRoleJoins[Q]]
+	IncidentServices       joinSet[incidentServiceJoins[Q]]
+	IncidentSubscribers    joinSet[incidentSubscriberJoins[Q]]
+	Incidents              joinSet[incidentJoins[Q]]
+	OncallOverrides        joinSet[oncallOverrideJoins[Q]]
+	OncallSchedules        joinSet[oncallScheduleJoins[Q]]
+	TimelineEvents         joinSet[timelineEventJoins[Q]]
 }
 
 func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q](c C, f F) joinSet[Q] {
@@ -174,8 +457,18 @@ func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q
 
 func getJoins[Q dialect.Joinable]() joins[Q] {
 	return joins[Q]{
-		Incidents:      buildJoinSet[incidentJoins[Q]](IncidentColumns, buildIncidentJoins),
-		TimelineEvents: buildJoinSet[timelineEventJoins[Q]](TimelineEventColumns, buildTimelineEventJoins),
+		AlertIncidents:         buildJoinSet[alertIncidentJoins[Q]](AlertIncidentColumns, buildAlertIncidentJoins),
+		IncidentChecklistItems: buildJoinSet[incidentChecklistItemJoins[Q]](IncidentChecklistItemColumns, buildIncidentChecklistItemJoins),
+		IncidentChecklists:     buildJoinSet[incidentChecklistJoins[Q]](IncidentChecklistColumns, buildIncidentChecklistJoins),
+		IncidentEscalations:    buildJoinSet[incidentEscalationJoins[Q]](IncidentEscalationColumns, buildIncidentEscalationJoins),
+		IncidentNotifications:  buildJoinSet[incidentNotificationJoins[Q]](IncidentNotificationColumns, buildIncidentNotificationJoins),
+		IncidentRoles:          buildJoinSet[incidentRoleJoins[Q]](IncidentRoleColumns, buildIncidentRoleJoins),
+		IncidentServices:       buildJoinSet[incidentServiceJoins[Q]](IncidentServiceColumns, buildIncidentServiceJoins),
+		IncidentSubscribers:    buildJoinSet[incidentSubscriberJoins[Q]](IncidentSubscriberColumns, buildIncidentSubscriberJoins),
+		Incidents:              buildJoinSet[incidentJoins[Q]](IncidentColumns, buildIncidentJoins),
+		OncallOverrides:        buildJoinSet[oncallOverrideJoins[Q]](OncallOverrideColumns, buildOncallOverrideJoins),
+		OncallSchedules:        buildJoinSet[oncallScheduleJoins[Q]](OncallScheduleColumns, buildOncallScheduleJoins),
+		TimelineEvents:         buildJoinSet[timelineEventJoins[Q]](TimelineEventColumns, buildTimelineEventJoins),
 	}
 }
 
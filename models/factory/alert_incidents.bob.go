@@ -0,0 +1,430 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	models "github.com/fishnix/ohshift/models"
+	"github.com/gofrs/uuid/v5"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type AlertIncidentMod interface {
+	Apply(context.Context, *AlertIncidentTemplate)
+}
+
+type AlertIncidentModFunc func(context.Context, *AlertIncidentTemplate)
+
+func (f AlertIncidentModFunc) Apply(ctx context.Context, n *AlertIncidentTemplate) {
+	f(ctx, n)
+}
+
+type AlertIncidentModSlice []AlertIncidentMod
+
+func (mods AlertIncidentModSlice) Apply(ctx context.Context, n *AlertIncidentTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// AlertIncidentTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type AlertIncidentTemplate struct {
+	Fingerprint func() string
+	IncidentID  func() sql.Null[uuid.UUID]
+	ClaimedAt   func() time.Time
+
+	r alertIncidentR
+	f *Factory
+}
+
+type alertIncidentR struct {
+	Incident *alertIncidentRIncidentR
+}
+
+type alertIncidentRIncidentR struct {
+	o *IncidentTemplate
+}
+
+// Apply mods to the AlertIncidentTemplate
+func (o *AlertIncidentTemplate) Apply(ctx context.Context, mods ...AlertIncidentMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.AlertIncident
+// according to the relationships in the template. Nothing is inserted into the db
+func (t AlertIncidentTemplate) setModelRels(o *models.AlertIncident) {
+	if t.r.Incident != nil {
+		rel := t.r.Incident.o.Build()
+		rel.R.AlertIncidents = append(rel.R.AlertIncidents, o)
+		o.IncidentID = sql.Null[uuid.UUID]{V: rel.ID, Valid: true} // h2
+		o.R.Incident = rel
+	}
+}
+
+// BuildSetter returns an *models.AlertIncidentSetter
+// this does nothing with the relationship templates
+func (o AlertIncidentTemplate) BuildSetter() *models.AlertIncidentSetter {
+	m := &models.AlertIncidentSetter{}
+
+	if o.Fingerprint != nil {
+		val := o.Fingerprint()
+		m.Fingerprint = &val
+	}
+	if o.IncidentID != nil {
+		val := o.IncidentID()
+		m.IncidentID = &val
+	}
+	if o.ClaimedAt != nil {
+		val := o.ClaimedAt()
+		m.ClaimedAt = &val
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.AlertIncidentSetter
+// this does nothing with the relationship templates
+func (o AlertIncidentTemplate) BuildManySetter(number int) []*models.AlertIncidentSetter {
+	m := make([]*models.AlertIncidentSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.AlertIncident
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use AlertIncidentTemplate.Create
+func (o AlertIncidentTemplate) Build() *models.AlertIncident {
+	m := &models.AlertIncident{}
+
+	if o.Fingerprint != nil {
+		m.Fingerprint = o.Fingerprint()
+	}
+	if o.IncidentID != nil {
+		m.IncidentID = o.IncidentID()
+	}
+	if o.ClaimedAt != nil {
+		m.ClaimedAt = o.ClaimedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.AlertIncidentSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use AlertIncidentTemplate.CreateMany
+func (o AlertIncidentTemplate) BuildMany(number int) models.AlertIncidentSlice {
+	m := make(models.AlertIncidentSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableAlertIncident(m *models.AlertIncidentSetter) {
+	if m.Fingerprint == nil {
+		val := random_string(nil, "64")
+		m.Fingerprint = &val
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.AlertIncident
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *AlertIncidentTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.AlertIncident) (context.Context, error) {
+	var err error
+
+	isIncidentDone, _ := alertIncidentRelIncidentCtx.Value(ctx)
+	if !isIncidentDone && o.r.Incident != nil {
+		ctx = alertIncidentRelIncidentCtx.WithValue(ctx, true)
+		var rel0 *models.Incident
+		ctx, rel0, err = o.r.Incident.o.create(ctx, exec)
+		if err != nil {
+			return ctx, err
+		}
+		err = m.AttachIncident(ctx, exec, rel0)
+		if err != nil {
+			return ctx, err
+		}
+
+	}
+
+	return ctx, err
+}
+
+// Create builds a alertIncident and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *AlertIncidentTemplate) Create(ctx context.Context, exec bob.Executor) (*models.AlertIncident, error) {
+	_, m, err := o.create(ctx, exec)
+	return m, err
+}
+
+// MustCreate builds a alertIncident and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *AlertIncidentTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.AlertIncident {
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a alertIncident and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *AlertIncidentTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.AlertIncident {
+	tb.Helper()
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// create builds a alertIncident and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted model
+func (o *AlertIncidentTemplate) create(ctx context.Context, exec bob.Executor) (context.Context, *models.AlertIncident, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableAlertIncident(opt)
+
+	m, err := models.AlertIncidents.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return ctx, nil, err
+	}
+	ctx = alertIncidentCtx.WithValue(ctx, m)
+
+	ctx, err = o.insertOptRels(ctx, exec, m)
+	return ctx, m, err
+}
+
+// CreateMany builds multiple alertIncidents and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o AlertIncidentTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.AlertIncidentSlice, error) {
+	_, m, err := o.createMany(ctx, exec, number)
+	return m, err
+}
+
+// MustCreateMany builds multiple alertIncidents and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o AlertIncidentTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.AlertIncidentSlice {
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple alertIncidents and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o AlertIncidentTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.AlertIncidentSlice {
+	tb.Helper()
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// createMany builds multiple alertIncidents and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted models
+func (o AlertIncidentTemplate) createMany(ctx context.Context, exec bob.Executor, number int) (context.Context, models.AlertIncidentSlice, error) {
+	var err error
+	m := make(models.AlertIncidentSlice, number)
+
+	for i := range m {
+		ctx, m[i], err = o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	return ctx, m, nil
+}
+
+// AlertIncident has methods that act as mods for the AlertIncidentTemplate
+var AlertIncidentMods alertIncidentMods
+
+type alertIncidentMods struct{}
+
+func (m alertIncidentMods) RandomizeAllColumns(f *faker.Faker) AlertIncidentMod {
+	return AlertIncidentModSlice{
+		AlertIncidentMods.RandomFingerprint(f),
+		AlertIncidentMods.RandomIncidentID(f),
+		AlertIncidentMods.RandomClaimedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m alertIncidentMods) Fingerprint(val string) AlertIncidentMod {
+	return AlertIncidentModFunc(func(_ context.Context, o *AlertIncidentTemplate) {
+		o.Fingerprint = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m alertIncidentMods) FingerprintFunc(f func() string) AlertIncidentMod {
+	return AlertIncidentModFunc(func(_ context.Context, o *AlertIncidentTemplate) {
+		o.Fingerprint = f
+	})
+}
+
+// Clear any values for the column
+func (m alertIncidentMods) UnsetFingerprint() AlertIncidentMod {
+	return AlertIncidentModFunc(func(_ context.Context, o *AlertIncidentTemplate) {
+		o.Fingerprint = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m alertIncidentMods) RandomFingerprint(f *faker.Faker) AlertIncidentMod {
+	return AlertIncidentModFunc(func(_ context.Context, o *AlertIncidentTemplate) {
+		o.Fingerprint = func() string {
+			return random_string(f, "64")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m alertIncidentMods) IncidentID(val sql.Null[uuid.UUID]) AlertIncidentMod {
+	return AlertIncidentModFunc(func(_ context.Context, o *AlertIncidentTemplate) {
+		o.IncidentID = func() sql.Null[uuid.UUID] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m alertIncidentMods) IncidentIDFunc(f func() sql.Null[uuid.UUID]) AlertIncidentMod {
+	return AlertIncidentModFunc(func(_ context.Context, o *AlertIncidentTemplate) {
+		o.IncidentID = f
+	})
+}
+
+// Clear any values for the column
+func (m alertIncidentMods) UnsetIncidentID() AlertIncidentMod {
+	return AlertIncidentModFunc(func(_ context.Context, o *AlertIncidentTemplate) {
+		o.IncidentID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m alertIncidentMods) RandomIncidentID(f *faker.Faker) AlertIncidentMod {
+	return AlertIncidentModFunc(func(_ context.Context, o *AlertIncidentTemplate) {
+		o.IncidentID = func() sql.Null[uuid.UUID] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_uuid_UUID(f)
+			return sql.Null[uuid.UUID]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m alertIncidentMods) RandomIncidentIDNotNull(f *faker.Faker) AlertIncidentMod {
+	return AlertIncidentModFunc(func(_ context.Context, o *AlertIncidentTemplate) {
+		o.IncidentID = func() sql.Null[uuid.UUID] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_uuid_UUID(f)
+			return sql.Null[uuid.UUID]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m alertIncidentMods) ClaimedAt(val time.Time) AlertIncidentMod {
+	return AlertIncidentModFunc(func(_ context.Context, o *AlertIncidentTemplate) {
+		o.ClaimedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m alertIncidentMods) ClaimedAtFunc(f func() time.Time) AlertIncidentMod {
+	return AlertIncidentModFunc(func(_ context.Context, o *AlertIncidentTemplate) {
+		o.ClaimedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m alertIncidentMods) UnsetClaimedAt() AlertIncidentMod {
+	return AlertIncidentModFunc(func(_ context.Context, o *AlertIncidentTemplate) {
+		o.ClaimedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m alertIncidentMods) RandomClaimedAt(f *faker.Faker) AlertIncidentMod {
+	return AlertIncidentModFunc(func(_ context.Context, o *AlertIncidentTemplate) {
+		o.ClaimedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m alertIncidentMods) WithParentsCascading() AlertIncidentMod {
+	return AlertIncidentModFunc(func(ctx context.Context, o *AlertIncidentTemplate) {
+		if isDone, _ := alertIncidentWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = alertIncidentWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewIncident(ctx, IncidentMods.WithParentsCascading())
+			m.WithIncident(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m alertIncidentMods) WithIncident(rel *IncidentTemplate) AlertIncidentMod {
+	return AlertIncidentModFunc(func(ctx context.Context, o *AlertIncidentTemplate) {
+		o.r.Incident = &alertIncidentRIncidentR{
+			o: rel,
+		}
+	})
+}
+
+func (m alertIncidentMods) WithNewIncident(mods ...IncidentMod) AlertIncidentMod {
+	return AlertIncidentModFunc(func(ctx context.Context, o *AlertIncidentTemplate) {
+		related := o.f.NewIncident(ctx, mods...)
+
+		m.WithIncident(related).Apply(ctx, o)
+	})
+}
+
+func (m alertIncidentMods) WithoutIncident() AlertIncidentMod {
+	return AlertIncidentModFunc(func(ctx context.Context, o *AlertIncidentTemplate) {
+		o.r.Incident = nil
+	})
+}
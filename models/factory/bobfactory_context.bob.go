@@ -14,16 +14,98 @@ type contextKey string
 var (
 	// Table context
 
-	gooseDBVersionCtx = newContextual[*models.GooseDBVersion]("gooseDBVersion")
-	incidentCtx       = newContextual[*models.Incident]("incident")
-	timelineEventCtx  = newContextual[*models.TimelineEvent]("timelineEvent")
+	alertIncidentCtx         = newContextual[*models.AlertIncident]("alertIncident")
+	gooseDBVersionCtx        = newContextual[*models.GooseDBVersion]("gooseDBVersion")
+	idempotencyKeyCtx        = newContextual[*models.IdempotencyKey]("idempotencyKey")
+	incidentChecklistItemCtx = newContextual[*models.IncidentChecklistItem]("incidentChecklistItem")
+	incidentChecklistCtx     = newContextual[*models.IncidentChecklist]("incidentChecklist")
+	incidentEscalationCtx    = newContextual[*models.IncidentEscalation]("incidentEscalation")
+	incidentNotificationCtx  = newContextual[*models.IncidentNotification]("incidentNotification")
+	incidentRoleCtx          = newContextual[*models.IncidentRole]("incidentRole")
+	incidentServiceCtx       = newContextual[*models.IncidentService]("incidentService")
+	incidentSubscriberCtx    = newContextual[*models.IncidentSubscriber]("incidentSubscriber")
+	incidentCtx              = newContextual[*models.Incident]("incident")
+	jobQueueCtx              = newContextual[*models.JobQueue]("jobQueue")
+	jobRunCtx                = newContextual[*models.JobRun]("jobRun")
+	oncallHandoffNoteCtx     = newContextual[*models.OncallHandoffNote]("oncallHandoffNote")
+	oncallOverrideCtx        = newContextual[*models.OncallOverride]("oncallOverride")
+	oncallScheduleCtx        = newContextual[*models.OncallSchedule]("oncallSchedule")
+	serviceCtx               = newContextual[*models.Service]("service")
+	slackInstallationCtx     = newContextual[*models.SlackInstallation]("slackInstallation")
+	timelineEventCtx         = newContextual[*models.TimelineEvent]("timelineEvent")
+
+	// Relationship Contexts for alert_incidents
+	alertIncidentWithParentsCascadingCtx = newContextual[bool]("alertIncidentWithParentsCascading")
+	alertIncidentRelIncidentCtx          = newContextual[bool]("alert_incidents.incidents.alert_incidents.alert_incidents_incident_id_fkey")
 
 	// Relationship Contexts for goose_db_version
 	gooseDBVersionWithParentsCascadingCtx = newContextual[bool]("gooseDBVersionWithParentsCascading")
 
+	// Relationship Contexts for idempotency_keys
+	idempotencyKeyWithParentsCascadingCtx = newContextual[bool]("idempotencyKeyWithParentsCascading")
+
+	// Relationship Contexts for incident_checklist_items
+	incidentChecklistItemWithParentsCascadingCtx         = newContextual[bool]("incidentChecklistItemWithParentsCascading")
+	incidentChecklistItemRelIncidentIncidentChecklistCtx = newContextual[bool]("incident_checklist_items.incident_checklists.incident_checklist_items.incident_checklist_items_incident_id_fkey")
+
+	// Relationship Contexts for incident_checklists
+	incidentChecklistWithParentsCascadingCtx              = newContextual[bool]("incidentChecklistWithParentsCascading")
+	incidentChecklistRelIncidentIncidentChecklistItemsCtx = newContextual[bool]("incident_checklist_items.incident_checklists.incident_checklist_items.incident_checklist_items_incident_id_fkey")
+	incidentChecklistRelIncidentCtx                       = newContextual[bool]("incident_checklists.incidents.incident_checklists.incident_checklists_incident_id_fkey")
+
+	// Relationship Contexts for incident_escalations
+	incidentEscalationWithParentsCascadingCtx = newContextual[bool]("incidentEscalationWithParentsCascading")
+	incidentEscalationRelIncidentCtx          = newContextual[bool]("incident_escalations.incidents.incident_escalations.incident_escalations_incident_id_fkey")
+
+	// Relationship Contexts for incident_notifications
+	incidentNotificationWithParentsCascadingCtx = newContextual[bool]("incidentNotificationWithParentsCascading")
+	incidentNotificationRelIncidentCtx          = newContextual[bool]("incident_notifications.incidents.incident_notifications.incident_notifications_incident_id_fkey")
+
+	// Relationship Contexts for incident_roles
+	incidentRoleWithParentsCascadingCtx = newContextual[bool]("incidentRoleWithParentsCascading")
+	incidentRoleRelIncidentCtx          = newContextual[bool]("incident_roles.incidents.incident_roles.incident_roles_incident_id_fkey")
+
+	// Relationship Contexts for incident_services
+	incidentServiceWithParentsCascadingCtx = newContextual[bool]("incidentServiceWithParentsCascading")
+	incidentServiceRelIncidentCtx          = newContextual[bool]("incident_services.incidents.incident_services.incident_services_incident_id_fkey")
+
+	// Relationship Contexts for incident_subscribers
+	incidentSubscriberWithParentsCascadingCtx = newContextual[bool]("incidentSubscriberWithParentsCascading")
+	incidentSubscriberRelIncidentCtx          = newContextual[bool]("incident_subscribers.incidents.incident_subscribers.incident_subscribers_incident_id_fkey")
+
 	// Relationship Contexts for incidents
-	incidentWithParentsCascadingCtx = newContextual[bool]("incidentWithParentsCascading")
-	incidentRelTimelineEventsCtx    = newContextual[bool]("incidents.timeline_events.timeline_events.timeline_events_incident_id_fkey")
+	incidentWithParentsCascadingCtx     = newContextual[bool]("incidentWithParentsCascading")
+	incidentRelAlertIncidentsCtx        = newContextual[bool]("alert_incidents.incidents.alert_incidents.alert_incidents_incident_id_fkey")
+	incidentRelIncidentChecklistCtx     = newContextual[bool]("incident_checklists.incidents.incident_checklists.incident_checklists_incident_id_fkey")
+	incidentRelIncidentEscalationCtx    = newContextual[bool]("incident_escalations.incidents.incident_escalations.incident_escalations_incident_id_fkey")
+	incidentRelIncidentNotificationsCtx = newContextual[bool]("incident_notifications.incidents.incident_notifications.incident_notifications_incident_id_fkey")
+	incidentRelIncidentRolesCtx         = newContextual[bool]("incident_roles.incidents.incident_roles.incident_roles_incident_id_fkey")
+	incidentRelIncidentServicesCtx      = newContextual[bool]("incident_services.incidents.incident_services.incident_services_incident_id_fkey")
+	incidentRelIncidentSubscribersCtx   = newContextual[bool]("incident_subscribers.incidents.incident_subscribers.incident_subscribers_incident_id_fkey")
+	incidentRelTimelineEventsCtx        = newContextual[bool]("incidents.timeline_events.timeline_events.timeline_events_incident_id_fkey")
+
+	// Relationship Contexts for job_queue
+	jobQueueWithParentsCascadingCtx = newContextual[bool]("jobQueueWithParentsCascading")
+
+	// Relationship Contexts for job_runs
+	jobRunWithParentsCascadingCtx = newContextual[bool]("jobRunWithParentsCascading")
+
+	// Relationship Contexts for oncall_handoff_notes
+	oncallHandoffNoteWithParentsCascadingCtx = newContextual[bool]("oncallHandoffNoteWithParentsCascading")
+
+	// Relationship Contexts for oncall_overrides
+	oncallOverrideWithParentsCascadingCtx      = newContextual[bool]("oncallOverrideWithParentsCascading")
+	oncallOverrideRelScheduleOncallScheduleCtx = newContextual[bool]("oncall_overrides.oncall_schedules.oncall_overrides.oncall_overrides_schedule_id_fkey")
+
+	// Relationship Contexts for oncall_schedules
+	oncallScheduleWithParentsCascadingCtx       = newContextual[bool]("oncallScheduleWithParentsCascading")
+	oncallScheduleRelScheduleOncallOverridesCtx = newContextual[bool]("oncall_overrides.oncall_schedules.oncall_overrides.oncall_overrides_schedule_id_fkey")
+
+	// Relationship Contexts for services
+	serviceWithParentsCascadingCtx = newContextual[bool]("serviceWithParentsCascading")
+
+	// Relationship Contexts for slack_installations
+	slackInstallationWithParentsCascadingCtx = newContextual[bool]("slackInstallationWithParentsCascading")
 
 	// Relationship Contexts for timeline_events
 	timelineEventWithParentsCascadingCtx = newContextual[bool]("timelineEventWithParentsCascading")
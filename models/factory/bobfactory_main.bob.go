@@ -6,15 +6,43 @@ package factory
 import "context"
 
 type Factory struct {
-	baseGooseDBVersionMods GooseDBVersionModSlice
-	baseIncidentMods       IncidentModSlice
-	baseTimelineEventMods  TimelineEventModSlice
+	baseAlertIncidentMods         AlertIncidentModSlice
+	baseGooseDBVersionMods        GooseDBVersionModSlice
+	baseIdempotencyKeyMods        IdempotencyKeyModSlice
+	baseIncidentChecklistItemMods IncidentChecklistItemModSlice
+	baseIncidentChecklistMods     IncidentChecklistModSlice
+	baseIncidentEscalationMods    IncidentEscalationModSlice
+	baseIncidentNotificationMods  IncidentNotificationModSlice
+	baseIncidentRoleMods          IncidentRoleModSlice
+	baseIncidentServiceMods       IncidentServiceModSlice
+	baseIncidentSubscriberMods    IncidentSubscriberModSlice
+	baseIncidentMods              IncidentModSlice
+	baseJobQueueMods              JobQueueModSlice
+	baseJobRunMods                JobRunModSlice
+	baseOncallHandoffNoteMods     OncallHandoffNoteModSlice
+	baseOncallOverrideMods        OncallOverrideModSlice
+	baseOncallScheduleMods        OncallScheduleModSlice
+	baseServiceMods               ServiceModSlice
+	baseSlackInstallationMods     SlackInstallationModSlice
+	baseTimelineEventMods         TimelineEventModSlice
 }
 
 func New() *Factory {
 	return &Factory{}
 }
 
+func (f *Factory) NewAlertIncident(ctx context.Context, mods ...AlertIncidentMod) *AlertIncidentTemplate {
+	o := &AlertIncidentTemplate{f: f}
+
+	if f != nil {
+		f.baseAlertIncidentMods.Apply(ctx, o)
+	}
+
+	AlertIncidentModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
 func (f *Factory) NewGooseDBVersion(ctx context.Context, mods ...GooseDBVersionMod) *GooseDBVersionTemplate {
 	o := &GooseDBVersionTemplate{f: f}
 
@@ -27,6 +55,102 @@ func (f *Factory) NewGooseDBVersion(ctx context.Context, mods ...GooseDBVersionM
 	return o
 }
 
+func (f *Factory) NewIdempotencyKey(ctx context.Context, mods ...IdempotencyKeyMod) *IdempotencyKeyTemplate {
+	o := &IdempotencyKeyTemplate{f: f}
+
+	if f != nil {
+		f.baseIdempotencyKeyMods.Apply(ctx, o)
+	}
+
+	IdempotencyKeyModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) NewIncidentChecklistItem(ctx context.Context, mods ...IncidentChecklistItemMod) *IncidentChecklistItemTemplate {
+	o := &IncidentChecklistItemTemplate{f: f}
+
+	if f != nil {
+		f.baseIncidentChecklistItemMods.Apply(ctx, o)
+	}
+
+	IncidentChecklistItemModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) NewIncidentChecklist(ctx context.Context, mods ...IncidentChecklistMod) *IncidentChecklistTemplate {
+	o := &IncidentChecklistTemplate{f: f}
+
+	if f != nil {
+		f.baseIncidentChecklistMods.Apply(ctx, o)
+	}
+
+	IncidentChecklistModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) NewIncidentEscalation(ctx context.Context, mods ...IncidentEscalationMod) *IncidentEscalationTemplate {
+	o := &IncidentEscalationTemplate{f: f}
+
+	if f != nil {
+		f.baseIncidentEscalationMods.Apply(ctx, o)
+	}
+
+	IncidentEscalationModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) NewIncidentNotification(ctx context.Context, mods ...IncidentNotificationMod) *IncidentNotificationTemplate {
+	o := &IncidentNotificationTemplate{f: f}
+
+	if f != nil {
+		f.baseIncidentNotificationMods.Apply(ctx, o)
+	}
+
+	IncidentNotificationModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) NewIncidentRole(ctx context.Context, mods ...IncidentRoleMod) *IncidentRoleTemplate {
+	o := &IncidentRoleTemplate{f: f}
+
+	if f != nil {
+		f.baseIncidentRoleMods.Apply(ctx, o)
+	}
+
+	IncidentRoleModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) NewIncidentService(ctx context.Context, mods ...IncidentServiceMod) *IncidentServiceTemplate {
+	o := &IncidentServiceTemplate{f: f}
+
+	if f != nil {
+		f.baseIncidentServiceMods.Apply(ctx, o)
+	}
+
+	IncidentServiceModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) NewIncidentSubscriber(ctx context.Context, mods ...IncidentSubscriberMod) *IncidentSubscriberTemplate {
+	o := &IncidentSubscriberTemplate{f: f}
+
+	if f != nil {
+		f.baseIncidentSubscriberMods.Apply(ctx, o)
+	}
+
+	IncidentSubscriberModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
 func (f *Factory) NewIncident(ctx context.Context, mods ...IncidentMod) *IncidentTemplate {
 	o := &IncidentTemplate{f: f}
 
@@ -39,6 +163,90 @@ func (f *Factory) NewIncident(ctx context.Context, mods ...IncidentMod) *Inciden
 	return o
 }
 
+func (f *Factory) NewJobQueue(ctx context.Context, mods ...JobQueueMod) *JobQueueTemplate {
+	o := &JobQueueTemplate{f: f}
+
+	if f != nil {
+		f.baseJobQueueMods.Apply(ctx, o)
+	}
+
+	JobQueueModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) NewJobRun(ctx context.Context, mods ...JobRunMod) *JobRunTemplate {
+	o := &JobRunTemplate{f: f}
+
+	if f != nil {
+		f.baseJobRunMods.Apply(ctx, o)
+	}
+
+	JobRunModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) NewOncallHandoffNote(ctx context.Context, mods ...OncallHandoffNoteMod) *OncallHandoffNoteTemplate {
+	o := &OncallHandoffNoteTemplate{f: f}
+
+	if f != nil {
+		f.baseOncallHandoffNoteMods.Apply(ctx, o)
+	}
+
+	OncallHandoffNoteModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) NewOncallOverride(ctx context.Context, mods ...OncallOverrideMod) *OncallOverrideTemplate {
+	o := &OncallOverrideTemplate{f: f}
+
+	if f != nil {
+		f.baseOncallOverrideMods.Apply(ctx, o)
+	}
+
+	OncallOverrideModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) NewOncallSchedule(ctx context.Context, mods ...OncallScheduleMod) *OncallScheduleTemplate {
+	o := &OncallScheduleTemplate{f: f}
+
+	if f != nil {
+		f.baseOncallScheduleMods.Apply(ctx, o)
+	}
+
+	OncallScheduleModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) NewService(ctx context.Context, mods ...ServiceMod) *ServiceTemplate {
+	o := &ServiceTemplate{f: f}
+
+	if f != nil {
+		f.baseServiceMods.Apply(ctx, o)
+	}
+
+	ServiceModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) NewSlackInstallation(ctx context.Context, mods ...SlackInstallationMod) *SlackInstallationTemplate {
+	o := &SlackInstallationTemplate{f: f}
+
+	if f != nil {
+		f.baseSlackInstallationMods.Apply(ctx, o)
+	}
+
+	SlackInstallationModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
 func (f *Factory) NewTimelineEvent(ctx context.Context, mods ...TimelineEventMod) *TimelineEventTemplate {
 	o := &TimelineEventTemplate{f: f}
 
@@ -51,6 +259,14 @@ func (f *Factory) NewTimelineEvent(ctx context.Context, mods ...TimelineEventMod
 	return o
 }
 
+func (f *Factory) ClearBaseAlertIncidentMods() {
+	f.baseAlertIncidentMods = nil
+}
+
+func (f *Factory) AddBaseAlertIncidentMod(mods ...AlertIncidentMod) {
+	f.baseAlertIncidentMods = append(f.baseAlertIncidentMods, mods...)
+}
+
 func (f *Factory) ClearBaseGooseDBVersionMods() {
 	f.baseGooseDBVersionMods = nil
 }
@@ -59,6 +275,70 @@ func (f *Factory) AddBaseGooseDBVersionMod(mods ...GooseDBVersionMod) {
 	f.baseGooseDBVersionMods = append(f.baseGooseDBVersionMods, mods...)
 }
 
+func (f *Factory) ClearBaseIdempotencyKeyMods() {
+	f.baseIdempotencyKeyMods = nil
+}
+
+func (f *Factory) AddBaseIdempotencyKeyMod(mods ...IdempotencyKeyMod) {
+	f.baseIdempotencyKeyMods = append(f.baseIdempotencyKeyMods, mods...)
+}
+
+func (f *Factory) ClearBaseIncidentChecklistItemMods() {
+	f.baseIncidentChecklistItemMods = nil
+}
+
+func (f *Factory) AddBaseIncidentChecklistItemMod(mods ...IncidentChecklistItemMod) {
+	f.baseIncidentChecklistItemMods = append(f.baseIncidentChecklistItemMods, mods...)
+}
+
+func (f *Factory) ClearBaseIncidentChecklistMods() {
+	f.baseIncidentChecklistMods = nil
+}
+
+func (f *Factory) AddBaseIncidentChecklistMod(mods ...IncidentChecklistMod) {
+	f.baseIncidentChecklistMods = append(f.baseIncidentChecklistMods, mods...)
+}
+
+func (f *Factory) ClearBaseIncidentEscalationMods() {
+	f.baseIncidentEscalationMods = nil
+}
+
+func (f *Factory) AddBaseIncidentEscalationMod(mods ...IncidentEscalationMod) {
+	f.baseIncidentEscalationMods = append(f.baseIncidentEscalationMods, mods...)
+}
+
+func (f *Factory) ClearBaseIncidentNotificationMods() {
+	f.baseIncidentNotificationMods = nil
+}
+
+func (f *Factory) AddBaseIncidentNotificationMod(mods ...IncidentNotificationMod) {
+	f.baseIncidentNotificationMods = append(f.baseIncidentNotificationMods, mods...)
+}
+
+func (f *Factory) ClearBaseIncidentRoleMods() {
+	f.baseIncidentRoleMods = nil
+}
+
+func (f *Factory) AddBaseIncidentRoleMod(mods ...IncidentRoleMod) {
+	f.baseIncidentRoleMods = append(f.baseIncidentRoleMods, mods...)
+}
+
+func (f *Factory) ClearBaseIncidentServiceMods() {
+	f.baseIncidentServiceMods = nil
+}
+
+func (f *Factory) AddBaseIncidentServiceMod(mods ...IncidentServiceMod) {
+	f.baseIncidentServiceMods = append(f.baseIncidentServiceMods, mods...)
+}
+
+func (f *Factory) ClearBaseIncidentSubscriberMods() {
+	f.baseIncidentSubscriberMods = nil
+}
+
+func (f *Factory) AddBaseIncidentSubscriberMod(mods ...IncidentSubscriberMod) {
+	f.baseIncidentSubscriberMods = append(f.baseIncidentSubscriberMods, mods...)
+}
+
 func (f *Factory) ClearBaseIncidentMods() {
 	f.baseIncidentMods = nil
 }
@@ -67,6 +347,62 @@ func (f *Factory) AddBaseIncidentMod(mods ...IncidentMod) {
 	f.baseIncidentMods = append(f.baseIncidentMods, mods...)
 }
 
+func (f *Factory) ClearBaseJobQueueMods() {
+	f.baseJobQueueMods = nil
+}
+
+func (f *Factory) AddBaseJobQueueMod(mods ...JobQueueMod) {
+	f.baseJobQueueMods = append(f.baseJobQueueMods, mods...)
+}
+
+func (f *Factory) ClearBaseJobRunMods() {
+	f.baseJobRunMods = nil
+}
+
+func (f *Factory) AddBaseJobRunMod(mods ...JobRunMod) {
+	f.baseJobRunMods = append(f.baseJobRunMods, mods...)
+}
+
+func (f *Factory) ClearBaseOncallHandoffNoteMods() {
+	f.baseOncallHandoffNoteMods = nil
+}
+
+func (f *Factory) AddBaseOncallHandoffNoteMod(mods ...OncallHandoffNoteMod) {
+	f.baseOncallHandoffNoteMods = append(f.baseOncallHandoffNoteMods, mods...)
+}
+
+func (f *Factory) ClearBaseOncallOverrideMods() {
+	f.baseOncallOverrideMods = nil
+}
+
+func (f *Factory) AddBaseOncallOverrideMod(mods ...OncallOverrideMod) {
+	f.baseOncallOverrideMods = append(f.baseOncallOverrideMods, mods...)
+}
+
+func (f *Factory) ClearBaseOncallScheduleMods() {
+	f.baseOncallScheduleMods = nil
+}
+
+func (f *Factory) AddBaseOncallScheduleMod(mods ...OncallScheduleMod) {
+	f.baseOncallScheduleMods = append(f.baseOncallScheduleMods, mods...)
+}
+
+func (f *Factory) ClearBaseServiceMods() {
+	f.baseServiceMods = nil
+}
+
+func (f *Factory) AddBaseServiceMod(mods ...ServiceMod) {
+	f.baseServiceMods = append(f.baseServiceMods, mods...)
+}
+
+func (f *Factory) ClearBaseSlackInstallationMods() {
+	f.baseSlackInstallationMods = nil
+}
+
+func (f *Factory) AddBaseSlackInstallationMod(mods ...SlackInstallationMod) {
+	f.baseSlackInstallationMods = append(f.baseSlackInstallationMods, mods...)
+}
+
 func (f *Factory) ClearBaseTimelineEventMods() {
 	f.baseTimelineEventMods = nil
 }
@@ -13,6 +13,7 @@ import (
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/jaswdr/faker/v2"
+	"github.com/lib/pq"
 	"github.com/stephenafamo/bob/types"
 )
 
@@ -42,6 +43,18 @@ func random_int64(f *faker.Faker, limits ...string) int64 {
 	return f.Int64()
 }
 
+func random_pq_StringArray(f *faker.Faker, limits ...string) pq.StringArray {
+	if f == nil {
+		f = &defaultFaker
+	}
+
+	arr := make(pq.StringArray, f.IntBetween(1, 5))
+	for i := range arr {
+		arr[i] = random_string(f, limits...)
+	}
+	return arr
+}
+
 func random_string(f *faker.Faker, limits ...string) string {
 	if f == nil {
 		f = &defaultFaker
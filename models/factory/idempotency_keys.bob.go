@@ -0,0 +1,461 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	models "github.com/fishnix/ohshift/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/types"
+)
+
+type IdempotencyKeyMod interface {
+	Apply(context.Context, *IdempotencyKeyTemplate)
+}
+
+type IdempotencyKeyModFunc func(context.Context, *IdempotencyKeyTemplate)
+
+func (f IdempotencyKeyModFunc) Apply(ctx context.Context, n *IdempotencyKeyTemplate) {
+	f(ctx, n)
+}
+
+type IdempotencyKeyModSlice []IdempotencyKeyMod
+
+func (mods IdempotencyKeyModSlice) Apply(ctx context.Context, n *IdempotencyKeyTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// IdempotencyKeyTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type IdempotencyKeyTemplate struct {
+	Key         func() string
+	Fingerprint func() string
+	Response    func() sql.Null[types.JSON[json.RawMessage]]
+	CreatedAt   func() time.Time
+	ExpiresAt   func() time.Time
+
+	f *Factory
+}
+
+// Apply mods to the IdempotencyKeyTemplate
+func (o *IdempotencyKeyTemplate) Apply(ctx context.Context, mods ...IdempotencyKeyMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.IdempotencyKey
+// according to the relationships in the template. Nothing is inserted into the db
+func (t IdempotencyKeyTemplate) setModelRels(o *models.IdempotencyKey) {}
+
+// BuildSetter returns an *models.IdempotencyKeySetter
+// this does nothing with the relationship templates
+func (o IdempotencyKeyTemplate) BuildSetter() *models.IdempotencyKeySetter {
+	m := &models.IdempotencyKeySetter{}
+
+	if o.Key != nil {
+		val := o.Key()
+		m.Key = &val
+	}
+	if o.Fingerprint != nil {
+		val := o.Fingerprint()
+		m.Fingerprint = &val
+	}
+	if o.Response != nil {
+		val := o.Response()
+		m.Response = &val
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = &val
+	}
+	if o.ExpiresAt != nil {
+		val := o.ExpiresAt()
+		m.ExpiresAt = &val
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.IdempotencyKeySetter
+// this does nothing with the relationship templates
+func (o IdempotencyKeyTemplate) BuildManySetter(number int) []*models.IdempotencyKeySetter {
+	m := make([]*models.IdempotencyKeySetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.IdempotencyKey
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use IdempotencyKeyTemplate.Create
+func (o IdempotencyKeyTemplate) Build() *models.IdempotencyKey {
+	m := &models.IdempotencyKey{}
+
+	if o.Key != nil {
+		m.Key = o.Key()
+	}
+	if o.Fingerprint != nil {
+		m.Fingerprint = o.Fingerprint()
+	}
+	if o.Response != nil {
+		m.Response = o.Response()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.ExpiresAt != nil {
+		m.ExpiresAt = o.ExpiresAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.IdempotencyKeySlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use IdempotencyKeyTemplate.CreateMany
+func (o IdempotencyKeyTemplate) BuildMany(number int) models.IdempotencyKeySlice {
+	m := make(models.IdempotencyKeySlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableIdempotencyKey(m *models.IdempotencyKeySetter) {
+	if m.Key == nil {
+		val := random_string(nil, "255")
+		m.Key = &val
+	}
+	if m.Fingerprint == nil {
+		val := random_string(nil, "64")
+		m.Fingerprint = &val
+	}
+	if m.ExpiresAt == nil {
+		val := random_time_Time(nil)
+		m.ExpiresAt = &val
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.IdempotencyKey
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *IdempotencyKeyTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.IdempotencyKey) (context.Context, error) {
+	var err error
+
+	return ctx, err
+}
+
+// Create builds a idempotencyKey and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *IdempotencyKeyTemplate) Create(ctx context.Context, exec bob.Executor) (*models.IdempotencyKey, error) {
+	_, m, err := o.create(ctx, exec)
+	return m, err
+}
+
+// MustCreate builds a idempotencyKey and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *IdempotencyKeyTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.IdempotencyKey {
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a idempotencyKey and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *IdempotencyKeyTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.IdempotencyKey {
+	tb.Helper()
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// create builds a idempotencyKey and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted model
+func (o *IdempotencyKeyTemplate) create(ctx context.Context, exec bob.Executor) (context.Context, *models.IdempotencyKey, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableIdempotencyKey(opt)
+
+	m, err := models.IdempotencyKeys.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return ctx, nil, err
+	}
+	ctx = idempotencyKeyCtx.WithValue(ctx, m)
+
+	ctx, err = o.insertOptRels(ctx, exec, m)
+	return ctx, m, err
+}
+
+// CreateMany builds multiple idempotencyKeys and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o IdempotencyKeyTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.IdempotencyKeySlice, error) {
+	_, m, err := o.createMany(ctx, exec, number)
+	return m, err
+}
+
+// MustCreateMany builds multiple idempotencyKeys and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o IdempotencyKeyTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.IdempotencyKeySlice {
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple idempotencyKeys and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o IdempotencyKeyTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.IdempotencyKeySlice {
+	tb.Helper()
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// createMany builds multiple idempotencyKeys and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted models
+func (o IdempotencyKeyTemplate) createMany(ctx context.Context, exec bob.Executor, number int) (context.Context, models.IdempotencyKeySlice, error) {
+	var err error
+	m := make(models.IdempotencyKeySlice, number)
+
+	for i := range m {
+		ctx, m[i], err = o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	return ctx, m, nil
+}
+
+// IdempotencyKey has methods that act as mods for the IdempotencyKeyTemplate
+var IdempotencyKeyMods idempotencyKeyMods
+
+type idempotencyKeyMods struct{}
+
+func (m idempotencyKeyMods) RandomizeAllColumns(f *faker.Faker) IdempotencyKeyMod {
+	return IdempotencyKeyModSlice{
+		IdempotencyKeyMods.RandomKey(f),
+		IdempotencyKeyMods.RandomFingerprint(f),
+		IdempotencyKeyMods.RandomResponse(f),
+		IdempotencyKeyMods.RandomCreatedAt(f),
+		IdempotencyKeyMods.RandomExpiresAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m idempotencyKeyMods) Key(val string) IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.Key = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m idempotencyKeyMods) KeyFunc(f func() string) IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.Key = f
+	})
+}
+
+// Clear any values for the column
+func (m idempotencyKeyMods) UnsetKey() IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.Key = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m idempotencyKeyMods) RandomKey(f *faker.Faker) IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.Key = func() string {
+			return random_string(f, "255")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m idempotencyKeyMods) Fingerprint(val string) IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.Fingerprint = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m idempotencyKeyMods) FingerprintFunc(f func() string) IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.Fingerprint = f
+	})
+}
+
+// Clear any values for the column
+func (m idempotencyKeyMods) UnsetFingerprint() IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.Fingerprint = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m idempotencyKeyMods) RandomFingerprint(f *faker.Faker) IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.Fingerprint = func() string {
+			return random_string(f, "64")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m idempotencyKeyMods) Response(val sql.Null[types.JSON[json.RawMessage]]) IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.Response = func() sql.Null[types.JSON[json.RawMessage]] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m idempotencyKeyMods) ResponseFunc(f func() sql.Null[types.JSON[json.RawMessage]]) IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.Response = f
+	})
+}
+
+// Clear any values for the column
+func (m idempotencyKeyMods) UnsetResponse() IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.Response = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m idempotencyKeyMods) RandomResponse(f *faker.Faker) IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.Response = func() sql.Null[types.JSON[json.RawMessage]] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_types_JSON_json_RawMessage_(f)
+			return sql.Null[types.JSON[json.RawMessage]]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m idempotencyKeyMods) RandomResponseNotNull(f *faker.Faker) IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.Response = func() sql.Null[types.JSON[json.RawMessage]] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_types_JSON_json_RawMessage_(f)
+			return sql.Null[types.JSON[json.RawMessage]]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m idempotencyKeyMods) CreatedAt(val time.Time) IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m idempotencyKeyMods) CreatedAtFunc(f func() time.Time) IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m idempotencyKeyMods) UnsetCreatedAt() IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m idempotencyKeyMods) RandomCreatedAt(f *faker.Faker) IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m idempotencyKeyMods) ExpiresAt(val time.Time) IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.ExpiresAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m idempotencyKeyMods) ExpiresAtFunc(f func() time.Time) IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.ExpiresAt = f
+	})
+}
+
+// Clear any values for the column
+func (m idempotencyKeyMods) UnsetExpiresAt() IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.ExpiresAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m idempotencyKeyMods) RandomExpiresAt(f *faker.Faker) IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(_ context.Context, o *IdempotencyKeyTemplate) {
+		o.ExpiresAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m idempotencyKeyMods) WithParentsCascading() IdempotencyKeyMod {
+	return IdempotencyKeyModFunc(func(ctx context.Context, o *IdempotencyKeyTemplate) {
+		if isDone, _ := idempotencyKeyWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = idempotencyKeyWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
@@ -0,0 +1,581 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	models "github.com/fishnix/ohshift/models"
+	"github.com/gofrs/uuid/v5"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type IncidentChecklistItemMod interface {
+	Apply(context.Context, *IncidentChecklistItemTemplate)
+}
+
+type IncidentChecklistItemModFunc func(context.Context, *IncidentChecklistItemTemplate)
+
+func (f IncidentChecklistItemModFunc) Apply(ctx context.Context, n *IncidentChecklistItemTemplate) {
+	f(ctx, n)
+}
+
+type IncidentChecklistItemModSlice []IncidentChecklistItemMod
+
+func (mods IncidentChecklistItemModSlice) Apply(ctx context.Context, n *IncidentChecklistItemTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// IncidentChecklistItemTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type IncidentChecklistItemTemplate struct {
+	IncidentID  func() uuid.UUID
+	Position    func() int32
+	Text        func() string
+	Required    func() bool
+	CompletedBy func() sql.Null[string]
+	CompletedAt func() sql.Null[time.Time]
+
+	r incidentChecklistItemR
+	f *Factory
+}
+
+type incidentChecklistItemR struct {
+	IncidentIncidentChecklist *incidentChecklistItemRIncidentIncidentChecklistR
+}
+
+type incidentChecklistItemRIncidentIncidentChecklistR struct {
+	o *IncidentChecklistTemplate
+}
+
+// Apply mods to the IncidentChecklistItemTemplate
+func (o *IncidentChecklistItemTemplate) Apply(ctx context.Context, mods ...IncidentChecklistItemMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.IncidentChecklistItem
+// according to the relationships in the template. Nothing is inserted into the db
+func (t IncidentChecklistItemTemplate) setModelRels(o *models.IncidentChecklistItem) {
+	if t.r.IncidentIncidentChecklist != nil {
+		rel := t.r.IncidentIncidentChecklist.o.Build()
+		rel.R.IncidentIncidentChecklistItems = append(rel.R.IncidentIncidentChecklistItems, o)
+		o.IncidentID = rel.IncidentID // h2
+		o.R.IncidentIncidentChecklist = rel
+	}
+}
+
+// BuildSetter returns an *models.IncidentChecklistItemSetter
+// this does nothing with the relationship templates
+func (o IncidentChecklistItemTemplate) BuildSetter() *models.IncidentChecklistItemSetter {
+	m := &models.IncidentChecklistItemSetter{}
+
+	if o.IncidentID != nil {
+		val := o.IncidentID()
+		m.IncidentID = &val
+	}
+	if o.Position != nil {
+		val := o.Position()
+		m.Position = &val
+	}
+	if o.Text != nil {
+		val := o.Text()
+		m.Text = &val
+	}
+	if o.Required != nil {
+		val := o.Required()
+		m.Required = &val
+	}
+	if o.CompletedBy != nil {
+		val := o.CompletedBy()
+		m.CompletedBy = &val
+	}
+	if o.CompletedAt != nil {
+		val := o.CompletedAt()
+		m.CompletedAt = &val
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.IncidentChecklistItemSetter
+// this does nothing with the relationship templates
+func (o IncidentChecklistItemTemplate) BuildManySetter(number int) []*models.IncidentChecklistItemSetter {
+	m := make([]*models.IncidentChecklistItemSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.IncidentChecklistItem
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use IncidentChecklistItemTemplate.Create
+func (o IncidentChecklistItemTemplate) Build() *models.IncidentChecklistItem {
+	m := &models.IncidentChecklistItem{}
+
+	if o.IncidentID != nil {
+		m.IncidentID = o.IncidentID()
+	}
+	if o.Position != nil {
+		m.Position = o.Position()
+	}
+	if o.Text != nil {
+		m.Text = o.Text()
+	}
+	if o.Required != nil {
+		m.Required = o.Required()
+	}
+	if o.CompletedBy != nil {
+		m.CompletedBy = o.CompletedBy()
+	}
+	if o.CompletedAt != nil {
+		m.CompletedAt = o.CompletedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.IncidentChecklistItemSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use IncidentChecklistItemTemplate.CreateMany
+func (o IncidentChecklistItemTemplate) BuildMany(number int) models.IncidentChecklistItemSlice {
+	m := make(models.IncidentChecklistItemSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableIncidentChecklistItem(m *models.IncidentChecklistItemSetter) {
+	if m.IncidentID == nil {
+		val := random_uuid_UUID(nil)
+		m.IncidentID = &val
+	}
+	if m.Position == nil {
+		val := random_int32(nil)
+		m.Position = &val
+	}
+	if m.Text == nil {
+		val := random_string(nil)
+		m.Text = &val
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.IncidentChecklistItem
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *IncidentChecklistItemTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.IncidentChecklistItem) (context.Context, error) {
+	var err error
+
+	return ctx, err
+}
+
+// Create builds a incidentChecklistItem and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *IncidentChecklistItemTemplate) Create(ctx context.Context, exec bob.Executor) (*models.IncidentChecklistItem, error) {
+	_, m, err := o.create(ctx, exec)
+	return m, err
+}
+
+// MustCreate builds a incidentChecklistItem and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *IncidentChecklistItemTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.IncidentChecklistItem {
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a incidentChecklistItem and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *IncidentChecklistItemTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.IncidentChecklistItem {
+	tb.Helper()
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// create builds a incidentChecklistItem and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted model
+func (o *IncidentChecklistItemTemplate) create(ctx context.Context, exec bob.Executor) (context.Context, *models.IncidentChecklistItem, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableIncidentChecklistItem(opt)
+
+	if o.r.IncidentIncidentChecklist == nil {
+		IncidentChecklistItemMods.WithNewIncidentIncidentChecklist().Apply(ctx, o)
+	}
+
+	rel0, ok := incidentChecklistCtx.Value(ctx)
+	if !ok {
+		ctx, rel0, err = o.r.IncidentIncidentChecklist.o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	opt.IncidentID = &rel0.IncidentID
+
+	m, err := models.IncidentChecklistItems.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return ctx, nil, err
+	}
+	ctx = incidentChecklistItemCtx.WithValue(ctx, m)
+
+	m.R.IncidentIncidentChecklist = rel0
+
+	ctx, err = o.insertOptRels(ctx, exec, m)
+	return ctx, m, err
+}
+
+// CreateMany builds multiple incidentChecklistItems and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o IncidentChecklistItemTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.IncidentChecklistItemSlice, error) {
+	_, m, err := o.createMany(ctx, exec, number)
+	return m, err
+}
+
+// MustCreateMany builds multiple incidentChecklistItems and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o IncidentChecklistItemTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.IncidentChecklistItemSlice {
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple incidentChecklistItems and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o IncidentChecklistItemTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.IncidentChecklistItemSlice {
+	tb.Helper()
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// createMany builds multiple incidentChecklistItems and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted models
+func (o IncidentChecklistItemTemplate) createMany(ctx context.Context, exec bob.Executor, number int) (context.Context, models.IncidentChecklistItemSlice, error) {
+	var err error
+	m := make(models.IncidentChecklistItemSlice, number)
+
+	for i := range m {
+		ctx, m[i], err = o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	return ctx, m, nil
+}
+
+// IncidentChecklistItem has methods that act as mods for the IncidentChecklistItemTemplate
+var IncidentChecklistItemMods incidentChecklistItemMods
+
+type incidentChecklistItemMods struct{}
+
+func (m incidentChecklistItemMods) RandomizeAllColumns(f *faker.Faker) IncidentChecklistItemMod {
+	return IncidentChecklistItemModSlice{
+		IncidentChecklistItemMods.RandomIncidentID(f),
+		IncidentChecklistItemMods.RandomPosition(f),
+		IncidentChecklistItemMods.RandomText(f),
+		IncidentChecklistItemMods.RandomRequired(f),
+		IncidentChecklistItemMods.RandomCompletedBy(f),
+		IncidentChecklistItemMods.RandomCompletedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m incidentChecklistItemMods) IncidentID(val uuid.UUID) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.IncidentID = func() uuid.UUID { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentChecklistItemMods) IncidentIDFunc(f func() uuid.UUID) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.IncidentID = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentChecklistItemMods) UnsetIncidentID() IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.IncidentID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentChecklistItemMods) RandomIncidentID(f *faker.Faker) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.IncidentID = func() uuid.UUID {
+			return random_uuid_UUID(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentChecklistItemMods) Position(val int32) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.Position = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentChecklistItemMods) PositionFunc(f func() int32) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.Position = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentChecklistItemMods) UnsetPosition() IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.Position = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentChecklistItemMods) RandomPosition(f *faker.Faker) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.Position = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentChecklistItemMods) Text(val string) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.Text = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentChecklistItemMods) TextFunc(f func() string) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.Text = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentChecklistItemMods) UnsetText() IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.Text = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentChecklistItemMods) RandomText(f *faker.Faker) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.Text = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentChecklistItemMods) Required(val bool) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.Required = func() bool { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentChecklistItemMods) RequiredFunc(f func() bool) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.Required = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentChecklistItemMods) UnsetRequired() IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.Required = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentChecklistItemMods) RandomRequired(f *faker.Faker) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.Required = func() bool {
+			return random_bool(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentChecklistItemMods) CompletedBy(val sql.Null[string]) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.CompletedBy = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentChecklistItemMods) CompletedByFunc(f func() sql.Null[string]) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.CompletedBy = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentChecklistItemMods) UnsetCompletedBy() IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.CompletedBy = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m incidentChecklistItemMods) RandomCompletedBy(f *faker.Faker) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.CompletedBy = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return sql.Null[string]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m incidentChecklistItemMods) RandomCompletedByNotNull(f *faker.Faker) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.CompletedBy = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return sql.Null[string]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentChecklistItemMods) CompletedAt(val sql.Null[time.Time]) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.CompletedAt = func() sql.Null[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentChecklistItemMods) CompletedAtFunc(f func() sql.Null[time.Time]) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.CompletedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentChecklistItemMods) UnsetCompletedAt() IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.CompletedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m incidentChecklistItemMods) RandomCompletedAt(f *faker.Faker) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.CompletedAt = func() sql.Null[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return sql.Null[time.Time]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m incidentChecklistItemMods) RandomCompletedAtNotNull(f *faker.Faker) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(_ context.Context, o *IncidentChecklistItemTemplate) {
+		o.CompletedAt = func() sql.Null[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return sql.Null[time.Time]{V: val, Valid: true}
+		}
+	})
+}
+
+func (m incidentChecklistItemMods) WithParentsCascading() IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(ctx context.Context, o *IncidentChecklistItemTemplate) {
+		if isDone, _ := incidentChecklistItemWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = incidentChecklistItemWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewIncidentChecklist(ctx, IncidentChecklistMods.WithParentsCascading())
+			m.WithIncidentIncidentChecklist(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m incidentChecklistItemMods) WithIncidentIncidentChecklist(rel *IncidentChecklistTemplate) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(ctx context.Context, o *IncidentChecklistItemTemplate) {
+		o.r.IncidentIncidentChecklist = &incidentChecklistItemRIncidentIncidentChecklistR{
+			o: rel,
+		}
+	})
+}
+
+func (m incidentChecklistItemMods) WithNewIncidentIncidentChecklist(mods ...IncidentChecklistMod) IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(ctx context.Context, o *IncidentChecklistItemTemplate) {
+		related := o.f.NewIncidentChecklist(ctx, mods...)
+
+		m.WithIncidentIncidentChecklist(related).Apply(ctx, o)
+	})
+}
+
+func (m incidentChecklistItemMods) WithoutIncidentIncidentChecklist() IncidentChecklistItemMod {
+	return IncidentChecklistItemModFunc(func(ctx context.Context, o *IncidentChecklistItemTemplate) {
+		o.r.IncidentIncidentChecklist = nil
+	})
+}
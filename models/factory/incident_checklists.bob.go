@@ -0,0 +1,567 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	models "github.com/fishnix/ohshift/models"
+	"github.com/gofrs/uuid/v5"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type IncidentChecklistMod interface {
+	Apply(context.Context, *IncidentChecklistTemplate)
+}
+
+type IncidentChecklistModFunc func(context.Context, *IncidentChecklistTemplate)
+
+func (f IncidentChecklistModFunc) Apply(ctx context.Context, n *IncidentChecklistTemplate) {
+	f(ctx, n)
+}
+
+type IncidentChecklistModSlice []IncidentChecklistMod
+
+func (mods IncidentChecklistModSlice) Apply(ctx context.Context, n *IncidentChecklistTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// IncidentChecklistTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type IncidentChecklistTemplate struct {
+	IncidentID     func() uuid.UUID
+	SlackChannelID func() string
+	SlackMessageTS func() sql.Null[string]
+	NextReminder   func() sql.Null[time.Time]
+
+	r incidentChecklistR
+	f *Factory
+}
+
+type incidentChecklistR struct {
+	IncidentIncidentChecklistItems []*incidentChecklistRIncidentIncidentChecklistItemsR
+	Incident                       *incidentChecklistRIncidentR
+}
+
+type incidentChecklistRIncidentIncidentChecklistItemsR struct {
+	number int
+	o      *IncidentChecklistItemTemplate
+}
+type incidentChecklistRIncidentR struct {
+	o *IncidentTemplate
+}
+
+// Apply mods to the IncidentChecklistTemplate
+func (o *IncidentChecklistTemplate) Apply(ctx context.Context, mods ...IncidentChecklistMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.IncidentChecklist
+// according to the relationships in the template. Nothing is inserted into the db
+func (t IncidentChecklistTemplate) setModelRels(o *models.IncidentChecklist) {
+	if t.r.IncidentIncidentChecklistItems != nil {
+		rel := models.IncidentChecklistItemSlice{}
+		for _, r := range t.r.IncidentIncidentChecklistItems {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.IncidentID = o.IncidentID // h2
+				rel.R.IncidentIncidentChecklist = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.IncidentIncidentChecklistItems = rel
+	}
+
+	if t.r.Incident != nil {
+		rel := t.r.Incident.o.Build()
+		rel.R.IncidentChecklist = o
+		o.IncidentID = rel.ID // h2
+		o.R.Incident = rel
+	}
+}
+
+// BuildSetter returns an *models.IncidentChecklistSetter
+// this does nothing with the relationship templates
+func (o IncidentChecklistTemplate) BuildSetter() *models.IncidentChecklistSetter {
+	m := &models.IncidentChecklistSetter{}
+
+	if o.IncidentID != nil {
+		val := o.IncidentID()
+		m.IncidentID = &val
+	}
+	if o.SlackChannelID != nil {
+		val := o.SlackChannelID()
+		m.SlackChannelID = &val
+	}
+	if o.SlackMessageTS != nil {
+		val := o.SlackMessageTS()
+		m.SlackMessageTS = &val
+	}
+	if o.NextReminder != nil {
+		val := o.NextReminder()
+		m.NextReminder = &val
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.IncidentChecklistSetter
+// this does nothing with the relationship templates
+func (o IncidentChecklistTemplate) BuildManySetter(number int) []*models.IncidentChecklistSetter {
+	m := make([]*models.IncidentChecklistSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.IncidentChecklist
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use IncidentChecklistTemplate.Create
+func (o IncidentChecklistTemplate) Build() *models.IncidentChecklist {
+	m := &models.IncidentChecklist{}
+
+	if o.IncidentID != nil {
+		m.IncidentID = o.IncidentID()
+	}
+	if o.SlackChannelID != nil {
+		m.SlackChannelID = o.SlackChannelID()
+	}
+	if o.SlackMessageTS != nil {
+		m.SlackMessageTS = o.SlackMessageTS()
+	}
+	if o.NextReminder != nil {
+		m.NextReminder = o.NextReminder()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.IncidentChecklistSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use IncidentChecklistTemplate.CreateMany
+func (o IncidentChecklistTemplate) BuildMany(number int) models.IncidentChecklistSlice {
+	m := make(models.IncidentChecklistSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableIncidentChecklist(m *models.IncidentChecklistSetter) {
+	if m.IncidentID == nil {
+		val := random_uuid_UUID(nil)
+		m.IncidentID = &val
+	}
+	if m.SlackChannelID == nil {
+		val := random_string(nil)
+		m.SlackChannelID = &val
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.IncidentChecklist
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *IncidentChecklistTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.IncidentChecklist) (context.Context, error) {
+	var err error
+
+	isIncidentIncidentChecklistItemsDone, _ := incidentChecklistRelIncidentIncidentChecklistItemsCtx.Value(ctx)
+	if !isIncidentIncidentChecklistItemsDone && o.r.IncidentIncidentChecklistItems != nil {
+		ctx = incidentChecklistRelIncidentIncidentChecklistItemsCtx.WithValue(ctx, true)
+		for _, r := range o.r.IncidentIncidentChecklistItems {
+			var rel0 models.IncidentChecklistItemSlice
+			ctx, rel0, err = r.o.createMany(ctx, exec, r.number)
+			if err != nil {
+				return ctx, err
+			}
+
+			err = m.AttachIncidentIncidentChecklistItems(ctx, exec, rel0...)
+			if err != nil {
+				return ctx, err
+			}
+		}
+	}
+
+	return ctx, err
+}
+
+// Create builds a incidentChecklist and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *IncidentChecklistTemplate) Create(ctx context.Context, exec bob.Executor) (*models.IncidentChecklist, error) {
+	_, m, err := o.create(ctx, exec)
+	return m, err
+}
+
+// MustCreate builds a incidentChecklist and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *IncidentChecklistTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.IncidentChecklist {
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a incidentChecklist and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *IncidentChecklistTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.IncidentChecklist {
+	tb.Helper()
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// create builds a incidentChecklist and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted model
+func (o *IncidentChecklistTemplate) create(ctx context.Context, exec bob.Executor) (context.Context, *models.IncidentChecklist, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableIncidentChecklist(opt)
+
+	if o.r.Incident == nil {
+		IncidentChecklistMods.WithNewIncident().Apply(ctx, o)
+	}
+
+	ctx, rel1, err := o.r.Incident.o.create(ctx, exec)
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	opt.IncidentID = &rel1.ID
+
+	m, err := models.IncidentChecklists.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return ctx, nil, err
+	}
+	ctx = incidentChecklistCtx.WithValue(ctx, m)
+
+	m.R.Incident = rel1
+
+	ctx, err = o.insertOptRels(ctx, exec, m)
+	return ctx, m, err
+}
+
+// CreateMany builds multiple incidentChecklists and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o IncidentChecklistTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.IncidentChecklistSlice, error) {
+	_, m, err := o.createMany(ctx, exec, number)
+	return m, err
+}
+
+// MustCreateMany builds multiple incidentChecklists and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o IncidentChecklistTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.IncidentChecklistSlice {
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple incidentChecklists and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o IncidentChecklistTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.IncidentChecklistSlice {
+	tb.Helper()
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// createMany builds multiple incidentChecklists and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted models
+func (o IncidentChecklistTemplate) createMany(ctx context.Context, exec bob.Executor, number int) (context.Context, models.IncidentChecklistSlice, error) {
+	var err error
+	m := make(models.IncidentChecklistSlice, number)
+
+	for i := range m {
+		ctx, m[i], err = o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	return ctx, m, nil
+}
+
+// IncidentChecklist has methods that act as mods for the IncidentChecklistTemplate
+var IncidentChecklistMods incidentChecklistMods
+
+type incidentChecklistMods struct{}
+
+func (m incidentChecklistMods) RandomizeAllColumns(f *faker.Faker) IncidentChecklistMod {
+	return IncidentChecklistModSlice{
+		IncidentChecklistMods.RandomIncidentID(f),
+		IncidentChecklistMods.RandomSlackChannelID(f),
+		IncidentChecklistMods.RandomSlackMessageTS(f),
+		IncidentChecklistMods.RandomNextReminder(f),
+	}
+}
+
+// Set the model columns to this value
+func (m incidentChecklistMods) IncidentID(val uuid.UUID) IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(_ context.Context, o *IncidentChecklistTemplate) {
+		o.IncidentID = func() uuid.UUID { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentChecklistMods) IncidentIDFunc(f func() uuid.UUID) IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(_ context.Context, o *IncidentChecklistTemplate) {
+		o.IncidentID = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentChecklistMods) UnsetIncidentID() IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(_ context.Context, o *IncidentChecklistTemplate) {
+		o.IncidentID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentChecklistMods) RandomIncidentID(f *faker.Faker) IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(_ context.Context, o *IncidentChecklistTemplate) {
+		o.IncidentID = func() uuid.UUID {
+			return random_uuid_UUID(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentChecklistMods) SlackChannelID(val string) IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(_ context.Context, o *IncidentChecklistTemplate) {
+		o.SlackChannelID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentChecklistMods) SlackChannelIDFunc(f func() string) IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(_ context.Context, o *IncidentChecklistTemplate) {
+		o.SlackChannelID = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentChecklistMods) UnsetSlackChannelID() IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(_ context.Context, o *IncidentChecklistTemplate) {
+		o.SlackChannelID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentChecklistMods) RandomSlackChannelID(f *faker.Faker) IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(_ context.Context, o *IncidentChecklistTemplate) {
+		o.SlackChannelID = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentChecklistMods) SlackMessageTS(val sql.Null[string]) IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(_ context.Context, o *IncidentChecklistTemplate) {
+		o.SlackMessageTS = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentChecklistMods) SlackMessageTSFunc(f func() sql.Null[string]) IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(_ context.Context, o *IncidentChecklistTemplate) {
+		o.SlackMessageTS = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentChecklistMods) UnsetSlackMessageTS() IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(_ context.Context, o *IncidentChecklistTemplate) {
+		o.SlackMessageTS = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m incidentChecklistMods) RandomSlackMessageTS(f *faker.Faker) IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(_ context.Context, o *IncidentChecklistTemplate) {
+		o.SlackMessageTS = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return sql.Null[string]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m incidentChecklistMods) RandomSlackMessageTSNotNull(f *faker.Faker) IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(_ context.Context, o *IncidentChecklistTemplate) {
+		o.SlackMessageTS = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return sql.Null[string]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentChecklistMods) NextReminder(val sql.Null[time.Time]) IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(_ context.Context, o *IncidentChecklistTemplate) {
+		o.NextReminder = func() sql.Null[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentChecklistMods) NextReminderFunc(f func() sql.Null[time.Time]) IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(_ context.Context, o *IncidentChecklistTemplate) {
+		o.NextReminder = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentChecklistMods) UnsetNextReminder() IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(_ context.Context, o *IncidentChecklistTemplate) {
+		o.NextReminder = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m incidentChecklistMods) RandomNextReminder(f *faker.Faker) IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(_ context.Context, o *IncidentChecklistTemplate) {
+		o.NextReminder = func() sql.Null[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return sql.Null[time.Time]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m incidentChecklistMods) RandomNextReminderNotNull(f *faker.Faker) IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(_ context.Context, o *IncidentChecklistTemplate) {
+		o.NextReminder = func() sql.Null[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return sql.Null[time.Time]{V: val, Valid: true}
+		}
+	})
+}
+
+func (m incidentChecklistMods) WithParentsCascading() IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(ctx context.Context, o *IncidentChecklistTemplate) {
+		if isDone, _ := incidentChecklistWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = incidentChecklistWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewIncident(ctx, IncidentMods.WithParentsCascading())
+			m.WithIncident(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m incidentChecklistMods) WithIncident(rel *IncidentTemplate) IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(ctx context.Context, o *IncidentChecklistTemplate) {
+		o.r.Incident = &incidentChecklistRIncidentR{
+			o: rel,
+		}
+	})
+}
+
+func (m incidentChecklistMods) WithNewIncident(mods ...IncidentMod) IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(ctx context.Context, o *IncidentChecklistTemplate) {
+		related := o.f.NewIncident(ctx, mods...)
+
+		m.WithIncident(related).Apply(ctx, o)
+	})
+}
+
+func (m incidentChecklistMods) WithoutIncident() IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(ctx context.Context, o *IncidentChecklistTemplate) {
+		o.r.Incident = nil
+	})
+}
+
+func (m incidentChecklistMods) WithIncidentIncidentChecklistItems(number int, related *IncidentChecklistItemTemplate) IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(ctx context.Context, o *IncidentChecklistTemplate) {
+		o.r.IncidentIncidentChecklistItems = []*incidentChecklistRIncidentIncidentChecklistItemsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m incidentChecklistMods) WithNewIncidentIncidentChecklistItems(number int, mods ...IncidentChecklistItemMod) IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(ctx context.Context, o *IncidentChecklistTemplate) {
+		related := o.f.NewIncidentChecklistItem(ctx, mods...)
+		m.WithIncidentIncidentChecklistItems(number, related).Apply(ctx, o)
+	})
+}
+
+func (m incidentChecklistMods) AddIncidentIncidentChecklistItems(number int, related *IncidentChecklistItemTemplate) IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(ctx context.Context, o *IncidentChecklistTemplate) {
+		o.r.IncidentIncidentChecklistItems = append(o.r.IncidentIncidentChecklistItems, &incidentChecklistRIncidentIncidentChecklistItemsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m incidentChecklistMods) AddNewIncidentIncidentChecklistItems(number int, mods ...IncidentChecklistItemMod) IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(ctx context.Context, o *IncidentChecklistTemplate) {
+		related := o.f.NewIncidentChecklistItem(ctx, mods...)
+		m.AddIncidentIncidentChecklistItems(number, related).Apply(ctx, o)
+	})
+}
+
+func (m incidentChecklistMods) WithoutIncidentIncidentChecklistItems() IncidentChecklistMod {
+	return IncidentChecklistModFunc(func(ctx context.Context, o *IncidentChecklistTemplate) {
+		o.r.IncidentIncidentChecklistItems = nil
+	})
+}
@@ -0,0 +1,636 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	models "github.com/fishnix/ohshift/models"
+	"github.com/gofrs/uuid/v5"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type IncidentEscalationMod interface {
+	Apply(context.Context, *IncidentEscalationTemplate)
+}
+
+type IncidentEscalationModFunc func(context.Context, *IncidentEscalationTemplate)
+
+func (f IncidentEscalationModFunc) Apply(ctx context.Context, n *IncidentEscalationTemplate) {
+	f(ctx, n)
+}
+
+type IncidentEscalationModSlice []IncidentEscalationMod
+
+func (mods IncidentEscalationModSlice) Apply(ctx context.Context, n *IncidentEscalationTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// IncidentEscalationTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type IncidentEscalationTemplate struct {
+	IncidentID     func() uuid.UUID
+	Policy         func() string
+	Level          func() int32
+	EscalatedAt    func() sql.Null[time.Time]
+	AcknowledgedBy func() sql.Null[string]
+	AcknowledgedAt func() sql.Null[time.Time]
+	CreatedAt      func() time.Time
+
+	r incidentEscalationR
+	f *Factory
+}
+
+type incidentEscalationR struct {
+	Incident *incidentEscalationRIncidentR
+}
+
+type incidentEscalationRIncidentR struct {
+	o *IncidentTemplate
+}
+
+// Apply mods to the IncidentEscalationTemplate
+func (o *IncidentEscalationTemplate) Apply(ctx context.Context, mods ...IncidentEscalationMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.IncidentEscalation
+// according to the relationships in the template. Nothing is inserted into the db
+func (t IncidentEscalationTemplate) setModelRels(o *models.IncidentEscalation) {
+	if t.r.Incident != nil {
+		rel := t.r.Incident.o.Build()
+		rel.R.IncidentEscalation = o
+		o.IncidentID = rel.ID // h2
+		o.R.Incident = rel
+	}
+}
+
+// BuildSetter returns an *models.IncidentEscalationSetter
+// this does nothing with the relationship templates
+func (o IncidentEscalationTemplate) BuildSetter() *models.IncidentEscalationSetter {
+	m := &models.IncidentEscalationSetter{}
+
+	if o.IncidentID != nil {
+		val := o.IncidentID()
+		m.IncidentID = &val
+	}
+	if o.Policy != nil {
+		val := o.Policy()
+		m.Policy = &val
+	}
+	if o.Level != nil {
+		val := o.Level()
+		m.Level = &val
+	}
+	if o.EscalatedAt != nil {
+		val := o.EscalatedAt()
+		m.EscalatedAt = &val
+	}
+	if o.AcknowledgedBy != nil {
+		val := o.AcknowledgedBy()
+		m.AcknowledgedBy = &val
+	}
+	if o.AcknowledgedAt != nil {
+		val := o.AcknowledgedAt()
+		m.AcknowledgedAt = &val
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = &val
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.IncidentEscalationSetter
+// this does nothing with the relationship templates
+func (o IncidentEscalationTemplate) BuildManySetter(number int) []*models.IncidentEscalationSetter {
+	m := make([]*models.IncidentEscalationSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.IncidentEscalation
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use IncidentEscalationTemplate.Create
+func (o IncidentEscalationTemplate) Build() *models.IncidentEscalation {
+	m := &models.IncidentEscalation{}
+
+	if o.IncidentID != nil {
+		m.IncidentID = o.IncidentID()
+	}
+	if o.Policy != nil {
+		m.Policy = o.Policy()
+	}
+	if o.Level != nil {
+		m.Level = o.Level()
+	}
+	if o.EscalatedAt != nil {
+		m.EscalatedAt = o.EscalatedAt()
+	}
+	if o.AcknowledgedBy != nil {
+		m.AcknowledgedBy = o.AcknowledgedBy()
+	}
+	if o.AcknowledgedAt != nil {
+		m.AcknowledgedAt = o.AcknowledgedAt()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.IncidentEscalationSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use IncidentEscalationTemplate.CreateMany
+func (o IncidentEscalationTemplate) BuildMany(number int) models.IncidentEscalationSlice {
+	m := make(models.IncidentEscalationSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableIncidentEscalation(m *models.IncidentEscalationSetter) {
+	if m.IncidentID == nil {
+		val := random_uuid_UUID(nil)
+		m.IncidentID = &val
+	}
+	if m.Policy == nil {
+		val := random_string(nil, "80")
+		m.Policy = &val
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.IncidentEscalation
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *IncidentEscalationTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.IncidentEscalation) (context.Context, error) {
+	var err error
+
+	return ctx, err
+}
+
+// Create builds a incidentEscalation and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *IncidentEscalationTemplate) Create(ctx context.Context, exec bob.Executor) (*models.IncidentEscalation, error) {
+	_, m, err := o.create(ctx, exec)
+	return m, err
+}
+
+// MustCreate builds a incidentEscalation and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *IncidentEscalationTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.IncidentEscalation {
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a incidentEscalation and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *IncidentEscalationTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.IncidentEscalation {
+	tb.Helper()
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// create builds a incidentEscalation and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted model
+func (o *IncidentEscalationTemplate) create(ctx context.Context, exec bob.Executor) (context.Context, *models.IncidentEscalation, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableIncidentEscalation(opt)
+
+	if o.r.Incident == nil {
+		IncidentEscalationMods.WithNewIncident().Apply(ctx, o)
+	}
+
+	ctx, rel0, err := o.r.Incident.o.create(ctx, exec)
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	opt.IncidentID = &rel0.ID
+
+	m, err := models.IncidentEscalations.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return ctx, nil, err
+	}
+	ctx = incidentEscalationCtx.WithValue(ctx, m)
+
+	m.R.Incident = rel0
+
+	ctx, err = o.insertOptRels(ctx, exec, m)
+	return ctx, m, err
+}
+
+// CreateMany builds multiple incidentEscalations and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o IncidentEscalationTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.IncidentEscalationSlice, error) {
+	_, m, err := o.createMany(ctx, exec, number)
+	return m, err
+}
+
+// MustCreateMany builds multiple incidentEscalations and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o IncidentEscalationTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.IncidentEscalationSlice {
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple incidentEscalations and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o IncidentEscalationTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.IncidentEscalationSlice {
+	tb.Helper()
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// createMany builds multiple incidentEscalations and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted models
+func (o IncidentEscalationTemplate) createMany(ctx context.Context, exec bob.Executor, number int) (context.Context, models.IncidentEscalationSlice, error) {
+	var err error
+	m := make(models.IncidentEscalationSlice, number)
+
+	for i := range m {
+		ctx, m[i], err = o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	return ctx, m, nil
+}
+
+// IncidentEscalation has methods that act as mods for the IncidentEscalationTemplate
+var IncidentEscalationMods incidentEscalationMods
+
+type incidentEscalationMods struct{}
+
+func (m incidentEscalationMods) RandomizeAllColumns(f *faker.Faker) IncidentEscalationMod {
+	return IncidentEscalationModSlice{
+		IncidentEscalationMods.RandomIncidentID(f),
+		IncidentEscalationMods.RandomPolicy(f),
+		IncidentEscalationMods.RandomLevel(f),
+		IncidentEscalationMods.RandomEscalatedAt(f),
+		IncidentEscalationMods.RandomAcknowledgedBy(f),
+		IncidentEscalationMods.RandomAcknowledgedAt(f),
+		IncidentEscalationMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m incidentEscalationMods) IncidentID(val uuid.UUID) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.IncidentID = func() uuid.UUID { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentEscalationMods) IncidentIDFunc(f func() uuid.UUID) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.IncidentID = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentEscalationMods) UnsetIncidentID() IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.IncidentID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentEscalationMods) RandomIncidentID(f *faker.Faker) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.IncidentID = func() uuid.UUID {
+			return random_uuid_UUID(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentEscalationMods) Policy(val string) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.Policy = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentEscalationMods) PolicyFunc(f func() string) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.Policy = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentEscalationMods) UnsetPolicy() IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.Policy = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentEscalationMods) RandomPolicy(f *faker.Faker) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.Policy = func() string {
+			return random_string(f, "80")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentEscalationMods) Level(val int32) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.Level = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentEscalationMods) LevelFunc(f func() int32) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.Level = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentEscalationMods) UnsetLevel() IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.Level = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentEscalationMods) RandomLevel(f *faker.Faker) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.Level = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentEscalationMods) EscalatedAt(val sql.Null[time.Time]) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.EscalatedAt = func() sql.Null[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentEscalationMods) EscalatedAtFunc(f func() sql.Null[time.Time]) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.EscalatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentEscalationMods) UnsetEscalatedAt() IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.EscalatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m incidentEscalationMods) RandomEscalatedAt(f *faker.Faker) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.EscalatedAt = func() sql.Null[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return sql.Null[time.Time]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m incidentEscalationMods) RandomEscalatedAtNotNull(f *faker.Faker) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.EscalatedAt = func() sql.Null[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return sql.Null[time.Time]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentEscalationMods) AcknowledgedBy(val sql.Null[string]) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.AcknowledgedBy = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentEscalationMods) AcknowledgedByFunc(f func() sql.Null[string]) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.AcknowledgedBy = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentEscalationMods) UnsetAcknowledgedBy() IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.AcknowledgedBy = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m incidentEscalationMods) RandomAcknowledgedBy(f *faker.Faker) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.AcknowledgedBy = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return sql.Null[string]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m incidentEscalationMods) RandomAcknowledgedByNotNull(f *faker.Faker) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.AcknowledgedBy = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return sql.Null[string]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentEscalationMods) AcknowledgedAt(val sql.Null[time.Time]) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.AcknowledgedAt = func() sql.Null[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentEscalationMods) AcknowledgedAtFunc(f func() sql.Null[time.Time]) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.AcknowledgedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentEscalationMods) UnsetAcknowledgedAt() IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.AcknowledgedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m incidentEscalationMods) RandomAcknowledgedAt(f *faker.Faker) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.AcknowledgedAt = func() sql.Null[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return sql.Null[time.Time]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m incidentEscalationMods) RandomAcknowledgedAtNotNull(f *faker.Faker) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.AcknowledgedAt = func() sql.Null[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return sql.Null[time.Time]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentEscalationMods) CreatedAt(val time.Time) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentEscalationMods) CreatedAtFunc(f func() time.Time) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentEscalationMods) UnsetCreatedAt() IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentEscalationMods) RandomCreatedAt(f *faker.Faker) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(_ context.Context, o *IncidentEscalationTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m incidentEscalationMods) WithParentsCascading() IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(ctx context.Context, o *IncidentEscalationTemplate) {
+		if isDone, _ := incidentEscalationWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = incidentEscalationWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewIncident(ctx, IncidentMods.WithParentsCascading())
+			m.WithIncident(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m incidentEscalationMods) WithIncident(rel *IncidentTemplate) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(ctx context.Context, o *IncidentEscalationTemplate) {
+		o.r.Incident = &incidentEscalationRIncidentR{
+			o: rel,
+		}
+	})
+}
+
+func (m incidentEscalationMods) WithNewIncident(mods ...IncidentMod) IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(ctx context.Context, o *IncidentEscalationTemplate) {
+		related := o.f.NewIncident(ctx, mods...)
+
+		m.WithIncident(related).Apply(ctx, o)
+	})
+}
+
+func (m incidentEscalationMods) WithoutIncident() IncidentEscalationMod {
+	return IncidentEscalationModFunc(func(ctx context.Context, o *IncidentEscalationTemplate) {
+		o.r.Incident = nil
+	})
+}
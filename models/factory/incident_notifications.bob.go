@@ -0,0 +1,456 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	models "github.com/fishnix/ohshift/models"
+	"github.com/gofrs/uuid/v5"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type IncidentNotificationMod interface {
+	Apply(context.Context, *IncidentNotificationTemplate)
+}
+
+type IncidentNotificationModFunc func(context.Context, *IncidentNotificationTemplate)
+
+func (f IncidentNotificationModFunc) Apply(ctx context.Context, n *IncidentNotificationTemplate) {
+	f(ctx, n)
+}
+
+type IncidentNotificationModSlice []IncidentNotificationMod
+
+func (mods IncidentNotificationModSlice) Apply(ctx context.Context, n *IncidentNotificationTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// IncidentNotificationTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type IncidentNotificationTemplate struct {
+	IncidentID     func() uuid.UUID
+	SlackChannelID func() string
+	SlackMessageTS func() string
+	PostedAt       func() time.Time
+
+	r incidentNotificationR
+	f *Factory
+}
+
+type incidentNotificationR struct {
+	Incident *incidentNotificationRIncidentR
+}
+
+type incidentNotificationRIncidentR struct {
+	o *IncidentTemplate
+}
+
+// Apply mods to the IncidentNotificationTemplate
+func (o *IncidentNotificationTemplate) Apply(ctx context.Context, mods ...IncidentNotificationMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.IncidentNotification
+// according to the relationships in the template. Nothing is inserted into the db
+func (t IncidentNotificationTemplate) setModelRels(o *models.IncidentNotification) {
+	if t.r.Incident != nil {
+		rel := t.r.Incident.o.Build()
+		rel.R.IncidentNotifications = append(rel.R.IncidentNotifications, o)
+		o.IncidentID = rel.ID // h2
+		o.R.Incident = rel
+	}
+}
+
+// BuildSetter returns an *models.IncidentNotificationSetter
+// this does nothing with the relationship templates
+func (o IncidentNotificationTemplate) BuildSetter() *models.IncidentNotificationSetter {
+	m := &models.IncidentNotificationSetter{}
+
+	if o.IncidentID != nil {
+		val := o.IncidentID()
+		m.IncidentID = &val
+	}
+	if o.SlackChannelID != nil {
+		val := o.SlackChannelID()
+		m.SlackChannelID = &val
+	}
+	if o.SlackMessageTS != nil {
+		val := o.SlackMessageTS()
+		m.SlackMessageTS = &val
+	}
+	if o.PostedAt != nil {
+		val := o.PostedAt()
+		m.PostedAt = &val
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.IncidentNotificationSetter
+// this does nothing with the relationship templates
+func (o IncidentNotificationTemplate) BuildManySetter(number int) []*models.IncidentNotificationSetter {
+	m := make([]*models.IncidentNotificationSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.IncidentNotification
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use IncidentNotificationTemplate.Create
+func (o IncidentNotificationTemplate) Build() *models.IncidentNotification {
+	m := &models.IncidentNotification{}
+
+	if o.IncidentID != nil {
+		m.IncidentID = o.IncidentID()
+	}
+	if o.SlackChannelID != nil {
+		m.SlackChannelID = o.SlackChannelID()
+	}
+	if o.SlackMessageTS != nil {
+		m.SlackMessageTS = o.SlackMessageTS()
+	}
+	if o.PostedAt != nil {
+		m.PostedAt = o.PostedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.IncidentNotificationSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use IncidentNotificationTemplate.CreateMany
+func (o IncidentNotificationTemplate) BuildMany(number int) models.IncidentNotificationSlice {
+	m := make(models.IncidentNotificationSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableIncidentNotification(m *models.IncidentNotificationSetter) {
+	if m.IncidentID == nil {
+		val := random_uuid_UUID(nil)
+		m.IncidentID = &val
+	}
+	if m.SlackChannelID == nil {
+		val := random_string(nil)
+		m.SlackChannelID = &val
+	}
+	if m.SlackMessageTS == nil {
+		val := random_string(nil)
+		m.SlackMessageTS = &val
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.IncidentNotification
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *IncidentNotificationTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.IncidentNotification) (context.Context, error) {
+	var err error
+
+	return ctx, err
+}
+
+// Create builds a incidentNotification and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *IncidentNotificationTemplate) Create(ctx context.Context, exec bob.Executor) (*models.IncidentNotification, error) {
+	_, m, err := o.create(ctx, exec)
+	return m, err
+}
+
+// MustCreate builds a incidentNotification and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *IncidentNotificationTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.IncidentNotification {
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a incidentNotification and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *IncidentNotificationTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.IncidentNotification {
+	tb.Helper()
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// create builds a incidentNotification and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted model
+func (o *IncidentNotificationTemplate) create(ctx context.Context, exec bob.Executor) (context.Context, *models.IncidentNotification, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableIncidentNotification(opt)
+
+	if o.r.Incident == nil {
+		IncidentNotificationMods.WithNewIncident().Apply(ctx, o)
+	}
+
+	rel0, ok := incidentCtx.Value(ctx)
+	if !ok {
+		ctx, rel0, err = o.r.Incident.o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	opt.IncidentID = &rel0.ID
+
+	m, err := models.IncidentNotifications.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return ctx, nil, err
+	}
+	ctx = incidentNotificationCtx.WithValue(ctx, m)
+
+	m.R.Incident = rel0
+
+	ctx, err = o.insertOptRels(ctx, exec, m)
+	return ctx, m, err
+}
+
+// CreateMany builds multiple incidentNotifications and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o IncidentNotificationTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.IncidentNotificationSlice, error) {
+	_, m, err := o.createMany(ctx, exec, number)
+	return m, err
+}
+
+// MustCreateMany builds multiple incidentNotifications and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o IncidentNotificationTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.IncidentNotificationSlice {
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple incidentNotifications and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o IncidentNotificationTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.IncidentNotificationSlice {
+	tb.Helper()
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// createMany builds multiple incidentNotifications and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted models
+func (o IncidentNotificationTemplate) createMany(ctx context.Context, exec bob.Executor, number int) (context.Context, models.IncidentNotificationSlice, error) {
+	var err error
+	m := make(models.IncidentNotificationSlice, number)
+
+	for i := range m {
+		ctx, m[i], err = o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	return ctx, m, nil
+}
+
+// IncidentNotification has methods that act as mods for the IncidentNotificationTemplate
+var IncidentNotificationMods incidentNotificationMods
+
+type incidentNotificationMods struct{}
+
+func (m incidentNotificationMods) RandomizeAllColumns(f *faker.Faker) IncidentNotificationMod {
+	return IncidentNotificationModSlice{
+		IncidentNotificationMods.RandomIncidentID(f),
+		IncidentNotificationMods.RandomSlackChannelID(f),
+		IncidentNotificationMods.RandomSlackMessageTS(f),
+		IncidentNotificationMods.RandomPostedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m incidentNotificationMods) IncidentID(val uuid.UUID) IncidentNotificationMod {
+	return IncidentNotificationModFunc(func(_ context.Context, o *IncidentNotificationTemplate) {
+		o.IncidentID = func() uuid.UUID { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentNotificationMods) IncidentIDFunc(f func() uuid.UUID) IncidentNotificationMod {
+	return IncidentNotificationModFunc(func(_ context.Context, o *IncidentNotificationTemplate) {
+		o.IncidentID = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentNotificationMods) UnsetIncidentID() IncidentNotificationMod {
+	return IncidentNotificationModFunc(func(_ context.Context, o *IncidentNotificationTemplate) {
+		o.IncidentID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentNotificationMods) RandomIncidentID(f *faker.Faker) IncidentNotificationMod {
+	return IncidentNotificationModFunc(func(_ context.Context, o *IncidentNotificationTemplate) {
+		o.IncidentID = func() uuid.UUID {
+			return random_uuid_UUID(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentNotificationMods) SlackChannelID(val string) IncidentNotificationMod {
+	return IncidentNotificationModFunc(func(_ context.Context, o *IncidentNotificationTemplate) {
+		o.SlackChannelID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentNotificationMods) SlackChannelIDFunc(f func() string) IncidentNotificationMod {
+	return IncidentNotificationModFunc(func(_ context.Context, o *IncidentNotificationTemplate) {
+		o.SlackChannelID = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentNotificationMods) UnsetSlackChannelID() IncidentNotificationMod {
+	return IncidentNotificationModFunc(func(_ context.Context, o *IncidentNotificationTemplate) {
+		o.SlackChannelID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentNotificationMods) RandomSlackChannelID(f *faker.Faker) IncidentNotificationMod {
+	return IncidentNotificationModFunc(func(_ context.Context, o *IncidentNotificationTemplate) {
+		o.SlackChannelID = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentNotificationMods) SlackMessageTS(val string) IncidentNotificationMod {
+	return IncidentNotificationModFunc(func(_ context.Context, o *IncidentNotificationTemplate) {
+		o.SlackMessageTS = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentNotificationMods) SlackMessageTSFunc(f func() string) IncidentNotificationMod {
+	return IncidentNotificationModFunc(func(_ context.Context, o *IncidentNotificationTemplate) {
+		o.SlackMessageTS = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentNotificationMods) UnsetSlackMessageTS() IncidentNotificationMod {
+	return IncidentNotificationModFunc(func(_ context.Context, o *IncidentNotificationTemplate) {
+		o.SlackMessageTS = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentNotificationMods) RandomSlackMessageTS(f *faker.Faker) IncidentNotificationMod {
+	return IncidentNotificationModFunc(func(_ context.Context, o *IncidentNotificationTemplate) {
+		o.SlackMessageTS = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentNotificationMods) PostedAt(val time.Time) IncidentNotificationMod {
+	return IncidentNotificationModFunc(func(_ context.Context, o *IncidentNotificationTemplate) {
+		o.PostedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentNotificationMods) PostedAtFunc(f func() time.Time) IncidentNotificationMod {
+	return IncidentNotificationModFunc(func(_ context.Context, o *IncidentNotificationTemplate) {
+		o.PostedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentNotificationMods) UnsetPostedAt() IncidentNotificationMod {
+	return IncidentNotificationModFunc(func(_ context.Context, o *IncidentNotificationTemplate) {
+		o.PostedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentNotificationMods) RandomPostedAt(f *faker.Faker) IncidentNotificationMod {
+	return IncidentNotificationModFunc(func(_ context.Context, o *IncidentNotificationTemplate) {
+		o.PostedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m incidentNotificationMods) WithParentsCascading() IncidentNotificationMod {
+	return IncidentNotificationModFunc(func(ctx context.Context, o *IncidentNotificationTemplate) {
+		if isDone, _ := incidentNotificationWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = incidentNotificationWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewIncident(ctx, IncidentMods.WithParentsCascading())
+			m.WithIncident(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m incidentNotificationMods) WithIncident(rel *IncidentTemplate) IncidentNotificationMod {
+	return IncidentNotificationModFunc(func(ctx context.Context, o *IncidentNotificationTemplate) {
+		o.r.Incident = &incidentNotificationRIncidentR{
+			o: rel,
+		}
+	})
+}
+
+func (m incidentNotificationMods) WithNewIncident(mods ...IncidentMod) IncidentNotificationMod {
+	return IncidentNotificationModFunc(func(ctx context.Context, o *IncidentNotificationTemplate) {
+		related := o.f.NewIncident(ctx, mods...)
+
+		m.WithIncident(related).Apply(ctx, o)
+	})
+}
+
+func (m incidentNotificationMods) WithoutIncident() IncidentNotificationMod {
+	return IncidentNotificationModFunc(func(ctx context.Context, o *IncidentNotificationTemplate) {
+		o.r.Incident = nil
+	})
+}
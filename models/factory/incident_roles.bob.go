@@ -0,0 +1,456 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	models "github.com/fishnix/ohshift/models"
+	"github.com/gofrs/uuid/v5"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type IncidentRoleMod interface {
+	Apply(context.Context, *IncidentRoleTemplate)
+}
+
+type IncidentRoleModFunc func(context.Context, *IncidentRoleTemplate)
+
+func (f IncidentRoleModFunc) Apply(ctx context.Context, n *IncidentRoleTemplate) {
+	f(ctx, n)
+}
+
+type IncidentRoleModSlice []IncidentRoleMod
+
+func (mods IncidentRoleModSlice) Apply(ctx context.Context, n *IncidentRoleTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// IncidentRoleTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type IncidentRoleTemplate struct {
+	IncidentID  func() uuid.UUID
+	Role        func() string
+	SlackUserID func() string
+	AssignedAt  func() time.Time
+
+	r incidentRoleR
+	f *Factory
+}
+
+type incidentRoleR struct {
+	Incident *incidentRoleRIncidentR
+}
+
+type incidentRoleRIncidentR struct {
+	o *IncidentTemplate
+}
+
+// Apply mods to the IncidentRoleTemplate
+func (o *IncidentRoleTemplate) Apply(ctx context.Context, mods ...IncidentRoleMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.IncidentRole
+// according to the relationships in the template. Nothing is inserted into the db
+func (t IncidentRoleTemplate) setModelRels(o *models.IncidentRole) {
+	if t.r.Incident != nil {
+		rel := t.r.Incident.o.Build()
+		rel.R.IncidentRoles = append(rel.R.IncidentRoles, o)
+		o.IncidentID = rel.ID // h2
+		o.R.Incident = rel
+	}
+}
+
+// BuildSetter returns an *models.IncidentRoleSetter
+// this does nothing with the relationship templates
+func (o IncidentRoleTemplate) BuildSetter() *models.IncidentRoleSetter {
+	m := &models.IncidentRoleSetter{}
+
+	if o.IncidentID != nil {
+		val := o.IncidentID()
+		m.IncidentID = &val
+	}
+	if o.Role != nil {
+		val := o.Role()
+		m.Role = &val
+	}
+	if o.SlackUserID != nil {
+		val := o.SlackUserID()
+		m.SlackUserID = &val
+	}
+	if o.AssignedAt != nil {
+		val := o.AssignedAt()
+		m.AssignedAt = &val
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.IncidentRoleSetter
+// this does nothing with the relationship templates
+func (o IncidentRoleTemplate) BuildManySetter(number int) []*models.IncidentRoleSetter {
+	m := make([]*models.IncidentRoleSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.IncidentRole
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use IncidentRoleTemplate.Create
+func (o IncidentRoleTemplate) Build() *models.IncidentRole {
+	m := &models.IncidentRole{}
+
+	if o.IncidentID != nil {
+		m.IncidentID = o.IncidentID()
+	}
+	if o.Role != nil {
+		m.Role = o.Role()
+	}
+	if o.SlackUserID != nil {
+		m.SlackUserID = o.SlackUserID()
+	}
+	if o.AssignedAt != nil {
+		m.AssignedAt = o.AssignedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.IncidentRoleSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use IncidentRoleTemplate.CreateMany
+func (o IncidentRoleTemplate) BuildMany(number int) models.IncidentRoleSlice {
+	m := make(models.IncidentRoleSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableIncidentRole(m *models.IncidentRoleSetter) {
+	if m.IncidentID == nil {
+		val := random_uuid_UUID(nil)
+		m.IncidentID = &val
+	}
+	if m.Role == nil {
+		val := random_string(nil, "50")
+		m.Role = &val
+	}
+	if m.SlackUserID == nil {
+		val := random_string(nil)
+		m.SlackUserID = &val
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.IncidentRole
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *IncidentRoleTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.IncidentRole) (context.Context, error) {
+	var err error
+
+	return ctx, err
+}
+
+// Create builds a incidentRole and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *IncidentRoleTemplate) Create(ctx context.Context, exec bob.Executor) (*models.IncidentRole, error) {
+	_, m, err := o.create(ctx, exec)
+	return m, err
+}
+
+// MustCreate builds a incidentRole and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *IncidentRoleTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.IncidentRole {
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a incidentRole and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *IncidentRoleTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.IncidentRole {
+	tb.Helper()
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// create builds a incidentRole and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted model
+func (o *IncidentRoleTemplate) create(ctx context.Context, exec bob.Executor) (context.Context, *models.IncidentRole, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableIncidentRole(opt)
+
+	if o.r.Incident == nil {
+		IncidentRoleMods.WithNewIncident().Apply(ctx, o)
+	}
+
+	rel0, ok := incidentCtx.Value(ctx)
+	if !ok {
+		ctx, rel0, err = o.r.Incident.o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	opt.IncidentID = &rel0.ID
+
+	m, err := models.IncidentRoles.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return ctx, nil, err
+	}
+	ctx = incidentRoleCtx.WithValue(ctx, m)
+
+	m.R.Incident = rel0
+
+	ctx, err = o.insertOptRels(ctx, exec, m)
+	return ctx, m, err
+}
+
+// CreateMany builds multiple incidentRoles and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o IncidentRoleTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.IncidentRoleSlice, error) {
+	_, m, err := o.createMany(ctx, exec, number)
+	return m, err
+}
+
+// MustCreateMany builds multiple incidentRoles and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o IncidentRoleTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.IncidentRoleSlice {
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple incidentRoles and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o IncidentRoleTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.IncidentRoleSlice {
+	tb.Helper()
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// createMany builds multiple incidentRoles and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted models
+func (o IncidentRoleTemplate) createMany(ctx context.Context, exec bob.Executor, number int) (context.Context, models.IncidentRoleSlice, error) {
+	var err error
+	m := make(models.IncidentRoleSlice, number)
+
+	for i := range m {
+		ctx, m[i], err = o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	return ctx, m, nil
+}
+
+// IncidentRole has methods that act as mods for the IncidentRoleTemplate
+var IncidentRoleMods incidentRoleMods
+
+type incidentRoleMods struct{}
+
+func (m incidentRoleMods) RandomizeAllColumns(f *faker.Faker) IncidentRoleMod {
+	return IncidentRoleModSlice{
+		IncidentRoleMods.RandomIncidentID(f),
+		IncidentRoleMods.RandomRole(f),
+		IncidentRoleMods.RandomSlackUserID(f),
+		IncidentRoleMods.RandomAssignedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m incidentRoleMods) IncidentID(val uuid.UUID) IncidentRoleMod {
+	return IncidentRoleModFunc(func(_ context.Context, o *IncidentRoleTemplate) {
+		o.IncidentID = func() uuid.UUID { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentRoleMods) IncidentIDFunc(f func() uuid.UUID) IncidentRoleMod {
+	return IncidentRoleModFunc(func(_ context.Context, o *IncidentRoleTemplate) {
+		o.IncidentID = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentRoleMods) UnsetIncidentID() IncidentRoleMod {
+	return IncidentRoleModFunc(func(_ context.Context, o *IncidentRoleTemplate) {
+		o.IncidentID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentRoleMods) RandomIncidentID(f *faker.Faker) IncidentRoleMod {
+	return IncidentRoleModFunc(func(_ context.Context, o *IncidentRoleTemplate) {
+		o.IncidentID = func() uuid.UUID {
+			return random_uuid_UUID(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentRoleMods) Role(val string) IncidentRoleMod {
+	return IncidentRoleModFunc(func(_ context.Context, o *IncidentRoleTemplate) {
+		o.Role = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentRoleMods) RoleFunc(f func() string) IncidentRoleMod {
+	return IncidentRoleModFunc(func(_ context.Context, o *IncidentRoleTemplate) {
+		o.Role = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentRoleMods) UnsetRole() IncidentRoleMod {
+	return IncidentRoleModFunc(func(_ context.Context, o *IncidentRoleTemplate) {
+		o.Role = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentRoleMods) RandomRole(f *faker.Faker) IncidentRoleMod {
+	return IncidentRoleModFunc(func(_ context.Context, o *IncidentRoleTemplate) {
+		o.Role = func() string {
+			return random_string(f, "50")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentRoleMods) SlackUserID(val string) IncidentRoleMod {
+	return IncidentRoleModFunc(func(_ context.Context, o *IncidentRoleTemplate) {
+		o.SlackUserID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentRoleMods) SlackUserIDFunc(f func() string) IncidentRoleMod {
+	return IncidentRoleModFunc(func(_ context.Context, o *IncidentRoleTemplate) {
+		o.SlackUserID = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentRoleMods) UnsetSlackUserID() IncidentRoleMod {
+	return IncidentRoleModFunc(func(_ context.Context, o *IncidentRoleTemplate) {
+		o.SlackUserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentRoleMods) RandomSlackUserID(f *faker.Faker) IncidentRoleMod {
+	return IncidentRoleModFunc(func(_ context.Context, o *IncidentRoleTemplate) {
+		o.SlackUserID = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentRoleMods) AssignedAt(val time.Time) IncidentRoleMod {
+	return IncidentRoleModFunc(func(_ context.Context, o *IncidentRoleTemplate) {
+		o.AssignedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentRoleMods) AssignedAtFunc(f func() time.Time) IncidentRoleMod {
+	return IncidentRoleModFunc(func(_ context.Context, o *IncidentRoleTemplate) {
+		o.AssignedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentRoleMods) UnsetAssignedAt() IncidentRoleMod {
+	return IncidentRoleModFunc(func(_ context.Context, o *IncidentRoleTemplate) {
+		o.AssignedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentRoleMods) RandomAssignedAt(f *faker.Faker) IncidentRoleMod {
+	return IncidentRoleModFunc(func(_ context.Context, o *IncidentRoleTemplate) {
+		o.AssignedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m incidentRoleMods) WithParentsCascading() IncidentRoleMod {
+	return IncidentRoleModFunc(func(ctx context.Context, o *IncidentRoleTemplate) {
+		if isDone, _ := incidentRoleWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = incidentRoleWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewIncident(ctx, IncidentMods.WithParentsCascading())
+			m.WithIncident(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m incidentRoleMods) WithIncident(rel *IncidentTemplate) IncidentRoleMod {
+	return IncidentRoleModFunc(func(ctx context.Context, o *IncidentRoleTemplate) {
+		o.r.Incident = &incidentRoleRIncidentR{
+			o: rel,
+		}
+	})
+}
+
+func (m incidentRoleMods) WithNewIncident(mods ...IncidentMod) IncidentRoleMod {
+	return IncidentRoleModFunc(func(ctx context.Context, o *IncidentRoleTemplate) {
+		related := o.f.NewIncident(ctx, mods...)
+
+		m.WithIncident(related).Apply(ctx, o)
+	})
+}
+
+func (m incidentRoleMods) WithoutIncident() IncidentRoleMod {
+	return IncidentRoleModFunc(func(ctx context.Context, o *IncidentRoleTemplate) {
+		o.r.Incident = nil
+	})
+}
@@ -0,0 +1,475 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	models "github.com/fishnix/ohshift/models"
+	"github.com/gofrs/uuid/v5"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type IncidentServiceMod interface {
+	Apply(context.Context, *IncidentServiceTemplate)
+}
+
+type IncidentServiceModFunc func(context.Context, *IncidentServiceTemplate)
+
+func (f IncidentServiceModFunc) Apply(ctx context.Context, n *IncidentServiceTemplate) {
+	f(ctx, n)
+}
+
+type IncidentServiceModSlice []IncidentServiceMod
+
+func (mods IncidentServiceModSlice) Apply(ctx context.Context, n *IncidentServiceTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// IncidentServiceTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type IncidentServiceTemplate struct {
+	IncidentID func() uuid.UUID
+	Service    func() string
+	AddedBy    func() sql.Null[string]
+	AddedAt    func() time.Time
+
+	r incidentServiceR
+	f *Factory
+}
+
+type incidentServiceR struct {
+	Incident *incidentServiceRIncidentR
+}
+
+type incidentServiceRIncidentR struct {
+	o *IncidentTemplate
+}
+
+// Apply mods to the IncidentServiceTemplate
+func (o *IncidentServiceTemplate) Apply(ctx context.Context, mods ...IncidentServiceMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.IncidentService
+// according to the relationships in the template. Nothing is inserted into the db
+func (t IncidentServiceTemplate) setModelRels(o *models.IncidentService) {
+	if t.r.Incident != nil {
+		rel := t.r.Incident.o.Build()
+		rel.R.IncidentServices = append(rel.R.IncidentServices, o)
+		o.IncidentID = rel.ID // h2
+		o.R.Incident = rel
+	}
+}
+
+// BuildSetter returns an *models.IncidentServiceSetter
+// this does nothing with the relationship templates
+func (o IncidentServiceTemplate) BuildSetter() *models.IncidentServiceSetter {
+	m := &models.IncidentServiceSetter{}
+
+	if o.IncidentID != nil {
+		val := o.IncidentID()
+		m.IncidentID = &val
+	}
+	if o.Service != nil {
+		val := o.Service()
+		m.Service = &val
+	}
+	if o.AddedBy != nil {
+		val := o.AddedBy()
+		m.AddedBy = &val
+	}
+	if o.AddedAt != nil {
+		val := o.AddedAt()
+		m.AddedAt = &val
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.IncidentServiceSetter
+// this does nothing with the relationship templates
+func (o IncidentServiceTemplate) BuildManySetter(number int) []*models.IncidentServiceSetter {
+	m := make([]*models.IncidentServiceSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.IncidentService
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use IncidentServiceTemplate.Create
+func (o IncidentServiceTemplate) Build() *models.IncidentService {
+	m := &models.IncidentService{}
+
+	if o.IncidentID != nil {
+		m.IncidentID = o.IncidentID()
+	}
+	if o.Service != nil {
+		m.Service = o.Service()
+	}
+	if o.AddedBy != nil {
+		m.AddedBy = o.AddedBy()
+	}
+	if o.AddedAt != nil {
+		m.AddedAt = o.AddedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.IncidentServiceSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use IncidentServiceTemplate.CreateMany
+func (o IncidentServiceTemplate) BuildMany(number int) models.IncidentServiceSlice {
+	m := make(models.IncidentServiceSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableIncidentService(m *models.IncidentServiceSetter) {
+	if m.IncidentID == nil {
+		val := random_uuid_UUID(nil)
+		m.IncidentID = &val
+	}
+	if m.Service == nil {
+		val := random_string(nil, "80")
+		m.Service = &val
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.IncidentService
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *IncidentServiceTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.IncidentService) (context.Context, error) {
+	var err error
+
+	return ctx, err
+}
+
+// Create builds a incidentService and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *IncidentServiceTemplate) Create(ctx context.Context, exec bob.Executor) (*models.IncidentService, error) {
+	_, m, err := o.create(ctx, exec)
+	return m, err
+}
+
+// MustCreate builds a incidentService and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *IncidentServiceTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.IncidentService {
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a incidentService and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *IncidentServiceTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.IncidentService {
+	tb.Helper()
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// create builds a incidentService and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted model
+func (o *IncidentServiceTemplate) create(ctx context.Context, exec bob.Executor) (context.Context, *models.IncidentService, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableIncidentService(opt)
+
+	if o.r.Incident == nil {
+		IncidentServiceMods.WithNewIncident().Apply(ctx, o)
+	}
+
+	rel0, ok := incidentCtx.Value(ctx)
+	if !ok {
+		ctx, rel0, err = o.r.Incident.o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	opt.IncidentID = &rel0.ID
+
+	m, err := models.IncidentServices.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return ctx, nil, err
+	}
+	ctx = incidentServiceCtx.WithValue(ctx, m)
+
+	m.R.Incident = rel0
+
+	ctx, err = o.insertOptRels(ctx, exec, m)
+	return ctx, m, err
+}
+
+// CreateMany builds multiple incidentServices and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o IncidentServiceTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.IncidentServiceSlice, error) {
+	_, m, err := o.createMany(ctx, exec, number)
+	return m, err
+}
+
+// MustCreateMany builds multiple incidentServices and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o IncidentServiceTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.IncidentServiceSlice {
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple incidentServices and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o IncidentServiceTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.IncidentServiceSlice {
+	tb.Helper()
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// createMany builds multiple incidentServices and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted models
+func (o IncidentServiceTemplate) createMany(ctx context.Context, exec bob.Executor, number int) (context.Context, models.IncidentServiceSlice, error) {
+	var err error
+	m := make(models.IncidentServiceSlice, number)
+
+	for i := range m {
+		ctx, m[i], err = o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	return ctx, m, nil
+}
+
+// IncidentService has methods that act as mods for the IncidentServiceTemplate
+var IncidentServiceMods incidentServiceMods
+
+type incidentServiceMods struct{}
+
+func (m incidentServiceMods) RandomizeAllColumns(f *faker.Faker) IncidentServiceMod {
+	return IncidentServiceModSlice{
+		IncidentServiceMods.RandomIncidentID(f),
+		IncidentServiceMods.RandomService(f),
+		IncidentServiceMods.RandomAddedBy(f),
+		IncidentServiceMods.RandomAddedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m incidentServiceMods) IncidentID(val uuid.UUID) IncidentServiceMod {
+	return IncidentServiceModFunc(func(_ context.Context, o *IncidentServiceTemplate) {
+		o.IncidentID = func() uuid.UUID { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentServiceMods) IncidentIDFunc(f func() uuid.UUID) IncidentServiceMod {
+	return IncidentServiceModFunc(func(_ context.Context, o *IncidentServiceTemplate) {
+		o.IncidentID = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentServiceMods) UnsetIncidentID() IncidentServiceMod {
+	return IncidentServiceModFunc(func(_ context.Context, o *IncidentServiceTemplate) {
+		o.IncidentID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentServiceMods) RandomIncidentID(f *faker.Faker) IncidentServiceMod {
+	return IncidentServiceModFunc(func(_ context.Context, o *IncidentServiceTemplate) {
+		o.IncidentID = func() uuid.UUID {
+			return random_uuid_UUID(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentServiceMods) Service(val string) IncidentServiceMod {
+	return IncidentServiceModFunc(func(_ context.Context, o *IncidentServiceTemplate) {
+		o.Service = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentServiceMods) ServiceFunc(f func() string) IncidentServiceMod {
+	return IncidentServiceModFunc(func(_ context.Context, o *IncidentServiceTemplate) {
+		o.Service = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentServiceMods) UnsetService() IncidentServiceMod {
+	return IncidentServiceModFunc(func(_ context.Context, o *IncidentServiceTemplate) {
+		o.Service = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentServiceMods) RandomService(f *faker.Faker) IncidentServiceMod {
+	return IncidentServiceModFunc(func(_ context.Context, o *IncidentServiceTemplate) {
+		o.Service = func() string {
+			return random_string(f, "80")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentServiceMods) AddedBy(val sql.Null[string]) IncidentServiceMod {
+	return IncidentServiceModFunc(func(_ context.Context, o *IncidentServiceTemplate) {
+		o.AddedBy = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentServiceMods) AddedByFunc(f func() sql.Null[string]) IncidentServiceMod {
+	return IncidentServiceModFunc(func(_ context.Context, o *IncidentServiceTemplate) {
+		o.AddedBy = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentServiceMods) UnsetAddedBy() IncidentServiceMod {
+	return IncidentServiceModFunc(func(_ context.Context, o *IncidentServiceTemplate) {
+		o.AddedBy = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m incidentServiceMods) RandomAddedBy(f *faker.Faker) IncidentServiceMod {
+	return IncidentServiceModFunc(func(_ context.Context, o *IncidentServiceTemplate) {
+		o.AddedBy = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return sql.Null[string]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m incidentServiceMods) RandomAddedByNotNull(f *faker.Faker) IncidentServiceMod {
+	return IncidentServiceModFunc(func(_ context.Context, o *IncidentServiceTemplate) {
+		o.AddedBy = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return sql.Null[string]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentServiceMods) AddedAt(val time.Time) IncidentServiceMod {
+	return IncidentServiceModFunc(func(_ context.Context, o *IncidentServiceTemplate) {
+		o.AddedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentServiceMods) AddedAtFunc(f func() time.Time) IncidentServiceMod {
+	return IncidentServiceModFunc(func(_ context.Context, o *IncidentServiceTemplate) {
+		o.AddedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentServiceMods) UnsetAddedAt() IncidentServiceMod {
+	return IncidentServiceModFunc(func(_ context.Context, o *IncidentServiceTemplate) {
+		o.AddedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentServiceMods) RandomAddedAt(f *faker.Faker) IncidentServiceMod {
+	return IncidentServiceModFunc(func(_ context.Context, o *IncidentServiceTemplate) {
+		o.AddedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m incidentServiceMods) WithParentsCascading() IncidentServiceMod {
+	return IncidentServiceModFunc(func(ctx context.Context, o *IncidentServiceTemplate) {
+		if isDone, _ := incidentServiceWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = incidentServiceWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewIncident(ctx, IncidentMods.WithParentsCascading())
+			m.WithIncident(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m incidentServiceMods) WithIncident(rel *IncidentTemplate) IncidentServiceMod {
+	return IncidentServiceModFunc(func(ctx context.Context, o *IncidentServiceTemplate) {
+		o.r.Incident = &incidentServiceRIncidentR{
+			o: rel,
+		}
+	})
+}
+
+func (m incidentServiceMods) WithNewIncident(mods ...IncidentMod) IncidentServiceMod {
+	return IncidentServiceModFunc(func(ctx context.Context, o *IncidentServiceTemplate) {
+		related := o.f.NewIncident(ctx, mods...)
+
+		m.WithIncident(related).Apply(ctx, o)
+	})
+}
+
+func (m incidentServiceMods) WithoutIncident() IncidentServiceMod {
+	return IncidentServiceModFunc(func(ctx context.Context, o *IncidentServiceTemplate) {
+		o.r.Incident = nil
+	})
+}
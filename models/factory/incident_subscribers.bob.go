@@ -0,0 +1,412 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	models "github.com/fishnix/ohshift/models"
+	"github.com/gofrs/uuid/v5"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type IncidentSubscriberMod interface {
+	Apply(context.Context, *IncidentSubscriberTemplate)
+}
+
+type IncidentSubscriberModFunc func(context.Context, *IncidentSubscriberTemplate)
+
+func (f IncidentSubscriberModFunc) Apply(ctx context.Context, n *IncidentSubscriberTemplate) {
+	f(ctx, n)
+}
+
+type IncidentSubscriberModSlice []IncidentSubscriberMod
+
+func (mods IncidentSubscriberModSlice) Apply(ctx context.Context, n *IncidentSubscriberTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// IncidentSubscriberTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type IncidentSubscriberTemplate struct {
+	IncidentID   func() uuid.UUID
+	SlackUserID  func() string
+	SubscribedAt func() time.Time
+
+	r incidentSubscriberR
+	f *Factory
+}
+
+type incidentSubscriberR struct {
+	Incident *incidentSubscriberRIncidentR
+}
+
+type incidentSubscriberRIncidentR struct {
+	o *IncidentTemplate
+}
+
+// Apply mods to the IncidentSubscriberTemplate
+func (o *IncidentSubscriberTemplate) Apply(ctx context.Context, mods ...IncidentSubscriberMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.IncidentSubscriber
+// according to the relationships in the template. Nothing is inserted into the db
+func (t IncidentSubscriberTemplate) setModelRels(o *models.IncidentSubscriber) {
+	if t.r.Incident != nil {
+		rel := t.r.Incident.o.Build()
+		rel.R.IncidentSubscribers = append(rel.R.IncidentSubscribers, o)
+		o.IncidentID = rel.ID // h2
+		o.R.Incident = rel
+	}
+}
+
+// BuildSetter returns an *models.IncidentSubscriberSetter
+// this does nothing with the relationship templates
+func (o IncidentSubscriberTemplate) BuildSetter() *models.IncidentSubscriberSetter {
+	m := &models.IncidentSubscriberSetter{}
+
+	if o.IncidentID != nil {
+		val := o.IncidentID()
+		m.IncidentID = &val
+	}
+	if o.SlackUserID != nil {
+		val := o.SlackUserID()
+		m.SlackUserID = &val
+	}
+	if o.SubscribedAt != nil {
+		val := o.SubscribedAt()
+		m.SubscribedAt = &val
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.IncidentSubscriberSetter
+// this does nothing with the relationship templates
+func (o IncidentSubscriberTemplate) BuildManySetter(number int) []*models.IncidentSubscriberSetter {
+	m := make([]*models.IncidentSubscriberSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.IncidentSubscriber
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use IncidentSubscriberTemplate.Create
+func (o IncidentSubscriberTemplate) Build() *models.IncidentSubscriber {
+	m := &models.IncidentSubscriber{}
+
+	if o.IncidentID != nil {
+		m.IncidentID = o.IncidentID()
+	}
+	if o.SlackUserID != nil {
+		m.SlackUserID = o.SlackUserID()
+	}
+	if o.SubscribedAt != nil {
+		m.SubscribedAt = o.SubscribedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.IncidentSubscriberSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use IncidentSubscriberTemplate.CreateMany
+func (o IncidentSubscriberTemplate) BuildMany(number int) models.IncidentSubscriberSlice {
+	m := make(models.IncidentSubscriberSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableIncidentSubscriber(m *models.IncidentSubscriberSetter) {
+	if m.IncidentID == nil {
+		val := random_uuid_UUID(nil)
+		m.IncidentID = &val
+	}
+	if m.SlackUserID == nil {
+		val := random_string(nil)
+		m.SlackUserID = &val
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.IncidentSubscriber
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *IncidentSubscriberTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.IncidentSubscriber) (context.Context, error) {
+	var err error
+
+	return ctx, err
+}
+
+// Create builds a incidentSubscriber and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *IncidentSubscriberTemplate) Create(ctx context.Context, exec bob.Executor) (*models.IncidentSubscriber, error) {
+	_, m, err := o.create(ctx, exec)
+	return m, err
+}
+
+// MustCreate builds a incidentSubscriber and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *IncidentSubscriberTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.IncidentSubscriber {
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a incidentSubscriber and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *IncidentSubscriberTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.IncidentSubscriber {
+	tb.Helper()
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// create builds a incidentSubscriber and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted model
+func (o *IncidentSubscriberTemplate) create(ctx context.Context, exec bob.Executor) (context.Context, *models.IncidentSubscriber, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableIncidentSubscriber(opt)
+
+	if o.r.Incident == nil {
+		IncidentSubscriberMods.WithNewIncident().Apply(ctx, o)
+	}
+
+	rel0, ok := incidentCtx.Value(ctx)
+	if !ok {
+		ctx, rel0, err = o.r.Incident.o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	opt.IncidentID = &rel0.ID
+
+	m, err := models.IncidentSubscribers.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return ctx, nil, err
+	}
+	ctx = incidentSubscriberCtx.WithValue(ctx, m)
+
+	m.R.Incident = rel0
+
+	ctx, err = o.insertOptRels(ctx, exec, m)
+	return ctx, m, err
+}
+
+// CreateMany builds multiple incidentSubscribers and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o IncidentSubscriberTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.IncidentSubscriberSlice, error) {
+	_, m, err := o.createMany(ctx, exec, number)
+	return m, err
+}
+
+// MustCreateMany builds multiple incidentSubscribers and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o IncidentSubscriberTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.IncidentSubscriberSlice {
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple incidentSubscribers and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o IncidentSubscriberTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.IncidentSubscriberSlice {
+	tb.Helper()
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// createMany builds multiple incidentSubscribers and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted models
+func (o IncidentSubscriberTemplate) createMany(ctx context.Context, exec bob.Executor, number int) (context.Context, models.IncidentSubscriberSlice, error) {
+	var err error
+	m := make(models.IncidentSubscriberSlice, number)
+
+	for i := range m {
+		ctx, m[i], err = o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	return ctx, m, nil
+}
+
+// IncidentSubscriber has methods that act as mods for the IncidentSubscriberTemplate
+var IncidentSubscriberMods incidentSubscriberMods
+
+type incidentSubscriberMods struct{}
+
+func (m incidentSubscriberMods) RandomizeAllColumns(f *faker.Faker) IncidentSubscriberMod {
+	return IncidentSubscriberModSlice{
+		IncidentSubscriberMods.RandomIncidentID(f),
+		IncidentSubscriberMods.RandomSlackUserID(f),
+		IncidentSubscriberMods.RandomSubscribedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m incidentSubscriberMods) IncidentID(val uuid.UUID) IncidentSubscriberMod {
+	return IncidentSubscriberModFunc(func(_ context.Context, o *IncidentSubscriberTemplate) {
+		o.IncidentID = func() uuid.UUID { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentSubscriberMods) IncidentIDFunc(f func() uuid.UUID) IncidentSubscriberMod {
+	return IncidentSubscriberModFunc(func(_ context.Context, o *IncidentSubscriberTemplate) {
+		o.IncidentID = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentSubscriberMods) UnsetIncidentID() IncidentSubscriberMod {
+	return IncidentSubscriberModFunc(func(_ context.Context, o *IncidentSubscriberTemplate) {
+		o.IncidentID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentSubscriberMods) RandomIncidentID(f *faker.Faker) IncidentSubscriberMod {
+	return IncidentSubscriberModFunc(func(_ context.Context, o *IncidentSubscriberTemplate) {
+		o.IncidentID = func() uuid.UUID {
+			return random_uuid_UUID(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentSubscriberMods) SlackUserID(val string) IncidentSubscriberMod {
+	return IncidentSubscriberModFunc(func(_ context.Context, o *IncidentSubscriberTemplate) {
+		o.SlackUserID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentSubscriberMods) SlackUserIDFunc(f func() string) IncidentSubscriberMod {
+	return IncidentSubscriberModFunc(func(_ context.Context, o *IncidentSubscriberTemplate) {
+		o.SlackUserID = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentSubscriberMods) UnsetSlackUserID() IncidentSubscriberMod {
+	return IncidentSubscriberModFunc(func(_ context.Context, o *IncidentSubscriberTemplate) {
+		o.SlackUserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentSubscriberMods) RandomSlackUserID(f *faker.Faker) IncidentSubscriberMod {
+	return IncidentSubscriberModFunc(func(_ context.Context, o *IncidentSubscriberTemplate) {
+		o.SlackUserID = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentSubscriberMods) SubscribedAt(val time.Time) IncidentSubscriberMod {
+	return IncidentSubscriberModFunc(func(_ context.Context, o *IncidentSubscriberTemplate) {
+		o.SubscribedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentSubscriberMods) SubscribedAtFunc(f func() time.Time) IncidentSubscriberMod {
+	return IncidentSubscriberModFunc(func(_ context.Context, o *IncidentSubscriberTemplate) {
+		o.SubscribedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentSubscriberMods) UnsetSubscribedAt() IncidentSubscriberMod {
+	return IncidentSubscriberModFunc(func(_ context.Context, o *IncidentSubscriberTemplate) {
+		o.SubscribedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentSubscriberMods) RandomSubscribedAt(f *faker.Faker) IncidentSubscriberMod {
+	return IncidentSubscriberModFunc(func(_ context.Context, o *IncidentSubscriberTemplate) {
+		o.SubscribedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m incidentSubscriberMods) WithParentsCascading() IncidentSubscriberMod {
+	return IncidentSubscriberModFunc(func(ctx context.Context, o *IncidentSubscriberTemplate) {
+		if isDone, _ := incidentSubscriberWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = incidentSubscriberWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewIncident(ctx, IncidentMods.WithParentsCascading())
+			m.WithIncident(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m incidentSubscriberMods) WithIncident(rel *IncidentTemplate) IncidentSubscriberMod {
+	return IncidentSubscriberModFunc(func(ctx context.Context, o *IncidentSubscriberTemplate) {
+		o.r.Incident = &incidentSubscriberRIncidentR{
+			o: rel,
+		}
+	})
+}
+
+func (m incidentSubscriberMods) WithNewIncident(mods ...IncidentMod) IncidentSubscriberMod {
+	return IncidentSubscriberModFunc(func(ctx context.Context, o *IncidentSubscriberTemplate) {
+		related := o.f.NewIncident(ctx, mods...)
+
+		m.WithIncident(related).Apply(ctx, o)
+	})
+}
+
+func (m incidentSubscriberMods) WithoutIncident() IncidentSubscriberMod {
+	return IncidentSubscriberModFunc(func(ctx context.Context, o *IncidentSubscriberTemplate) {
+		o.r.Incident = nil
+	})
+}
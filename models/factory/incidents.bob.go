@@ -36,27 +36,72 @@ func (mods IncidentModSlice) Apply(ctx context.Context, n *IncidentTemplate) {
 // IncidentTemplate is an object representing the database table.
 // all columns are optional and should be set by mods
 type IncidentTemplate struct {
-	ID             func() uuid.UUID
-	SlackChannelID func() string
-	Status         func() string
-	Severity       func() string
-	Title          func() string
-	Description    func() sql.Null[string]
-	StartedBy      func() string
-	StartedAt      func() sql.Null[time.Time]
-	ResolvedBy     func() sql.Null[string]
-	ResolvedAt     func() sql.Null[time.Time]
-	ExportURL      func() sql.Null[string]
-	LastUpdated    func() sql.Null[time.Time]
+	ID                func() uuid.UUID
+	SlackChannelID    func() string
+	Status            func() string
+	Severity          func() string
+	Title             func() string
+	Description       func() sql.Null[string]
+	StartedBy         func() string
+	StartedAt         func() time.Time
+	ResolvedBy        func() sql.Null[string]
+	ResolvedAt        func() sql.Null[time.Time]
+	ExportURL         func() sql.Null[string]
+	LastUpdated       func() time.Time
+	SlackChannelName  func() sql.Null[string]
+	StartedByUserID   func() sql.Null[string]
+	NextUpdateDue     func() sql.Null[time.Time]
+	SubStatus         func() sql.Null[string]
+	StalePromptedAt   func() sql.Null[time.Time]
+	StaleSnoozedUntil func() sql.Null[time.Time]
+	TeamID            func() sql.Null[string]
+	EnterpriseID      func() sql.Null[string]
+	Team              func() sql.Null[string]
+	IncidentType      func() sql.Null[string]
+	Private           func() bool
+	FirstResponseAt   func() sql.Null[time.Time]
 
 	r incidentR
 	f *Factory
 }
 
 type incidentR struct {
-	TimelineEvents []*incidentRTimelineEventsR
+	AlertIncidents        []*incidentRAlertIncidentsR
+	IncidentChecklist     *incidentRIncidentChecklistR
+	IncidentEscalation    *incidentRIncidentEscalationR
+	IncidentNotifications []*incidentRIncidentNotificationsR
+	IncidentRoles         []*incidentRIncidentRolesR
+	IncidentServices      []*incidentRIncidentServicesR
+	IncidentSubscribers   []*incidentRIncidentSubscribersR
+	TimelineEvents        []*incidentRTimelineEventsR
 }
 
+type incidentRAlertIncidentsR struct {
+	number int
+	o      *AlertIncidentTemplate
+}
+type incidentRIncidentChecklistR struct {
+	o *IncidentChecklistTemplate
+}
+type incidentRIncidentEscalationR struct {
+	o *IncidentEscalationTemplate
+}
+type incidentRIncidentNotificationsR struct {
+	number int
+	o      *IncidentNotificationTemplate
+}
+type incidentRIncidentRolesR struct {
+	number int
+	o      *IncidentRoleTemplate
+}
+type incidentRIncidentServicesR struct {
+	number int
+	o      *IncidentServiceTemplate
+}
+type incidentRIncidentSubscribersR struct {
+	number int
+	o      *IncidentSubscriberTemplate
+}
 type incidentRTimelineEventsR struct {
 	number int
 	o      *TimelineEventTemplate
@@ -72,6 +117,85 @@ func (o *IncidentTemplate) Apply(ctx context.Context, mods ...IncidentMod) {
 // setModelRels creates and sets the relationships on *models.Incident
 // according to the relationships in the template. Nothing is inserted into the db
 func (t IncidentTemplate) setModelRels(o *models.Incident) {
+	if t.r.AlertIncidents != nil {
+		rel := models.AlertIncidentSlice{}
+		for _, r := range t.r.AlertIncidents {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.IncidentID = sql.Null[uuid.UUID]{V: o.ID, Valid: true} // h2
+				rel.R.Incident = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.AlertIncidents = rel
+	}
+
+	if t.r.IncidentChecklist != nil {
+		rel := t.r.IncidentChecklist.o.Build()
+		rel.R.Incident = o
+		rel.IncidentID = o.ID // h2
+		o.R.IncidentChecklist = rel
+	}
+
+	if t.r.IncidentEscalation != nil {
+		rel := t.r.IncidentEscalation.o.Build()
+		rel.R.Incident = o
+		rel.IncidentID = o.ID // h2
+		o.R.IncidentEscalation = rel
+	}
+
+	if t.r.IncidentNotifications != nil {
+		rel := models.IncidentNotificationSlice{}
+		for _, r := range t.r.IncidentNotifications {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.IncidentID = o.ID // h2
+				rel.R.Incident = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.IncidentNotifications = rel
+	}
+
+	if t.r.IncidentRoles != nil {
+		rel := models.IncidentRoleSlice{}
+		for _, r := range t.r.IncidentRoles {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.IncidentID = o.ID // h2
+				rel.R.Incident = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.IncidentRoles = rel
+	}
+
+	if t.r.IncidentServices != nil {
+		rel := models.IncidentServiceSlice{}
+		for _, r := range t.r.IncidentServices {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.IncidentID = o.ID // h2
+				rel.R.Incident = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.IncidentServices = rel
+	}
+
+	if t.r.IncidentSubscribers != nil {
+		rel := models.IncidentSubscriberSlice{}
+		for _, r := range t.r.IncidentSubscribers {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.IncidentID = o.ID // h2
+				rel.R.Incident = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.IncidentSubscribers = rel
+	}
+
 	if t.r.TimelineEvents != nil {
 		rel := models.TimelineEventSlice{}
 		for _, r := range t.r.TimelineEvents {
@@ -139,6 +263,54 @@ func (o IncidentTemplate) BuildSetter() *models.IncidentSetter {
 		val := o.LastUpdated()
 		m.LastUpdated = &val
 	}
+	if o.SlackChannelName != nil {
+		val := o.SlackChannelName()
+		m.SlackChannelName = &val
+	}
+	if o.StartedByUserID != nil {
+		val := o.StartedByUserID()
+		m.StartedByUserID = &val
+	}
+	if o.NextUpdateDue != nil {
+		val := o.NextUpdateDue()
+		m.NextUpdateDue = &val
+	}
+	if o.SubStatus != nil {
+		val := o.SubStatus()
+		m.SubStatus = &val
+	}
+	if o.StalePromptedAt != nil {
+		val := o.StalePromptedAt()
+		m.StalePromptedAt = &val
+	}
+	if o.StaleSnoozedUntil != nil {
+		val := o.StaleSnoozedUntil()
+		m.StaleSnoozedUntil = &val
+	}
+	if o.TeamID != nil {
+		val := o.TeamID()
+		m.TeamID = &val
+	}
+	if o.EnterpriseID != nil {
+		val := o.EnterpriseID()
+		m.EnterpriseID = &val
+	}
+	if o.Team != nil {
+		val := o.Team()
+		m.Team = &val
+	}
+	if o.IncidentType != nil {
+		val := o.IncidentType()
+		m.IncidentType = &val
+	}
+	if o.Private != nil {
+		val := o.Private()
+		m.Private = &val
+	}
+	if o.FirstResponseAt != nil {
+		val := o.FirstResponseAt()
+		m.FirstResponseAt = &val
+	}
 
 	return m
 }
@@ -197,6 +369,42 @@ func (o IncidentTemplate) Build() *models.Incident {
 	if o.LastUpdated != nil {
 		m.LastUpdated = o.LastUpdated()
 	}
+	if o.SlackChannelName != nil {
+		m.SlackChannelName = o.SlackChannelName()
+	}
+	if o.StartedByUserID != nil {
+		m.StartedByUserID = o.StartedByUserID()
+	}
+	if o.NextUpdateDue != nil {
+		m.NextUpdateDue = o.NextUpdateDue()
+	}
+	if o.SubStatus != nil {
+		m.SubStatus = o.SubStatus()
+	}
+	if o.StalePromptedAt != nil {
+		m.StalePromptedAt = o.StalePromptedAt()
+	}
+	if o.StaleSnoozedUntil != nil {
+		m.StaleSnoozedUntil = o.StaleSnoozedUntil()
+	}
+	if o.TeamID != nil {
+		m.TeamID = o.TeamID()
+	}
+	if o.EnterpriseID != nil {
+		m.EnterpriseID = o.EnterpriseID()
+	}
+	if o.Team != nil {
+		m.Team = o.Team()
+	}
+	if o.IncidentType != nil {
+		m.IncidentType = o.IncidentType()
+	}
+	if o.Private != nil {
+		m.Private = o.Private()
+	}
+	if o.FirstResponseAt != nil {
+		m.FirstResponseAt = o.FirstResponseAt()
+	}
 
 	o.setModelRels(m)
 
@@ -245,17 +453,132 @@ func ensureCreatableIncident(m *models.IncidentSetter) {
 func (o *IncidentTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Incident) (context.Context, error) {
 	var err error
 
+	isAlertIncidentsDone, _ := incidentRelAlertIncidentsCtx.Value(ctx)
+	if !isAlertIncidentsDone && o.r.AlertIncidents != nil {
+		ctx = incidentRelAlertIncidentsCtx.WithValue(ctx, true)
+		for _, r := range o.r.AlertIncidents {
+			var rel0 models.AlertIncidentSlice
+			ctx, rel0, err = r.o.createMany(ctx, exec, r.number)
+			if err != nil {
+				return ctx, err
+			}
+
+			err = m.AttachAlertIncidents(ctx, exec, rel0...)
+			if err != nil {
+				return ctx, err
+			}
+		}
+	}
+
+	isIncidentChecklistDone, _ := incidentRelIncidentChecklistCtx.Value(ctx)
+	if !isIncidentChecklistDone && o.r.IncidentChecklist != nil {
+		ctx = incidentRelIncidentChecklistCtx.WithValue(ctx, true)
+		var rel1 *models.IncidentChecklist
+		ctx, rel1, err = o.r.IncidentChecklist.o.create(ctx, exec)
+		if err != nil {
+			return ctx, err
+		}
+		err = m.AttachIncidentChecklist(ctx, exec, rel1)
+		if err != nil {
+			return ctx, err
+		}
+
+	}
+
+	isIncidentEscalationDone, _ := incidentRelIncidentEscalationCtx.Value(ctx)
+	if !isIncidentEscalationDone && o.r.IncidentEscalation != nil {
+		ctx = incidentRelIncidentEscalationCtx.WithValue(ctx, true)
+		var rel2 *models.IncidentEscalation
+		ctx, rel2, err = o.r.IncidentEscalation.o.create(ctx, exec)
+		if err != nil {
+			return ctx, err
+		}
+		err = m.AttachIncidentEscalation(ctx, exec, rel2)
+		if err != nil {
+			return ctx, err
+		}
+
+	}
+
+	isIncidentNotificationsDone, _ := incidentRelIncidentNotificationsCtx.Value(ctx)
+	if !isIncidentNotificationsDone && o.r.IncidentNotifications != nil {
+		ctx = incidentRelIncidentNotificationsCtx.WithValue(ctx, true)
+		for _, r := range o.r.IncidentNotifications {
+			var rel3 models.IncidentNotificationSlice
+			ctx, rel3, err = r.o.createMany(ctx, exec, r.number)
+			if err != nil {
+				return ctx, err
+			}
+
+			err = m.AttachIncidentNotifications(ctx, exec, rel3...)
+			if err != nil {
+				return ctx, err
+			}
+		}
+	}
+
+	isIncidentRolesDone, _ := incidentRelIncidentRolesCtx.Value(ctx)
+	if !isIncidentRolesDone && o.r.IncidentRoles != nil {
+		ctx = incidentRelIncidentRolesCtx.WithValue(ctx, true)
+		for _, r := range o.r.IncidentRoles {
+			var rel4 models.IncidentRoleSlice
+			ctx, rel4, err = r.o.createMany(ctx, exec, r.number)
+			if err != nil {
+				return ctx, err
+			}
+
+			err = m.AttachIncidentRoles(ctx, exec, rel4...)
+			if err != nil {
+				return ctx, err
+			}
+		}
+	}
+
+	isIncidentServicesDone, _ := incidentRelIncidentServicesCtx.Value(ctx)
+	if !isIncidentServicesDone && o.r.IncidentServices != nil {
+		ctx = incidentRelIncidentServicesCtx.WithValue(ctx, true)
+		for _, r := range o.r.IncidentServices {
+			var rel5 models.IncidentServiceSlice
+			ctx, rel5, err = r.o.createMany(ctx, exec, r.number)
+			if err != nil {
+				return ctx, err
+			}
+
+			err = m.AttachIncidentServices(ctx, exec, rel5...)
+			if err != nil {
+				return ctx, err
+			}
+		}
+	}
+
+	isIncidentSubscribersDone, _ := incidentRelIncidentSubscribersCtx.Value(ctx)
+	if !isIncidentSubscribersDone && o.r.IncidentSubscribers != nil {
+		ctx = incidentRelIncidentSubscribersCtx.WithValue(ctx, true)
+		for _, r := range o.r.IncidentSubscribers {
+			var rel6 models.IncidentSubscriberSlice
+			ctx, rel6, err = r.o.createMany(ctx, exec, r.number)
+			if err != nil {
+				return ctx, err
+			}
+
+			err = m.AttachIncidentSubscribers(ctx, exec, rel6...)
+			if err != nil {
+				return ctx, err
+			}
+		}
+	}
+
 	isTimelineEventsDone, _ := incidentRelTimelineEventsCtx.Value(ctx)
 	if !isTimelineEventsDone && o.r.TimelineEvents != nil {
 		ctx = incidentRelTimelineEventsCtx.WithValue(ctx, true)
 		for _, r := range o.r.TimelineEvents {
-			var rel0 models.TimelineEventSlice
-			ctx, rel0, err = r.o.createMany(ctx, exec, r.number)
+			var rel7 models.TimelineEventSlice
+			ctx, rel7, err = r.o.createMany(ctx, exec, r.number)
 			if err != nil {
 				return ctx, err
 			}
 
-			err = m.AttachTimelineEvents(ctx, exec, rel0...)
+			err = m.AttachTimelineEvents(ctx, exec, rel7...)
 			if err != nil {
 				return ctx, err
 			}
@@ -381,6 +704,18 @@ func (m incidentMods) RandomizeAllColumns(f *faker.Faker) IncidentMod {
 		IncidentMods.RandomResolvedAt(f),
 		IncidentMods.RandomExportURL(f),
 		IncidentMods.RandomLastUpdated(f),
+		IncidentMods.RandomSlackChannelName(f),
+		IncidentMods.RandomStartedByUserID(f),
+		IncidentMods.RandomNextUpdateDue(f),
+		IncidentMods.RandomSubStatus(f),
+		IncidentMods.RandomStalePromptedAt(f),
+		IncidentMods.RandomStaleSnoozedUntil(f),
+		IncidentMods.RandomTeamID(f),
+		IncidentMods.RandomEnterpriseID(f),
+		IncidentMods.RandomTeam(f),
+		IncidentMods.RandomIncidentType(f),
+		IncidentMods.RandomPrivate(f),
+		IncidentMods.RandomFirstResponseAt(f),
 	}
 }
 
@@ -624,14 +959,14 @@ func (m incidentMods) RandomStartedBy(f *faker.Faker) IncidentMod {
 }
 
 // Set the model columns to this value
-func (m incidentMods) StartedAt(val sql.Null[time.Time]) IncidentMod {
+func (m incidentMods) StartedAt(val time.Time) IncidentMod {
 	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
-		o.StartedAt = func() sql.Null[time.Time] { return val }
+		o.StartedAt = func() time.Time { return val }
 	})
 }
 
 // Set the Column from the function
-func (m incidentMods) StartedAtFunc(f func() sql.Null[time.Time]) IncidentMod {
+func (m incidentMods) StartedAtFunc(f func() time.Time) IncidentMod {
 	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
 		o.StartedAt = f
 	})
@@ -646,32 +981,10 @@ func (m incidentMods) UnsetStartedAt() IncidentMod {
 
 // Generates a random value for the column using the given faker
 // if faker is nil, a default faker is used
-// The generated value is sometimes null
 func (m incidentMods) RandomStartedAt(f *faker.Faker) IncidentMod {
 	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
-		o.StartedAt = func() sql.Null[time.Time] {
-			if f == nil {
-				f = &defaultFaker
-			}
-
-			val := random_time_Time(f)
-			return sql.Null[time.Time]{V: val, Valid: f.Bool()}
-		}
-	})
-}
-
-// Generates a random value for the column using the given faker
-// if faker is nil, a default faker is used
-// The generated value is never null
-func (m incidentMods) RandomStartedAtNotNull(f *faker.Faker) IncidentMod {
-	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
-		o.StartedAt = func() sql.Null[time.Time] {
-			if f == nil {
-				f = &defaultFaker
-			}
-
-			val := random_time_Time(f)
-			return sql.Null[time.Time]{V: val, Valid: true}
+		o.StartedAt = func() time.Time {
+			return random_time_Time(f)
 		}
 	})
 }
@@ -836,14 +1149,14 @@ func (m incidentMods) RandomExportURLNotNull(f *faker.Faker) IncidentMod {
 }
 
 // Set the model columns to this value
-func (m incidentMods) LastUpdated(val sql.Null[time.Time]) IncidentMod {
+func (m incidentMods) LastUpdated(val time.Time) IncidentMod {
 	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
-		o.LastUpdated = func() sql.Null[time.Time] { return val }
+		o.LastUpdated = func() time.Time { return val }
 	})
 }
 
 // Set the Column from the function
-func (m incidentMods) LastUpdatedFunc(f func() sql.Null[time.Time]) IncidentMod {
+func (m incidentMods) LastUpdatedFunc(f func() time.Time) IncidentMod {
 	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
 		o.LastUpdated = f
 	})
@@ -858,16 +1171,47 @@ func (m incidentMods) UnsetLastUpdated() IncidentMod {
 
 // Generates a random value for the column using the given faker
 // if faker is nil, a default faker is used
-// The generated value is sometimes null
 func (m incidentMods) RandomLastUpdated(f *faker.Faker) IncidentMod {
 	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
-		o.LastUpdated = func() sql.Null[time.Time] {
+		o.LastUpdated = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentMods) SlackChannelName(val sql.Null[string]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.SlackChannelName = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentMods) SlackChannelNameFunc(f func() sql.Null[string]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.SlackChannelName = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentMods) UnsetSlackChannelName() IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.SlackChannelName = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m incidentMods) RandomSlackChannelName(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.SlackChannelName = func() sql.Null[string] {
 			if f == nil {
 				f = &defaultFaker
 			}
 
-			val := random_time_Time(f)
-			return sql.Null[time.Time]{V: val, Valid: f.Bool()}
+			val := random_string(f)
+			return sql.Null[string]{V: val, Valid: f.Bool()}
 		}
 	})
 }
@@ -875,25 +1219,830 @@ func (m incidentMods) RandomLastUpdated(f *faker.Faker) IncidentMod {
 // Generates a random value for the column using the given faker
 // if faker is nil, a default faker is used
 // The generated value is never null
-func (m incidentMods) RandomLastUpdatedNotNull(f *faker.Faker) IncidentMod {
+func (m incidentMods) RandomSlackChannelNameNotNull(f *faker.Faker) IncidentMod {
 	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
-		o.LastUpdated = func() sql.Null[time.Time] {
+		o.SlackChannelName = func() sql.Null[string] {
 			if f == nil {
 				f = &defaultFaker
 			}
 
-			val := random_time_Time(f)
-			return sql.Null[time.Time]{V: val, Valid: true}
+			val := random_string(f)
+			return sql.Null[string]{V: val, Valid: true}
 		}
 	})
 }
 
-func (m incidentMods) WithParentsCascading() IncidentMod {
-	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
-		if isDone, _ := incidentWithParentsCascadingCtx.Value(ctx); isDone {
-			return
+// Set the model columns to this value
+func (m incidentMods) StartedByUserID(val sql.Null[string]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.StartedByUserID = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentMods) StartedByUserIDFunc(f func() sql.Null[string]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.StartedByUserID = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentMods) UnsetStartedByUserID() IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.StartedByUserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m incidentMods) RandomStartedByUserID(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.StartedByUserID = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return sql.Null[string]{V: val, Valid: f.Bool()}
 		}
-		ctx = incidentWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m incidentMods) RandomStartedByUserIDNotNull(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.StartedByUserID = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return sql.Null[string]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentMods) NextUpdateDue(val sql.Null[time.Time]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.NextUpdateDue = func() sql.Null[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentMods) NextUpdateDueFunc(f func() sql.Null[time.Time]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.NextUpdateDue = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentMods) UnsetNextUpdateDue() IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.NextUpdateDue = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m incidentMods) RandomNextUpdateDue(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.NextUpdateDue = func() sql.Null[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return sql.Null[time.Time]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m incidentMods) RandomNextUpdateDueNotNull(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.NextUpdateDue = func() sql.Null[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return sql.Null[time.Time]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentMods) SubStatus(val sql.Null[string]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.SubStatus = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentMods) SubStatusFunc(f func() sql.Null[string]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.SubStatus = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentMods) UnsetSubStatus() IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.SubStatus = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m incidentMods) RandomSubStatus(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.SubStatus = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "20")
+			return sql.Null[string]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m incidentMods) RandomSubStatusNotNull(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.SubStatus = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "20")
+			return sql.Null[string]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentMods) StalePromptedAt(val sql.Null[time.Time]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.StalePromptedAt = func() sql.Null[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentMods) StalePromptedAtFunc(f func() sql.Null[time.Time]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.StalePromptedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentMods) UnsetStalePromptedAt() IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.StalePromptedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m incidentMods) RandomStalePromptedAt(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.StalePromptedAt = func() sql.Null[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return sql.Null[time.Time]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m incidentMods) RandomStalePromptedAtNotNull(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.StalePromptedAt = func() sql.Null[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return sql.Null[time.Time]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentMods) StaleSnoozedUntil(val sql.Null[time.Time]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.StaleSnoozedUntil = func() sql.Null[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentMods) StaleSnoozedUntilFunc(f func() sql.Null[time.Time]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.StaleSnoozedUntil = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentMods) UnsetStaleSnoozedUntil() IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.StaleSnoozedUntil = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m incidentMods) RandomStaleSnoozedUntil(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.StaleSnoozedUntil = func() sql.Null[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return sql.Null[time.Time]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m incidentMods) RandomStaleSnoozedUntilNotNull(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.StaleSnoozedUntil = func() sql.Null[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return sql.Null[time.Time]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentMods) TeamID(val sql.Null[string]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.TeamID = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentMods) TeamIDFunc(f func() sql.Null[string]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.TeamID = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentMods) UnsetTeamID() IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.TeamID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m incidentMods) RandomTeamID(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.TeamID = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "20")
+			return sql.Null[string]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m incidentMods) RandomTeamIDNotNull(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.TeamID = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "20")
+			return sql.Null[string]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentMods) EnterpriseID(val sql.Null[string]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.EnterpriseID = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentMods) EnterpriseIDFunc(f func() sql.Null[string]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.EnterpriseID = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentMods) UnsetEnterpriseID() IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.EnterpriseID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m incidentMods) RandomEnterpriseID(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.EnterpriseID = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "20")
+			return sql.Null[string]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m incidentMods) RandomEnterpriseIDNotNull(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.EnterpriseID = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "20")
+			return sql.Null[string]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentMods) Team(val sql.Null[string]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.Team = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentMods) TeamFunc(f func() sql.Null[string]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.Team = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentMods) UnsetTeam() IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.Team = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m incidentMods) RandomTeam(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.Team = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "80")
+			return sql.Null[string]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m incidentMods) RandomTeamNotNull(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.Team = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "80")
+			return sql.Null[string]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentMods) IncidentType(val sql.Null[string]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.IncidentType = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentMods) IncidentTypeFunc(f func() sql.Null[string]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.IncidentType = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentMods) UnsetIncidentType() IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.IncidentType = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m incidentMods) RandomIncidentType(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.IncidentType = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "80")
+			return sql.Null[string]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m incidentMods) RandomIncidentTypeNotNull(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.IncidentType = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "80")
+			return sql.Null[string]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentMods) Private(val bool) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.Private = func() bool { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentMods) PrivateFunc(f func() bool) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.Private = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentMods) UnsetPrivate() IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.Private = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m incidentMods) RandomPrivate(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.Private = func() bool {
+			return random_bool(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m incidentMods) FirstResponseAt(val sql.Null[time.Time]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.FirstResponseAt = func() sql.Null[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m incidentMods) FirstResponseAtFunc(f func() sql.Null[time.Time]) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.FirstResponseAt = f
+	})
+}
+
+// Clear any values for the column
+func (m incidentMods) UnsetFirstResponseAt() IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.FirstResponseAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m incidentMods) RandomFirstResponseAt(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.FirstResponseAt = func() sql.Null[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return sql.Null[time.Time]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m incidentMods) RandomFirstResponseAtNotNull(f *faker.Faker) IncidentMod {
+	return IncidentModFunc(func(_ context.Context, o *IncidentTemplate) {
+		o.FirstResponseAt = func() sql.Null[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return sql.Null[time.Time]{V: val, Valid: true}
+		}
+	})
+}
+
+func (m incidentMods) WithParentsCascading() IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		if isDone, _ := incidentWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = incidentWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewIncidentChecklist(ctx, IncidentChecklistMods.WithParentsCascading())
+			m.WithIncidentChecklist(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewIncidentEscalation(ctx, IncidentEscalationMods.WithParentsCascading())
+			m.WithIncidentEscalation(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m incidentMods) WithIncidentChecklist(rel *IncidentChecklistTemplate) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		o.r.IncidentChecklist = &incidentRIncidentChecklistR{
+			o: rel,
+		}
+	})
+}
+
+func (m incidentMods) WithNewIncidentChecklist(mods ...IncidentChecklistMod) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		related := o.f.NewIncidentChecklist(ctx, mods...)
+
+		m.WithIncidentChecklist(related).Apply(ctx, o)
+	})
+}
+
+func (m incidentMods) WithoutIncidentChecklist() IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		o.r.IncidentChecklist = nil
+	})
+}
+
+func (m incidentMods) WithIncidentEscalation(rel *IncidentEscalationTemplate) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		o.r.IncidentEscalation = &incidentRIncidentEscalationR{
+			o: rel,
+		}
+	})
+}
+
+func (m incidentMods) WithNewIncidentEscalation(mods ...IncidentEscalationMod) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		related := o.f.NewIncidentEscalation(ctx, mods...)
+
+		m.WithIncidentEscalation(related).Apply(ctx, o)
+	})
+}
+
+func (m incidentMods) WithoutIncidentEscalation() IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		o.r.IncidentEscalation = nil
+	})
+}
+
+func (m incidentMods) WithAlertIncidents(number int, related *AlertIncidentTemplate) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		o.r.AlertIncidents = []*incidentRAlertIncidentsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m incidentMods) WithNewAlertIncidents(number int, mods ...AlertIncidentMod) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		related := o.f.NewAlertIncident(ctx, mods...)
+		m.WithAlertIncidents(number, related).Apply(ctx, o)
+	})
+}
+
+func (m incidentMods) AddAlertIncidents(number int, related *AlertIncidentTemplate) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		o.r.AlertIncidents = append(o.r.AlertIncidents, &incidentRAlertIncidentsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m incidentMods) AddNewAlertIncidents(number int, mods ...AlertIncidentMod) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		related := o.f.NewAlertIncident(ctx, mods...)
+		m.AddAlertIncidents(number, related).Apply(ctx, o)
+	})
+}
+
+func (m incidentMods) WithoutAlertIncidents() IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		o.r.AlertIncidents = nil
+	})
+}
+
+func (m incidentMods) WithIncidentNotifications(number int, related *IncidentNotificationTemplate) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		o.r.IncidentNotifications = []*incidentRIncidentNotificationsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m incidentMods) WithNewIncidentNotifications(number int, mods ...IncidentNotificationMod) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		related := o.f.NewIncidentNotification(ctx, mods...)
+		m.WithIncidentNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m incidentMods) AddIncidentNotifications(number int, related *IncidentNotificationTemplate) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		o.r.IncidentNotifications = append(o.r.IncidentNotifications, &incidentRIncidentNotificationsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m incidentMods) AddNewIncidentNotifications(number int, mods ...IncidentNotificationMod) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		related := o.f.NewIncidentNotification(ctx, mods...)
+		m.AddIncidentNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m incidentMods) WithoutIncidentNotifications() IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		o.r.IncidentNotifications = nil
+	})
+}
+
+func (m incidentMods) WithIncidentRoles(number int, related *IncidentRoleTemplate) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		o.r.IncidentRoles = []*incidentRIncidentRolesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m incidentMods) WithNewIncidentRoles(number int, mods ...IncidentRoleMod) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		related := o.f.NewIncidentRole(ctx, mods...)
+		m.WithIncidentRoles(number, related).Apply(ctx, o)
+	})
+}
+
+func (m incidentMods) AddIncidentRoles(number int, related *IncidentRoleTemplate) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		o.r.IncidentRoles = append(o.r.IncidentRoles, &incidentRIncidentRolesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m incidentMods) AddNewIncidentRoles(number int, mods ...IncidentRoleMod) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		related := o.f.NewIncidentRole(ctx, mods...)
+		m.AddIncidentRoles(number, related).Apply(ctx, o)
+	})
+}
+
+func (m incidentMods) WithoutIncidentRoles() IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		o.r.IncidentRoles = nil
+	})
+}
+
+func (m incidentMods) WithIncidentServices(number int, related *IncidentServiceTemplate) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		o.r.IncidentServices = []*incidentRIncidentServicesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m incidentMods) WithNewIncidentServices(number int, mods ...IncidentServiceMod) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		related := o.f.NewIncidentService(ctx, mods...)
+		m.WithIncidentServices(number, related).Apply(ctx, o)
+	})
+}
+
+func (m incidentMods) AddIncidentServices(number int, related *IncidentServiceTemplate) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		o.r.IncidentServices = append(o.r.IncidentServices, &incidentRIncidentServicesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m incidentMods) AddNewIncidentServices(number int, mods ...IncidentServiceMod) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		related := o.f.NewIncidentService(ctx, mods...)
+		m.AddIncidentServices(number, related).Apply(ctx, o)
+	})
+}
+
+func (m incidentMods) WithoutIncidentServices() IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		o.r.IncidentServices = nil
+	})
+}
+
+func (m incidentMods) WithIncidentSubscribers(number int, related *IncidentSubscriberTemplate) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		o.r.IncidentSubscribers = []*incidentRIncidentSubscribersR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m incidentMods) WithNewIncidentSubscribers(number int, mods ...IncidentSubscriberMod) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		related := o.f.NewIncidentSubscriber(ctx, mods...)
+		m.WithIncidentSubscribers(number, related).Apply(ctx, o)
+	})
+}
+
+func (m incidentMods) AddIncidentSubscribers(number int, related *IncidentSubscriberTemplate) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		o.r.IncidentSubscribers = append(o.r.IncidentSubscribers, &incidentRIncidentSubscribersR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m incidentMods) AddNewIncidentSubscribers(number int, mods ...IncidentSubscriberMod) IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		related := o.f.NewIncidentSubscriber(ctx, mods...)
+		m.AddIncidentSubscribers(number, related).Apply(ctx, o)
+	})
+}
+
+func (m incidentMods) WithoutIncidentSubscribers() IncidentMod {
+	return IncidentModFunc(func(ctx context.Context, o *IncidentTemplate) {
+		o.r.IncidentSubscribers = nil
 	})
 }
 
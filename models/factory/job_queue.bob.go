@@ -0,0 +1,595 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	models "github.com/fishnix/ohshift/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/types"
+)
+
+type JobQueueMod interface {
+	Apply(context.Context, *JobQueueTemplate)
+}
+
+type JobQueueModFunc func(context.Context, *JobQueueTemplate)
+
+func (f JobQueueModFunc) Apply(ctx context.Context, n *JobQueueTemplate) {
+	f(ctx, n)
+}
+
+type JobQueueModSlice []JobQueueMod
+
+func (mods JobQueueModSlice) Apply(ctx context.Context, n *JobQueueTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// JobQueueTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type JobQueueTemplate struct {
+	ID         func() int64
+	Kind       func() string
+	Payload    func() types.JSON[json.RawMessage]
+	RunAt      func() time.Time
+	Attempts   func() int32
+	LastError  func() sql.Null[string]
+	CreatedAt  func() time.Time
+	FinishedAt func() sql.Null[time.Time]
+
+	f *Factory
+}
+
+// Apply mods to the JobQueueTemplate
+func (o *JobQueueTemplate) Apply(ctx context.Context, mods ...JobQueueMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.JobQueue
+// according to the relationships in the template. Nothing is inserted into the db
+func (t JobQueueTemplate) setModelRels(o *models.JobQueue) {}
+
+// BuildSetter returns an *models.JobQueueSetter
+// this does nothing with the relationship templates
+func (o JobQueueTemplate) BuildSetter() *models.JobQueueSetter {
+	m := &models.JobQueueSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = &val
+	}
+	if o.Kind != nil {
+		val := o.Kind()
+		m.Kind = &val
+	}
+	if o.Payload != nil {
+		val := o.Payload()
+		m.Payload = &val
+	}
+	if o.RunAt != nil {
+		val := o.RunAt()
+		m.RunAt = &val
+	}
+	if o.Attempts != nil {
+		val := o.Attempts()
+		m.Attempts = &val
+	}
+	if o.LastError != nil {
+		val := o.LastError()
+		m.LastError = &val
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = &val
+	}
+	if o.FinishedAt != nil {
+		val := o.FinishedAt()
+		m.FinishedAt = &val
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.JobQueueSetter
+// this does nothing with the relationship templates
+func (o JobQueueTemplate) BuildManySetter(number int) []*models.JobQueueSetter {
+	m := make([]*models.JobQueueSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.JobQueue
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use JobQueueTemplate.Create
+func (o JobQueueTemplate) Build() *models.JobQueue {
+	m := &models.JobQueue{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.Kind != nil {
+		m.Kind = o.Kind()
+	}
+	if o.Payload != nil {
+		m.Payload = o.Payload()
+	}
+	if o.RunAt != nil {
+		m.RunAt = o.RunAt()
+	}
+	if o.Attempts != nil {
+		m.Attempts = o.Attempts()
+	}
+	if o.LastError != nil {
+		m.LastError = o.LastError()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.FinishedAt != nil {
+		m.FinishedAt = o.FinishedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.JobQueueSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use JobQueueTemplate.CreateMany
+func (o JobQueueTemplate) BuildMany(number int) models.JobQueueSlice {
+	m := make(models.JobQueueSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableJobQueue(m *models.JobQueueSetter) {
+	if m.Kind == nil {
+		val := random_string(nil, "50")
+		m.Kind = &val
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.JobQueue
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *JobQueueTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.JobQueue) (context.Context, error) {
+	var err error
+
+	return ctx, err
+}
+
+// Create builds a jobQueue and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *JobQueueTemplate) Create(ctx context.Context, exec bob.Executor) (*models.JobQueue, error) {
+	_, m, err := o.create(ctx, exec)
+	return m, err
+}
+
+// MustCreate builds a jobQueue and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *JobQueueTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.JobQueue {
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a jobQueue and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *JobQueueTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.JobQueue {
+	tb.Helper()
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// create builds a jobQueue and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted model
+func (o *JobQueueTemplate) create(ctx context.Context, exec bob.Executor) (context.Context, *models.JobQueue, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableJobQueue(opt)
+
+	m, err := models.JobQueues.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return ctx, nil, err
+	}
+	ctx = jobQueueCtx.WithValue(ctx, m)
+
+	ctx, err = o.insertOptRels(ctx, exec, m)
+	return ctx, m, err
+}
+
+// CreateMany builds multiple jobQueues and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o JobQueueTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.JobQueueSlice, error) {
+	_, m, err := o.createMany(ctx, exec, number)
+	return m, err
+}
+
+// MustCreateMany builds multiple jobQueues and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o JobQueueTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.JobQueueSlice {
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple jobQueues and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o JobQueueTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.JobQueueSlice {
+	tb.Helper()
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// createMany builds multiple jobQueues and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted models
+func (o JobQueueTemplate) createMany(ctx context.Context, exec bob.Executor, number int) (context.Context, models.JobQueueSlice, error) {
+	var err error
+	m := make(models.JobQueueSlice, number)
+
+	for i := range m {
+		ctx, m[i], err = o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	return ctx, m, nil
+}
+
+// JobQueue has methods that act as mods for the JobQueueTemplate
+var JobQueueMods jobQueueMods
+
+type jobQueueMods struct{}
+
+func (m jobQueueMods) RandomizeAllColumns(f *faker.Faker) JobQueueMod {
+	return JobQueueModSlice{
+		JobQueueMods.RandomID(f),
+		JobQueueMods.RandomKind(f),
+		JobQueueMods.RandomPayload(f),
+		JobQueueMods.RandomRunAt(f),
+		JobQueueMods.RandomAttempts(f),
+		JobQueueMods.RandomLastError(f),
+		JobQueueMods.RandomCreatedAt(f),
+		JobQueueMods.RandomFinishedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m jobQueueMods) ID(val int64) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m jobQueueMods) IDFunc(f func() int64) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m jobQueueMods) UnsetID() JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m jobQueueMods) RandomID(f *faker.Faker) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m jobQueueMods) Kind(val string) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.Kind = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m jobQueueMods) KindFunc(f func() string) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.Kind = f
+	})
+}
+
+// Clear any values for the column
+func (m jobQueueMods) UnsetKind() JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.Kind = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m jobQueueMods) RandomKind(f *faker.Faker) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.Kind = func() string {
+			return random_string(f, "50")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m jobQueueMods) Payload(val types.JSON[json.RawMessage]) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.Payload = func() types.JSON[json.RawMessage] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m jobQueueMods) PayloadFunc(f func() types.JSON[json.RawMessage]) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.Payload = f
+	})
+}
+
+// Clear any values for the column
+func (m jobQueueMods) UnsetPayload() JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.Payload = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m jobQueueMods) RandomPayload(f *faker.Faker) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.Payload = func() types.JSON[json.RawMessage] {
+			return random_types_JSON_json_RawMessage_(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m jobQueueMods) RunAt(val time.Time) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.RunAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m jobQueueMods) RunAtFunc(f func() time.Time) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.RunAt = f
+	})
+}
+
+// Clear any values for the column
+func (m jobQueueMods) UnsetRunAt() JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.RunAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m jobQueueMods) RandomRunAt(f *faker.Faker) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.RunAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m jobQueueMods) Attempts(val int32) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.Attempts = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m jobQueueMods) AttemptsFunc(f func() int32) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.Attempts = f
+	})
+}
+
+// Clear any values for the column
+func (m jobQueueMods) UnsetAttempts() JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.Attempts = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m jobQueueMods) RandomAttempts(f *faker.Faker) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.Attempts = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m jobQueueMods) LastError(val sql.Null[string]) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.LastError = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m jobQueueMods) LastErrorFunc(f func() sql.Null[string]) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.LastError = f
+	})
+}
+
+// Clear any values for the column
+func (m jobQueueMods) UnsetLastError() JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.LastError = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m jobQueueMods) RandomLastError(f *faker.Faker) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.LastError = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return sql.Null[string]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m jobQueueMods) RandomLastErrorNotNull(f *faker.Faker) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.LastError = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return sql.Null[string]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m jobQueueMods) CreatedAt(val time.Time) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m jobQueueMods) CreatedAtFunc(f func() time.Time) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m jobQueueMods) UnsetCreatedAt() JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m jobQueueMods) RandomCreatedAt(f *faker.Faker) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m jobQueueMods) FinishedAt(val sql.Null[time.Time]) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.FinishedAt = func() sql.Null[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m jobQueueMods) FinishedAtFunc(f func() sql.Null[time.Time]) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.FinishedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m jobQueueMods) UnsetFinishedAt() JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.FinishedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m jobQueueMods) RandomFinishedAt(f *faker.Faker) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.FinishedAt = func() sql.Null[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return sql.Null[time.Time]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m jobQueueMods) RandomFinishedAtNotNull(f *faker.Faker) JobQueueMod {
+	return JobQueueModFunc(func(_ context.Context, o *JobQueueTemplate) {
+		o.FinishedAt = func() sql.Null[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return sql.Null[time.Time]{V: val, Valid: true}
+		}
+	})
+}
+
+func (m jobQueueMods) WithParentsCascading() JobQueueMod {
+	return JobQueueModFunc(func(ctx context.Context, o *JobQueueTemplate) {
+		if isDone, _ := jobQueueWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = jobQueueWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
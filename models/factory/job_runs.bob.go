@@ -0,0 +1,352 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	models "github.com/fishnix/ohshift/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type JobRunMod interface {
+	Apply(context.Context, *JobRunTemplate)
+}
+
+type JobRunModFunc func(context.Context, *JobRunTemplate)
+
+func (f JobRunModFunc) Apply(ctx context.Context, n *JobRunTemplate) {
+	f(ctx, n)
+}
+
+type JobRunModSlice []JobRunMod
+
+func (mods JobRunModSlice) Apply(ctx context.Context, n *JobRunTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// JobRunTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type JobRunTemplate struct {
+	Job         func() string
+	ScheduledAt func() time.Time
+	FinishedAt  func() time.Time
+
+	f *Factory
+}
+
+// Apply mods to the JobRunTemplate
+func (o *JobRunTemplate) Apply(ctx context.Context, mods ...JobRunMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.JobRun
+// according to the relationships in the template. Nothing is inserted into the db
+func (t JobRunTemplate) setModelRels(o *models.JobRun) {}
+
+// BuildSetter returns an *models.JobRunSetter
+// this does nothing with the relationship templates
+func (o JobRunTemplate) BuildSetter() *models.JobRunSetter {
+	m := &models.JobRunSetter{}
+
+	if o.Job != nil {
+		val := o.Job()
+		m.Job = &val
+	}
+	if o.ScheduledAt != nil {
+		val := o.ScheduledAt()
+		m.ScheduledAt = &val
+	}
+	if o.FinishedAt != nil {
+		val := o.FinishedAt()
+		m.FinishedAt = &val
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.JobRunSetter
+// this does nothing with the relationship templates
+func (o JobRunTemplate) BuildManySetter(number int) []*models.JobRunSetter {
+	m := make([]*models.JobRunSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.JobRun
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use JobRunTemplate.Create
+func (o JobRunTemplate) Build() *models.JobRun {
+	m := &models.JobRun{}
+
+	if o.Job != nil {
+		m.Job = o.Job()
+	}
+	if o.ScheduledAt != nil {
+		m.ScheduledAt = o.ScheduledAt()
+	}
+	if o.FinishedAt != nil {
+		m.FinishedAt = o.FinishedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.JobRunSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use JobRunTemplate.CreateMany
+func (o JobRunTemplate) BuildMany(number int) models.JobRunSlice {
+	m := make(models.JobRunSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableJobRun(m *models.JobRunSetter) {
+	if m.Job == nil {
+		val := random_string(nil, "100")
+		m.Job = &val
+	}
+	if m.ScheduledAt == nil {
+		val := random_time_Time(nil)
+		m.ScheduledAt = &val
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.JobRun
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *JobRunTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.JobRun) (context.Context, error) {
+	var err error
+
+	return ctx, err
+}
+
+// Create builds a jobRun and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *JobRunTemplate) Create(ctx context.Context, exec bob.Executor) (*models.JobRun, error) {
+	_, m, err := o.create(ctx, exec)
+	return m, err
+}
+
+// MustCreate builds a jobRun and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *JobRunTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.JobRun {
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a jobRun and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *JobRunTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.JobRun {
+	tb.Helper()
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// create builds a jobRun and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted model
+func (o *JobRunTemplate) create(ctx context.Context, exec bob.Executor) (context.Context, *models.JobRun, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableJobRun(opt)
+
+	m, err := models.JobRuns.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return ctx, nil, err
+	}
+	ctx = jobRunCtx.WithValue(ctx, m)
+
+	ctx, err = o.insertOptRels(ctx, exec, m)
+	return ctx, m, err
+}
+
+// CreateMany builds multiple jobRuns and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o JobRunTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.JobRunSlice, error) {
+	_, m, err := o.createMany(ctx, exec, number)
+	return m, err
+}
+
+// MustCreateMany builds multiple jobRuns and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o JobRunTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.JobRunSlice {
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple jobRuns and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o JobRunTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.JobRunSlice {
+	tb.Helper()
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// createMany builds multiple jobRuns and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted models
+func (o JobRunTemplate) createMany(ctx context.Context, exec bob.Executor, number int) (context.Context, models.JobRunSlice, error) {
+	var err error
+	m := make(models.JobRunSlice, number)
+
+	for i := range m {
+		ctx, m[i], err = o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	return ctx, m, nil
+}
+
+// JobRun has methods that act as mods for the JobRunTemplate
+var JobRunMods jobRunMods
+
+type jobRunMods struct{}
+
+func (m jobRunMods) RandomizeAllColumns(f *faker.Faker) JobRunMod {
+	return JobRunModSlice{
+		JobRunMods.RandomJob(f),
+		JobRunMods.RandomScheduledAt(f),
+		JobRunMods.RandomFinishedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m jobRunMods) Job(val string) JobRunMod {
+	return JobRunModFunc(func(_ context.Context, o *JobRunTemplate) {
+		o.Job = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m jobRunMods) JobFunc(f func() string) JobRunMod {
+	return JobRunModFunc(func(_ context.Context, o *JobRunTemplate) {
+		o.Job = f
+	})
+}
+
+// Clear any values for the column
+func (m jobRunMods) UnsetJob() JobRunMod {
+	return JobRunModFunc(func(_ context.Context, o *JobRunTemplate) {
+		o.Job = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m jobRunMods) RandomJob(f *faker.Faker) JobRunMod {
+	return JobRunModFunc(func(_ context.Context, o *JobRunTemplate) {
+		o.Job = func() string {
+			return random_string(f, "100")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m jobRunMods) ScheduledAt(val time.Time) JobRunMod {
+	return JobRunModFunc(func(_ context.Context, o *JobRunTemplate) {
+		o.ScheduledAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m jobRunMods) ScheduledAtFunc(f func() time.Time) JobRunMod {
+	return JobRunModFunc(func(_ context.Context, o *JobRunTemplate) {
+		o.ScheduledAt = f
+	})
+}
+
+// Clear any values for the column
+func (m jobRunMods) UnsetScheduledAt() JobRunMod {
+	return JobRunModFunc(func(_ context.Context, o *JobRunTemplate) {
+		o.ScheduledAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m jobRunMods) RandomScheduledAt(f *faker.Faker) JobRunMod {
+	return JobRunModFunc(func(_ context.Context, o *JobRunTemplate) {
+		o.ScheduledAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m jobRunMods) FinishedAt(val time.Time) JobRunMod {
+	return JobRunModFunc(func(_ context.Context, o *JobRunTemplate) {
+		o.FinishedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m jobRunMods) FinishedAtFunc(f func() time.Time) JobRunMod {
+	return JobRunModFunc(func(_ context.Context, o *JobRunTemplate) {
+		o.FinishedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m jobRunMods) UnsetFinishedAt() JobRunMod {
+	return JobRunModFunc(func(_ context.Context, o *JobRunTemplate) {
+		o.FinishedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m jobRunMods) RandomFinishedAt(f *faker.Faker) JobRunMod {
+	return JobRunModFunc(func(_ context.Context, o *JobRunTemplate) {
+		o.FinishedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m jobRunMods) WithParentsCascading() JobRunMod {
+	return JobRunModFunc(func(ctx context.Context, o *JobRunTemplate) {
+		if isDone, _ := jobRunWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = jobRunWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
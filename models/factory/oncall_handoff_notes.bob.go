@@ -0,0 +1,436 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	models "github.com/fishnix/ohshift/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type OncallHandoffNoteMod interface {
+	Apply(context.Context, *OncallHandoffNoteTemplate)
+}
+
+type OncallHandoffNoteModFunc func(context.Context, *OncallHandoffNoteTemplate)
+
+func (f OncallHandoffNoteModFunc) Apply(ctx context.Context, n *OncallHandoffNoteTemplate) {
+	f(ctx, n)
+}
+
+type OncallHandoffNoteModSlice []OncallHandoffNoteMod
+
+func (mods OncallHandoffNoteModSlice) Apply(ctx context.Context, n *OncallHandoffNoteTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// OncallHandoffNoteTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type OncallHandoffNoteTemplate struct {
+	ID          func() int64
+	Rotation    func() string
+	SlackUserID func() string
+	Note        func() string
+	CreatedAt   func() time.Time
+
+	f *Factory
+}
+
+// Apply mods to the OncallHandoffNoteTemplate
+func (o *OncallHandoffNoteTemplate) Apply(ctx context.Context, mods ...OncallHandoffNoteMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.OncallHandoffNote
+// according to the relationships in the template. Nothing is inserted into the db
+func (t OncallHandoffNoteTemplate) setModelRels(o *models.OncallHandoffNote) {}
+
+// BuildSetter returns an *models.OncallHandoffNoteSetter
+// this does nothing with the relationship templates
+func (o OncallHandoffNoteTemplate) BuildSetter() *models.OncallHandoffNoteSetter {
+	m := &models.OncallHandoffNoteSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = &val
+	}
+	if o.Rotation != nil {
+		val := o.Rotation()
+		m.Rotation = &val
+	}
+	if o.SlackUserID != nil {
+		val := o.SlackUserID()
+		m.SlackUserID = &val
+	}
+	if o.Note != nil {
+		val := o.Note()
+		m.Note = &val
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = &val
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.OncallHandoffNoteSetter
+// this does nothing with the relationship templates
+func (o OncallHandoffNoteTemplate) BuildManySetter(number int) []*models.OncallHandoffNoteSetter {
+	m := make([]*models.OncallHandoffNoteSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.OncallHandoffNote
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use OncallHandoffNoteTemplate.Create
+func (o OncallHandoffNoteTemplate) Build() *models.OncallHandoffNote {
+	m := &models.OncallHandoffNote{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.Rotation != nil {
+		m.Rotation = o.Rotation()
+	}
+	if o.SlackUserID != nil {
+		m.SlackUserID = o.SlackUserID()
+	}
+	if o.Note != nil {
+		m.Note = o.Note()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.OncallHandoffNoteSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use OncallHandoffNoteTemplate.CreateMany
+func (o OncallHandoffNoteTemplate) BuildMany(number int) models.OncallHandoffNoteSlice {
+	m := make(models.OncallHandoffNoteSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableOncallHandoffNote(m *models.OncallHandoffNoteSetter) {
+	if m.Rotation == nil {
+		val := random_string(nil, "80")
+		m.Rotation = &val
+	}
+	if m.SlackUserID == nil {
+		val := random_string(nil)
+		m.SlackUserID = &val
+	}
+	if m.Note == nil {
+		val := random_string(nil)
+		m.Note = &val
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.OncallHandoffNote
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *OncallHandoffNoteTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.OncallHandoffNote) (context.Context, error) {
+	var err error
+
+	return ctx, err
+}
+
+// Create builds a oncallHandoffNote and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *OncallHandoffNoteTemplate) Create(ctx context.Context, exec bob.Executor) (*models.OncallHandoffNote, error) {
+	_, m, err := o.create(ctx, exec)
+	return m, err
+}
+
+// MustCreate builds a oncallHandoffNote and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *OncallHandoffNoteTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.OncallHandoffNote {
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a oncallHandoffNote and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *OncallHandoffNoteTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.OncallHandoffNote {
+	tb.Helper()
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// create builds a oncallHandoffNote and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted model
+func (o *OncallHandoffNoteTemplate) create(ctx context.Context, exec bob.Executor) (context.Context, *models.OncallHandoffNote, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableOncallHandoffNote(opt)
+
+	m, err := models.OncallHandoffNotes.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return ctx, nil, err
+	}
+	ctx = oncallHandoffNoteCtx.WithValue(ctx, m)
+
+	ctx, err = o.insertOptRels(ctx, exec, m)
+	return ctx, m, err
+}
+
+// CreateMany builds multiple oncallHandoffNotes and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o OncallHandoffNoteTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.OncallHandoffNoteSlice, error) {
+	_, m, err := o.createMany(ctx, exec, number)
+	return m, err
+}
+
+// MustCreateMany builds multiple oncallHandoffNotes and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o OncallHandoffNoteTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.OncallHandoffNoteSlice {
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple oncallHandoffNotes and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o OncallHandoffNoteTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.OncallHandoffNoteSlice {
+	tb.Helper()
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// createMany builds multiple oncallHandoffNotes and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted models
+func (o OncallHandoffNoteTemplate) createMany(ctx context.Context, exec bob.Executor, number int) (context.Context, models.OncallHandoffNoteSlice, error) {
+	var err error
+	m := make(models.OncallHandoffNoteSlice, number)
+
+	for i := range m {
+		ctx, m[i], err = o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	return ctx, m, nil
+}
+
+// OncallHandoffNote has methods that act as mods for the OncallHandoffNoteTemplate
+var OncallHandoffNoteMods oncallHandoffNoteMods
+
+type oncallHandoffNoteMods struct{}
+
+func (m oncallHandoffNoteMods) RandomizeAllColumns(f *faker.Faker) OncallHandoffNoteMod {
+	return OncallHandoffNoteModSlice{
+		OncallHandoffNoteMods.RandomID(f),
+		OncallHandoffNoteMods.RandomRotation(f),
+		OncallHandoffNoteMods.RandomSlackUserID(f),
+		OncallHandoffNoteMods.RandomNote(f),
+		OncallHandoffNoteMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m oncallHandoffNoteMods) ID(val int64) OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(_ context.Context, o *OncallHandoffNoteTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallHandoffNoteMods) IDFunc(f func() int64) OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(_ context.Context, o *OncallHandoffNoteTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallHandoffNoteMods) UnsetID() OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(_ context.Context, o *OncallHandoffNoteTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallHandoffNoteMods) RandomID(f *faker.Faker) OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(_ context.Context, o *OncallHandoffNoteTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oncallHandoffNoteMods) Rotation(val string) OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(_ context.Context, o *OncallHandoffNoteTemplate) {
+		o.Rotation = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallHandoffNoteMods) RotationFunc(f func() string) OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(_ context.Context, o *OncallHandoffNoteTemplate) {
+		o.Rotation = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallHandoffNoteMods) UnsetRotation() OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(_ context.Context, o *OncallHandoffNoteTemplate) {
+		o.Rotation = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallHandoffNoteMods) RandomRotation(f *faker.Faker) OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(_ context.Context, o *OncallHandoffNoteTemplate) {
+		o.Rotation = func() string {
+			return random_string(f, "80")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oncallHandoffNoteMods) SlackUserID(val string) OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(_ context.Context, o *OncallHandoffNoteTemplate) {
+		o.SlackUserID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallHandoffNoteMods) SlackUserIDFunc(f func() string) OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(_ context.Context, o *OncallHandoffNoteTemplate) {
+		o.SlackUserID = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallHandoffNoteMods) UnsetSlackUserID() OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(_ context.Context, o *OncallHandoffNoteTemplate) {
+		o.SlackUserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallHandoffNoteMods) RandomSlackUserID(f *faker.Faker) OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(_ context.Context, o *OncallHandoffNoteTemplate) {
+		o.SlackUserID = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oncallHandoffNoteMods) Note(val string) OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(_ context.Context, o *OncallHandoffNoteTemplate) {
+		o.Note = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallHandoffNoteMods) NoteFunc(f func() string) OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(_ context.Context, o *OncallHandoffNoteTemplate) {
+		o.Note = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallHandoffNoteMods) UnsetNote() OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(_ context.Context, o *OncallHandoffNoteTemplate) {
+		o.Note = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallHandoffNoteMods) RandomNote(f *faker.Faker) OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(_ context.Context, o *OncallHandoffNoteTemplate) {
+		o.Note = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oncallHandoffNoteMods) CreatedAt(val time.Time) OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(_ context.Context, o *OncallHandoffNoteTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallHandoffNoteMods) CreatedAtFunc(f func() time.Time) OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(_ context.Context, o *OncallHandoffNoteTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallHandoffNoteMods) UnsetCreatedAt() OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(_ context.Context, o *OncallHandoffNoteTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallHandoffNoteMods) RandomCreatedAt(f *faker.Faker) OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(_ context.Context, o *OncallHandoffNoteTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m oncallHandoffNoteMods) WithParentsCascading() OncallHandoffNoteMod {
+	return OncallHandoffNoteModFunc(func(ctx context.Context, o *OncallHandoffNoteTemplate) {
+		if isDone, _ := oncallHandoffNoteWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = oncallHandoffNoteWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
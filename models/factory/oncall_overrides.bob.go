@@ -0,0 +1,584 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	models "github.com/fishnix/ohshift/models"
+	"github.com/gofrs/uuid/v5"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type OncallOverrideMod interface {
+	Apply(context.Context, *OncallOverrideTemplate)
+}
+
+type OncallOverrideModFunc func(context.Context, *OncallOverrideTemplate)
+
+func (f OncallOverrideModFunc) Apply(ctx context.Context, n *OncallOverrideTemplate) {
+	f(ctx, n)
+}
+
+type OncallOverrideModSlice []OncallOverrideMod
+
+func (mods OncallOverrideModSlice) Apply(ctx context.Context, n *OncallOverrideTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// OncallOverrideTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type OncallOverrideTemplate struct {
+	ID          func() int64
+	ScheduleID  func() uuid.UUID
+	SlackUserID func() string
+	StartsAt    func() time.Time
+	EndsAt      func() time.Time
+	CreatedBy   func() string
+	CreatedAt   func() time.Time
+
+	r oncallOverrideR
+	f *Factory
+}
+
+type oncallOverrideR struct {
+	ScheduleOncallSchedule *oncallOverrideRScheduleOncallScheduleR
+}
+
+type oncallOverrideRScheduleOncallScheduleR struct {
+	o *OncallScheduleTemplate
+}
+
+// Apply mods to the OncallOverrideTemplate
+func (o *OncallOverrideTemplate) Apply(ctx context.Context, mods ...OncallOverrideMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.OncallOverride
+// according to the relationships in the template. Nothing is inserted into the db
+func (t OncallOverrideTemplate) setModelRels(o *models.OncallOverride) {
+	if t.r.ScheduleOncallSchedule != nil {
+		rel := t.r.ScheduleOncallSchedule.o.Build()
+		rel.R.ScheduleOncallOverrides = append(rel.R.ScheduleOncallOverrides, o)
+		o.ScheduleID = rel.ID // h2
+		o.R.ScheduleOncallSchedule = rel
+	}
+}
+
+// BuildSetter returns an *models.OncallOverrideSetter
+// this does nothing with the relationship templates
+func (o OncallOverrideTemplate) BuildSetter() *models.OncallOverrideSetter {
+	m := &models.OncallOverrideSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = &val
+	}
+	if o.ScheduleID != nil {
+		val := o.ScheduleID()
+		m.ScheduleID = &val
+	}
+	if o.SlackUserID != nil {
+		val := o.SlackUserID()
+		m.SlackUserID = &val
+	}
+	if o.StartsAt != nil {
+		val := o.StartsAt()
+		m.StartsAt = &val
+	}
+	if o.EndsAt != nil {
+		val := o.EndsAt()
+		m.EndsAt = &val
+	}
+	if o.CreatedBy != nil {
+		val := o.CreatedBy()
+		m.CreatedBy = &val
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = &val
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.OncallOverrideSetter
+// this does nothing with the relationship templates
+func (o OncallOverrideTemplate) BuildManySetter(number int) []*models.OncallOverrideSetter {
+	m := make([]*models.OncallOverrideSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.OncallOverride
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use OncallOverrideTemplate.Create
+func (o OncallOverrideTemplate) Build() *models.OncallOverride {
+	m := &models.OncallOverride{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.ScheduleID != nil {
+		m.ScheduleID = o.ScheduleID()
+	}
+	if o.SlackUserID != nil {
+		m.SlackUserID = o.SlackUserID()
+	}
+	if o.StartsAt != nil {
+		m.StartsAt = o.StartsAt()
+	}
+	if o.EndsAt != nil {
+		m.EndsAt = o.EndsAt()
+	}
+	if o.CreatedBy != nil {
+		m.CreatedBy = o.CreatedBy()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.OncallOverrideSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use OncallOverrideTemplate.CreateMany
+func (o OncallOverrideTemplate) BuildMany(number int) models.OncallOverrideSlice {
+	m := make(models.OncallOverrideSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableOncallOverride(m *models.OncallOverrideSetter) {
+	if m.ScheduleID == nil {
+		val := random_uuid_UUID(nil)
+		m.ScheduleID = &val
+	}
+	if m.SlackUserID == nil {
+		val := random_string(nil)
+		m.SlackUserID = &val
+	}
+	if m.StartsAt == nil {
+		val := random_time_Time(nil)
+		m.StartsAt = &val
+	}
+	if m.EndsAt == nil {
+		val := random_time_Time(nil)
+		m.EndsAt = &val
+	}
+	if m.CreatedBy == nil {
+		val := random_string(nil)
+		m.CreatedBy = &val
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.OncallOverride
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *OncallOverrideTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.OncallOverride) (context.Context, error) {
+	var err error
+
+	return ctx, err
+}
+
+// Create builds a oncallOverride and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *OncallOverrideTemplate) Create(ctx context.Context, exec bob.Executor) (*models.OncallOverride, error) {
+	_, m, err := o.create(ctx, exec)
+	return m, err
+}
+
+// MustCreate builds a oncallOverride and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *OncallOverrideTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.OncallOverride {
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a oncallOverride and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *OncallOverrideTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.OncallOverride {
+	tb.Helper()
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// create builds a oncallOverride and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted model
+func (o *OncallOverrideTemplate) create(ctx context.Context, exec bob.Executor) (context.Context, *models.OncallOverride, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableOncallOverride(opt)
+
+	if o.r.ScheduleOncallSchedule == nil {
+		OncallOverrideMods.WithNewScheduleOncallSchedule().Apply(ctx, o)
+	}
+
+	rel0, ok := oncallScheduleCtx.Value(ctx)
+	if !ok {
+		ctx, rel0, err = o.r.ScheduleOncallSchedule.o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	opt.ScheduleID = &rel0.ID
+
+	m, err := models.OncallOverrides.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return ctx, nil, err
+	}
+	ctx = oncallOverrideCtx.WithValue(ctx, m)
+
+	m.R.ScheduleOncallSchedule = rel0
+
+	ctx, err = o.insertOptRels(ctx, exec, m)
+	return ctx, m, err
+}
+
+// CreateMany builds multiple oncallOverrides and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o OncallOverrideTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.OncallOverrideSlice, error) {
+	_, m, err := o.createMany(ctx, exec, number)
+	return m, err
+}
+
+// MustCreateMany builds multiple oncallOverrides and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o OncallOverrideTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.OncallOverrideSlice {
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple oncallOverrides and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o OncallOverrideTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.OncallOverrideSlice {
+	tb.Helper()
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// createMany builds multiple oncallOverrides and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted models
+func (o OncallOverrideTemplate) createMany(ctx context.Context, exec bob.Executor, number int) (context.Context, models.OncallOverrideSlice, error) {
+	var err error
+	m := make(models.OncallOverrideSlice, number)
+
+	for i := range m {
+		ctx, m[i], err = o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	return ctx, m, nil
+}
+
+// OncallOverride has methods that act as mods for the OncallOverrideTemplate
+var OncallOverrideMods oncallOverrideMods
+
+type oncallOverrideMods struct{}
+
+func (m oncallOverrideMods) RandomizeAllColumns(f *faker.Faker) OncallOverrideMod {
+	return OncallOverrideModSlice{
+		OncallOverrideMods.RandomID(f),
+		OncallOverrideMods.RandomScheduleID(f),
+		OncallOverrideMods.RandomSlackUserID(f),
+		OncallOverrideMods.RandomStartsAt(f),
+		OncallOverrideMods.RandomEndsAt(f),
+		OncallOverrideMods.RandomCreatedBy(f),
+		OncallOverrideMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m oncallOverrideMods) ID(val int64) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.ID = func() int64 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallOverrideMods) IDFunc(f func() int64) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallOverrideMods) UnsetID() OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallOverrideMods) RandomID(f *faker.Faker) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.ID = func() int64 {
+			return random_int64(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oncallOverrideMods) ScheduleID(val uuid.UUID) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.ScheduleID = func() uuid.UUID { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallOverrideMods) ScheduleIDFunc(f func() uuid.UUID) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.ScheduleID = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallOverrideMods) UnsetScheduleID() OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.ScheduleID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallOverrideMods) RandomScheduleID(f *faker.Faker) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.ScheduleID = func() uuid.UUID {
+			return random_uuid_UUID(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oncallOverrideMods) SlackUserID(val string) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.SlackUserID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallOverrideMods) SlackUserIDFunc(f func() string) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.SlackUserID = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallOverrideMods) UnsetSlackUserID() OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.SlackUserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallOverrideMods) RandomSlackUserID(f *faker.Faker) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.SlackUserID = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oncallOverrideMods) StartsAt(val time.Time) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.StartsAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallOverrideMods) StartsAtFunc(f func() time.Time) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.StartsAt = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallOverrideMods) UnsetStartsAt() OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.StartsAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallOverrideMods) RandomStartsAt(f *faker.Faker) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.StartsAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oncallOverrideMods) EndsAt(val time.Time) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.EndsAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallOverrideMods) EndsAtFunc(f func() time.Time) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.EndsAt = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallOverrideMods) UnsetEndsAt() OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.EndsAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallOverrideMods) RandomEndsAt(f *faker.Faker) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.EndsAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oncallOverrideMods) CreatedBy(val string) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.CreatedBy = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallOverrideMods) CreatedByFunc(f func() string) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.CreatedBy = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallOverrideMods) UnsetCreatedBy() OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.CreatedBy = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallOverrideMods) RandomCreatedBy(f *faker.Faker) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.CreatedBy = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oncallOverrideMods) CreatedAt(val time.Time) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallOverrideMods) CreatedAtFunc(f func() time.Time) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallOverrideMods) UnsetCreatedAt() OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallOverrideMods) RandomCreatedAt(f *faker.Faker) OncallOverrideMod {
+	return OncallOverrideModFunc(func(_ context.Context, o *OncallOverrideTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m oncallOverrideMods) WithParentsCascading() OncallOverrideMod {
+	return OncallOverrideModFunc(func(ctx context.Context, o *OncallOverrideTemplate) {
+		if isDone, _ := oncallOverrideWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = oncallOverrideWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewOncallSchedule(ctx, OncallScheduleMods.WithParentsCascading())
+			m.WithScheduleOncallSchedule(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m oncallOverrideMods) WithScheduleOncallSchedule(rel *OncallScheduleTemplate) OncallOverrideMod {
+	return OncallOverrideModFunc(func(ctx context.Context, o *OncallOverrideTemplate) {
+		o.r.ScheduleOncallSchedule = &oncallOverrideRScheduleOncallScheduleR{
+			o: rel,
+		}
+	})
+}
+
+func (m oncallOverrideMods) WithNewScheduleOncallSchedule(mods ...OncallScheduleMod) OncallOverrideMod {
+	return OncallOverrideModFunc(func(ctx context.Context, o *OncallOverrideTemplate) {
+		related := o.f.NewOncallSchedule(ctx, mods...)
+
+		m.WithScheduleOncallSchedule(related).Apply(ctx, o)
+	})
+}
+
+func (m oncallOverrideMods) WithoutScheduleOncallSchedule() OncallOverrideMod {
+	return OncallOverrideModFunc(func(ctx context.Context, o *OncallOverrideTemplate) {
+		o.r.ScheduleOncallSchedule = nil
+	})
+}
@@ -0,0 +1,692 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	models "github.com/fishnix/ohshift/models"
+	"github.com/gofrs/uuid/v5"
+	"github.com/jaswdr/faker/v2"
+	"github.com/lib/pq"
+	"github.com/stephenafamo/bob"
+)
+
+type OncallScheduleMod interface {
+	Apply(context.Context, *OncallScheduleTemplate)
+}
+
+type OncallScheduleModFunc func(context.Context, *OncallScheduleTemplate)
+
+func (f OncallScheduleModFunc) Apply(ctx context.Context, n *OncallScheduleTemplate) {
+	f(ctx, n)
+}
+
+type OncallScheduleModSlice []OncallScheduleMod
+
+func (mods OncallScheduleModSlice) Apply(ctx context.Context, n *OncallScheduleTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// OncallScheduleTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type OncallScheduleTemplate struct {
+	ID           func() uuid.UUID
+	Name         func() string
+	Rotation     func() string
+	Participants func() pq.StringArray
+	HandoffTime  func() string
+	Timezone     func() string
+	StartDate    func() time.Time
+	CreatedBy    func() string
+	CreatedAt    func() time.Time
+
+	r oncallScheduleR
+	f *Factory
+}
+
+type oncallScheduleR struct {
+	ScheduleOncallOverrides []*oncallScheduleRScheduleOncallOverridesR
+}
+
+type oncallScheduleRScheduleOncallOverridesR struct {
+	number int
+	o      *OncallOverrideTemplate
+}
+
+// Apply mods to the OncallScheduleTemplate
+func (o *OncallScheduleTemplate) Apply(ctx context.Context, mods ...OncallScheduleMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.OncallSchedule
+// according to the relationships in the template. Nothing is inserted into the db
+func (t OncallScheduleTemplate) setModelRels(o *models.OncallSchedule) {
+	if t.r.ScheduleOncallOverrides != nil {
+		rel := models.OncallOverrideSlice{}
+		for _, r := range t.r.ScheduleOncallOverrides {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.ScheduleID = o.ID // h2
+				rel.R.ScheduleOncallSchedule = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ScheduleOncallOverrides = rel
+	}
+}
+
+// BuildSetter returns an *models.OncallScheduleSetter
+// this does nothing with the relationship templates
+func (o OncallScheduleTemplate) BuildSetter() *models.OncallScheduleSetter {
+	m := &models.OncallScheduleSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = &val
+	}
+	if o.Name != nil {
+		val := o.Name()
+		m.Name = &val
+	}
+	if o.Rotation != nil {
+		val := o.Rotation()
+		m.Rotation = &val
+	}
+	if o.Participants != nil {
+		val := o.Participants()
+		m.Participants = &val
+	}
+	if o.HandoffTime != nil {
+		val := o.HandoffTime()
+		m.HandoffTime = &val
+	}
+	if o.Timezone != nil {
+		val := o.Timezone()
+		m.Timezone = &val
+	}
+	if o.StartDate != nil {
+		val := o.StartDate()
+		m.StartDate = &val
+	}
+	if o.CreatedBy != nil {
+		val := o.CreatedBy()
+		m.CreatedBy = &val
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = &val
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.OncallScheduleSetter
+// this does nothing with the relationship templates
+func (o OncallScheduleTemplate) BuildManySetter(number int) []*models.OncallScheduleSetter {
+	m := make([]*models.OncallScheduleSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.OncallSchedule
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use OncallScheduleTemplate.Create
+func (o OncallScheduleTemplate) Build() *models.OncallSchedule {
+	m := &models.OncallSchedule{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.Name != nil {
+		m.Name = o.Name()
+	}
+	if o.Rotation != nil {
+		m.Rotation = o.Rotation()
+	}
+	if o.Participants != nil {
+		m.Participants = o.Participants()
+	}
+	if o.HandoffTime != nil {
+		m.HandoffTime = o.HandoffTime()
+	}
+	if o.Timezone != nil {
+		m.Timezone = o.Timezone()
+	}
+	if o.StartDate != nil {
+		m.StartDate = o.StartDate()
+	}
+	if o.CreatedBy != nil {
+		m.CreatedBy = o.CreatedBy()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.OncallScheduleSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use OncallScheduleTemplate.CreateMany
+func (o OncallScheduleTemplate) BuildMany(number int) models.OncallScheduleSlice {
+	m := make(models.OncallScheduleSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableOncallSchedule(m *models.OncallScheduleSetter) {
+	if m.Name == nil {
+		val := random_string(nil, "80")
+		m.Name = &val
+	}
+	if m.Rotation == nil {
+		val := random_string(nil, "20")
+		m.Rotation = &val
+	}
+	if m.Participants == nil {
+		val := random_pq_StringArray(nil)
+		m.Participants = &val
+	}
+	if m.HandoffTime == nil {
+		val := random_string(nil, "5")
+		m.HandoffTime = &val
+	}
+	if m.Timezone == nil {
+		val := random_string(nil)
+		m.Timezone = &val
+	}
+	if m.StartDate == nil {
+		val := random_time_Time(nil)
+		m.StartDate = &val
+	}
+	if m.CreatedBy == nil {
+		val := random_string(nil)
+		m.CreatedBy = &val
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.OncallSchedule
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *OncallScheduleTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.OncallSchedule) (context.Context, error) {
+	var err error
+
+	isScheduleOncallOverridesDone, _ := oncallScheduleRelScheduleOncallOverridesCtx.Value(ctx)
+	if !isScheduleOncallOverridesDone && o.r.ScheduleOncallOverrides != nil {
+		ctx = oncallScheduleRelScheduleOncallOverridesCtx.WithValue(ctx, true)
+		for _, r := range o.r.ScheduleOncallOverrides {
+			var rel0 models.OncallOverrideSlice
+			ctx, rel0, err = r.o.createMany(ctx, exec, r.number)
+			if err != nil {
+				return ctx, err
+			}
+
+			err = m.AttachScheduleOncallOverrides(ctx, exec, rel0...)
+			if err != nil {
+				return ctx, err
+			}
+		}
+	}
+
+	return ctx, err
+}
+
+// Create builds a oncallSchedule and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *OncallScheduleTemplate) Create(ctx context.Context, exec bob.Executor) (*models.OncallSchedule, error) {
+	_, m, err := o.create(ctx, exec)
+	return m, err
+}
+
+// MustCreate builds a oncallSchedule and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *OncallScheduleTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.OncallSchedule {
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a oncallSchedule and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *OncallScheduleTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.OncallSchedule {
+	tb.Helper()
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// create builds a oncallSchedule and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted model
+func (o *OncallScheduleTemplate) create(ctx context.Context, exec bob.Executor) (context.Context, *models.OncallSchedule, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableOncallSchedule(opt)
+
+	m, err := models.OncallSchedules.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return ctx, nil, err
+	}
+	ctx = oncallScheduleCtx.WithValue(ctx, m)
+
+	ctx, err = o.insertOptRels(ctx, exec, m)
+	return ctx, m, err
+}
+
+// CreateMany builds multiple oncallSchedules and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o OncallScheduleTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.OncallScheduleSlice, error) {
+	_, m, err := o.createMany(ctx, exec, number)
+	return m, err
+}
+
+// MustCreateMany builds multiple oncallSchedules and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o OncallScheduleTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.OncallScheduleSlice {
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple oncallSchedules and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o OncallScheduleTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.OncallScheduleSlice {
+	tb.Helper()
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// createMany builds multiple oncallSchedules and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted models
+func (o OncallScheduleTemplate) createMany(ctx context.Context, exec bob.Executor, number int) (context.Context, models.OncallScheduleSlice, error) {
+	var err error
+	m := make(models.OncallScheduleSlice, number)
+
+	for i := range m {
+		ctx, m[i], err = o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	return ctx, m, nil
+}
+
+// OncallSchedule has methods that act as mods for the OncallScheduleTemplate
+var OncallScheduleMods oncallScheduleMods
+
+type oncallScheduleMods struct{}
+
+func (m oncallScheduleMods) RandomizeAllColumns(f *faker.Faker) OncallScheduleMod {
+	return OncallScheduleModSlice{
+		OncallScheduleMods.RandomID(f),
+		OncallScheduleMods.RandomName(f),
+		OncallScheduleMods.RandomRotation(f),
+		OncallScheduleMods.RandomParticipants(f),
+		OncallScheduleMods.RandomHandoffTime(f),
+		OncallScheduleMods.RandomTimezone(f),
+		OncallScheduleMods.RandomStartDate(f),
+		OncallScheduleMods.RandomCreatedBy(f),
+		OncallScheduleMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m oncallScheduleMods) ID(val uuid.UUID) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.ID = func() uuid.UUID { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallScheduleMods) IDFunc(f func() uuid.UUID) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallScheduleMods) UnsetID() OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallScheduleMods) RandomID(f *faker.Faker) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.ID = func() uuid.UUID {
+			return random_uuid_UUID(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oncallScheduleMods) Name(val string) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.Name = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallScheduleMods) NameFunc(f func() string) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.Name = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallScheduleMods) UnsetName() OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.Name = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallScheduleMods) RandomName(f *faker.Faker) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.Name = func() string {
+			return random_string(f, "80")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oncallScheduleMods) Rotation(val string) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.Rotation = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallScheduleMods) RotationFunc(f func() string) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.Rotation = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallScheduleMods) UnsetRotation() OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.Rotation = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallScheduleMods) RandomRotation(f *faker.Faker) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.Rotation = func() string {
+			return random_string(f, "20")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oncallScheduleMods) Participants(val pq.StringArray) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.Participants = func() pq.StringArray { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallScheduleMods) ParticipantsFunc(f func() pq.StringArray) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.Participants = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallScheduleMods) UnsetParticipants() OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.Participants = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallScheduleMods) RandomParticipants(f *faker.Faker) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.Participants = func() pq.StringArray {
+			return random_pq_StringArray(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oncallScheduleMods) HandoffTime(val string) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.HandoffTime = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallScheduleMods) HandoffTimeFunc(f func() string) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.HandoffTime = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallScheduleMods) UnsetHandoffTime() OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.HandoffTime = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallScheduleMods) RandomHandoffTime(f *faker.Faker) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.HandoffTime = func() string {
+			return random_string(f, "5")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oncallScheduleMods) Timezone(val string) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.Timezone = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallScheduleMods) TimezoneFunc(f func() string) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.Timezone = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallScheduleMods) UnsetTimezone() OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.Timezone = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallScheduleMods) RandomTimezone(f *faker.Faker) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.Timezone = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oncallScheduleMods) StartDate(val time.Time) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.StartDate = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallScheduleMods) StartDateFunc(f func() time.Time) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.StartDate = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallScheduleMods) UnsetStartDate() OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.StartDate = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallScheduleMods) RandomStartDate(f *faker.Faker) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.StartDate = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oncallScheduleMods) CreatedBy(val string) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.CreatedBy = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallScheduleMods) CreatedByFunc(f func() string) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.CreatedBy = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallScheduleMods) UnsetCreatedBy() OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.CreatedBy = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallScheduleMods) RandomCreatedBy(f *faker.Faker) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.CreatedBy = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m oncallScheduleMods) CreatedAt(val time.Time) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m oncallScheduleMods) CreatedAtFunc(f func() time.Time) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m oncallScheduleMods) UnsetCreatedAt() OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m oncallScheduleMods) RandomCreatedAt(f *faker.Faker) OncallScheduleMod {
+	return OncallScheduleModFunc(func(_ context.Context, o *OncallScheduleTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m oncallScheduleMods) WithParentsCascading() OncallScheduleMod {
+	return OncallScheduleModFunc(func(ctx context.Context, o *OncallScheduleTemplate) {
+		if isDone, _ := oncallScheduleWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = oncallScheduleWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
+
+func (m oncallScheduleMods) WithScheduleOncallOverrides(number int, related *OncallOverrideTemplate) OncallScheduleMod {
+	return OncallScheduleModFunc(func(ctx context.Context, o *OncallScheduleTemplate) {
+		o.r.ScheduleOncallOverrides = []*oncallScheduleRScheduleOncallOverridesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m oncallScheduleMods) WithNewScheduleOncallOverrides(number int, mods ...OncallOverrideMod) OncallScheduleMod {
+	return OncallScheduleModFunc(func(ctx context.Context, o *OncallScheduleTemplate) {
+		related := o.f.NewOncallOverride(ctx, mods...)
+		m.WithScheduleOncallOverrides(number, related).Apply(ctx, o)
+	})
+}
+
+func (m oncallScheduleMods) AddScheduleOncallOverrides(number int, related *OncallOverrideTemplate) OncallScheduleMod {
+	return OncallScheduleModFunc(func(ctx context.Context, o *OncallScheduleTemplate) {
+		o.r.ScheduleOncallOverrides = append(o.r.ScheduleOncallOverrides, &oncallScheduleRScheduleOncallOverridesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m oncallScheduleMods) AddNewScheduleOncallOverrides(number int, mods ...OncallOverrideMod) OncallScheduleMod {
+	return OncallScheduleModFunc(func(ctx context.Context, o *OncallScheduleTemplate) {
+		related := o.f.NewOncallOverride(ctx, mods...)
+		m.AddScheduleOncallOverrides(number, related).Apply(ctx, o)
+	})
+}
+
+func (m oncallScheduleMods) WithoutScheduleOncallOverrides() OncallScheduleMod {
+	return OncallScheduleModFunc(func(ctx context.Context, o *OncallScheduleTemplate) {
+		o.r.ScheduleOncallOverrides = nil
+	})
+}
@@ -0,0 +1,597 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	models "github.com/fishnix/ohshift/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type ServiceMod interface {
+	Apply(context.Context, *ServiceTemplate)
+}
+
+type ServiceModFunc func(context.Context, *ServiceTemplate)
+
+func (f ServiceModFunc) Apply(ctx context.Context, n *ServiceTemplate) {
+	f(ctx, n)
+}
+
+type ServiceModSlice []ServiceMod
+
+func (mods ServiceModSlice) Apply(ctx context.Context, n *ServiceTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// ServiceTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type ServiceTemplate struct {
+	Name            func() string
+	OwnerTeam       func() sql.Null[string]
+	OncallUsergroup func() sql.Null[string]
+	RunbookURL      func() sql.Null[string]
+	SlackChannel    func() sql.Null[string]
+	CreatedAt       func() time.Time
+	UpdatedAt       func() time.Time
+
+	f *Factory
+}
+
+// Apply mods to the ServiceTemplate
+func (o *ServiceTemplate) Apply(ctx context.Context, mods ...ServiceMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Service
+// according to the relationships in the template. Nothing is inserted into the db
+func (t ServiceTemplate) setModelRels(o *models.Service) {}
+
+// BuildSetter returns an *models.ServiceSetter
+// this does nothing with the relationship templates
+func (o ServiceTemplate) BuildSetter() *models.ServiceSetter {
+	m := &models.ServiceSetter{}
+
+	if o.Name != nil {
+		val := o.Name()
+		m.Name = &val
+	}
+	if o.OwnerTeam != nil {
+		val := o.OwnerTeam()
+		m.OwnerTeam = &val
+	}
+	if o.OncallUsergroup != nil {
+		val := o.OncallUsergroup()
+		m.OncallUsergroup = &val
+	}
+	if o.RunbookURL != nil {
+		val := o.RunbookURL()
+		m.RunbookURL = &val
+	}
+	if o.SlackChannel != nil {
+		val := o.SlackChannel()
+		m.SlackChannel = &val
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = &val
+	}
+	if o.UpdatedAt != nil {
+		val := o.UpdatedAt()
+		m.UpdatedAt = &val
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.ServiceSetter
+// this does nothing with the relationship templates
+func (o ServiceTemplate) BuildManySetter(number int) []*models.ServiceSetter {
+	m := make([]*models.ServiceSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Service
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use ServiceTemplate.Create
+func (o ServiceTemplate) Build() *models.Service {
+	m := &models.Service{}
+
+	if o.Name != nil {
+		m.Name = o.Name()
+	}
+	if o.OwnerTeam != nil {
+		m.OwnerTeam = o.OwnerTeam()
+	}
+	if o.OncallUsergroup != nil {
+		m.OncallUsergroup = o.OncallUsergroup()
+	}
+	if o.RunbookURL != nil {
+		m.RunbookURL = o.RunbookURL()
+	}
+	if o.SlackChannel != nil {
+		m.SlackChannel = o.SlackChannel()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.UpdatedAt != nil {
+		m.UpdatedAt = o.UpdatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.ServiceSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use ServiceTemplate.CreateMany
+func (o ServiceTemplate) BuildMany(number int) models.ServiceSlice {
+	m := make(models.ServiceSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableService(m *models.ServiceSetter) {
+	if m.Name == nil {
+		val := random_string(nil, "80")
+		m.Name = &val
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Service
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *ServiceTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Service) (context.Context, error) {
+	var err error
+
+	return ctx, err
+}
+
+// Create builds a service and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *ServiceTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Service, error) {
+	_, m, err := o.create(ctx, exec)
+	return m, err
+}
+
+// MustCreate builds a service and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *ServiceTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Service {
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a service and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *ServiceTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Service {
+	tb.Helper()
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// create builds a service and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted model
+func (o *ServiceTemplate) create(ctx context.Context, exec bob.Executor) (context.Context, *models.Service, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableService(opt)
+
+	m, err := models.Services.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return ctx, nil, err
+	}
+	ctx = serviceCtx.WithValue(ctx, m)
+
+	ctx, err = o.insertOptRels(ctx, exec, m)
+	return ctx, m, err
+}
+
+// CreateMany builds multiple services and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o ServiceTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.ServiceSlice, error) {
+	_, m, err := o.createMany(ctx, exec, number)
+	return m, err
+}
+
+// MustCreateMany builds multiple services and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o ServiceTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.ServiceSlice {
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple services and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o ServiceTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.ServiceSlice {
+	tb.Helper()
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// createMany builds multiple services and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted models
+func (o ServiceTemplate) createMany(ctx context.Context, exec bob.Executor, number int) (context.Context, models.ServiceSlice, error) {
+	var err error
+	m := make(models.ServiceSlice, number)
+
+	for i := range m {
+		ctx, m[i], err = o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	return ctx, m, nil
+}
+
+// Service has methods that act as mods for the ServiceTemplate
+var ServiceMods serviceMods
+
+type serviceMods struct{}
+
+func (m serviceMods) RandomizeAllColumns(f *faker.Faker) ServiceMod {
+	return ServiceModSlice{
+		ServiceMods.RandomName(f),
+		ServiceMods.RandomOwnerTeam(f),
+		ServiceMods.RandomOncallUsergroup(f),
+		ServiceMods.RandomRunbookURL(f),
+		ServiceMods.RandomSlackChannel(f),
+		ServiceMods.RandomCreatedAt(f),
+		ServiceMods.RandomUpdatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m serviceMods) Name(val string) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.Name = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m serviceMods) NameFunc(f func() string) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.Name = f
+	})
+}
+
+// Clear any values for the column
+func (m serviceMods) UnsetName() ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.Name = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m serviceMods) RandomName(f *faker.Faker) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.Name = func() string {
+			return random_string(f, "80")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m serviceMods) OwnerTeam(val sql.Null[string]) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.OwnerTeam = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m serviceMods) OwnerTeamFunc(f func() sql.Null[string]) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.OwnerTeam = f
+	})
+}
+
+// Clear any values for the column
+func (m serviceMods) UnsetOwnerTeam() ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.OwnerTeam = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m serviceMods) RandomOwnerTeam(f *faker.Faker) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.OwnerTeam = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "80")
+			return sql.Null[string]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m serviceMods) RandomOwnerTeamNotNull(f *faker.Faker) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.OwnerTeam = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "80")
+			return sql.Null[string]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m serviceMods) OncallUsergroup(val sql.Null[string]) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.OncallUsergroup = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m serviceMods) OncallUsergroupFunc(f func() sql.Null[string]) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.OncallUsergroup = f
+	})
+}
+
+// Clear any values for the column
+func (m serviceMods) UnsetOncallUsergroup() ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.OncallUsergroup = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m serviceMods) RandomOncallUsergroup(f *faker.Faker) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.OncallUsergroup = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "20")
+			return sql.Null[string]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m serviceMods) RandomOncallUsergroupNotNull(f *faker.Faker) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.OncallUsergroup = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "20")
+			return sql.Null[string]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m serviceMods) RunbookURL(val sql.Null[string]) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.RunbookURL = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m serviceMods) RunbookURLFunc(f func() sql.Null[string]) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.RunbookURL = f
+	})
+}
+
+// Clear any values for the column
+func (m serviceMods) UnsetRunbookURL() ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.RunbookURL = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m serviceMods) RandomRunbookURL(f *faker.Faker) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.RunbookURL = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return sql.Null[string]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m serviceMods) RandomRunbookURLNotNull(f *faker.Faker) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.RunbookURL = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return sql.Null[string]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m serviceMods) SlackChannel(val sql.Null[string]) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.SlackChannel = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m serviceMods) SlackChannelFunc(f func() sql.Null[string]) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.SlackChannel = f
+	})
+}
+
+// Clear any values for the column
+func (m serviceMods) UnsetSlackChannel() ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.SlackChannel = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m serviceMods) RandomSlackChannel(f *faker.Faker) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.SlackChannel = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "80")
+			return sql.Null[string]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m serviceMods) RandomSlackChannelNotNull(f *faker.Faker) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.SlackChannel = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "80")
+			return sql.Null[string]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m serviceMods) CreatedAt(val time.Time) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.CreatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m serviceMods) CreatedAtFunc(f func() time.Time) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m serviceMods) UnsetCreatedAt() ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m serviceMods) RandomCreatedAt(f *faker.Faker) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.CreatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m serviceMods) UpdatedAt(val time.Time) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.UpdatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m serviceMods) UpdatedAtFunc(f func() time.Time) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.UpdatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m serviceMods) UnsetUpdatedAt() ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.UpdatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m serviceMods) RandomUpdatedAt(f *faker.Faker) ServiceMod {
+	return ServiceModFunc(func(_ context.Context, o *ServiceTemplate) {
+		o.UpdatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m serviceMods) WithParentsCascading() ServiceMod {
+	return ServiceModFunc(func(ctx context.Context, o *ServiceTemplate) {
+		if isDone, _ := serviceWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = serviceWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
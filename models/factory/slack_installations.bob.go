@@ -0,0 +1,787 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	models "github.com/fishnix/ohshift/models"
+	"github.com/jaswdr/faker/v2"
+	"github.com/stephenafamo/bob"
+)
+
+type SlackInstallationMod interface {
+	Apply(context.Context, *SlackInstallationTemplate)
+}
+
+type SlackInstallationModFunc func(context.Context, *SlackInstallationTemplate)
+
+func (f SlackInstallationModFunc) Apply(ctx context.Context, n *SlackInstallationTemplate) {
+	f(ctx, n)
+}
+
+type SlackInstallationModSlice []SlackInstallationMod
+
+func (mods SlackInstallationModSlice) Apply(ctx context.Context, n *SlackInstallationTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// SlackInstallationTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type SlackInstallationTemplate struct {
+	ID                  func() string
+	TeamID              func() sql.Null[string]
+	TeamName            func() sql.Null[string]
+	EnterpriseID        func() sql.Null[string]
+	EnterpriseName      func() sql.Null[string]
+	IsEnterpriseInstall func() bool
+	BotToken            func() string
+	BotUserID           func() string
+	InstalledBy         func() sql.Null[string]
+	InstalledAt         func() time.Time
+	UpdatedAt           func() time.Time
+
+	f *Factory
+}
+
+// Apply mods to the SlackInstallationTemplate
+func (o *SlackInstallationTemplate) Apply(ctx context.Context, mods ...SlackInstallationMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.SlackInstallation
+// according to the relationships in the template. Nothing is inserted into the db
+func (t SlackInstallationTemplate) setModelRels(o *models.SlackInstallation) {}
+
+// BuildSetter returns an *models.SlackInstallationSetter
+// this does nothing with the relationship templates
+func (o SlackInstallationTemplate) BuildSetter() *models.SlackInstallationSetter {
+	m := &models.SlackInstallationSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = &val
+	}
+	if o.TeamID != nil {
+		val := o.TeamID()
+		m.TeamID = &val
+	}
+	if o.TeamName != nil {
+		val := o.TeamName()
+		m.TeamName = &val
+	}
+	if o.EnterpriseID != nil {
+		val := o.EnterpriseID()
+		m.EnterpriseID = &val
+	}
+	if o.EnterpriseName != nil {
+		val := o.EnterpriseName()
+		m.EnterpriseName = &val
+	}
+	if o.IsEnterpriseInstall != nil {
+		val := o.IsEnterpriseInstall()
+		m.IsEnterpriseInstall = &val
+	}
+	if o.BotToken != nil {
+		val := o.BotToken()
+		m.BotToken = &val
+	}
+	if o.BotUserID != nil {
+		val := o.BotUserID()
+		m.BotUserID = &val
+	}
+	if o.InstalledBy != nil {
+		val := o.InstalledBy()
+		m.InstalledBy = &val
+	}
+	if o.InstalledAt != nil {
+		val := o.InstalledAt()
+		m.InstalledAt = &val
+	}
+	if o.UpdatedAt != nil {
+		val := o.UpdatedAt()
+		m.UpdatedAt = &val
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.SlackInstallationSetter
+// this does nothing with the relationship templates
+func (o SlackInstallationTemplate) BuildManySetter(number int) []*models.SlackInstallationSetter {
+	m := make([]*models.SlackInstallationSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.SlackInstallation
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use SlackInstallationTemplate.Create
+func (o SlackInstallationTemplate) Build() *models.SlackInstallation {
+	m := &models.SlackInstallation{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.TeamID != nil {
+		m.TeamID = o.TeamID()
+	}
+	if o.TeamName != nil {
+		m.TeamName = o.TeamName()
+	}
+	if o.EnterpriseID != nil {
+		m.EnterpriseID = o.EnterpriseID()
+	}
+	if o.EnterpriseName != nil {
+		m.EnterpriseName = o.EnterpriseName()
+	}
+	if o.IsEnterpriseInstall != nil {
+		m.IsEnterpriseInstall = o.IsEnterpriseInstall()
+	}
+	if o.BotToken != nil {
+		m.BotToken = o.BotToken()
+	}
+	if o.BotUserID != nil {
+		m.BotUserID = o.BotUserID()
+	}
+	if o.InstalledBy != nil {
+		m.InstalledBy = o.InstalledBy()
+	}
+	if o.InstalledAt != nil {
+		m.InstalledAt = o.InstalledAt()
+	}
+	if o.UpdatedAt != nil {
+		m.UpdatedAt = o.UpdatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.SlackInstallationSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use SlackInstallationTemplate.CreateMany
+func (o SlackInstallationTemplate) BuildMany(number int) models.SlackInstallationSlice {
+	m := make(models.SlackInstallationSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableSlackInstallation(m *models.SlackInstallationSetter) {
+	if m.ID == nil {
+		val := random_string(nil, "20")
+		m.ID = &val
+	}
+	if m.BotToken == nil {
+		val := random_string(nil)
+		m.BotToken = &val
+	}
+	if m.BotUserID == nil {
+		val := random_string(nil, "20")
+		m.BotUserID = &val
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.SlackInstallation
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *SlackInstallationTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.SlackInstallation) (context.Context, error) {
+	var err error
+
+	return ctx, err
+}
+
+// Create builds a slackInstallation and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *SlackInstallationTemplate) Create(ctx context.Context, exec bob.Executor) (*models.SlackInstallation, error) {
+	_, m, err := o.create(ctx, exec)
+	return m, err
+}
+
+// MustCreate builds a slackInstallation and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *SlackInstallationTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.SlackInstallation {
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a slackInstallation and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *SlackInstallationTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.SlackInstallation {
+	tb.Helper()
+	_, m, err := o.create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// create builds a slackInstallation and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted model
+func (o *SlackInstallationTemplate) create(ctx context.Context, exec bob.Executor) (context.Context, *models.SlackInstallation, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableSlackInstallation(opt)
+
+	m, err := models.SlackInstallations.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return ctx, nil, err
+	}
+	ctx = slackInstallationCtx.WithValue(ctx, m)
+
+	ctx, err = o.insertOptRels(ctx, exec, m)
+	return ctx, m, err
+}
+
+// CreateMany builds multiple slackInstallations and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o SlackInstallationTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.SlackInstallationSlice, error) {
+	_, m, err := o.createMany(ctx, exec, number)
+	return m, err
+}
+
+// MustCreateMany builds multiple slackInstallations and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o SlackInstallationTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.SlackInstallationSlice {
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple slackInstallations and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o SlackInstallationTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.SlackInstallationSlice {
+	tb.Helper()
+	_, m, err := o.createMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// createMany builds multiple slackInstallations and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// this returns a context that includes the newly inserted models
+func (o SlackInstallationTemplate) createMany(ctx context.Context, exec bob.Executor, number int) (context.Context, models.SlackInstallationSlice, error) {
+	var err error
+	m := make(models.SlackInstallationSlice, number)
+
+	for i := range m {
+		ctx, m[i], err = o.create(ctx, exec)
+		if err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	return ctx, m, nil
+}
+
+// SlackInstallation has methods that act as mods for the SlackInstallationTemplate
+var SlackInstallationMods slackInstallationMods
+
+type slackInstallationMods struct{}
+
+func (m slackInstallationMods) RandomizeAllColumns(f *faker.Faker) SlackInstallationMod {
+	return SlackInstallationModSlice{
+		SlackInstallationMods.RandomID(f),
+		SlackInstallationMods.RandomTeamID(f),
+		SlackInstallationMods.RandomTeamName(f),
+		SlackInstallationMods.RandomEnterpriseID(f),
+		SlackInstallationMods.RandomEnterpriseName(f),
+		SlackInstallationMods.RandomIsEnterpriseInstall(f),
+		SlackInstallationMods.RandomBotToken(f),
+		SlackInstallationMods.RandomBotUserID(f),
+		SlackInstallationMods.RandomInstalledBy(f),
+		SlackInstallationMods.RandomInstalledAt(f),
+		SlackInstallationMods.RandomUpdatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m slackInstallationMods) ID(val string) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.ID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m slackInstallationMods) IDFunc(f func() string) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m slackInstallationMods) UnsetID() SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m slackInstallationMods) RandomID(f *faker.Faker) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.ID = func() string {
+			return random_string(f, "20")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m slackInstallationMods) TeamID(val sql.Null[string]) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.TeamID = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m slackInstallationMods) TeamIDFunc(f func() sql.Null[string]) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.TeamID = f
+	})
+}
+
+// Clear any values for the column
+func (m slackInstallationMods) UnsetTeamID() SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.TeamID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m slackInstallationMods) RandomTeamID(f *faker.Faker) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.TeamID = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "20")
+			return sql.Null[string]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m slackInstallationMods) RandomTeamIDNotNull(f *faker.Faker) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.TeamID = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "20")
+			return sql.Null[string]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m slackInstallationMods) TeamName(val sql.Null[string]) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.TeamName = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m slackInstallationMods) TeamNameFunc(f func() sql.Null[string]) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.TeamName = f
+	})
+}
+
+// Clear any values for the column
+func (m slackInstallationMods) UnsetTeamName() SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.TeamName = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m slackInstallationMods) RandomTeamName(f *faker.Faker) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.TeamName = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return sql.Null[string]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m slackInstallationMods) RandomTeamNameNotNull(f *faker.Faker) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.TeamName = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return sql.Null[string]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m slackInstallationMods) EnterpriseID(val sql.Null[string]) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.EnterpriseID = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m slackInstallationMods) EnterpriseIDFunc(f func() sql.Null[string]) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.EnterpriseID = f
+	})
+}
+
+// Clear any values for the column
+func (m slackInstallationMods) UnsetEnterpriseID() SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.EnterpriseID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m slackInstallationMods) RandomEnterpriseID(f *faker.Faker) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.EnterpriseID = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "20")
+			return sql.Null[string]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m slackInstallationMods) RandomEnterpriseIDNotNull(f *faker.Faker) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.EnterpriseID = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "20")
+			return sql.Null[string]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m slackInstallationMods) EnterpriseName(val sql.Null[string]) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.EnterpriseName = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m slackInstallationMods) EnterpriseNameFunc(f func() sql.Null[string]) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.EnterpriseName = f
+	})
+}
+
+// Clear any values for the column
+func (m slackInstallationMods) UnsetEnterpriseName() SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.EnterpriseName = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m slackInstallationMods) RandomEnterpriseName(f *faker.Faker) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.EnterpriseName = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return sql.Null[string]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m slackInstallationMods) RandomEnterpriseNameNotNull(f *faker.Faker) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.EnterpriseName = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return sql.Null[string]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m slackInstallationMods) IsEnterpriseInstall(val bool) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.IsEnterpriseInstall = func() bool { return val }
+	})
+}
+
+// Set the Column from the function
+func (m slackInstallationMods) IsEnterpriseInstallFunc(f func() bool) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.IsEnterpriseInstall = f
+	})
+}
+
+// Clear any values for the column
+func (m slackInstallationMods) UnsetIsEnterpriseInstall() SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.IsEnterpriseInstall = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m slackInstallationMods) RandomIsEnterpriseInstall(f *faker.Faker) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.IsEnterpriseInstall = func() bool {
+			return random_bool(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m slackInstallationMods) BotToken(val string) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.BotToken = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m slackInstallationMods) BotTokenFunc(f func() string) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.BotToken = f
+	})
+}
+
+// Clear any values for the column
+func (m slackInstallationMods) UnsetBotToken() SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.BotToken = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m slackInstallationMods) RandomBotToken(f *faker.Faker) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.BotToken = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m slackInstallationMods) BotUserID(val string) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.BotUserID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m slackInstallationMods) BotUserIDFunc(f func() string) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.BotUserID = f
+	})
+}
+
+// Clear any values for the column
+func (m slackInstallationMods) UnsetBotUserID() SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.BotUserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m slackInstallationMods) RandomBotUserID(f *faker.Faker) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.BotUserID = func() string {
+			return random_string(f, "20")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m slackInstallationMods) InstalledBy(val sql.Null[string]) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.InstalledBy = func() sql.Null[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m slackInstallationMods) InstalledByFunc(f func() sql.Null[string]) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.InstalledBy = f
+	})
+}
+
+// Clear any values for the column
+func (m slackInstallationMods) UnsetInstalledBy() SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.InstalledBy = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m slackInstallationMods) RandomInstalledBy(f *faker.Faker) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.InstalledBy = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "20")
+			return sql.Null[string]{V: val, Valid: f.Bool()}
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m slackInstallationMods) RandomInstalledByNotNull(f *faker.Faker) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.InstalledBy = func() sql.Null[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "20")
+			return sql.Null[string]{V: val, Valid: true}
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m slackInstallationMods) InstalledAt(val time.Time) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.InstalledAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m slackInstallationMods) InstalledAtFunc(f func() time.Time) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.InstalledAt = f
+	})
+}
+
+// Clear any values for the column
+func (m slackInstallationMods) UnsetInstalledAt() SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.InstalledAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m slackInstallationMods) RandomInstalledAt(f *faker.Faker) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.InstalledAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m slackInstallationMods) UpdatedAt(val time.Time) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.UpdatedAt = func() time.Time { return val }
+	})
+}
+
+// Set the Column from the function
+func (m slackInstallationMods) UpdatedAtFunc(f func() time.Time) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.UpdatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m slackInstallationMods) UnsetUpdatedAt() SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.UpdatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m slackInstallationMods) RandomUpdatedAt(f *faker.Faker) SlackInstallationMod {
+	return SlackInstallationModFunc(func(_ context.Context, o *SlackInstallationTemplate) {
+		o.UpdatedAt = func() time.Time {
+			return random_time_Time(f)
+		}
+	})
+}
+
+func (m slackInstallationMods) WithParentsCascading() SlackInstallationMod {
+	return SlackInstallationModFunc(func(ctx context.Context, o *SlackInstallationTemplate) {
+		if isDone, _ := slackInstallationWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = slackInstallationWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
@@ -40,11 +40,14 @@ func (mods TimelineEventModSlice) Apply(ctx context.Context, n *TimelineEventTem
 type TimelineEventTemplate struct {
 	ID             func() uuid.UUID
 	IncidentID     func() uuid.UUID
-	Timestamp      func() sql.Null[time.Time]
+	Timestamp      func() time.Time
 	EventType      func() string
 	SlackUserID    func() string
 	SlackMessageTS func() sql.Null[string]
-	Metadata       func() sql.Null[types.JSON[json.RawMessage]]
+	Metadata       func() types.JSON[json.RawMessage]
+	EntryKey       func() string
+	SlackUsername  func() string
+	Content        func() string
 
 	r timelineEventR
 	f *Factory
@@ -109,6 +112,18 @@ func (o TimelineEventTemplate) BuildSetter() *models.TimelineEventSetter {
 		val := o.Metadata()
 		m.Metadata = &val
 	}
+	if o.EntryKey != nil {
+		val := o.EntryKey()
+		m.EntryKey = &val
+	}
+	if o.SlackUsername != nil {
+		val := o.SlackUsername()
+		m.SlackUsername = &val
+	}
+	if o.Content != nil {
+		val := o.Content()
+		m.Content = &val
+	}
 
 	return m
 }
@@ -152,6 +167,15 @@ func (o TimelineEventTemplate) Build() *models.TimelineEvent {
 	if o.Metadata != nil {
 		m.Metadata = o.Metadata()
 	}
+	if o.EntryKey != nil {
+		m.EntryKey = o.EntryKey()
+	}
+	if o.SlackUsername != nil {
+		m.SlackUsername = o.SlackUsername()
+	}
+	if o.Content != nil {
+		m.Content = o.Content()
+	}
 
 	o.setModelRels(m)
 
@@ -322,6 +346,9 @@ func (m timelineEventMods) RandomizeAllColumns(f *faker.Faker) TimelineEventMod
 		TimelineEventMods.RandomSlackUserID(f),
 		TimelineEventMods.RandomSlackMessageTS(f),
 		TimelineEventMods.RandomMetadata(f),
+		TimelineEventMods.RandomEntryKey(f),
+		TimelineEventMods.RandomSlackUsername(f),
+		TimelineEventMods.RandomContent(f),
 	}
 }
 
@@ -388,14 +415,14 @@ func (m timelineEventMods) RandomIncidentID(f *faker.Faker) TimelineEventMod {
 }
 
 // Set the model columns to this value
-func (m timelineEventMods) Timestamp(val sql.Null[time.Time]) TimelineEventMod {
+func (m timelineEventMods) Timestamp(val time.Time) TimelineEventMod {
 	return TimelineEventModFunc(func(_ context.Context, o *TimelineEventTemplate) {
-		o.Timestamp = func() sql.Null[time.Time] { return val }
+		o.Timestamp = func() time.Time { return val }
 	})
 }
 
 // Set the Column from the function
-func (m timelineEventMods) TimestampFunc(f func() sql.Null[time.Time]) TimelineEventMod {
+func (m timelineEventMods) TimestampFunc(f func() time.Time) TimelineEventMod {
 	return TimelineEventModFunc(func(_ context.Context, o *TimelineEventTemplate) {
 		o.Timestamp = f
 	})
@@ -410,32 +437,10 @@ func (m timelineEventMods) UnsetTimestamp() TimelineEventMod {
 
 // Generates a random value for the column using the given faker
 // if faker is nil, a default faker is used
-// The generated value is sometimes null
 func (m timelineEventMods) RandomTimestamp(f *faker.Faker) TimelineEventMod {
 	return TimelineEventModFunc(func(_ context.Context, o *TimelineEventTemplate) {
-		o.Timestamp = func() sql.Null[time.Time] {
-			if f == nil {
-				f = &defaultFaker
-			}
-
-			val := random_time_Time(f)
-			return sql.Null[time.Time]{V: val, Valid: f.Bool()}
-		}
-	})
-}
-
-// Generates a random value for the column using the given faker
-// if faker is nil, a default faker is used
-// The generated value is never null
-func (m timelineEventMods) RandomTimestampNotNull(f *faker.Faker) TimelineEventMod {
-	return TimelineEventModFunc(func(_ context.Context, o *TimelineEventTemplate) {
-		o.Timestamp = func() sql.Null[time.Time] {
-			if f == nil {
-				f = &defaultFaker
-			}
-
-			val := random_time_Time(f)
-			return sql.Null[time.Time]{V: val, Valid: true}
+		o.Timestamp = func() time.Time {
+			return random_time_Time(f)
 		}
 	})
 }
@@ -556,14 +561,14 @@ func (m timelineEventMods) RandomSlackMessageTSNotNull(f *faker.Faker) TimelineE
 }
 
 // Set the model columns to this value
-func (m timelineEventMods) Metadata(val sql.Null[types.JSON[json.RawMessage]]) TimelineEventMod {
+func (m timelineEventMods) Metadata(val types.JSON[json.RawMessage]) TimelineEventMod {
 	return TimelineEventModFunc(func(_ context.Context, o *TimelineEventTemplate) {
-		o.Metadata = func() sql.Null[types.JSON[json.RawMessage]] { return val }
+		o.Metadata = func() types.JSON[json.RawMessage] { return val }
 	})
 }
 
 // Set the Column from the function
-func (m timelineEventMods) MetadataFunc(f func() sql.Null[types.JSON[json.RawMessage]]) TimelineEventMod {
+func (m timelineEventMods) MetadataFunc(f func() types.JSON[json.RawMessage]) TimelineEventMod {
 	return TimelineEventModFunc(func(_ context.Context, o *TimelineEventTemplate) {
 		o.Metadata = f
 	})
@@ -578,32 +583,103 @@ func (m timelineEventMods) UnsetMetadata() TimelineEventMod {
 
 // Generates a random value for the column using the given faker
 // if faker is nil, a default faker is used
-// The generated value is sometimes null
 func (m timelineEventMods) RandomMetadata(f *faker.Faker) TimelineEventMod {
 	return TimelineEventModFunc(func(_ context.Context, o *TimelineEventTemplate) {
-		o.Metadata = func() sql.Null[types.JSON[json.RawMessage]] {
-			if f == nil {
-				f = &defaultFaker
-			}
+		o.Metadata = func() types.JSON[json.RawMessage] {
+			return random_types_JSON_json_RawMessage_(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m timelineEventMods) EntryKey(val string) TimelineEventMod {
+	return TimelineEventModFunc(func(_ context.Context, o *TimelineEventTemplate) {
+		o.EntryKey = func() string { return val }
+	})
+}
 
-			val := random_types_JSON_json_RawMessage_(f)
-			return sql.Null[types.JSON[json.RawMessage]]{V: val, Valid: f.Bool()}
+// Set the Column from the function
+func (m timelineEventMods) EntryKeyFunc(f func() string) TimelineEventMod {
+	return TimelineEventModFunc(func(_ context.Context, o *TimelineEventTemplate) {
+		o.EntryKey = f
+	})
+}
+
+// Clear any values for the column
+func (m timelineEventMods) UnsetEntryKey() TimelineEventMod {
+	return TimelineEventModFunc(func(_ context.Context, o *TimelineEventTemplate) {
+		o.EntryKey = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m timelineEventMods) RandomEntryKey(f *faker.Faker) TimelineEventMod {
+	return TimelineEventModFunc(func(_ context.Context, o *TimelineEventTemplate) {
+		o.EntryKey = func() string {
+			return random_string(f)
 		}
 	})
 }
 
+// Set the model columns to this value
+func (m timelineEventMods) SlackUsername(val string) TimelineEventMod {
+	return TimelineEventModFunc(func(_ context.Context, o *TimelineEventTemplate) {
+		o.SlackUsername = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m timelineEventMods) SlackUsernameFunc(f func() string) TimelineEventMod {
+	return TimelineEventModFunc(func(_ context.Context, o *TimelineEventTemplate) {
+		o.SlackUsername = f
+	})
+}
+
+// Clear any values for the column
+func (m timelineEventMods) UnsetSlackUsername() TimelineEventMod {
+	return TimelineEventModFunc(func(_ context.Context, o *TimelineEventTemplate) {
+		o.SlackUsername = nil
+	})
+}
+
 // Generates a random value for the column using the given faker
 // if faker is nil, a default faker is used
-// The generated value is never null
-func (m timelineEventMods) RandomMetadataNotNull(f *faker.Faker) TimelineEventMod {
+func (m timelineEventMods) RandomSlackUsername(f *faker.Faker) TimelineEventMod {
 	return TimelineEventModFunc(func(_ context.Context, o *TimelineEventTemplate) {
-		o.Metadata = func() sql.Null[types.JSON[json.RawMessage]] {
-			if f == nil {
-				f = &defaultFaker
-			}
+		o.SlackUsername = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m timelineEventMods) Content(val string) TimelineEventMod {
+	return TimelineEventModFunc(func(_ context.Context, o *TimelineEventTemplate) {
+		o.Content = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m timelineEventMods) ContentFunc(f func() string) TimelineEventMod {
+	return TimelineEventModFunc(func(_ context.Context, o *TimelineEventTemplate) {
+		o.Content = f
+	})
+}
 
-			val := random_types_JSON_json_RawMessage_(f)
-			return sql.Null[types.JSON[json.RawMessage]]{V: val, Valid: true}
+// Clear any values for the column
+func (m timelineEventMods) UnsetContent() TimelineEventMod {
+	return TimelineEventModFunc(func(_ context.Context, o *TimelineEventTemplate) {
+		o.Content = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m timelineEventMods) RandomContent(f *faker.Faker) TimelineEventMod {
+	return TimelineEventModFunc(func(_ context.Context, o *TimelineEventTemplate) {
+		o.Content = func() string {
+			return random_string(f)
 		}
 	})
 }
@@ -0,0 +1,475 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/types"
+)
+
+// IdempotencyKey is an object representing the database table.
+type IdempotencyKey struct {
+	Key         string                                `db:"key,pk" `
+	Fingerprint string                                `db:"fingerprint" `
+	Response    sql.Null[types.JSON[json.RawMessage]] `db:"response" `
+	CreatedAt   time.Time                             `db:"created_at" `
+	ExpiresAt   time.Time                             `db:"expires_at" `
+}
+
+// IdempotencyKeySlice is an alias for a slice of pointers to IdempotencyKey.
+// This should almost always be used instead of []*IdempotencyKey.
+type IdempotencyKeySlice []*IdempotencyKey
+
+// IdempotencyKeys contains methods to work with the idempotency_keys table
+var IdempotencyKeys = psql.NewTablex[*IdempotencyKey, IdempotencyKeySlice, *IdempotencyKeySetter]("", "idempotency_keys")
+
+// IdempotencyKeysQuery is a query on the idempotency_keys table
+type IdempotencyKeysQuery = *psql.ViewQuery[*IdempotencyKey, IdempotencyKeySlice]
+
+type idempotencyKeyColumnNames struct {
+	Key         string
+	Fingerprint string
+	Response    string
+	CreatedAt   string
+	ExpiresAt   string
+}
+
+var IdempotencyKeyColumns = buildIdempotencyKeyColumns("idempotency_keys")
+
+type idempotencyKeyColumns struct {
+	tableAlias  string
+	Key         psql.Expression
+	Fingerprint psql.Expression
+	Response    psql.Expression
+	CreatedAt   psql.Expression
+	ExpiresAt   psql.Expression
+}
+
+func (c idempotencyKeyColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (idempotencyKeyColumns) AliasedAs(alias string) idempotencyKeyColumns {
+	return buildIdempotencyKeyColumns(alias)
+}
+
+func buildIdempotencyKeyColumns(alias string) idempotencyKeyColumns {
+	return idempotencyKeyColumns{
+		tableAlias:  alias,
+		Key:         psql.Quote(alias, "key"),
+		Fingerprint: psql.Quote(alias, "fingerprint"),
+		Response:    psql.Quote(alias, "response"),
+		CreatedAt:   psql.Quote(alias, "created_at"),
+		ExpiresAt:   psql.Quote(alias, "expires_at"),
+	}
+}
+
+type idempotencyKeyWhere[Q psql.Filterable] struct {
+	Key         psql.WhereMod[Q, string]
+	Fingerprint psql.WhereMod[Q, string]
+	Response    psql.WhereNullMod[Q, types.JSON[json.RawMessage]]
+	CreatedAt   psql.WhereMod[Q, time.Time]
+	ExpiresAt   psql.WhereMod[Q, time.Time]
+}
+
+func (idempotencyKeyWhere[Q]) AliasedAs(alias string) idempotencyKeyWhere[Q] {
+	return buildIdempotencyKeyWhere[Q](buildIdempotencyKeyColumns(alias))
+}
+
+func buildIdempotencyKeyWhere[Q psql.Filterable](cols idempotencyKeyColumns) idempotencyKeyWhere[Q] {
+	return idempotencyKeyWhere[Q]{
+		Key:         psql.Where[Q, string](cols.Key),
+		Fingerprint: psql.Where[Q, string](cols.Fingerprint),
+		Response:    psql.WhereNull[Q, types.JSON[json.RawMessage]](cols.Response),
+		CreatedAt:   psql.Where[Q, time.Time](cols.CreatedAt),
+		ExpiresAt:   psql.Where[Q, time.Time](cols.ExpiresAt),
+	}
+}
+
+var IdempotencyKeyErrors = &idempotencyKeyErrors{
+	ErrUniqueIdempotencyKeysPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "idempotency_keys",
+		columns: []string{"key"},
+		s:       "idempotency_keys_pkey",
+	},
+}
+
+type idempotencyKeyErrors struct {
+	ErrUniqueIdempotencyKeysPkey *UniqueConstraintError
+}
+
+// IdempotencyKeySetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type IdempotencyKeySetter struct {
+	Key         *string                                `db:"key,pk" `
+	Fingerprint *string                                `db:"fingerprint" `
+	Response    *sql.Null[types.JSON[json.RawMessage]] `db:"response" `
+	CreatedAt   *time.Time                             `db:"created_at" `
+	ExpiresAt   *time.Time                             `db:"expires_at" `
+}
+
+func (s IdempotencyKeySetter) SetColumns() []string {
+	vals := make([]string, 0, 5)
+	if s.Key != nil {
+		vals = append(vals, "key")
+	}
+
+	if s.Fingerprint != nil {
+		vals = append(vals, "fingerprint")
+	}
+
+	if s.Response != nil {
+		vals = append(vals, "response")
+	}
+
+	if s.CreatedAt != nil {
+		vals = append(vals, "created_at")
+	}
+
+	if s.ExpiresAt != nil {
+		vals = append(vals, "expires_at")
+	}
+
+	return vals
+}
+
+func (s IdempotencyKeySetter) Overwrite(t *IdempotencyKey) {
+	if s.Key != nil {
+		t.Key = *s.Key
+	}
+	if s.Fingerprint != nil {
+		t.Fingerprint = *s.Fingerprint
+	}
+	if s.Response != nil {
+		t.Response = *s.Response
+	}
+	if s.CreatedAt != nil {
+		t.CreatedAt = *s.CreatedAt
+	}
+	if s.ExpiresAt != nil {
+		t.ExpiresAt = *s.ExpiresAt
+	}
+}
+
+func (s *IdempotencyKeySetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return IdempotencyKeys.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 5)
+		if s.Key != nil {
+			vals[0] = psql.Arg(*s.Key)
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.Fingerprint != nil {
+			vals[1] = psql.Arg(*s.Fingerprint)
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.Response != nil {
+			vals[2] = psql.Arg(*s.Response)
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedAt != nil {
+			vals[3] = psql.Arg(*s.CreatedAt)
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.ExpiresAt != nil {
+			vals[4] = psql.Arg(*s.ExpiresAt)
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s IdempotencyKeySetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s IdempotencyKeySetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 5)
+
+	if s.Key != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "key")...),
+			psql.Arg(s.Key),
+		}})
+	}
+
+	if s.Fingerprint != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "fingerprint")...),
+			psql.Arg(s.Fingerprint),
+		}})
+	}
+
+	if s.Response != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "response")...),
+			psql.Arg(s.Response),
+		}})
+	}
+
+	if s.CreatedAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	if s.ExpiresAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "expires_at")...),
+			psql.Arg(s.ExpiresAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindIdempotencyKey retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindIdempotencyKey(ctx context.Context, exec bob.Executor, KeyPK string, cols ...string) (*IdempotencyKey, error) {
+	if len(cols) == 0 {
+		return IdempotencyKeys.Query(
+			SelectWhere.IdempotencyKeys.Key.EQ(KeyPK),
+		).One(ctx, exec)
+	}
+
+	return IdempotencyKeys.Query(
+		SelectWhere.IdempotencyKeys.Key.EQ(KeyPK),
+		sm.Columns(IdempotencyKeys.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// IdempotencyKeyExists checks the presence of a single record by primary key
+func IdempotencyKeyExists(ctx context.Context, exec bob.Executor, KeyPK string) (bool, error) {
+	return IdempotencyKeys.Query(
+		SelectWhere.IdempotencyKeys.Key.EQ(KeyPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after IdempotencyKey is retrieved from the database
+func (o *IdempotencyKey) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = IdempotencyKeys.AfterSelectHooks.RunHooks(ctx, exec, IdempotencyKeySlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = IdempotencyKeys.AfterInsertHooks.RunHooks(ctx, exec, IdempotencyKeySlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = IdempotencyKeys.AfterUpdateHooks.RunHooks(ctx, exec, IdempotencyKeySlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = IdempotencyKeys.AfterDeleteHooks.RunHooks(ctx, exec, IdempotencyKeySlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the IdempotencyKey
+func (o *IdempotencyKey) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.Key)
+}
+
+func (o *IdempotencyKey) pkEQ() dialect.Expression {
+	return psql.Quote("idempotency_keys", "key").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the IdempotencyKey
+func (o *IdempotencyKey) Update(ctx context.Context, exec bob.Executor, s *IdempotencyKeySetter) error {
+	v, err := IdempotencyKeys.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single IdempotencyKey record with an executor
+func (o *IdempotencyKey) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := IdempotencyKeys.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the IdempotencyKey using the executor
+func (o *IdempotencyKey) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := IdempotencyKeys.Query(
+		SelectWhere.IdempotencyKeys.Key.EQ(o.Key),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after IdempotencyKeySlice is retrieved from the database
+func (o IdempotencyKeySlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = IdempotencyKeys.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = IdempotencyKeys.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = IdempotencyKeys.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = IdempotencyKeys.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o IdempotencyKeySlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("idempotency_keys", "key").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o IdempotencyKeySlice) copyMatchingRows(from ...*IdempotencyKey) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.Key != old.Key {
+				continue
+			}
+
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o IdempotencyKeySlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return IdempotencyKeys.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *IdempotencyKey:
+				o.copyMatchingRows(retrieved)
+			case []*IdempotencyKey:
+				o.copyMatchingRows(retrieved...)
+			case IdempotencyKeySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a IdempotencyKey or a slice of IdempotencyKey
+				// then run the AfterUpdateHooks on the slice
+				_, err = IdempotencyKeys.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o IdempotencyKeySlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return IdempotencyKeys.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *IdempotencyKey:
+				o.copyMatchingRows(retrieved)
+			case []*IdempotencyKey:
+				o.copyMatchingRows(retrieved...)
+			case IdempotencyKeySlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a IdempotencyKey or a slice of IdempotencyKey
+				// then run the AfterDeleteHooks on the slice
+				_, err = IdempotencyKeys.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o IdempotencyKeySlice) UpdateAll(ctx context.Context, exec bob.Executor, vals IdempotencyKeySetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := IdempotencyKeys.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o IdempotencyKeySlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := IdempotencyKeys.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o IdempotencyKeySlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := IdempotencyKeys.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
@@ -0,0 +1,734 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// IncidentChecklistItem is an object representing the database table.
+type IncidentChecklistItem struct {
+	IncidentID  uuid.UUID           `db:"incident_id,pk" `
+	Position    int32               `db:"position,pk" `
+	Text        string              `db:"text" `
+	Required    bool                `db:"required" `
+	CompletedBy sql.Null[string]    `db:"completed_by" `
+	CompletedAt sql.Null[time.Time] `db:"completed_at" `
+
+	R incidentChecklistItemR `db:"-" `
+}
+
+// IncidentChecklistItemSlice is an alias for a slice of pointers to IncidentChecklistItem.
+// This should almost always be used instead of []*IncidentChecklistItem.
+type IncidentChecklistItemSlice []*IncidentChecklistItem
+
+// IncidentChecklistItems contains methods to work with the incident_checklist_items table
+var IncidentChecklistItems = psql.NewTablex[*IncidentChecklistItem, IncidentChecklistItemSlice, *IncidentChecklistItemSetter]("", "incident_checklist_items")
+
+// IncidentChecklistItemsQuery is a query on the incident_checklist_items table
+type IncidentChecklistItemsQuery = *psql.ViewQuery[*IncidentChecklistItem, IncidentChecklistItemSlice]
+
+// incidentChecklistItemR is where relationships are stored.
+type incidentChecklistItemR struct {
+	IncidentIncidentChecklist *IncidentChecklist // incident_checklist_items.incident_checklist_items_incident_id_fkey
+}
+
+type incidentChecklistItemColumnNames struct {
+	IncidentID  string
+	Position    string
+	Text        string
+	Required    string
+	CompletedBy string
+	CompletedAt string
+}
+
+var IncidentChecklistItemColumns = buildIncidentChecklistItemColumns("incident_checklist_items")
+
+type incidentChecklistItemColumns struct {
+	tableAlias  string
+	IncidentID  psql.Expression
+	Position    psql.Expression
+	Text        psql.Expression
+	Required    psql.Expression
+	CompletedBy psql.Expression
+	CompletedAt psql.Expression
+}
+
+func (c incidentChecklistItemColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (incidentChecklistItemColumns) AliasedAs(alias string) incidentChecklistItemColumns {
+	return buildIncidentChecklistItemColumns(alias)
+}
+
+func buildIncidentChecklistItemColumns(alias string) incidentChecklistItemColumns {
+	return incidentChecklistItemColumns{
+		tableAlias:  alias,
+		IncidentID:  psql.Quote(alias, "incident_id"),
+		Position:    psql.Quote(alias, "position"),
+		Text:        psql.Quote(alias, "text"),
+		Required:    psql.Quote(alias, "required"),
+		CompletedBy: psql.Quote(alias, "completed_by"),
+		CompletedAt: psql.Quote(alias, "completed_at"),
+	}
+}
+
+type incidentChecklistItemWhere[Q psql.Filterable] struct {
+	IncidentID  psql.WhereMod[Q, uuid.UUID]
+	Position    psql.WhereMod[Q, int32]
+	Text        psql.WhereMod[Q, string]
+	Required    psql.WhereMod[Q, bool]
+	CompletedBy psql.WhereNullMod[Q, string]
+	CompletedAt psql.WhereNullMod[Q, time.Time]
+}
+
+func (incidentChecklistItemWhere[Q]) AliasedAs(alias string) incidentChecklistItemWhere[Q] {
+	return buildIncidentChecklistItemWhere[Q](buildIncidentChecklistItemColumns(alias))
+}
+
+func buildIncidentChecklistItemWhere[Q psql.Filterable](cols incidentChecklistItemColumns) incidentChecklistItemWhere[Q] {
+	return incidentChecklistItemWhere[Q]{
+		IncidentID:  psql.Where[Q, uuid.UUID](cols.IncidentID),
+		Position:    psql.Where[Q, int32](cols.Position),
+		Text:        psql.Where[Q, string](cols.Text),
+		Required:    psql.Where[Q, bool](cols.Required),
+		CompletedBy: psql.WhereNull[Q, string](cols.CompletedBy),
+		CompletedAt: psql.WhereNull[Q, time.Time](cols.CompletedAt),
+	}
+}
+
+var IncidentChecklistItemErrors = &incidentChecklistItemErrors{
+	ErrUniqueIncidentChecklistItemsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "incident_checklist_items",
+		columns: []string{"incident_id", "position"},
+		s:       "incident_checklist_items_pkey",
+	},
+}
+
+type incidentChecklistItemErrors struct {
+	ErrUniqueIncidentChecklistItemsPkey *UniqueConstraintError
+}
+
+// IncidentChecklistItemSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type IncidentChecklistItemSetter struct {
+	IncidentID  *uuid.UUID           `db:"incident_id,pk" `
+	Position    *int32               `db:"position,pk" `
+	Text        *string              `db:"text" `
+	Required    *bool                `db:"required" `
+	CompletedBy *sql.Null[string]    `db:"completed_by" `
+	CompletedAt *sql.Null[time.Time] `db:"completed_at" `
+}
+
+func (s IncidentChecklistItemSetter) SetColumns() []string {
+	vals := make([]string, 0, 6)
+	if s.IncidentID != nil {
+		vals = append(vals, "incident_id")
+	}
+
+	if s.Position != nil {
+		vals = append(vals, "position")
+	}
+
+	if s.Text != nil {
+		vals = append(vals, "text")
+	}
+
+	if s.Required != nil {
+		vals = append(vals, "required")
+	}
+
+	if s.CompletedBy != nil {
+		vals = append(vals, "completed_by")
+	}
+
+	if s.CompletedAt != nil {
+		vals = append(vals, "completed_at")
+	}
+
+	return vals
+}
+
+func (s IncidentChecklistItemSetter) Overwrite(t *IncidentChecklistItem) {
+	if s.IncidentID != nil {
+		t.IncidentID = *s.IncidentID
+	}
+	if s.Position != nil {
+		t.Position = *s.Position
+	}
+	if s.Text != nil {
+		t.Text = *s.Text
+	}
+	if s.Required != nil {
+		t.Required = *s.Required
+	}
+	if s.CompletedBy != nil {
+		t.CompletedBy = *s.CompletedBy
+	}
+	if s.CompletedAt != nil {
+		t.CompletedAt = *s.CompletedAt
+	}
+}
+
+func (s *IncidentChecklistItemSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return IncidentChecklistItems.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 6)
+		if s.IncidentID != nil {
+			vals[0] = psql.Arg(*s.IncidentID)
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.Position != nil {
+			vals[1] = psql.Arg(*s.Position)
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.Text != nil {
+			vals[2] = psql.Arg(*s.Text)
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.Required != nil {
+			vals[3] = psql.Arg(*s.Required)
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.CompletedBy != nil {
+			vals[4] = psql.Arg(*s.CompletedBy)
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.CompletedAt != nil {
+			vals[5] = psql.Arg(*s.CompletedAt)
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s IncidentChecklistItemSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s IncidentChecklistItemSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 6)
+
+	if s.IncidentID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "incident_id")...),
+			psql.Arg(s.IncidentID),
+		}})
+	}
+
+	if s.Position != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "position")...),
+			psql.Arg(s.Position),
+		}})
+	}
+
+	if s.Text != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "text")...),
+			psql.Arg(s.Text),
+		}})
+	}
+
+	if s.Required != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "required")...),
+			psql.Arg(s.Required),
+		}})
+	}
+
+	if s.CompletedBy != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "completed_by")...),
+			psql.Arg(s.CompletedBy),
+		}})
+	}
+
+	if s.CompletedAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "completed_at")...),
+			psql.Arg(s.CompletedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindIncidentChecklistItem retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindIncidentChecklistItem(ctx context.Context, exec bob.Executor, IncidentIDPK uuid.UUID, PositionPK int32, cols ...string) (*IncidentChecklistItem, error) {
+	if len(cols) == 0 {
+		return IncidentChecklistItems.Query(
+			SelectWhere.IncidentChecklistItems.IncidentID.EQ(IncidentIDPK),
+			SelectWhere.IncidentChecklistItems.Position.EQ(PositionPK),
+		).One(ctx, exec)
+	}
+
+	return IncidentChecklistItems.Query(
+		SelectWhere.IncidentChecklistItems.IncidentID.EQ(IncidentIDPK),
+		SelectWhere.IncidentChecklistItems.Position.EQ(PositionPK),
+		sm.Columns(IncidentChecklistItems.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// IncidentChecklistItemExists checks the presence of a single record by primary key
+func IncidentChecklistItemExists(ctx context.Context, exec bob.Executor, IncidentIDPK uuid.UUID, PositionPK int32) (bool, error) {
+	return IncidentChecklistItems.Query(
+		SelectWhere.IncidentChecklistItems.IncidentID.EQ(IncidentIDPK),
+		SelectWhere.IncidentChecklistItems.Position.EQ(PositionPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after IncidentChecklistItem is retrieved from the database
+func (o *IncidentChecklistItem) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = IncidentChecklistItems.AfterSelectHooks.RunHooks(ctx, exec, IncidentChecklistItemSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = IncidentChecklistItems.AfterInsertHooks.RunHooks(ctx, exec, IncidentChecklistItemSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = IncidentChecklistItems.AfterUpdateHooks.RunHooks(ctx, exec, IncidentChecklistItemSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = IncidentChecklistItems.AfterDeleteHooks.RunHooks(ctx, exec, IncidentChecklistItemSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the IncidentChecklistItem
+func (o *IncidentChecklistItem) primaryKeyVals() bob.Expression {
+	return psql.ArgGroup(
+		o.IncidentID,
+		o.Position,
+	)
+}
+
+func (o *IncidentChecklistItem) pkEQ() dialect.Expression {
+	return psql.Group(psql.Quote("incident_checklist_items", "incident_id"), psql.Quote("incident_checklist_items", "position")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the IncidentChecklistItem
+func (o *IncidentChecklistItem) Update(ctx context.Context, exec bob.Executor, s *IncidentChecklistItemSetter) error {
+	v, err := IncidentChecklistItems.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single IncidentChecklistItem record with an executor
+func (o *IncidentChecklistItem) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := IncidentChecklistItems.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the IncidentChecklistItem using the executor
+func (o *IncidentChecklistItem) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := IncidentChecklistItems.Query(
+		SelectWhere.IncidentChecklistItems.IncidentID.EQ(o.IncidentID),
+		SelectWhere.IncidentChecklistItems.Position.EQ(o.Position),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after IncidentChecklistItemSlice is retrieved from the database
+func (o IncidentChecklistItemSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = IncidentChecklistItems.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = IncidentChecklistItems.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = IncidentChecklistItems.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = IncidentChecklistItems.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o IncidentChecklistItemSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Group(psql.Quote("incident_checklist_items", "incident_id"), psql.Quote("incident_checklist_items", "position")).In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o IncidentChecklistItemSlice) copyMatchingRows(from ...*IncidentChecklistItem) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.IncidentID != old.IncidentID {
+				continue
+			}
+			if new.Position != old.Position {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o IncidentChecklistItemSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return IncidentChecklistItems.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *IncidentChecklistItem:
+				o.copyMatchingRows(retrieved)
+			case []*IncidentChecklistItem:
+				o.copyMatchingRows(retrieved...)
+			case IncidentChecklistItemSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a IncidentChecklistItem or a slice of IncidentChecklistItem
+				// then run the AfterUpdateHooks on the slice
+				_, err = IncidentChecklistItems.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o IncidentChecklistItemSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return IncidentChecklistItems.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *IncidentChecklistItem:
+				o.copyMatchingRows(retrieved)
+			case []*IncidentChecklistItem:
+				o.copyMatchingRows(retrieved...)
+			case IncidentChecklistItemSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a IncidentChecklistItem or a slice of IncidentChecklistItem
+				// then run the AfterDeleteHooks on the slice
+				_, err = IncidentChecklistItems.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o IncidentChecklistItemSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals IncidentChecklistItemSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := IncidentChecklistItems.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o IncidentChecklistItemSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := IncidentChecklistItems.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o IncidentChecklistItemSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := IncidentChecklistItems.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type incidentChecklistItemJoins[Q dialect.Joinable] struct {
+	typ                       string
+	IncidentIncidentChecklist modAs[Q, incidentChecklistColumns]
+}
+
+func (j incidentChecklistItemJoins[Q]) aliasedAs(alias string) incidentChecklistItemJoins[Q] {
+	return buildIncidentChecklistItemJoins[Q](buildIncidentChecklistItemColumns(alias), j.typ)
+}
+
+func buildIncidentChecklistItemJoins[Q dialect.Joinable](cols incidentChecklistItemColumns, typ string) incidentChecklistItemJoins[Q] {
+	return incidentChecklistItemJoins[Q]{
+		typ: typ,
+		IncidentIncidentChecklist: modAs[Q, incidentChecklistColumns]{
+			c: IncidentChecklistColumns,
+			f: func(to incidentChecklistColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, IncidentChecklists.Name().As(to.Alias())).On(
+						to.IncidentID.EQ(cols.IncidentID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
+
+// IncidentIncidentChecklist starts a query for related objects on incident_checklists
+func (o *IncidentChecklistItem) IncidentIncidentChecklist(mods ...bob.Mod[*dialect.SelectQuery]) IncidentChecklistsQuery {
+	return IncidentChecklists.Query(append(mods,
+		sm.Where(IncidentChecklistColumns.IncidentID.EQ(psql.Arg(o.IncidentID))),
+	)...)
+}
+
+func (os IncidentChecklistItemSlice) IncidentIncidentChecklist(mods ...bob.Mod[*dialect.SelectQuery]) IncidentChecklistsQuery {
+	pkIncidentID := make(pgtypes.Array[uuid.UUID], len(os))
+	for i, o := range os {
+		pkIncidentID[i] = o.IncidentID
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkIncidentID), "uuid[]")),
+	))
+
+	return IncidentChecklists.Query(append(mods,
+		sm.Where(psql.Group(IncidentChecklistColumns.IncidentID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func (o *IncidentChecklistItem) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "IncidentIncidentChecklist":
+		rel, ok := retrieved.(*IncidentChecklist)
+		if !ok {
+			return fmt.Errorf("incidentChecklistItem cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.IncidentIncidentChecklist = rel
+
+		if rel != nil {
+			rel.R.IncidentIncidentChecklistItems = IncidentChecklistItemSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("incidentChecklistItem has no relationship %q", name)
+	}
+}
+
+type incidentChecklistItemPreloader struct {
+	IncidentIncidentChecklist func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildIncidentChecklistItemPreloader() incidentChecklistItemPreloader {
+	return incidentChecklistItemPreloader{
+		IncidentIncidentChecklist: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*IncidentChecklist, IncidentChecklistSlice](orm.Relationship{
+				Name: "IncidentIncidentChecklist",
+				Sides: []orm.RelSide{
+					{
+						From: TableNames.IncidentChecklistItems,
+						To:   TableNames.IncidentChecklists,
+						FromColumns: []string{
+							ColumnNames.IncidentChecklistItems.IncidentID,
+						},
+						ToColumns: []string{
+							ColumnNames.IncidentChecklists.IncidentID,
+						},
+					},
+				},
+			}, IncidentChecklists.Columns().Names(), opts...)
+		},
+	}
+}
+
+type incidentChecklistItemThenLoader[Q orm.Loadable] struct {
+	IncidentIncidentChecklist func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildIncidentChecklistItemThenLoader[Q orm.Loadable]() incidentChecklistItemThenLoader[Q] {
+	type IncidentIncidentChecklistLoadInterface interface {
+		LoadIncidentIncidentChecklist(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return incidentChecklistItemThenLoader[Q]{
+		IncidentIncidentChecklist: thenLoadBuilder[Q](
+			"IncidentIncidentChecklist",
+			func(ctx context.Context, exec bob.Executor, retrieved IncidentIncidentChecklistLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadIncidentIncidentChecklist(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadIncidentIncidentChecklist loads the incidentChecklistItem's IncidentIncidentChecklist into the .R struct
+func (o *IncidentChecklistItem) LoadIncidentIncidentChecklist(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.IncidentIncidentChecklist = nil
+
+	related, err := o.IncidentIncidentChecklist(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.IncidentIncidentChecklistItems = IncidentChecklistItemSlice{o}
+
+	o.R.IncidentIncidentChecklist = related
+	return nil
+}
+
+// LoadIncidentIncidentChecklist loads the incidentChecklistItem's IncidentIncidentChecklist into the .R struct
+func (os IncidentChecklistItemSlice) LoadIncidentIncidentChecklist(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	incidentChecklists, err := os.IncidentIncidentChecklist(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range incidentChecklists {
+			if o.IncidentID != rel.IncidentID {
+				continue
+			}
+
+			rel.R.IncidentIncidentChecklistItems = append(rel.R.IncidentIncidentChecklistItems, o)
+
+			o.R.IncidentIncidentChecklist = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func attachIncidentChecklistItemIncidentIncidentChecklist0(ctx context.Context, exec bob.Executor, count int, incidentChecklistItem0 *IncidentChecklistItem, incidentChecklist1 *IncidentChecklist) (*IncidentChecklistItem, error) {
+	setter := &IncidentChecklistItemSetter{
+		IncidentID: &incidentChecklist1.IncidentID,
+	}
+
+	err := incidentChecklistItem0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachIncidentChecklistItemIncidentIncidentChecklist0: %w", err)
+	}
+
+	return incidentChecklistItem0, nil
+}
+
+func (incidentChecklistItem0 *IncidentChecklistItem) InsertIncidentIncidentChecklist(ctx context.Context, exec bob.Executor, related *IncidentChecklistSetter) error {
+	incidentChecklist1, err := IncidentChecklists.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachIncidentChecklistItemIncidentIncidentChecklist0(ctx, exec, 1, incidentChecklistItem0, incidentChecklist1)
+	if err != nil {
+		return err
+	}
+
+	incidentChecklistItem0.R.IncidentIncidentChecklist = incidentChecklist1
+
+	incidentChecklist1.R.IncidentIncidentChecklistItems = append(incidentChecklist1.R.IncidentIncidentChecklistItems, incidentChecklistItem0)
+
+	return nil
+}
+
+func (incidentChecklistItem0 *IncidentChecklistItem) AttachIncidentIncidentChecklist(ctx context.Context, exec bob.Executor, incidentChecklist1 *IncidentChecklist) error {
+	var err error
+
+	_, err = attachIncidentChecklistItemIncidentIncidentChecklist0(ctx, exec, 1, incidentChecklistItem0, incidentChecklist1)
+	if err != nil {
+		return err
+	}
+
+	incidentChecklistItem0.R.IncidentIncidentChecklist = incidentChecklist1
+
+	incidentChecklist1.R.IncidentIncidentChecklistItems = append(incidentChecklist1.R.IncidentIncidentChecklistItems, incidentChecklistItem0)
+
+	return nil
+}
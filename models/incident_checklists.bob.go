@@ -0,0 +1,851 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// IncidentChecklist is an object representing the database table.
+type IncidentChecklist struct {
+	IncidentID     uuid.UUID           `db:"incident_id,pk" `
+	SlackChannelID string              `db:"slack_channel_id" `
+	SlackMessageTS sql.Null[string]    `db:"slack_message_ts" `
+	NextReminder   sql.Null[time.Time] `db:"next_reminder" `
+
+	R incidentChecklistR `db:"-" `
+}
+
+// IncidentChecklistSlice is an alias for a slice of pointers to IncidentChecklist.
+// This should almost always be used instead of []*IncidentChecklist.
+type IncidentChecklistSlice []*IncidentChecklist
+
+// IncidentChecklists contains methods to work with the incident_checklists table
+var IncidentChecklists = psql.NewTablex[*IncidentChecklist, IncidentChecklistSlice, *IncidentChecklistSetter]("", "incident_checklists")
+
+// IncidentChecklistsQuery is a query on the incident_checklists table
+type IncidentChecklistsQuery = *psql.ViewQuery[*IncidentChecklist, IncidentChecklistSlice]
+
+// incidentChecklistR is where relationships are stored.
+type incidentChecklistR struct {
+	IncidentIncidentChecklistItems IncidentChecklistItemSlice // incident_checklist_items.incident_checklist_items_incident_id_fkey
+	Incident                       *Incident                  // incident_checklists.incident_checklists_incident_id_fkey
+}
+
+type incidentChecklistColumnNames struct {
+	IncidentID     string
+	SlackChannelID string
+	SlackMessageTS string
+	NextReminder   string
+}
+
+var IncidentChecklistColumns = buildIncidentChecklistColumns("incident_checklists")
+
+type incidentChecklistColumns struct {
+	tableAlias     string
+	IncidentID     psql.Expression
+	SlackChannelID psql.Expression
+	SlackMessageTS psql.Expression
+	NextReminder   psql.Expression
+}
+
+func (c incidentChecklistColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (incidentChecklistColumns) AliasedAs(alias string) incidentChecklistColumns {
+	return buildIncidentChecklistColumns(alias)
+}
+
+func buildIncidentChecklistColumns(alias string) incidentChecklistColumns {
+	return incidentChecklistColumns{
+		tableAlias:     alias,
+		IncidentID:     psql.Quote(alias, "incident_id"),
+		SlackChannelID: psql.Quote(alias, "slack_channel_id"),
+		SlackMessageTS: psql.Quote(alias, "slack_message_ts"),
+		NextReminder:   psql.Quote(alias, "next_reminder"),
+	}
+}
+
+type incidentChecklistWhere[Q psql.Filterable] struct {
+	IncidentID     psql.WhereMod[Q, uuid.UUID]
+	SlackChannelID psql.WhereMod[Q, string]
+	SlackMessageTS psql.WhereNullMod[Q, string]
+	NextReminder   psql.WhereNullMod[Q, time.Time]
+}
+
+func (incidentChecklistWhere[Q]) AliasedAs(alias string) incidentChecklistWhere[Q] {
+	return buildIncidentChecklistWhere[Q](buildIncidentChecklistColumns(alias))
+}
+
+func buildIncidentChecklistWhere[Q psql.Filterable](cols incidentChecklistColumns) incidentChecklistWhere[Q] {
+	return incidentChecklistWhere[Q]{
+		IncidentID:     psql.Where[Q, uuid.UUID](cols.IncidentID),
+		SlackChannelID: psql.Where[Q, string](cols.SlackChannelID),
+		SlackMessageTS: psql.WhereNull[Q, string](cols.SlackMessageTS),
+		NextReminder:   psql.WhereNull[Q, time.Time](cols.NextReminder),
+	}
+}
+
+var IncidentChecklistErrors = &incidentChecklistErrors{
+	ErrUniqueIncidentChecklistsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "incident_checklists",
+		columns: []string{"incident_id"},
+		s:       "incident_checklists_pkey",
+	},
+}
+
+type incidentChecklistErrors struct {
+	ErrUniqueIncidentChecklistsPkey *UniqueConstraintError
+}
+
+// IncidentChecklistSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type IncidentChecklistSetter struct {
+	IncidentID     *uuid.UUID           `db:"incident_id,pk" `
+	SlackChannelID *string              `db:"slack_channel_id" `
+	SlackMessageTS *sql.Null[string]    `db:"slack_message_ts" `
+	NextReminder   *sql.Null[time.Time] `db:"next_reminder" `
+}
+
+func (s IncidentChecklistSetter) SetColumns() []string {
+	vals := make([]string, 0, 4)
+	if s.IncidentID != nil {
+		vals = append(vals, "incident_id")
+	}
+
+	if s.SlackChannelID != nil {
+		vals = append(vals, "slack_channel_id")
+	}
+
+	if s.SlackMessageTS != nil {
+		vals = append(vals, "slack_message_ts")
+	}
+
+	if s.NextReminder != nil {
+		vals = append(vals, "next_reminder")
+	}
+
+	return vals
+}
+
+func (s IncidentChecklistSetter) Overwrite(t *IncidentChecklist) {
+	if s.IncidentID != nil {
+		t.IncidentID = *s.IncidentID
+	}
+	if s.SlackChannelID != nil {
+		t.SlackChannelID = *s.SlackChannelID
+	}
+	if s.SlackMessageTS != nil {
+		t.SlackMessageTS = *s.SlackMessageTS
+	}
+	if s.NextReminder != nil {
+		t.NextReminder = *s.NextReminder
+	}
+}
+
+func (s *IncidentChecklistSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return IncidentChecklists.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 4)
+		if s.IncidentID != nil {
+			vals[0] = psql.Arg(*s.IncidentID)
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.SlackChannelID != nil {
+			vals[1] = psql.Arg(*s.SlackChannelID)
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.SlackMessageTS != nil {
+			vals[2] = psql.Arg(*s.SlackMessageTS)
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.NextReminder != nil {
+			vals[3] = psql.Arg(*s.NextReminder)
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s IncidentChecklistSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s IncidentChecklistSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 4)
+
+	if s.IncidentID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "incident_id")...),
+			psql.Arg(s.IncidentID),
+		}})
+	}
+
+	if s.SlackChannelID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "slack_channel_id")...),
+			psql.Arg(s.SlackChannelID),
+		}})
+	}
+
+	if s.SlackMessageTS != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "slack_message_ts")...),
+			psql.Arg(s.SlackMessageTS),
+		}})
+	}
+
+	if s.NextReminder != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "next_reminder")...),
+			psql.Arg(s.NextReminder),
+		}})
+	}
+
+	return exprs
+}
+
+// FindIncidentChecklist retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindIncidentChecklist(ctx context.Context, exec bob.Executor, IncidentIDPK uuid.UUID, cols ...string) (*IncidentChecklist, error) {
+	if len(cols) == 0 {
+		return IncidentChecklists.Query(
+			SelectWhere.IncidentChecklists.IncidentID.EQ(IncidentIDPK),
+		).One(ctx, exec)
+	}
+
+	return IncidentChecklists.Query(
+		SelectWhere.IncidentChecklists.IncidentID.EQ(IncidentIDPK),
+		sm.Columns(IncidentChecklists.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// IncidentChecklistExists checks the presence of a single record by primary key
+func IncidentChecklistExists(ctx context.Context, exec bob.Executor, IncidentIDPK uuid.UUID) (bool, error) {
+	return IncidentChecklists.Query(
+		SelectWhere.IncidentChecklists.IncidentID.EQ(IncidentIDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after IncidentChecklist is retrieved from the database
+func (o *IncidentChecklist) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = IncidentChecklists.AfterSelectHooks.RunHooks(ctx, exec, IncidentChecklistSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = IncidentChecklists.AfterInsertHooks.RunHooks(ctx, exec, IncidentChecklistSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = IncidentChecklists.AfterUpdateHooks.RunHooks(ctx, exec, IncidentChecklistSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = IncidentChecklists.AfterDeleteHooks.RunHooks(ctx, exec, IncidentChecklistSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the IncidentChecklist
+func (o *IncidentChecklist) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.IncidentID)
+}
+
+func (o *IncidentChecklist) pkEQ() dialect.Expression {
+	return psql.Quote("incident_checklists", "incident_id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the IncidentChecklist
+func (o *IncidentChecklist) Update(ctx context.Context, exec bob.Executor, s *IncidentChecklistSetter) error {
+	v, err := IncidentChecklists.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single IncidentChecklist record with an executor
+func (o *IncidentChecklist) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := IncidentChecklists.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the IncidentChecklist using the executor
+func (o *IncidentChecklist) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := IncidentChecklists.Query(
+		SelectWhere.IncidentChecklists.IncidentID.EQ(o.IncidentID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after IncidentChecklistSlice is retrieved from the database
+func (o IncidentChecklistSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = IncidentChecklists.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = IncidentChecklists.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = IncidentChecklists.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = IncidentChecklists.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o IncidentChecklistSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("incident_checklists", "incident_id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o IncidentChecklistSlice) copyMatchingRows(from ...*IncidentChecklist) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.IncidentID != old.IncidentID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o IncidentChecklistSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return IncidentChecklists.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *IncidentChecklist:
+				o.copyMatchingRows(retrieved)
+			case []*IncidentChecklist:
+				o.copyMatchingRows(retrieved...)
+			case IncidentChecklistSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a IncidentChecklist or a slice of IncidentChecklist
+				// then run the AfterUpdateHooks on the slice
+				_, err = IncidentChecklists.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o IncidentChecklistSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return IncidentChecklists.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *IncidentChecklist:
+				o.copyMatchingRows(retrieved)
+			case []*IncidentChecklist:
+				o.copyMatchingRows(retrieved...)
+			case IncidentChecklistSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a IncidentChecklist or a slice of IncidentChecklist
+				// then run the AfterDeleteHooks on the slice
+				_, err = IncidentChecklists.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o IncidentChecklistSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals IncidentChecklistSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := IncidentChecklists.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o IncidentChecklistSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := IncidentChecklists.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o IncidentChecklistSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := IncidentChecklists.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type incidentChecklistJoins[Q dialect.Joinable] struct {
+	typ                            string
+	IncidentIncidentChecklistItems modAs[Q, incidentChecklistItemColumns]
+	Incident                       modAs[Q, incidentColumns]
+}
+
+func (j incidentChecklistJoins[Q]) aliasedAs(alias string) incidentChecklistJoins[Q] {
+	return buildIncidentChecklistJoins[Q](buildIncidentChecklistColumns(alias), j.typ)
+}
+
+func buildIncidentChecklistJoins[Q dialect.Joinable](cols incidentChecklistColumns, typ string) incidentChecklistJoins[Q] {
+	return incidentChecklistJoins[Q]{
+		typ: typ,
+		IncidentIncidentChecklistItems: modAs[Q, incidentChecklistItemColumns]{
+			c: IncidentChecklistItemColumns,
+			f: func(to incidentChecklistItemColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, IncidentChecklistItems.Name().As(to.Alias())).On(
+						to.IncidentID.EQ(cols.IncidentID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Incident: modAs[Q, incidentColumns]{
+			c: IncidentColumns,
+			f: func(to incidentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Incidents.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.IncidentID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
+
+// IncidentIncidentChecklistItems starts a query for related objects on incident_checklist_items
+func (o *IncidentChecklist) IncidentIncidentChecklistItems(mods ...bob.Mod[*dialect.SelectQuery]) IncidentChecklistItemsQuery {
+	return IncidentChecklistItems.Query(append(mods,
+		sm.Where(IncidentChecklistItemColumns.IncidentID.EQ(psql.Arg(o.IncidentID))),
+	)...)
+}
+
+func (os IncidentChecklistSlice) IncidentIncidentChecklistItems(mods ...bob.Mod[*dialect.SelectQuery]) IncidentChecklistItemsQuery {
+	pkIncidentID := make(pgtypes.Array[uuid.UUID], len(os))
+	for i, o := range os {
+		pkIncidentID[i] = o.IncidentID
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkIncidentID), "uuid[]")),
+	))
+
+	return IncidentChecklistItems.Query(append(mods,
+		sm.Where(psql.Group(IncidentChecklistItemColumns.IncidentID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Incident starts a query for related objects on incidents
+func (o *IncidentChecklist) Incident(mods ...bob.Mod[*dialect.SelectQuery]) IncidentsQuery {
+	return Incidents.Query(append(mods,
+		sm.Where(IncidentColumns.ID.EQ(psql.Arg(o.IncidentID))),
+	)...)
+}
+
+func (os IncidentChecklistSlice) Incident(mods ...bob.Mod[*dialect.SelectQuery]) IncidentsQuery {
+	pkIncidentID := make(pgtypes.Array[uuid.UUID], len(os))
+	for i, o := range os {
+		pkIncidentID[i] = o.IncidentID
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkIncidentID), "uuid[]")),
+	))
+
+	return Incidents.Query(append(mods,
+		sm.Where(psql.Group(IncidentColumns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func (o *IncidentChecklist) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "IncidentIncidentChecklistItems":
+		rels, ok := retrieved.(IncidentChecklistItemSlice)
+		if !ok {
+			return fmt.Errorf("incidentChecklist cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.IncidentIncidentChecklistItems = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.IncidentIncidentChecklist = o
+			}
+		}
+		return nil
+	case "Incident":
+		rel, ok := retrieved.(*Incident)
+		if !ok {
+			return fmt.Errorf("incidentChecklist cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Incident = rel
+
+		if rel != nil {
+			rel.R.IncidentChecklist = o
+		}
+		return nil
+	default:
+		return fmt.Errorf("incidentChecklist has no relationship %q", name)
+	}
+}
+
+type incidentChecklistPreloader struct {
+	Incident func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildIncidentChecklistPreloader() incidentChecklistPreloader {
+	return incidentChecklistPreloader{
+		Incident: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Incident, IncidentSlice](orm.Relationship{
+				Name: "Incident",
+				Sides: []orm.RelSide{
+					{
+						From: TableNames.IncidentChecklists,
+						To:   TableNames.Incidents,
+						FromColumns: []string{
+							ColumnNames.IncidentChecklists.IncidentID,
+						},
+						ToColumns: []string{
+							ColumnNames.Incidents.ID,
+						},
+					},
+				},
+			}, Incidents.Columns().Names(), opts...)
+		},
+	}
+}
+
+type incidentChecklistThenLoader[Q orm.Loadable] struct {
+	IncidentIncidentChecklistItems func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Incident                       func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildIncidentChecklistThenLoader[Q orm.Loadable]() incidentChecklistThenLoader[Q] {
+	type IncidentIncidentChecklistItemsLoadInterface interface {
+		LoadIncidentIncidentChecklistItems(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type IncidentLoadInterface interface {
+		LoadIncident(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return incidentChecklistThenLoader[Q]{
+		IncidentIncidentChecklistItems: thenLoadBuilder[Q](
+			"IncidentIncidentChecklistItems",
+			func(ctx context.Context, exec bob.Executor, retrieved IncidentIncidentChecklistItemsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadIncidentIncidentChecklistItems(ctx, exec, mods...)
+			},
+		),
+		Incident: thenLoadBuilder[Q](
+			"Incident",
+			func(ctx context.Context, exec bob.Executor, retrieved IncidentLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadIncident(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadIncidentIncidentChecklistItems loads the incidentChecklist's IncidentIncidentChecklistItems into the .R struct
+func (o *IncidentChecklist) LoadIncidentIncidentChecklistItems(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.IncidentIncidentChecklistItems = nil
+
+	related, err := o.IncidentIncidentChecklistItems(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.IncidentIncidentChecklist = o
+	}
+
+	o.R.IncidentIncidentChecklistItems = related
+	return nil
+}
+
+// LoadIncidentIncidentChecklistItems loads the incidentChecklist's IncidentIncidentChecklistItems into the .R struct
+func (os IncidentChecklistSlice) LoadIncidentIncidentChecklistItems(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	incidentChecklistItems, err := os.IncidentIncidentChecklistItems(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.IncidentIncidentChecklistItems = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range incidentChecklistItems {
+			if o.IncidentID != rel.IncidentID {
+				continue
+			}
+
+			rel.R.IncidentIncidentChecklist = o
+
+			o.R.IncidentIncidentChecklistItems = append(o.R.IncidentIncidentChecklistItems, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadIncident loads the incidentChecklist's Incident into the .R struct
+func (o *IncidentChecklist) LoadIncident(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Incident = nil
+
+	related, err := o.Incident(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.IncidentChecklist = o
+
+	o.R.Incident = related
+	return nil
+}
+
+// LoadIncident loads the incidentChecklist's Incident into the .R struct
+func (os IncidentChecklistSlice) LoadIncident(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	incidents, err := os.Incident(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range incidents {
+			if o.IncidentID != rel.ID {
+				continue
+			}
+
+			rel.R.IncidentChecklist = o
+
+			o.R.Incident = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func insertIncidentChecklistIncidentIncidentChecklistItems0(ctx context.Context, exec bob.Executor, incidentChecklistItems1 []*IncidentChecklistItemSetter, incidentChecklist0 *IncidentChecklist) (IncidentChecklistItemSlice, error) {
+	for i := range incidentChecklistItems1 {
+		incidentChecklistItems1[i].IncidentID = &incidentChecklist0.IncidentID
+	}
+
+	ret, err := IncidentChecklistItems.Insert(bob.ToMods(incidentChecklistItems1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertIncidentChecklistIncidentIncidentChecklistItems0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachIncidentChecklistIncidentIncidentChecklistItems0(ctx context.Context, exec bob.Executor, count int, incidentChecklistItems1 IncidentChecklistItemSlice, incidentChecklist0 *IncidentChecklist) (IncidentChecklistItemSlice, error) {
+	setter := &IncidentChecklistItemSetter{
+		IncidentID: &incidentChecklist0.IncidentID,
+	}
+
+	err := incidentChecklistItems1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachIncidentChecklistIncidentIncidentChecklistItems0: %w", err)
+	}
+
+	return incidentChecklistItems1, nil
+}
+
+func (incidentChecklist0 *IncidentChecklist) InsertIncidentIncidentChecklistItems(ctx context.Context, exec bob.Executor, related ...*IncidentChecklistItemSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	incidentChecklistItems1, err := insertIncidentChecklistIncidentIncidentChecklistItems0(ctx, exec, related, incidentChecklist0)
+	if err != nil {
+		return err
+	}
+
+	incidentChecklist0.R.IncidentIncidentChecklistItems = append(incidentChecklist0.R.IncidentIncidentChecklistItems, incidentChecklistItems1...)
+
+	for _, rel := range incidentChecklistItems1 {
+		rel.R.IncidentIncidentChecklist = incidentChecklist0
+	}
+	return nil
+}
+
+func (incidentChecklist0 *IncidentChecklist) AttachIncidentIncidentChecklistItems(ctx context.Context, exec bob.Executor, related ...*IncidentChecklistItem) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	incidentChecklistItems1 := IncidentChecklistItemSlice(related)
+
+	_, err = attachIncidentChecklistIncidentIncidentChecklistItems0(ctx, exec, len(related), incidentChecklistItems1, incidentChecklist0)
+	if err != nil {
+		return err
+	}
+
+	incidentChecklist0.R.IncidentIncidentChecklistItems = append(incidentChecklist0.R.IncidentIncidentChecklistItems, incidentChecklistItems1...)
+
+	for _, rel := range related {
+		rel.R.IncidentIncidentChecklist = incidentChecklist0
+	}
+
+	return nil
+}
+
+func attachIncidentChecklistIncident0(ctx context.Context, exec bob.Executor, count int, incidentChecklist0 *IncidentChecklist, incident1 *Incident) (*IncidentChecklist, error) {
+	setter := &IncidentChecklistSetter{
+		IncidentID: &incident1.ID,
+	}
+
+	err := incidentChecklist0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachIncidentChecklistIncident0: %w", err)
+	}
+
+	return incidentChecklist0, nil
+}
+
+func (incidentChecklist0 *IncidentChecklist) InsertIncident(ctx context.Context, exec bob.Executor, related *IncidentSetter) error {
+	incident1, err := Incidents.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachIncidentChecklistIncident0(ctx, exec, 1, incidentChecklist0, incident1)
+	if err != nil {
+		return err
+	}
+
+	incidentChecklist0.R.Incident = incident1
+
+	incident1.R.IncidentChecklist = incidentChecklist0
+
+	return nil
+}
+
+func (incidentChecklist0 *IncidentChecklist) AttachIncident(ctx context.Context, exec bob.Executor, incident1 *Incident) error {
+	var err error
+
+	_, err = attachIncidentChecklistIncident0(ctx, exec, 1, incidentChecklist0, incident1)
+	if err != nil {
+		return err
+	}
+
+	incidentChecklist0.R.Incident = incident1
+
+	incident1.R.IncidentChecklist = incidentChecklist0
+
+	return nil
+}
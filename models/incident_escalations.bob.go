@@ -0,0 +1,751 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// IncidentEscalation is an object representing the database table.
+type IncidentEscalation struct {
+	IncidentID     uuid.UUID           `db:"incident_id,pk" `
+	Policy         string              `db:"policy" `
+	Level          int32               `db:"level" `
+	EscalatedAt    sql.Null[time.Time] `db:"escalated_at" `
+	AcknowledgedBy sql.Null[string]    `db:"acknowledged_by" `
+	AcknowledgedAt sql.Null[time.Time] `db:"acknowledged_at" `
+	CreatedAt      time.Time           `db:"created_at" `
+
+	R incidentEscalationR `db:"-" `
+}
+
+// IncidentEscalationSlice is an alias for a slice of pointers to IncidentEscalation.
+// This should almost always be used instead of []*IncidentEscalation.
+type IncidentEscalationSlice []*IncidentEscalation
+
+// IncidentEscalations contains methods to work with the incident_escalations table
+var IncidentEscalations = psql.NewTablex[*IncidentEscalation, IncidentEscalationSlice, *IncidentEscalationSetter]("", "incident_escalations")
+
+// IncidentEscalationsQuery is a query on the incident_escalations table
+type IncidentEscalationsQuery = *psql.ViewQuery[*IncidentEscalation, IncidentEscalationSlice]
+
+// incidentEscalationR is where relationships are stored.
+type incidentEscalationR struct {
+	Incident *Incident // incident_escalations.incident_escalations_incident_id_fkey
+}
+
+type incidentEscalationColumnNames struct {
+	IncidentID     string
+	Policy         string
+	Level          string
+	EscalatedAt    string
+	AcknowledgedBy string
+	AcknowledgedAt string
+	CreatedAt      string
+}
+
+var IncidentEscalationColumns = buildIncidentEscalationColumns("incident_escalations")
+
+type incidentEscalationColumns struct {
+	tableAlias     string
+	IncidentID     psql.Expression
+	Policy         psql.Expression
+	Level          psql.Expression
+	EscalatedAt    psql.Expression
+	AcknowledgedBy psql.Expression
+	AcknowledgedAt psql.Expression
+	CreatedAt      psql.Expression
+}
+
+func (c incidentEscalationColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (incidentEscalationColumns) AliasedAs(alias string) incidentEscalationColumns {
+	return buildIncidentEscalationColumns(alias)
+}
+
+func buildIncidentEscalationColumns(alias string) incidentEscalationColumns {
+	return incidentEscalationColumns{
+		tableAlias:     alias,
+		IncidentID:     psql.Quote(alias, "incident_id"),
+		Policy:         psql.Quote(alias, "policy"),
+		Level:          psql.Quote(alias, "level"),
+		EscalatedAt:    psql.Quote(alias, "escalated_at"),
+		AcknowledgedBy: psql.Quote(alias, "acknowledged_by"),
+		AcknowledgedAt: psql.Quote(alias, "acknowledged_at"),
+		CreatedAt:      psql.Quote(alias, "created_at"),
+	}
+}
+
+type incidentEscalationWhere[Q psql.Filterable] struct {
+	IncidentID     psql.WhereMod[Q, uuid.UUID]
+	Policy         psql.WhereMod[Q, string]
+	Level          psql.WhereMod[Q, int32]
+	EscalatedAt    psql.WhereNullMod[Q, time.Time]
+	AcknowledgedBy psql.WhereNullMod[Q, string]
+	AcknowledgedAt psql.WhereNullMod[Q, time.Time]
+	CreatedAt      psql.WhereMod[Q, time.Time]
+}
+
+func (incidentEscalationWhere[Q]) AliasedAs(alias string) incidentEscalationWhere[Q] {
+	return buildIncidentEscalationWhere[Q](buildIncidentEscalationColumns(alias))
+}
+
+func buildIncidentEscalationWhere[Q psql.Filterable](cols incidentEscalationColumns) incidentEscalationWhere[Q] {
+	return incidentEscalationWhere[Q]{
+		IncidentID:     psql.Where[Q, uuid.UUID](cols.IncidentID),
+		Policy:         psql.Where[Q, string](cols.Policy),
+		Level:          psql.Where[Q, int32](cols.Level),
+		EscalatedAt:    psql.WhereNull[Q, time.Time](cols.EscalatedAt),
+		AcknowledgedBy: psql.WhereNull[Q, string](cols.AcknowledgedBy),
+		AcknowledgedAt: psql.WhereNull[Q, time.Time](cols.AcknowledgedAt),
+		CreatedAt:      psql.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+var IncidentEscalationErrors = &incidentEscalationErrors{
+	ErrUniqueIncidentEscalationsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "incident_escalations",
+		columns: []string{"incident_id"},
+		s:       "incident_escalations_pkey",
+	},
+}
+
+type incidentEscalationErrors struct {
+	ErrUniqueIncidentEscalationsPkey *UniqueConstraintError
+}
+
+// IncidentEscalationSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type IncidentEscalationSetter struct {
+	IncidentID     *uuid.UUID           `db:"incident_id,pk" `
+	Policy         *string              `db:"policy" `
+	Level          *int32               `db:"level" `
+	EscalatedAt    *sql.Null[time.Time] `db:"escalated_at" `
+	AcknowledgedBy *sql.Null[string]    `db:"acknowledged_by" `
+	AcknowledgedAt *sql.Null[time.Time] `db:"acknowledged_at" `
+	CreatedAt      *time.Time           `db:"created_at" `
+}
+
+func (s IncidentEscalationSetter) SetColumns() []string {
+	vals := make([]string, 0, 7)
+	if s.IncidentID != nil {
+		vals = append(vals, "incident_id")
+	}
+
+	if s.Policy != nil {
+		vals = append(vals, "policy")
+	}
+
+	if s.Level != nil {
+		vals = append(vals, "level")
+	}
+
+	if s.EscalatedAt != nil {
+		vals = append(vals, "escalated_at")
+	}
+
+	if s.AcknowledgedBy != nil {
+		vals = append(vals, "acknowledged_by")
+	}
+
+	if s.AcknowledgedAt != nil {
+		vals = append(vals, "acknowledged_at")
+	}
+
+	if s.CreatedAt != nil {
+		vals = append(vals, "created_at")
+	}
+
+	return vals
+}
+
+func (s IncidentEscalationSetter) Overwrite(t *IncidentEscalation) {
+	if s.IncidentID != nil {
+		t.IncidentID = *s.IncidentID
+	}
+	if s.Policy != nil {
+		t.Policy = *s.Policy
+	}
+	if s.Level != nil {
+		t.Level = *s.Level
+	}
+	if s.EscalatedAt != nil {
+		t.EscalatedAt = *s.EscalatedAt
+	}
+	if s.AcknowledgedBy != nil {
+		t.AcknowledgedBy = *s.AcknowledgedBy
+	}
+	if s.AcknowledgedAt != nil {
+		t.AcknowledgedAt = *s.AcknowledgedAt
+	}
+	if s.CreatedAt != nil {
+		t.CreatedAt = *s.CreatedAt
+	}
+}
+
+func (s *IncidentEscalationSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return IncidentEscalations.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 7)
+		if s.IncidentID != nil {
+			vals[0] = psql.Arg(*s.IncidentID)
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.Policy != nil {
+			vals[1] = psql.Arg(*s.Policy)
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.Level != nil {
+			vals[2] = psql.Arg(*s.Level)
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.EscalatedAt != nil {
+			vals[3] = psql.Arg(*s.EscalatedAt)
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.AcknowledgedBy != nil {
+			vals[4] = psql.Arg(*s.AcknowledgedBy)
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.AcknowledgedAt != nil {
+			vals[5] = psql.Arg(*s.AcknowledgedAt)
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedAt != nil {
+			vals[6] = psql.Arg(*s.CreatedAt)
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s IncidentEscalationSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s IncidentEscalationSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 7)
+
+	if s.IncidentID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "incident_id")...),
+			psql.Arg(s.IncidentID),
+		}})
+	}
+
+	if s.Policy != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "policy")...),
+			psql.Arg(s.Policy),
+		}})
+	}
+
+	if s.Level != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "level")...),
+			psql.Arg(s.Level),
+		}})
+	}
+
+	if s.EscalatedAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "escalated_at")...),
+			psql.Arg(s.EscalatedAt),
+		}})
+	}
+
+	if s.AcknowledgedBy != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "acknowledged_by")...),
+			psql.Arg(s.AcknowledgedBy),
+		}})
+	}
+
+	if s.AcknowledgedAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "acknowledged_at")...),
+			psql.Arg(s.AcknowledgedAt),
+		}})
+	}
+
+	if s.CreatedAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindIncidentEscalation retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindIncidentEscalation(ctx context.Context, exec bob.Executor, IncidentIDPK uuid.UUID, cols ...string) (*IncidentEscalation, error) {
+	if len(cols) == 0 {
+		return IncidentEscalations.Query(
+			SelectWhere.IncidentEscalations.IncidentID.EQ(IncidentIDPK),
+		).One(ctx, exec)
+	}
+
+	return IncidentEscalations.Query(
+		SelectWhere.IncidentEscalations.IncidentID.EQ(IncidentIDPK),
+		sm.Columns(IncidentEscalations.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// IncidentEscalationExists checks the presence of a single record by primary key
+func IncidentEscalationExists(ctx context.Context, exec bob.Executor, IncidentIDPK uuid.UUID) (bool, error) {
+	return IncidentEscalations.Query(
+		SelectWhere.IncidentEscalations.IncidentID.EQ(IncidentIDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after IncidentEscalation is retrieved from the database
+func (o *IncidentEscalation) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = IncidentEscalations.AfterSelectHooks.RunHooks(ctx, exec, IncidentEscalationSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = IncidentEscalations.AfterInsertHooks.RunHooks(ctx, exec, IncidentEscalationSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = IncidentEscalations.AfterUpdateHooks.RunHooks(ctx, exec, IncidentEscalationSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = IncidentEscalations.AfterDeleteHooks.RunHooks(ctx, exec, IncidentEscalationSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the IncidentEscalation
+func (o *IncidentEscalation) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.IncidentID)
+}
+
+func (o *IncidentEscalation) pkEQ() dialect.Expression {
+	return psql.Quote("incident_escalations", "incident_id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the IncidentEscalation
+func (o *IncidentEscalation) Update(ctx context.Context, exec bob.Executor, s *IncidentEscalationSetter) error {
+	v, err := IncidentEscalations.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single IncidentEscalation record with an executor
+func (o *IncidentEscalation) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := IncidentEscalations.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the IncidentEscalation using the executor
+func (o *IncidentEscalation) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := IncidentEscalations.Query(
+		SelectWhere.IncidentEscalations.IncidentID.EQ(o.IncidentID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after IncidentEscalationSlice is retrieved from the database
+func (o IncidentEscalationSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = IncidentEscalations.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = IncidentEscalations.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = IncidentEscalations.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = IncidentEscalations.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o IncidentEscalationSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("incident_escalations", "incident_id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o IncidentEscalationSlice) copyMatchingRows(from ...*IncidentEscalation) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.IncidentID != old.IncidentID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o IncidentEscalationSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return IncidentEscalations.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *IncidentEscalation:
+				o.copyMatchingRows(retrieved)
+			case []*IncidentEscalation:
+				o.copyMatchingRows(retrieved...)
+			case IncidentEscalationSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a IncidentEscalation or a slice of IncidentEscalation
+				// then run the AfterUpdateHooks on the slice
+				_, err = IncidentEscalations.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o IncidentEscalationSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return IncidentEscalations.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *IncidentEscalation:
+				o.copyMatchingRows(retrieved)
+			case []*IncidentEscalation:
+				o.copyMatchingRows(retrieved...)
+			case IncidentEscalationSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a IncidentEscalation or a slice of IncidentEscalation
+				// then run the AfterDeleteHooks on the slice
+				_, err = IncidentEscalations.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o IncidentEscalationSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals IncidentEscalationSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := IncidentEscalations.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o IncidentEscalationSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := IncidentEscalations.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o IncidentEscalationSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := IncidentEscalations.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type incidentEscalationJoins[Q dialect.Joinable] struct {
+	typ      string
+	Incident modAs[Q, incidentColumns]
+}
+
+func (j incidentEscalationJoins[Q]) aliasedAs(alias string) incidentEscalationJoins[Q] {
+	return buildIncidentEscalationJoins[Q](buildIncidentEscalationColumns(alias), j.typ)
+}
+
+func buildIncidentEscalationJoins[Q dialect.Joinable](cols incidentEscalationColumns, typ string) incidentEscalationJoins[Q] {
+	return incidentEscalationJoins[Q]{
+		typ: typ,
+		Incident: modAs[Q, incidentColumns]{
+			c: IncidentColumns,
+			f: func(to incidentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Incidents.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.IncidentID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
+
+// Incident starts a query for related objects on incidents
+func (o *IncidentEscalation) Incident(mods ...bob.Mod[*dialect.SelectQuery]) IncidentsQuery {
+	return Incidents.Query(append(mods,
+		sm.Where(IncidentColumns.ID.EQ(psql.Arg(o.IncidentID))),
+	)...)
+}
+
+func (os IncidentEscalationSlice) Incident(mods ...bob.Mod[*dialect.SelectQuery]) IncidentsQuery {
+	pkIncidentID := make(pgtypes.Array[uuid.UUID], len(os))
+	for i, o := range os {
+		pkIncidentID[i] = o.IncidentID
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkIncidentID), "uuid[]")),
+	))
+
+	return Incidents.Query(append(mods,
+		sm.Where(psql.Group(IncidentColumns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func (o *IncidentEscalation) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Incident":
+		rel, ok := retrieved.(*Incident)
+		if !ok {
+			return fmt.Errorf("incidentEscalation cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Incident = rel
+
+		if rel != nil {
+			rel.R.IncidentEscalation = o
+		}
+		return nil
+	default:
+		return fmt.Errorf("incidentEscalation has no relationship %q", name)
+	}
+}
+
+type incidentEscalationPreloader struct {
+	Incident func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildIncidentEscalationPreloader() incidentEscalationPreloader {
+	return incidentEscalationPreloader{
+		Incident: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Incident, IncidentSlice](orm.Relationship{
+				Name: "Incident",
+				Sides: []orm.RelSide{
+					{
+						From: TableNames.IncidentEscalations,
+						To:   TableNames.Incidents,
+						FromColumns: []string{
+							ColumnNames.IncidentEscalations.IncidentID,
+						},
+						ToColumns: []string{
+							ColumnNames.Incidents.ID,
+						},
+					},
+				},
+			}, Incidents.Columns().Names(), opts...)
+		},
+	}
+}
+
+type incidentEscalationThenLoader[Q orm.Loadable] struct {
+	Incident func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildIncidentEscalationThenLoader[Q orm.Loadable]() incidentEscalationThenLoader[Q] {
+	type IncidentLoadInterface interface {
+		LoadIncident(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return incidentEscalationThenLoader[Q]{
+		Incident: thenLoadBuilder[Q](
+			"Incident",
+			func(ctx context.Context, exec bob.Executor, retrieved IncidentLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadIncident(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadIncident loads the incidentEscalation's Incident into the .R struct
+func (o *IncidentEscalation) LoadIncident(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Incident = nil
+
+	related, err := o.Incident(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.IncidentEscalation = o
+
+	o.R.Incident = related
+	return nil
+}
+
+// LoadIncident loads the incidentEscalation's Incident into the .R struct
+func (os IncidentEscalationSlice) LoadIncident(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	incidents, err := os.Incident(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range incidents {
+			if o.IncidentID != rel.ID {
+				continue
+			}
+
+			rel.R.IncidentEscalation = o
+
+			o.R.Incident = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func attachIncidentEscalationIncident0(ctx context.Context, exec bob.Executor, count int, incidentEscalation0 *IncidentEscalation, incident1 *Incident) (*IncidentEscalation, error) {
+	setter := &IncidentEscalationSetter{
+		IncidentID: &incident1.ID,
+	}
+
+	err := incidentEscalation0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachIncidentEscalationIncident0: %w", err)
+	}
+
+	return incidentEscalation0, nil
+}
+
+func (incidentEscalation0 *IncidentEscalation) InsertIncident(ctx context.Context, exec bob.Executor, related *IncidentSetter) error {
+	incident1, err := Incidents.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachIncidentEscalationIncident0(ctx, exec, 1, incidentEscalation0, incident1)
+	if err != nil {
+		return err
+	}
+
+	incidentEscalation0.R.Incident = incident1
+
+	incident1.R.IncidentEscalation = incidentEscalation0
+
+	return nil
+}
+
+func (incidentEscalation0 *IncidentEscalation) AttachIncident(ctx context.Context, exec bob.Executor, incident1 *Incident) error {
+	var err error
+
+	_, err = attachIncidentEscalationIncident0(ctx, exec, 1, incidentEscalation0, incident1)
+	if err != nil {
+		return err
+	}
+
+	incidentEscalation0.R.Incident = incident1
+
+	incident1.R.IncidentEscalation = incidentEscalation0
+
+	return nil
+}
@@ -0,0 +1,679 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// IncidentNotification is an object representing the database table.
+type IncidentNotification struct {
+	IncidentID     uuid.UUID `db:"incident_id,pk" `
+	SlackChannelID string    `db:"slack_channel_id,pk" `
+	SlackMessageTS string    `db:"slack_message_ts" `
+	PostedAt       time.Time `db:"posted_at" `
+
+	R incidentNotificationR `db:"-" `
+}
+
+// IncidentNotificationSlice is an alias for a slice of pointers to IncidentNotification.
+// This should almost always be used instead of []*IncidentNotification.
+type IncidentNotificationSlice []*IncidentNotification
+
+// IncidentNotifications contains methods to work with the incident_notifications table
+var IncidentNotifications = psql.NewTablex[*IncidentNotification, IncidentNotificationSlice, *IncidentNotificationSetter]("", "incident_notifications")
+
+// IncidentNotificationsQuery is a query on the incident_notifications table
+type IncidentNotificationsQuery = *psql.ViewQuery[*IncidentNotification, IncidentNotificationSlice]
+
+// incidentNotificationR is where relationships are stored.
+type incidentNotificationR struct {
+	Incident *Incident // incident_notifications.incident_notifications_incident_id_fkey
+}
+
+type incidentNotificationColumnNames struct {
+	IncidentID     string
+	SlackChannelID string
+	SlackMessageTS string
+	PostedAt       string
+}
+
+var IncidentNotificationColumns = buildIncidentNotificationColumns("incident_notifications")
+
+type incidentNotificationColumns struct {
+	tableAlias     string
+	IncidentID     psql.Expression
+	SlackChannelID psql.Expression
+	SlackMessageTS psql.Expression
+	PostedAt       psql.Expression
+}
+
+func (c incidentNotificationColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (incidentNotificationColumns) AliasedAs(alias string) incidentNotificationColumns {
+	return buildIncidentNotificationColumns(alias)
+}
+
+func buildIncidentNotificationColumns(alias string) incidentNotificationColumns {
+	return incidentNotificationColumns{
+		tableAlias:     alias,
+		IncidentID:     psql.Quote(alias, "incident_id"),
+		SlackChannelID: psql.Quote(alias, "slack_channel_id"),
+		SlackMessageTS: psql.Quote(alias, "slack_message_ts"),
+		PostedAt:       psql.Quote(alias, "posted_at"),
+	}
+}
+
+type incidentNotificationWhere[Q psql.Filterable] struct {
+	IncidentID     psql.WhereMod[Q, uuid.UUID]
+	SlackChannelID psql.WhereMod[Q, string]
+	SlackMessageTS psql.WhereMod[Q, string]
+	PostedAt       psql.WhereMod[Q, time.Time]
+}
+
+func (incidentNotificationWhere[Q]) AliasedAs(alias string) incidentNotificationWhere[Q] {
+	return buildIncidentNotificationWhere[Q](buildIncidentNotificationColumns(alias))
+}
+
+func buildIncidentNotificationWhere[Q psql.Filterable](cols incidentNotificationColumns) incidentNotificationWhere[Q] {
+	return incidentNotificationWhere[Q]{
+		IncidentID:     psql.Where[Q, uuid.UUID](cols.IncidentID),
+		SlackChannelID: psql.Where[Q, string](cols.SlackChannelID),
+		SlackMessageTS: psql.Where[Q, string](cols.SlackMessageTS),
+		PostedAt:       psql.Where[Q, time.Time](cols.PostedAt),
+	}
+}
+
+var IncidentNotificationErrors = &incidentNotificationErrors{
+	ErrUniqueIncidentNotificationsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "incident_notifications",
+		columns: []string{"incident_id", "slack_channel_id"},
+		s:       "incident_notifications_pkey",
+	},
+}
+
+type incidentNotificationErrors struct {
+	ErrUniqueIncidentNotificationsPkey *UniqueConstraintError
+}
+
+// IncidentNotificationSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type IncidentNotificationSetter struct {
+	IncidentID     *uuid.UUID `db:"incident_id,pk" `
+	SlackChannelID *string    `db:"slack_channel_id,pk" `
+	SlackMessageTS *string    `db:"slack_message_ts" `
+	PostedAt       *time.Time `db:"posted_at" `
+}
+
+func (s IncidentNotificationSetter) SetColumns() []string {
+	vals := make([]string, 0, 4)
+	if s.IncidentID != nil {
+		vals = append(vals, "incident_id")
+	}
+
+	if s.SlackChannelID != nil {
+		vals = append(vals, "slack_channel_id")
+	}
+
+	if s.SlackMessageTS != nil {
+		vals = append(vals, "slack_message_ts")
+	}
+
+	if s.PostedAt != nil {
+		vals = append(vals, "posted_at")
+	}
+
+	return vals
+}
+
+func (s IncidentNotificationSetter) Overwrite(t *IncidentNotification) {
+	if s.IncidentID != nil {
+		t.IncidentID = *s.IncidentID
+	}
+	if s.SlackChannelID != nil {
+		t.SlackChannelID = *s.SlackChannelID
+	}
+	if s.SlackMessageTS != nil {
+		t.SlackMessageTS = *s.SlackMessageTS
+	}
+	if s.PostedAt != nil {
+		t.PostedAt = *s.PostedAt
+	}
+}
+
+func (s *IncidentNotificationSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return IncidentNotifications.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 4)
+		if s.IncidentID != nil {
+			vals[0] = psql.Arg(*s.IncidentID)
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.SlackChannelID != nil {
+			vals[1] = psql.Arg(*s.SlackChannelID)
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.SlackMessageTS != nil {
+			vals[2] = psql.Arg(*s.SlackMessageTS)
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.PostedAt != nil {
+			vals[3] = psql.Arg(*s.PostedAt)
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s IncidentNotificationSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s IncidentNotificationSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 4)
+
+	if s.IncidentID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "incident_id")...),
+			psql.Arg(s.IncidentID),
+		}})
+	}
+
+	if s.SlackChannelID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "slack_channel_id")...),
+			psql.Arg(s.SlackChannelID),
+		}})
+	}
+
+	if s.SlackMessageTS != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "slack_message_ts")...),
+			psql.Arg(s.SlackMessageTS),
+		}})
+	}
+
+	if s.PostedAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "posted_at")...),
+			psql.Arg(s.PostedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindIncidentNotification retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindIncidentNotification(ctx context.Context, exec bob.Executor, IncidentIDPK uuid.UUID, SlackChannelIDPK string, cols ...string) (*IncidentNotification, error) {
+	if len(cols) == 0 {
+		return IncidentNotifications.Query(
+			SelectWhere.IncidentNotifications.IncidentID.EQ(IncidentIDPK),
+			SelectWhere.IncidentNotifications.SlackChannelID.EQ(SlackChannelIDPK),
+		).One(ctx, exec)
+	}
+
+	return IncidentNotifications.Query(
+		SelectWhere.IncidentNotifications.IncidentID.EQ(IncidentIDPK),
+		SelectWhere.IncidentNotifications.SlackChannelID.EQ(SlackChannelIDPK),
+		sm.Columns(IncidentNotifications.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// IncidentNotificationExists checks the presence of a single record by primary key
+func IncidentNotificationExists(ctx context.Context, exec bob.Executor, IncidentIDPK uuid.UUID, SlackChannelIDPK string) (bool, error) {
+	return IncidentNotifications.Query(
+		SelectWhere.IncidentNotifications.IncidentID.EQ(IncidentIDPK),
+		SelectWhere.IncidentNotifications.SlackChannelID.EQ(SlackChannelIDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after IncidentNotification is retrieved from the database
+func (o *IncidentNotification) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = IncidentNotifications.AfterSelectHooks.RunHooks(ctx, exec, IncidentNotificationSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = IncidentNotifications.AfterInsertHooks.RunHooks(ctx, exec, IncidentNotificationSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = IncidentNotifications.AfterUpdateHooks.RunHooks(ctx, exec, IncidentNotificationSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = IncidentNotifications.AfterDeleteHooks.RunHooks(ctx, exec, IncidentNotificationSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the IncidentNotification
+func (o *IncidentNotification) primaryKeyVals() bob.Expression {
+	return psql.ArgGroup(
+		o.IncidentID,
+		o.SlackChannelID,
+	)
+}
+
+func (o *IncidentNotification) pkEQ() dialect.Expression {
+	return psql.Group(psql.Quote("incident_notifications", "incident_id"), psql.Quote("incident_notifications", "slack_channel_id")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the IncidentNotification
+func (o *IncidentNotification) Update(ctx context.Context, exec bob.Executor, s *IncidentNotificationSetter) error {
+	v, err := IncidentNotifications.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single IncidentNotification record with an executor
+func (o *IncidentNotification) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := IncidentNotifications.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the IncidentNotification using the executor
+func (o *IncidentNotification) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := IncidentNotifications.Query(
+		SelectWhere.IncidentNotifications.IncidentID.EQ(o.IncidentID),
+		SelectWhere.IncidentNotifications.SlackChannelID.EQ(o.SlackChannelID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after IncidentNotificationSlice is retrieved from the database
+func (o IncidentNotificationSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = IncidentNotifications.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = IncidentNotifications.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = IncidentNotifications.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = IncidentNotifications.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o IncidentNotificationSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Group(psql.Quote("incident_notifications", "incident_id"), psql.Quote("incident_notifications", "slack_channel_id")).In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o IncidentNotificationSlice) copyMatchingRows(from ...*IncidentNotification) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.IncidentID != old.IncidentID {
+				continue
+			}
+			if new.SlackChannelID != old.SlackChannelID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o IncidentNotificationSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return IncidentNotifications.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *IncidentNotification:
+				o.copyMatchingRows(retrieved)
+			case []*IncidentNotification:
+				o.copyMatchingRows(retrieved...)
+			case IncidentNotificationSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a IncidentNotification or a slice of IncidentNotification
+				// then run the AfterUpdateHooks on the slice
+				_, err = IncidentNotifications.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o IncidentNotificationSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return IncidentNotifications.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *IncidentNotification:
+				o.copyMatchingRows(retrieved)
+			case []*IncidentNotification:
+				o.copyMatchingRows(retrieved...)
+			case IncidentNotificationSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a IncidentNotification or a slice of IncidentNotification
+				// then run the AfterDeleteHooks on the slice
+				_, err = IncidentNotifications.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o IncidentNotificationSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals IncidentNotificationSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := IncidentNotifications.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o IncidentNotificationSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := IncidentNotifications.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o IncidentNotificationSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := IncidentNotifications.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type incidentNotificationJoins[Q dialect.Joinable] struct {
+	typ      string
+	Incident modAs[Q, incidentColumns]
+}
+
+func (j incidentNotificationJoins[Q]) aliasedAs(alias string) incidentNotificationJoins[Q] {
+	return buildIncidentNotificationJoins[Q](buildIncidentNotificationColumns(alias), j.typ)
+}
+
+func buildIncidentNotificationJoins[Q dialect.Joinable](cols incidentNotificationColumns, typ string) incidentNotificationJoins[Q] {
+	return incidentNotificationJoins[Q]{
+		typ: typ,
+		Incident: modAs[Q, incidentColumns]{
+			c: IncidentColumns,
+			f: func(to incidentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Incidents.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.IncidentID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
+
+// Incident starts a query for related objects on incidents
+func (o *IncidentNotification) Incident(mods ...bob.Mod[*dialect.SelectQuery]) IncidentsQuery {
+	return Incidents.Query(append(mods,
+		sm.Where(IncidentColumns.ID.EQ(psql.Arg(o.IncidentID))),
+	)...)
+}
+
+func (os IncidentNotificationSlice) Incident(mods ...bob.Mod[*dialect.SelectQuery]) IncidentsQuery {
+	pkIncidentID := make(pgtypes.Array[uuid.UUID], len(os))
+	for i, o := range os {
+		pkIncidentID[i] = o.IncidentID
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkIncidentID), "uuid[]")),
+	))
+
+	return Incidents.Query(append(mods,
+		sm.Where(psql.Group(IncidentColumns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func (o *IncidentNotification) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Incident":
+		rel, ok := retrieved.(*Incident)
+		if !ok {
+			return fmt.Errorf("incidentNotification cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Incident = rel
+
+		if rel != nil {
+			rel.R.IncidentNotifications = IncidentNotificationSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("incidentNotification has no relationship %q", name)
+	}
+}
+
+type incidentNotificationPreloader struct {
+	Incident func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildIncidentNotificationPreloader() incidentNotificationPreloader {
+	return incidentNotificationPreloader{
+		Incident: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Incident, IncidentSlice](orm.Relationship{
+				Name: "Incident",
+				Sides: []orm.RelSide{
+					{
+						From: TableNames.IncidentNotifications,
+						To:   TableNames.Incidents,
+						FromColumns: []string{
+							ColumnNames.IncidentNotifications.IncidentID,
+						},
+						ToColumns: []string{
+							ColumnNames.Incidents.ID,
+						},
+					},
+				},
+			}, Incidents.Columns().Names(), opts...)
+		},
+	}
+}
+
+type incidentNotificationThenLoader[Q orm.Loadable] struct {
+	Incident func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildIncidentNotificationThenLoader[Q orm.Loadable]() incidentNotificationThenLoader[Q] {
+	type IncidentLoadInterface interface {
+		LoadIncident(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return incidentNotificationThenLoader[Q]{
+		Incident: thenLoadBuilder[Q](
+			"Incident",
+			func(ctx context.Context, exec bob.Executor, retrieved IncidentLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadIncident(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadIncident loads the incidentNotification's Incident into the .R struct
+func (o *IncidentNotification) LoadIncident(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Incident = nil
+
+	related, err := o.Incident(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.IncidentNotifications = IncidentNotificationSlice{o}
+
+	o.R.Incident = related
+	return nil
+}
+
+// LoadIncident loads the incidentNotification's Incident into the .R struct
+func (os IncidentNotificationSlice) LoadIncident(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	incidents, err := os.Incident(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range incidents {
+			if o.IncidentID != rel.ID {
+				continue
+			}
+
+			rel.R.IncidentNotifications = append(rel.R.IncidentNotifications, o)
+
+			o.R.Incident = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func attachIncidentNotificationIncident0(ctx context.Context, exec bob.Executor, count int, incidentNotification0 *IncidentNotification, incident1 *Incident) (*IncidentNotification, error) {
+	setter := &IncidentNotificationSetter{
+		IncidentID: &incident1.ID,
+	}
+
+	err := incidentNotification0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachIncidentNotificationIncident0: %w", err)
+	}
+
+	return incidentNotification0, nil
+}
+
+func (incidentNotification0 *IncidentNotification) InsertIncident(ctx context.Context, exec bob.Executor, related *IncidentSetter) error {
+	incident1, err := Incidents.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachIncidentNotificationIncident0(ctx, exec, 1, incidentNotification0, incident1)
+	if err != nil {
+		return err
+	}
+
+	incidentNotification0.R.Incident = incident1
+
+	incident1.R.IncidentNotifications = append(incident1.R.IncidentNotifications, incidentNotification0)
+
+	return nil
+}
+
+func (incidentNotification0 *IncidentNotification) AttachIncident(ctx context.Context, exec bob.Executor, incident1 *Incident) error {
+	var err error
+
+	_, err = attachIncidentNotificationIncident0(ctx, exec, 1, incidentNotification0, incident1)
+	if err != nil {
+		return err
+	}
+
+	incidentNotification0.R.Incident = incident1
+
+	incident1.R.IncidentNotifications = append(incident1.R.IncidentNotifications, incidentNotification0)
+
+	return nil
+}
@@ -0,0 +1,679 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// IncidentRole is an object representing the database table.
+type IncidentRole struct {
+	IncidentID  uuid.UUID `db:"incident_id,pk" `
+	Role        string    `db:"role,pk" `
+	SlackUserID string    `db:"slack_user_id" `
+	AssignedAt  time.Time `db:"assigned_at" `
+
+	R incidentRoleR `db:"-" `
+}
+
+// IncidentRoleSlice is an alias for a slice of pointers to IncidentRole.
+// This should almost always be used instead of []*IncidentRole.
+type IncidentRoleSlice []*IncidentRole
+
+// IncidentRoles contains methods to work with the incident_roles table
+var IncidentRoles = psql.NewTablex[*IncidentRole, IncidentRoleSlice, *IncidentRoleSetter]("", "incident_roles")
+
+// IncidentRolesQuery is a query on the incident_roles table
+type IncidentRolesQuery = *psql.ViewQuery[*IncidentRole, IncidentRoleSlice]
+
+// incidentRoleR is where relationships are stored.
+type incidentRoleR struct {
+	Incident *Incident // incident_roles.incident_roles_incident_id_fkey
+}
+
+type incidentRoleColumnNames struct {
+	IncidentID  string
+	Role        string
+	SlackUserID string
+	AssignedAt  string
+}
+
+var IncidentRoleColumns = buildIncidentRoleColumns("incident_roles")
+
+type incidentRoleColumns struct {
+	tableAlias  string
+	IncidentID  psql.Expression
+	Role        psql.Expression
+	SlackUserID psql.Expression
+	AssignedAt  psql.Expression
+}
+
+func (c incidentRoleColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (incidentRoleColumns) AliasedAs(alias string) incidentRoleColumns {
+	return buildIncidentRoleColumns(alias)
+}
+
+func buildIncidentRoleColumns(alias string) incidentRoleColumns {
+	return incidentRoleColumns{
+		tableAlias:  alias,
+		IncidentID:  psql.Quote(alias, "incident_id"),
+		Role:        psql.Quote(alias, "role"),
+		SlackUserID: psql.Quote(alias, "slack_user_id"),
+		AssignedAt:  psql.Quote(alias, "assigned_at"),
+	}
+}
+
+type incidentRoleWhere[Q psql.Filterable] struct {
+	IncidentID  psql.WhereMod[Q, uuid.UUID]
+	Role        psql.WhereMod[Q, string]
+	SlackUserID psql.WhereMod[Q, string]
+	AssignedAt  psql.WhereMod[Q, time.Time]
+}
+
+func (incidentRoleWhere[Q]) AliasedAs(alias string) incidentRoleWhere[Q] {
+	return buildIncidentRoleWhere[Q](buildIncidentRoleColumns(alias))
+}
+
+func buildIncidentRoleWhere[Q psql.Filterable](cols incidentRoleColumns) incidentRoleWhere[Q] {
+	return incidentRoleWhere[Q]{
+		IncidentID:  psql.Where[Q, uuid.UUID](cols.IncidentID),
+		Role:        psql.Where[Q, string](cols.Role),
+		SlackUserID: psql.Where[Q, string](cols.SlackUserID),
+		AssignedAt:  psql.Where[Q, time.Time](cols.AssignedAt),
+	}
+}
+
+var IncidentRoleErrors = &incidentRoleErrors{
+	ErrUniqueIncidentRolesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "incident_roles",
+		columns: []string{"incident_id", "role"},
+		s:       "incident_roles_pkey",
+	},
+}
+
+type incidentRoleErrors struct {
+	ErrUniqueIncidentRolesPkey *UniqueConstraintError
+}
+
+// IncidentRoleSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type IncidentRoleSetter struct {
+	IncidentID  *uuid.UUID `db:"incident_id,pk" `
+	Role        *string    `db:"role,pk" `
+	SlackUserID *string    `db:"slack_user_id" `
+	AssignedAt  *time.Time `db:"assigned_at" `
+}
+
+func (s IncidentRoleSetter) SetColumns() []string {
+	vals := make([]string, 0, 4)
+	if s.IncidentID != nil {
+		vals = append(vals, "incident_id")
+	}
+
+	if s.Role != nil {
+		vals = append(vals, "role")
+	}
+
+	if s.SlackUserID != nil {
+		vals = append(vals, "slack_user_id")
+	}
+
+	if s.AssignedAt != nil {
+		vals = append(vals, "assigned_at")
+	}
+
+	return vals
+}
+
+func (s IncidentRoleSetter) Overwrite(t *IncidentRole) {
+	if s.IncidentID != nil {
+		t.IncidentID = *s.IncidentID
+	}
+	if s.Role != nil {
+		t.Role = *s.Role
+	}
+	if s.SlackUserID != nil {
+		t.SlackUserID = *s.SlackUserID
+	}
+	if s.AssignedAt != nil {
+		t.AssignedAt = *s.AssignedAt
+	}
+}
+
+func (s *IncidentRoleSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return IncidentRoles.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 4)
+		if s.IncidentID != nil {
+			vals[0] = psql.Arg(*s.IncidentID)
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.Role != nil {
+			vals[1] = psql.Arg(*s.Role)
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.SlackUserID != nil {
+			vals[2] = psql.Arg(*s.SlackUserID)
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.AssignedAt != nil {
+			vals[3] = psql.Arg(*s.AssignedAt)
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s IncidentRoleSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s IncidentRoleSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 4)
+
+	if s.IncidentID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "incident_id")...),
+			psql.Arg(s.IncidentID),
+		}})
+	}
+
+	if s.Role != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "role")...),
+			psql.Arg(s.Role),
+		}})
+	}
+
+	if s.SlackUserID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "slack_user_id")...),
+			psql.Arg(s.SlackUserID),
+		}})
+	}
+
+	if s.AssignedAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "assigned_at")...),
+			psql.Arg(s.AssignedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindIncidentRole retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindIncidentRole(ctx context.Context, exec bob.Executor, IncidentIDPK uuid.UUID, RolePK string, cols ...string) (*IncidentRole, error) {
+	if len(cols) == 0 {
+		return IncidentRoles.Query(
+			SelectWhere.IncidentRoles.IncidentID.EQ(IncidentIDPK),
+			SelectWhere.IncidentRoles.Role.EQ(RolePK),
+		).One(ctx, exec)
+	}
+
+	return IncidentRoles.Query(
+		SelectWhere.IncidentRoles.IncidentID.EQ(IncidentIDPK),
+		SelectWhere.IncidentRoles.Role.EQ(RolePK),
+		sm.Columns(IncidentRoles.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// IncidentRoleExists checks the presence of a single record by primary key
+func IncidentRoleExists(ctx context.Context, exec bob.Executor, IncidentIDPK uuid.UUID, RolePK string) (bool, error) {
+	return IncidentRoles.Query(
+		SelectWhere.IncidentRoles.IncidentID.EQ(IncidentIDPK),
+		SelectWhere.IncidentRoles.Role.EQ(RolePK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after IncidentRole is retrieved from the database
+func (o *IncidentRole) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = IncidentRoles.AfterSelectHooks.RunHooks(ctx, exec, IncidentRoleSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = IncidentRoles.AfterInsertHooks.RunHooks(ctx, exec, IncidentRoleSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = IncidentRoles.AfterUpdateHooks.RunHooks(ctx, exec, IncidentRoleSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = IncidentRoles.AfterDeleteHooks.RunHooks(ctx, exec, IncidentRoleSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the IncidentRole
+func (o *IncidentRole) primaryKeyVals() bob.Expression {
+	return psql.ArgGroup(
+		o.IncidentID,
+		o.Role,
+	)
+}
+
+func (o *IncidentRole) pkEQ() dialect.Expression {
+	return psql.Group(psql.Quote("incident_roles", "incident_id"), psql.Quote("incident_roles", "role")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the IncidentRole
+func (o *IncidentRole) Update(ctx context.Context, exec bob.Executor, s *IncidentRoleSetter) error {
+	v, err := IncidentRoles.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single IncidentRole record with an executor
+func (o *IncidentRole) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := IncidentRoles.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the IncidentRole using the executor
+func (o *IncidentRole) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := IncidentRoles.Query(
+		SelectWhere.IncidentRoles.IncidentID.EQ(o.IncidentID),
+		SelectWhere.IncidentRoles.Role.EQ(o.Role),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after IncidentRoleSlice is retrieved from the database
+func (o IncidentRoleSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = IncidentRoles.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = IncidentRoles.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = IncidentRoles.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = IncidentRoles.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o IncidentRoleSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Group(psql.Quote("incident_roles", "incident_id"), psql.Quote("incident_roles", "role")).In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o IncidentRoleSlice) copyMatchingRows(from ...*IncidentRole) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.IncidentID != old.IncidentID {
+				continue
+			}
+			if new.Role != old.Role {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o IncidentRoleSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return IncidentRoles.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *IncidentRole:
+				o.copyMatchingRows(retrieved)
+			case []*IncidentRole:
+				o.copyMatchingRows(retrieved...)
+			case IncidentRoleSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a IncidentRole or a slice of IncidentRole
+				// then run the AfterUpdateHooks on the slice
+				_, err = IncidentRoles.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o IncidentRoleSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return IncidentRoles.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *IncidentRole:
+				o.copyMatchingRows(retrieved)
+			case []*IncidentRole:
+				o.copyMatchingRows(retrieved...)
+			case IncidentRoleSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a IncidentRole or a slice of IncidentRole
+				// then run the AfterDeleteHooks on the slice
+				_, err = IncidentRoles.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o IncidentRoleSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals IncidentRoleSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := IncidentRoles.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o IncidentRoleSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := IncidentRoles.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o IncidentRoleSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := IncidentRoles.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type incidentRoleJoins[Q dialect.Joinable] struct {
+	typ      string
+	Incident modAs[Q, incidentColumns]
+}
+
+func (j incidentRoleJoins[Q]) aliasedAs(alias string) incidentRoleJoins[Q] {
+	return buildIncidentRoleJoins[Q](buildIncidentRoleColumns(alias), j.typ)
+}
+
+func buildIncidentRoleJoins[Q dialect.Joinable](cols incidentRoleColumns, typ string) incidentRoleJoins[Q] {
+	return incidentRoleJoins[Q]{
+		typ: typ,
+		Incident: modAs[Q, incidentColumns]{
+			c: IncidentColumns,
+			f: func(to incidentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Incidents.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.IncidentID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
+
+// Incident starts a query for related objects on incidents
+func (o *IncidentRole) Incident(mods ...bob.Mod[*dialect.SelectQuery]) IncidentsQuery {
+	return Incidents.Query(append(mods,
+		sm.Where(IncidentColumns.ID.EQ(psql.Arg(o.IncidentID))),
+	)...)
+}
+
+func (os IncidentRoleSlice) Incident(mods ...bob.Mod[*dialect.SelectQuery]) IncidentsQuery {
+	pkIncidentID := make(pgtypes.Array[uuid.UUID], len(os))
+	for i, o := range os {
+		pkIncidentID[i] = o.IncidentID
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkIncidentID), "uuid[]")),
+	))
+
+	return Incidents.Query(append(mods,
+		sm.Where(psql.Group(IncidentColumns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func (o *IncidentRole) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Incident":
+		rel, ok := retrieved.(*Incident)
+		if !ok {
+			return fmt.Errorf("incidentRole cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Incident = rel
+
+		if rel != nil {
+			rel.R.IncidentRoles = IncidentRoleSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("incidentRole has no relationship %q", name)
+	}
+}
+
+type incidentRolePreloader struct {
+	Incident func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildIncidentRolePreloader() incidentRolePreloader {
+	return incidentRolePreloader{
+		Incident: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Incident, IncidentSlice](orm.Relationship{
+				Name: "Incident",
+				Sides: []orm.RelSide{
+					{
+						From: TableNames.IncidentRoles,
+						To:   TableNames.Incidents,
+						FromColumns: []string{
+							ColumnNames.IncidentRoles.IncidentID,
+						},
+						ToColumns: []string{
+							ColumnNames.Incidents.ID,
+						},
+					},
+				},
+			}, Incidents.Columns().Names(), opts...)
+		},
+	}
+}
+
+type incidentRoleThenLoader[Q orm.Loadable] struct {
+	Incident func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildIncidentRoleThenLoader[Q orm.Loadable]() incidentRoleThenLoader[Q] {
+	type IncidentLoadInterface interface {
+		LoadIncident(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return incidentRoleThenLoader[Q]{
+		Incident: thenLoadBuilder[Q](
+			"Incident",
+			func(ctx context.Context, exec bob.Executor, retrieved IncidentLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadIncident(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadIncident loads the incidentRole's Incident into the .R struct
+func (o *IncidentRole) LoadIncident(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Incident = nil
+
+	related, err := o.Incident(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.IncidentRoles = IncidentRoleSlice{o}
+
+	o.R.Incident = related
+	return nil
+}
+
+// LoadIncident loads the incidentRole's Incident into the .R struct
+func (os IncidentRoleSlice) LoadIncident(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	incidents, err := os.Incident(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range incidents {
+			if o.IncidentID != rel.ID {
+				continue
+			}
+
+			rel.R.IncidentRoles = append(rel.R.IncidentRoles, o)
+
+			o.R.Incident = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func attachIncidentRoleIncident0(ctx context.Context, exec bob.Executor, count int, incidentRole0 *IncidentRole, incident1 *Incident) (*IncidentRole, error) {
+	setter := &IncidentRoleSetter{
+		IncidentID: &incident1.ID,
+	}
+
+	err := incidentRole0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachIncidentRoleIncident0: %w", err)
+	}
+
+	return incidentRole0, nil
+}
+
+func (incidentRole0 *IncidentRole) InsertIncident(ctx context.Context, exec bob.Executor, related *IncidentSetter) error {
+	incident1, err := Incidents.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachIncidentRoleIncident0(ctx, exec, 1, incidentRole0, incident1)
+	if err != nil {
+		return err
+	}
+
+	incidentRole0.R.Incident = incident1
+
+	incident1.R.IncidentRoles = append(incident1.R.IncidentRoles, incidentRole0)
+
+	return nil
+}
+
+func (incidentRole0 *IncidentRole) AttachIncident(ctx context.Context, exec bob.Executor, incident1 *Incident) error {
+	var err error
+
+	_, err = attachIncidentRoleIncident0(ctx, exec, 1, incidentRole0, incident1)
+	if err != nil {
+		return err
+	}
+
+	incidentRole0.R.Incident = incident1
+
+	incident1.R.IncidentRoles = append(incident1.R.IncidentRoles, incidentRole0)
+
+	return nil
+}
@@ -0,0 +1,680 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// IncidentService is an object representing the database table.
+type IncidentService struct {
+	IncidentID uuid.UUID        `db:"incident_id,pk" `
+	Service    string           `db:"service,pk" `
+	AddedBy    sql.Null[string] `db:"added_by" `
+	AddedAt    time.Time        `db:"added_at" `
+
+	R incidentServiceR `db:"-" `
+}
+
+// IncidentServiceSlice is an alias for a slice of pointers to IncidentService.
+// This should almost always be used instead of []*IncidentService.
+type IncidentServiceSlice []*IncidentService
+
+// IncidentServices contains methods to work with the incident_services table
+var IncidentServices = psql.NewTablex[*IncidentService, IncidentServiceSlice, *IncidentServiceSetter]("", "incident_services")
+
+// IncidentServicesQuery is a query on the incident_services table
+type IncidentServicesQuery = *psql.ViewQuery[*IncidentService, IncidentServiceSlice]
+
+// incidentServiceR is where relationships are stored.
+type incidentServiceR struct {
+	Incident *Incident // incident_services.incident_services_incident_id_fkey
+}
+
+type incidentServiceColumnNames struct {
+	IncidentID string
+	Service    string
+	AddedBy    string
+	AddedAt    string
+}
+
+var IncidentServiceColumns = buildIncidentServiceColumns("incident_services")
+
+type incidentServiceColumns struct {
+	tableAlias string
+	IncidentID psql.Expression
+	Service    psql.Expression
+	AddedBy    psql.Expression
+	AddedAt    psql.Expression
+}
+
+func (c incidentServiceColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (incidentServiceColumns) AliasedAs(alias string) incidentServiceColumns {
+	return buildIncidentServiceColumns(alias)
+}
+
+func buildIncidentServiceColumns(alias string) incidentServiceColumns {
+	return incidentServiceColumns{
+		tableAlias: alias,
+		IncidentID: psql.Quote(alias, "incident_id"),
+		Service:    psql.Quote(alias, "service"),
+		AddedBy:    psql.Quote(alias, "added_by"),
+		AddedAt:    psql.Quote(alias, "added_at"),
+	}
+}
+
+type incidentServiceWhere[Q psql.Filterable] struct {
+	IncidentID psql.WhereMod[Q, uuid.UUID]
+	Service    psql.WhereMod[Q, string]
+	AddedBy    psql.WhereNullMod[Q, string]
+	AddedAt    psql.WhereMod[Q, time.Time]
+}
+
+func (incidentServiceWhere[Q]) AliasedAs(alias string) incidentServiceWhere[Q] {
+	return buildIncidentServiceWhere[Q](buildIncidentServiceColumns(alias))
+}
+
+func buildIncidentServiceWhere[Q psql.Filterable](cols incidentServiceColumns) incidentServiceWhere[Q] {
+	return incidentServiceWhere[Q]{
+		IncidentID: psql.Where[Q, uuid.UUID](cols.IncidentID),
+		Service:    psql.Where[Q, string](cols.Service),
+		AddedBy:    psql.WhereNull[Q, string](cols.AddedBy),
+		AddedAt:    psql.Where[Q, time.Time](cols.AddedAt),
+	}
+}
+
+var IncidentServiceErrors = &incidentServiceErrors{
+	ErrUniqueIncidentServicesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "incident_services",
+		columns: []string{"incident_id", "service"},
+		s:       "incident_services_pkey",
+	},
+}
+
+type incidentServiceErrors struct {
+	ErrUniqueIncidentServicesPkey *UniqueConstraintError
+}
+
+// IncidentServiceSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type IncidentServiceSetter struct {
+	IncidentID *uuid.UUID        `db:"incident_id,pk" `
+	Service    *string           `db:"service,pk" `
+	AddedBy    *sql.Null[string] `db:"added_by" `
+	AddedAt    *time.Time        `db:"added_at" `
+}
+
+func (s IncidentServiceSetter) SetColumns() []string {
+	vals := make([]string, 0, 4)
+	if s.IncidentID != nil {
+		vals = append(vals, "incident_id")
+	}
+
+	if s.Service != nil {
+		vals = append(vals, "service")
+	}
+
+	if s.AddedBy != nil {
+		vals = append(vals, "added_by")
+	}
+
+	if s.AddedAt != nil {
+		vals = append(vals, "added_at")
+	}
+
+	return vals
+}
+
+func (s IncidentServiceSetter) Overwrite(t *IncidentService) {
+	if s.IncidentID != nil {
+		t.IncidentID = *s.IncidentID
+	}
+	if s.Service != nil {
+		t.Service = *s.Service
+	}
+	if s.AddedBy != nil {
+		t.AddedBy = *s.AddedBy
+	}
+	if s.AddedAt != nil {
+		t.AddedAt = *s.AddedAt
+	}
+}
+
+func (s *IncidentServiceSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return IncidentServices.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 4)
+		if s.IncidentID != nil {
+			vals[0] = psql.Arg(*s.IncidentID)
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.Service != nil {
+			vals[1] = psql.Arg(*s.Service)
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.AddedBy != nil {
+			vals[2] = psql.Arg(*s.AddedBy)
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.AddedAt != nil {
+			vals[3] = psql.Arg(*s.AddedAt)
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s IncidentServiceSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s IncidentServiceSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 4)
+
+	if s.IncidentID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "incident_id")...),
+			psql.Arg(s.IncidentID),
+		}})
+	}
+
+	if s.Service != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "service")...),
+			psql.Arg(s.Service),
+		}})
+	}
+
+	if s.AddedBy != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "added_by")...),
+			psql.Arg(s.AddedBy),
+		}})
+	}
+
+	if s.AddedAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "added_at")...),
+			psql.Arg(s.AddedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindIncidentService retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindIncidentService(ctx context.Context, exec bob.Executor, IncidentIDPK uuid.UUID, ServicePK string, cols ...string) (*IncidentService, error) {
+	if len(cols) == 0 {
+		return IncidentServices.Query(
+			SelectWhere.IncidentServices.IncidentID.EQ(IncidentIDPK),
+			SelectWhere.IncidentServices.Service.EQ(ServicePK),
+		).One(ctx, exec)
+	}
+
+	return IncidentServices.Query(
+		SelectWhere.IncidentServices.IncidentID.EQ(IncidentIDPK),
+		SelectWhere.IncidentServices.Service.EQ(ServicePK),
+		sm.Columns(IncidentServices.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// IncidentServiceExists checks the presence of a single record by primary key
+func IncidentServiceExists(ctx context.Context, exec bob.Executor, IncidentIDPK uuid.UUID, ServicePK string) (bool, error) {
+	return IncidentServices.Query(
+		SelectWhere.IncidentServices.IncidentID.EQ(IncidentIDPK),
+		SelectWhere.IncidentServices.Service.EQ(ServicePK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after IncidentService is retrieved from the database
+func (o *IncidentService) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = IncidentServices.AfterSelectHooks.RunHooks(ctx, exec, IncidentServiceSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = IncidentServices.AfterInsertHooks.RunHooks(ctx, exec, IncidentServiceSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = IncidentServices.AfterUpdateHooks.RunHooks(ctx, exec, IncidentServiceSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = IncidentServices.AfterDeleteHooks.RunHooks(ctx, exec, IncidentServiceSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the IncidentService
+func (o *IncidentService) primaryKeyVals() bob.Expression {
+	return psql.ArgGroup(
+		o.IncidentID,
+		o.Service,
+	)
+}
+
+func (o *IncidentService) pkEQ() dialect.Expression {
+	return psql.Group(psql.Quote("incident_services", "incident_id"), psql.Quote("incident_services", "service")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the IncidentService
+func (o *IncidentService) Update(ctx context.Context, exec bob.Executor, s *IncidentServiceSetter) error {
+	v, err := IncidentServices.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single IncidentService record with an executor
+func (o *IncidentService) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := IncidentServices.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the IncidentService using the executor
+func (o *IncidentService) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := IncidentServices.Query(
+		SelectWhere.IncidentServices.IncidentID.EQ(o.IncidentID),
+		SelectWhere.IncidentServices.Service.EQ(o.Service),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after IncidentServiceSlice is retrieved from the database
+func (o IncidentServiceSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = IncidentServices.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = IncidentServices.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = IncidentServices.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = IncidentServices.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o IncidentServiceSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Group(psql.Quote("incident_services", "incident_id"), psql.Quote("incident_services", "service")).In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o IncidentServiceSlice) copyMatchingRows(from ...*IncidentService) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.IncidentID != old.IncidentID {
+				continue
+			}
+			if new.Service != old.Service {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o IncidentServiceSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return IncidentServices.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *IncidentService:
+				o.copyMatchingRows(retrieved)
+			case []*IncidentService:
+				o.copyMatchingRows(retrieved...)
+			case IncidentServiceSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a IncidentService or a slice of IncidentService
+				// then run the AfterUpdateHooks on the slice
+				_, err = IncidentServices.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o IncidentServiceSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return IncidentServices.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *IncidentService:
+				o.copyMatchingRows(retrieved)
+			case []*IncidentService:
+				o.copyMatchingRows(retrieved...)
+			case IncidentServiceSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a IncidentService or a slice of IncidentService
+				// then run the AfterDeleteHooks on the slice
+				_, err = IncidentServices.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o IncidentServiceSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals IncidentServiceSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := IncidentServices.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o IncidentServiceSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := IncidentServices.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o IncidentServiceSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := IncidentServices.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type incidentServiceJoins[Q dialect.Joinable] struct {
+	typ      string
+	Incident modAs[Q, incidentColumns]
+}
+
+func (j incidentServiceJoins[Q]) aliasedAs(alias string) incidentServiceJoins[Q] {
+	return buildIncidentServiceJoins[Q](buildIncidentServiceColumns(alias), j.typ)
+}
+
+func buildIncidentServiceJoins[Q dialect.Joinable](cols incidentServiceColumns, typ string) incidentServiceJoins[Q] {
+	return incidentServiceJoins[Q]{
+		typ: typ,
+		Incident: modAs[Q, incidentColumns]{
+			c: IncidentColumns,
+			f: func(to incidentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Incidents.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.IncidentID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
+
+// Incident starts a query for related objects on incidents
+func (o *IncidentService) Incident(mods ...bob.Mod[*dialect.SelectQuery]) IncidentsQuery {
+	return Incidents.Query(append(mods,
+		sm.Where(IncidentColumns.ID.EQ(psql.Arg(o.IncidentID))),
+	)...)
+}
+
+func (os IncidentServiceSlice) Incident(mods ...bob.Mod[*dialect.SelectQuery]) IncidentsQuery {
+	pkIncidentID := make(pgtypes.Array[uuid.UUID], len(os))
+	for i, o := range os {
+		pkIncidentID[i] = o.IncidentID
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkIncidentID), "uuid[]")),
+	))
+
+	return Incidents.Query(append(mods,
+		sm.Where(psql.Group(IncidentColumns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func (o *IncidentService) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Incident":
+		rel, ok := retrieved.(*Incident)
+		if !ok {
+			return fmt.Errorf("incidentService cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Incident = rel
+
+		if rel != nil {
+			rel.R.IncidentServices = IncidentServiceSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("incidentService has no relationship %q", name)
+	}
+}
+
+type incidentServicePreloader struct {
+	Incident func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildIncidentServicePreloader() incidentServicePreloader {
+	return incidentServicePreloader{
+		Incident: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Incident, IncidentSlice](orm.Relationship{
+				Name: "Incident",
+				Sides: []orm.RelSide{
+					{
+						From: TableNames.IncidentServices,
+						To:   TableNames.Incidents,
+						FromColumns: []string{
+							ColumnNames.IncidentServices.IncidentID,
+						},
+						ToColumns: []string{
+							ColumnNames.Incidents.ID,
+						},
+					},
+				},
+			}, Incidents.Columns().Names(), opts...)
+		},
+	}
+}
+
+type incidentServiceThenLoader[Q orm.Loadable] struct {
+	Incident func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildIncidentServiceThenLoader[Q orm.Loadable]() incidentServiceThenLoader[Q] {
+	type IncidentLoadInterface interface {
+		LoadIncident(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return incidentServiceThenLoader[Q]{
+		Incident: thenLoadBuilder[Q](
+			"Incident",
+			func(ctx context.Context, exec bob.Executor, retrieved IncidentLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadIncident(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadIncident loads the incidentService's Incident into the .R struct
+func (o *IncidentService) LoadIncident(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Incident = nil
+
+	related, err := o.Incident(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.IncidentServices = IncidentServiceSlice{o}
+
+	o.R.Incident = related
+	return nil
+}
+
+// LoadIncident loads the incidentService's Incident into the .R struct
+func (os IncidentServiceSlice) LoadIncident(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	incidents, err := os.Incident(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range incidents {
+			if o.IncidentID != rel.ID {
+				continue
+			}
+
+			rel.R.IncidentServices = append(rel.R.IncidentServices, o)
+
+			o.R.Incident = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func attachIncidentServiceIncident0(ctx context.Context, exec bob.Executor, count int, incidentService0 *IncidentService, incident1 *Incident) (*IncidentService, error) {
+	setter := &IncidentServiceSetter{
+		IncidentID: &incident1.ID,
+	}
+
+	err := incidentService0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachIncidentServiceIncident0: %w", err)
+	}
+
+	return incidentService0, nil
+}
+
+func (incidentService0 *IncidentService) InsertIncident(ctx context.Context, exec bob.Executor, related *IncidentSetter) error {
+	incident1, err := Incidents.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachIncidentServiceIncident0(ctx, exec, 1, incidentService0, incident1)
+	if err != nil {
+		return err
+	}
+
+	incidentService0.R.Incident = incident1
+
+	incident1.R.IncidentServices = append(incident1.R.IncidentServices, incidentService0)
+
+	return nil
+}
+
+func (incidentService0 *IncidentService) AttachIncident(ctx context.Context, exec bob.Executor, incident1 *Incident) error {
+	var err error
+
+	_, err = attachIncidentServiceIncident0(ctx, exec, 1, incidentService0, incident1)
+	if err != nil {
+		return err
+	}
+
+	incidentService0.R.Incident = incident1
+
+	incident1.R.IncidentServices = append(incident1.R.IncidentServices, incidentService0)
+
+	return nil
+}
@@ -0,0 +1,652 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// IncidentSubscriber is an object representing the database table.
+type IncidentSubscriber struct {
+	IncidentID   uuid.UUID `db:"incident_id,pk" `
+	SlackUserID  string    `db:"slack_user_id,pk" `
+	SubscribedAt time.Time `db:"subscribed_at" `
+
+	R incidentSubscriberR `db:"-" `
+}
+
+// IncidentSubscriberSlice is an alias for a slice of pointers to IncidentSubscriber.
+// This should almost always be used instead of []*IncidentSubscriber.
+type IncidentSubscriberSlice []*IncidentSubscriber
+
+// IncidentSubscribers contains methods to work with the incident_subscribers table
+var IncidentSubscribers = psql.NewTablex[*IncidentSubscriber, IncidentSubscriberSlice, *IncidentSubscriberSetter]("", "incident_subscribers")
+
+// IncidentSubscribersQuery is a query on the incident_subscribers table
+type IncidentSubscribersQuery = *psql.ViewQuery[*IncidentSubscriber, IncidentSubscriberSlice]
+
+// incidentSubscriberR is where relationships are stored.
+type incidentSubscriberR struct {
+	Incident *Incident // incident_subscribers.incident_subscribers_incident_id_fkey
+}
+
+type incidentSubscriberColumnNames struct {
+	IncidentID   string
+	SlackUserID  string
+	SubscribedAt string
+}
+
+var IncidentSubscriberColumns = buildIncidentSubscriberColumns("incident_subscribers")
+
+type incidentSubscriberColumns struct {
+	tableAlias   string
+	IncidentID   psql.Expression
+	SlackUserID  psql.Expression
+	SubscribedAt psql.Expression
+}
+
+func (c incidentSubscriberColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (incidentSubscriberColumns) AliasedAs(alias string) incidentSubscriberColumns {
+	return buildIncidentSubscriberColumns(alias)
+}
+
+func buildIncidentSubscriberColumns(alias string) incidentSubscriberColumns {
+	return incidentSubscriberColumns{
+		tableAlias:   alias,
+		IncidentID:   psql.Quote(alias, "incident_id"),
+		SlackUserID:  psql.Quote(alias, "slack_user_id"),
+		SubscribedAt: psql.Quote(alias, "subscribed_at"),
+	}
+}
+
+type incidentSubscriberWhere[Q psql.Filterable] struct {
+	IncidentID   psql.WhereMod[Q, uuid.UUID]
+	SlackUserID  psql.WhereMod[Q, string]
+	SubscribedAt psql.WhereMod[Q, time.Time]
+}
+
+func (incidentSubscriberWhere[Q]) AliasedAs(alias string) incidentSubscriberWhere[Q] {
+	return buildIncidentSubscriberWhere[Q](buildIncidentSubscriberColumns(alias))
+}
+
+func buildIncidentSubscriberWhere[Q psql.Filterable](cols incidentSubscriberColumns) incidentSubscriberWhere[Q] {
+	return incidentSubscriberWhere[Q]{
+		IncidentID:   psql.Where[Q, uuid.UUID](cols.IncidentID),
+		SlackUserID:  psql.Where[Q, string](cols.SlackUserID),
+		SubscribedAt: psql.Where[Q, time.Time](cols.SubscribedAt),
+	}
+}
+
+var IncidentSubscriberErrors = &incidentSubscriberErrors{
+	ErrUniqueIncidentSubscribersPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "incident_subscribers",
+		columns: []string{"incident_id", "slack_user_id"},
+		s:       "incident_subscribers_pkey",
+	},
+}
+
+type incidentSubscriberErrors struct {
+	ErrUniqueIncidentSubscribersPkey *UniqueConstraintError
+}
+
+// IncidentSubscriberSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type IncidentSubscriberSetter struct {
+	IncidentID   *uuid.UUID `db:"incident_id,pk" `
+	SlackUserID  *string    `db:"slack_user_id,pk" `
+	SubscribedAt *time.Time `db:"subscribed_at" `
+}
+
+func (s IncidentSubscriberSetter) SetColumns() []string {
+	vals := make([]string, 0, 3)
+	if s.IncidentID != nil {
+		vals = append(vals, "incident_id")
+	}
+
+	if s.SlackUserID != nil {
+		vals = append(vals, "slack_user_id")
+	}
+
+	if s.SubscribedAt != nil {
+		vals = append(vals, "subscribed_at")
+	}
+
+	return vals
+}
+
+func (s IncidentSubscriberSetter) Overwrite(t *IncidentSubscriber) {
+	if s.IncidentID != nil {
+		t.IncidentID = *s.IncidentID
+	}
+	if s.SlackUserID != nil {
+		t.SlackUserID = *s.SlackUserID
+	}
+	if s.SubscribedAt != nil {
+		t.SubscribedAt = *s.SubscribedAt
+	}
+}
+
+func (s *IncidentSubscriberSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return IncidentSubscribers.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 3)
+		if s.IncidentID != nil {
+			vals[0] = psql.Arg(*s.IncidentID)
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.SlackUserID != nil {
+			vals[1] = psql.Arg(*s.SlackUserID)
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.SubscribedAt != nil {
+			vals[2] = psql.Arg(*s.SubscribedAt)
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s IncidentSubscriberSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s IncidentSubscriberSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 3)
+
+	if s.IncidentID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "incident_id")...),
+			psql.Arg(s.IncidentID),
+		}})
+	}
+
+	if s.SlackUserID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "slack_user_id")...),
+			psql.Arg(s.SlackUserID),
+		}})
+	}
+
+	if s.SubscribedAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "subscribed_at")...),
+			psql.Arg(s.SubscribedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindIncidentSubscriber retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindIncidentSubscriber(ctx context.Context, exec bob.Executor, IncidentIDPK uuid.UUID, SlackUserIDPK string, cols ...string) (*IncidentSubscriber, error) {
+	if len(cols) == 0 {
+		return IncidentSubscribers.Query(
+			SelectWhere.IncidentSubscribers.IncidentID.EQ(IncidentIDPK),
+			SelectWhere.IncidentSubscribers.SlackUserID.EQ(SlackUserIDPK),
+		).One(ctx, exec)
+	}
+
+	return IncidentSubscribers.Query(
+		SelectWhere.IncidentSubscribers.IncidentID.EQ(IncidentIDPK),
+		SelectWhere.IncidentSubscribers.SlackUserID.EQ(SlackUserIDPK),
+		sm.Columns(IncidentSubscribers.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// IncidentSubscriberExists checks the presence of a single record by primary key
+func IncidentSubscriberExists(ctx context.Context, exec bob.Executor, IncidentIDPK uuid.UUID, SlackUserIDPK string) (bool, error) {
+	return IncidentSubscribers.Query(
+		SelectWhere.IncidentSubscribers.IncidentID.EQ(IncidentIDPK),
+		SelectWhere.IncidentSubscribers.SlackUserID.EQ(SlackUserIDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after IncidentSubscriber is retrieved from the database
+func (o *IncidentSubscriber) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = IncidentSubscribers.AfterSelectHooks.RunHooks(ctx, exec, IncidentSubscriberSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = IncidentSubscribers.AfterInsertHooks.RunHooks(ctx, exec, IncidentSubscriberSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = IncidentSubscribers.AfterUpdateHooks.RunHooks(ctx, exec, IncidentSubscriberSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = IncidentSubscribers.AfterDeleteHooks.RunHooks(ctx, exec, IncidentSubscriberSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the IncidentSubscriber
+func (o *IncidentSubscriber) primaryKeyVals() bob.Expression {
+	return psql.ArgGroup(
+		o.IncidentID,
+		o.SlackUserID,
+	)
+}
+
+func (o *IncidentSubscriber) pkEQ() dialect.Expression {
+	return psql.Group(psql.Quote("incident_subscribers", "incident_id"), psql.Quote("incident_subscribers", "slack_user_id")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the IncidentSubscriber
+func (o *IncidentSubscriber) Update(ctx context.Context, exec bob.Executor, s *IncidentSubscriberSetter) error {
+	v, err := IncidentSubscribers.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single IncidentSubscriber record with an executor
+func (o *IncidentSubscriber) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := IncidentSubscribers.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the IncidentSubscriber using the executor
+func (o *IncidentSubscriber) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := IncidentSubscribers.Query(
+		SelectWhere.IncidentSubscribers.IncidentID.EQ(o.IncidentID),
+		SelectWhere.IncidentSubscribers.SlackUserID.EQ(o.SlackUserID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after IncidentSubscriberSlice is retrieved from the database
+func (o IncidentSubscriberSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = IncidentSubscribers.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = IncidentSubscribers.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = IncidentSubscribers.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = IncidentSubscribers.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o IncidentSubscriberSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Group(psql.Quote("incident_subscribers", "incident_id"), psql.Quote("incident_subscribers", "slack_user_id")).In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o IncidentSubscriberSlice) copyMatchingRows(from ...*IncidentSubscriber) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.IncidentID != old.IncidentID {
+				continue
+			}
+			if new.SlackUserID != old.SlackUserID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o IncidentSubscriberSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return IncidentSubscribers.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *IncidentSubscriber:
+				o.copyMatchingRows(retrieved)
+			case []*IncidentSubscriber:
+				o.copyMatchingRows(retrieved...)
+			case IncidentSubscriberSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a IncidentSubscriber or a slice of IncidentSubscriber
+				// then run the AfterUpdateHooks on the slice
+				_, err = IncidentSubscribers.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o IncidentSubscriberSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return IncidentSubscribers.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *IncidentSubscriber:
+				o.copyMatchingRows(retrieved)
+			case []*IncidentSubscriber:
+				o.copyMatchingRows(retrieved...)
+			case IncidentSubscriberSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a IncidentSubscriber or a slice of IncidentSubscriber
+				// then run the AfterDeleteHooks on the slice
+				_, err = IncidentSubscribers.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o IncidentSubscriberSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals IncidentSubscriberSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := IncidentSubscribers.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o IncidentSubscriberSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := IncidentSubscribers.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o IncidentSubscriberSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := IncidentSubscribers.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type incidentSubscriberJoins[Q dialect.Joinable] struct {
+	typ      string
+	Incident modAs[Q, incidentColumns]
+}
+
+func (j incidentSubscriberJoins[Q]) aliasedAs(alias string) incidentSubscriberJoins[Q] {
+	return buildIncidentSubscriberJoins[Q](buildIncidentSubscriberColumns(alias), j.typ)
+}
+
+func buildIncidentSubscriberJoins[Q dialect.Joinable](cols incidentSubscriberColumns, typ string) incidentSubscriberJoins[Q] {
+	return incidentSubscriberJoins[Q]{
+		typ: typ,
+		Incident: modAs[Q, incidentColumns]{
+			c: IncidentColumns,
+			f: func(to incidentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Incidents.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.IncidentID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
+
+// Incident starts a query for related objects on incidents
+func (o *IncidentSubscriber) Incident(mods ...bob.Mod[*dialect.SelectQuery]) IncidentsQuery {
+	return Incidents.Query(append(mods,
+		sm.Where(IncidentColumns.ID.EQ(psql.Arg(o.IncidentID))),
+	)...)
+}
+
+func (os IncidentSubscriberSlice) Incident(mods ...bob.Mod[*dialect.SelectQuery]) IncidentsQuery {
+	pkIncidentID := make(pgtypes.Array[uuid.UUID], len(os))
+	for i, o := range os {
+		pkIncidentID[i] = o.IncidentID
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkIncidentID), "uuid[]")),
+	))
+
+	return Incidents.Query(append(mods,
+		sm.Where(psql.Group(IncidentColumns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func (o *IncidentSubscriber) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Incident":
+		rel, ok := retrieved.(*Incident)
+		if !ok {
+			return fmt.Errorf("incidentSubscriber cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Incident = rel
+
+		if rel != nil {
+			rel.R.IncidentSubscribers = IncidentSubscriberSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("incidentSubscriber has no relationship %q", name)
+	}
+}
+
+type incidentSubscriberPreloader struct {
+	Incident func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildIncidentSubscriberPreloader() incidentSubscriberPreloader {
+	return incidentSubscriberPreloader{
+		Incident: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Incident, IncidentSlice](orm.Relationship{
+				Name: "Incident",
+				Sides: []orm.RelSide{
+					{
+						From: TableNames.IncidentSubscribers,
+						To:   TableNames.Incidents,
+						FromColumns: []string{
+							ColumnNames.IncidentSubscribers.IncidentID,
+						},
+						ToColumns: []string{
+							ColumnNames.Incidents.ID,
+						},
+					},
+				},
+			}, Incidents.Columns().Names(), opts...)
+		},
+	}
+}
+
+type incidentSubscriberThenLoader[Q orm.Loadable] struct {
+	Incident func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildIncidentSubscriberThenLoader[Q orm.Loadable]() incidentSubscriberThenLoader[Q] {
+	type IncidentLoadInterface interface {
+		LoadIncident(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return incidentSubscriberThenLoader[Q]{
+		Incident: thenLoadBuilder[Q](
+			"Incident",
+			func(ctx context.Context, exec bob.Executor, retrieved IncidentLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadIncident(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadIncident loads the incidentSubscriber's Incident into the .R struct
+func (o *IncidentSubscriber) LoadIncident(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Incident = nil
+
+	related, err := o.Incident(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.IncidentSubscribers = IncidentSubscriberSlice{o}
+
+	o.R.Incident = related
+	return nil
+}
+
+// LoadIncident loads the incidentSubscriber's Incident into the .R struct
+func (os IncidentSubscriberSlice) LoadIncident(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	incidents, err := os.Incident(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range incidents {
+			if o.IncidentID != rel.ID {
+				continue
+			}
+
+			rel.R.IncidentSubscribers = append(rel.R.IncidentSubscribers, o)
+
+			o.R.Incident = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func attachIncidentSubscriberIncident0(ctx context.Context, exec bob.Executor, count int, incidentSubscriber0 *IncidentSubscriber, incident1 *Incident) (*IncidentSubscriber, error) {
+	setter := &IncidentSubscriberSetter{
+		IncidentID: &incident1.ID,
+	}
+
+	err := incidentSubscriber0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachIncidentSubscriberIncident0: %w", err)
+	}
+
+	return incidentSubscriber0, nil
+}
+
+func (incidentSubscriber0 *IncidentSubscriber) InsertIncident(ctx context.Context, exec bob.Executor, related *IncidentSetter) error {
+	incident1, err := Incidents.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachIncidentSubscriberIncident0(ctx, exec, 1, incidentSubscriber0, incident1)
+	if err != nil {
+		return err
+	}
+
+	incidentSubscriber0.R.Incident = incident1
+
+	incident1.R.IncidentSubscribers = append(incident1.R.IncidentSubscribers, incidentSubscriber0)
+
+	return nil
+}
+
+func (incidentSubscriber0 *IncidentSubscriber) AttachIncident(ctx context.Context, exec bob.Executor, incident1 *Incident) error {
+	var err error
+
+	_, err = attachIncidentSubscriberIncident0(ctx, exec, 1, incidentSubscriber0, incident1)
+	if err != nil {
+		return err
+	}
+
+	incidentSubscriber0.R.Incident = incident1
+
+	incident1.R.IncidentSubscribers = append(incident1.R.IncidentSubscribers, incidentSubscriber0)
+
+	return nil
+}
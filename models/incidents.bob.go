@@ -25,18 +25,30 @@ import (
 
 // Incident is an object representing the database table.
 type Incident struct {
-	ID             uuid.UUID           `db:"id,pk" `
-	SlackChannelID string              `db:"slack_channel_id" `
-	Status         string              `db:"status" `
-	Severity       string              `db:"severity" `
-	Title          string              `db:"title" `
-	Description    sql.Null[string]    `db:"description" `
-	StartedBy      string              `db:"started_by" `
-	StartedAt      sql.Null[time.Time] `db:"started_at" `
-	ResolvedBy     sql.Null[string]    `db:"resolved_by" `
-	ResolvedAt     sql.Null[time.Time] `db:"resolved_at" `
-	ExportURL      sql.Null[string]    `db:"export_url" `
-	LastUpdated    sql.Null[time.Time] `db:"last_updated" `
+	ID                uuid.UUID           `db:"id,pk" `
+	SlackChannelID    string              `db:"slack_channel_id" `
+	Status            string              `db:"status" `
+	Severity          string              `db:"severity" `
+	Title             string              `db:"title" `
+	Description       sql.Null[string]    `db:"description" `
+	StartedBy         string              `db:"started_by" `
+	StartedAt         time.Time           `db:"started_at" `
+	ResolvedBy        sql.Null[string]    `db:"resolved_by" `
+	ResolvedAt        sql.Null[time.Time] `db:"resolved_at" `
+	ExportURL         sql.Null[string]    `db:"export_url" `
+	LastUpdated       time.Time           `db:"last_updated" `
+	SlackChannelName  sql.Null[string]    `db:"slack_channel_name" `
+	StartedByUserID   sql.Null[string]    `db:"started_by_user_id" `
+	NextUpdateDue     sql.Null[time.Time] `db:"next_update_due" `
+	SubStatus         sql.Null[string]    `db:"sub_status" `
+	StalePromptedAt   sql.Null[time.Time] `db:"stale_prompted_at" `
+	StaleSnoozedUntil sql.Null[time.Time] `db:"stale_snoozed_until" `
+	TeamID            sql.Null[string]    `db:"team_id" `
+	EnterpriseID      sql.Null[string]    `db:"enterprise_id" `
+	Team              sql.Null[string]    `db:"team" `
+	IncidentType      sql.Null[string]    `db:"incident_type" `
+	Private           bool                `db:"private" `
+	FirstResponseAt   sql.Null[time.Time] `db:"first_response_at" `
 
 	R incidentR `db:"-" `
 }
@@ -53,40 +65,71 @@ type IncidentsQuery = *psql.ViewQuery[*Incident, IncidentSlice]
 
 // incidentR is where relationships are stored.
 type incidentR struct {
-	TimelineEvents TimelineEventSlice // timeline_events.timeline_events_incident_id_fkey
+	AlertIncidents        AlertIncidentSlice        // alert_incidents.alert_incidents_incident_id_fkey
+	IncidentChecklist     *IncidentChecklist        // incident_checklists.incident_checklists_incident_id_fkey
+	IncidentEscalation    *IncidentEscalation       // incident_escalations.incident_escalations_incident_id_fkey
+	IncidentNotifications IncidentNotificationSlice // incident_notifications.incident_notifications_incident_id_fkey
+	IncidentRoles         IncidentRoleSlice         // incident_roles.incident_roles_incident_id_fkey
+	IncidentServices      IncidentServiceSlice      // incident_services.incident_services_incident_id_fkey
+	IncidentSubscribers   IncidentSubscriberSlice   // incident_subscribers.incident_subscribers_incident_id_fkey
+	TimelineEvents        TimelineEventSlice        // timeline_events.timeline_events_incident_id_fkey
 }
 
 type incidentColumnNames struct {
-	ID             string
-	SlackChannelID string
-	Status         string
-	Severity       string
-	Title          string
-	Description    string
-	StartedBy      string
-	StartedAt      string
-	ResolvedBy     string
-	ResolvedAt     string
-	ExportURL      string
-	LastUpdated    string
+	ID                string
+	SlackChannelID    string
+	Status            string
+	Severity          string
+	Title             string
+	Description       string
+	StartedBy         string
+	StartedAt         string
+	ResolvedBy        string
+	ResolvedAt        string
+	ExportURL         string
+	LastUpdated       string
+	SlackChannelName  string
+	StartedByUserID   string
+	NextUpdateDue     string
+	SubStatus         string
+	StalePromptedAt   string
+	StaleSnoozedUntil string
+	TeamID            string
+	EnterpriseID      string
+	Team              string
+	IncidentType      string
+	Private           string
+	FirstResponseAt   string
 }
 
 var IncidentColumns = buildIncidentColumns("incidents")
 
 type incidentColumns struct {
-	tableAlias     string
-	ID             psql.Expression
-	SlackChannelID psql.Expression
-	Status         psql.Expression
-	Severity       psql.Expression
-	Title          psql.Expression
-	Description    psql.Expression
-	StartedBy      psql.Expression
-	StartedAt      psql.Expression
-	ResolvedBy     psql.Expression
-	ResolvedAt     psql.Expression
-	ExportURL      psql.Expression
-	LastUpdated    psql.Expression
+	tableAlias        string
+	ID                psql.Expression
+	SlackChannelID    psql.Expression
+	Status            psql.Expression
+	Severity          psql.Expression
+	Title             psql.Expression
+	Description       psql.Expression
+	StartedBy         psql.Expression
+	StartedAt         psql.Expression
+	ResolvedBy        psql.Expression
+	ResolvedAt        psql.Expression
+	ExportURL         psql.Expression
+	LastUpdated       psql.Expression
+	SlackChannelName  psql.Expression
+	StartedByUserID   psql.Expression
+	NextUpdateDue     psql.Expression
+	SubStatus         psql.Expression
+	StalePromptedAt   psql.Expression
+	StaleSnoozedUntil psql.Expression
+	TeamID            psql.Expression
+	EnterpriseID      psql.Expression
+	Team              psql.Expression
+	IncidentType      psql.Expression
+	Private           psql.Expression
+	FirstResponseAt   psql.Expression
 }
 
 func (c incidentColumns) Alias() string {
@@ -99,35 +142,59 @@ func (incidentColumns) AliasedAs(alias string) incidentColumns {
 
 func buildIncidentColumns(alias string) incidentColumns {
 	return incidentColumns{
-		tableAlias:     alias,
-		ID:             psql.Quote(alias, "id"),
-		SlackChannelID: psql.Quote(alias, "slack_channel_id"),
-		Status:         psql.Quote(alias, "status"),
-		Severity:       psql.Quote(alias, "severity"),
-		Title:          psql.Quote(alias, "title"),
-		Description:    psql.Quote(alias, "description"),
-		StartedBy:      psql.Quote(alias, "started_by"),
-		StartedAt:      psql.Quote(alias, "started_at"),
-		ResolvedBy:     psql.Quote(alias, "resolved_by"),
-		ResolvedAt:     psql.Quote(alias, "resolved_at"),
-		ExportURL:      psql.Quote(alias, "export_url"),
-		LastUpdated:    psql.Quote(alias, "last_updated"),
+		tableAlias:        alias,
+		ID:                psql.Quote(alias, "id"),
+		SlackChannelID:    psql.Quote(alias, "slack_channel_id"),
+		Status:            psql.Quote(alias, "status"),
+		Severity:          psql.Quote(alias, "severity"),
+		Title:             psql.Quote(alias, "title"),
+		Description:       psql.Quote(alias, "description"),
+		StartedBy:         psql.Quote(alias, "started_by"),
+		StartedAt:         psql.Quote(alias, "started_at"),
+		ResolvedBy:        psql.Quote(alias, "resolved_by"),
+		ResolvedAt:        psql.Quote(alias, "resolved_at"),
+		ExportURL:         psql.Quote(alias, "export_url"),
+		LastUpdated:       psql.Quote(alias, "last_updated"),
+		SlackChannelName:  psql.Quote(alias, "slack_channel_name"),
+		StartedByUserID:   psql.Quote(alias, "started_by_user_id"),
+		NextUpdateDue:     psql.Quote(alias, "next_update_due"),
+		SubStatus:         psql.Quote(alias, "sub_status"),
+		StalePromptedAt:   psql.Quote(alias, "stale_prompted_at"),
+		StaleSnoozedUntil: psql.Quote(alias, "stale_snoozed_until"),
+		TeamID:            psql.Quote(alias, "team_id"),
+		EnterpriseID:      psql.Quote(alias, "enterprise_id"),
+		Team:              psql.Quote(alias, "team"),
+		IncidentType:      psql.Quote(alias, "incident_type"),
+		Private:           psql.Quote(alias, "private"),
+		FirstResponseAt:   psql.Quote(alias, "first_response_at"),
 	}
 }
 
 type incidentWhere[Q psql.Filterable] struct {
-	ID             psql.WhereMod[Q, uuid.UUID]
-	SlackChannelID psql.WhereMod[Q, string]
-	Status         psql.WhereMod[Q, string]
-	Severity       psql.WhereMod[Q, string]
-	Title          psql.WhereMod[Q, string]
-	Description    psql.WhereNullMod[Q, string]
-	StartedBy      psql.WhereMod[Q, string]
-	StartedAt      psql.WhereNullMod[Q, time.Time]
-	ResolvedBy     psql.WhereNullMod[Q, string]
-	ResolvedAt     psql.WhereNullMod[Q, time.Time]
-	ExportURL      psql.WhereNullMod[Q, string]
-	LastUpdated    psql.WhereNullMod[Q, time.Time]
+	ID                psql.WhereMod[Q, uuid.UUID]
+	SlackChannelID    psql.WhereMod[Q, string]
+	Status            psql.WhereMod[Q, string]
+	Severity          psql.WhereMod[Q, string]
+	Title             psql.WhereMod[Q, string]
+	Description       psql.WhereNullMod[Q, string]
+	StartedBy         psql.WhereMod[Q, string]
+	StartedAt         psql.WhereMod[Q, time.Time]
+	ResolvedBy        psql.WhereNullMod[Q, string]
+	ResolvedAt        psql.WhereNullMod[Q, time.Time]
+	ExportURL         psql.WhereNullMod[Q, string]
+	LastUpdated       psql.WhereMod[Q, time.Time]
+	SlackChannelName  psql.WhereNullMod[Q, string]
+	StartedByUserID   psql.WhereNullMod[Q, string]
+	NextUpdateDue     psql.WhereNullMod[Q, time.Time]
+	SubStatus         psql.WhereNullMod[Q, string]
+	StalePromptedAt   psql.WhereNullMod[Q, time.Time]
+	StaleSnoozedUntil psql.WhereNullMod[Q, time.Time]
+	TeamID            psql.WhereNullMod[Q, string]
+	EnterpriseID      psql.WhereNullMod[Q, string]
+	Team              psql.WhereNullMod[Q, string]
+	IncidentType      psql.WhereNullMod[Q, string]
+	Private           psql.WhereMod[Q, bool]
+	FirstResponseAt   psql.WhereNullMod[Q, time.Time]
 }
 
 func (incidentWhere[Q]) AliasedAs(alias string) incidentWhere[Q] {
@@ -136,18 +203,30 @@ func (incidentWhere[Q]) AliasedAs(alias string) incidentWhere[Q] {
 
 func buildIncidentWhere[Q psql.Filterable](cols incidentColumns) incidentWhere[Q] {
 	return incidentWhere[Q]{
-		ID:             psql.Where[Q, uuid.UUID](cols.ID),
-		SlackChannelID: psql.Where[Q, string](cols.SlackChannelID),
-		Status:         psql.Where[Q, string](cols.Status),
-		Severity:       psql.Where[Q, string](cols.Severity),
-		Title:          psql.Where[Q, string](cols.Title),
-		Description:    psql.WhereNull[Q, string](cols.Description),
-		StartedBy:      psql.Where[Q, string](cols.StartedBy),
-		StartedAt:      psql.WhereNull[Q, time.Time](cols.StartedAt),
-		ResolvedBy:     psql.WhereNull[Q, string](cols.ResolvedBy),
-		ResolvedAt:     psql.WhereNull[Q, time.Time](cols.ResolvedAt),
-		ExportURL:      psql.WhereNull[Q, string](cols.ExportURL),
-		LastUpdated:    psql.WhereNull[Q, time.Time](cols.LastUpdated),
+		ID:                psql.Where[Q, uuid.UUID](cols.ID),
+		SlackChannelID:    psql.Where[Q, string](cols.SlackChannelID),
+		Status:            psql.Where[Q, string](cols.Status),
+		Severity:          psql.Where[Q, string](cols.Severity),
+		Title:             psql.Where[Q, string](cols.Title),
+		Description:       psql.WhereNull[Q, string](cols.Description),
+		StartedBy:         psql.Where[Q, string](cols.StartedBy),
+		StartedAt:         psql.Where[Q, time.Time](cols.StartedAt),
+		ResolvedBy:        psql.WhereNull[Q, string](cols.ResolvedBy),
+		ResolvedAt:        psql.WhereNull[Q, time.Time](cols.ResolvedAt),
+		ExportURL:         psql.WhereNull[Q, string](cols.ExportURL),
+		LastUpdated:       psql.Where[Q, time.Time](cols.LastUpdated),
+		SlackChannelName:  psql.WhereNull[Q, string](cols.SlackChannelName),
+		StartedByUserID:   psql.WhereNull[Q, string](cols.StartedByUserID),
+		NextUpdateDue:     psql.WhereNull[Q, time.Time](cols.NextUpdateDue),
+		SubStatus:         psql.WhereNull[Q, string](cols.SubStatus),
+		StalePromptedAt:   psql.WhereNull[Q, time.Time](cols.StalePromptedAt),
+		StaleSnoozedUntil: psql.WhereNull[Q, time.Time](cols.StaleSnoozedUntil),
+		TeamID:            psql.WhereNull[Q, string](cols.TeamID),
+		EnterpriseID:      psql.WhereNull[Q, string](cols.EnterpriseID),
+		Team:              psql.WhereNull[Q, string](cols.Team),
+		IncidentType:      psql.WhereNull[Q, string](cols.IncidentType),
+		Private:           psql.Where[Q, bool](cols.Private),
+		FirstResponseAt:   psql.WhereNull[Q, time.Time](cols.FirstResponseAt),
 	}
 }
 
@@ -168,22 +247,34 @@ type incidentErrors struct {
 // All values are optional, and do not have to be set
 // Generated columns are not included
 type IncidentSetter struct {
-	ID             *uuid.UUID           `db:"id,pk" `
-	SlackChannelID *string              `db:"slack_channel_id" `
-	Status         *string              `db:"status" `
-	Severity       *string              `db:"severity" `
-	Title          *string              `db:"title" `
-	Description    *sql.Null[string]    `db:"description" `
-	StartedBy      *string              `db:"started_by" `
-	StartedAt      *sql.Null[time.Time] `db:"started_at" `
-	ResolvedBy     *sql.Null[string]    `db:"resolved_by" `
-	ResolvedAt     *sql.Null[time.Time] `db:"resolved_at" `
-	ExportURL      *sql.Null[string]    `db:"export_url" `
-	LastUpdated    *sql.Null[time.Time] `db:"last_updated" `
+	ID                *uuid.UUID           `db:"id,pk" `
+	SlackChannelID    *string              `db:"slack_channel_id" `
+	Status            *string              `db:"status" `
+	Severity          *string              `db:"severity" `
+	Title             *string              `db:"title" `
+	Description       *sql.Null[string]    `db:"description" `
+	StartedBy         *string              `db:"started_by" `
+	StartedAt         *time.Time           `db:"started_at" `
+	ResolvedBy        *sql.Null[string]    `db:"resolved_by" `
+	ResolvedAt        *sql.Null[time.Time] `db:"resolved_at" `
+	ExportURL         *sql.Null[string]    `db:"export_url" `
+	LastUpdated       *time.Time           `db:"last_updated" `
+	SlackChannelName  *sql.Null[string]    `db:"slack_channel_name" `
+	StartedByUserID   *sql.Null[string]    `db:"started_by_user_id" `
+	NextUpdateDue     *sql.Null[time.Time] `db:"next_update_due" `
+	SubStatus         *sql.Null[string]    `db:"sub_status" `
+	StalePromptedAt   *sql.Null[time.Time] `db:"stale_prompted_at" `
+	StaleSnoozedUntil *sql.Null[time.Time] `db:"stale_snoozed_until" `
+	TeamID            *sql.Null[string]    `db:"team_id" `
+	EnterpriseID      *sql.Null[string]    `db:"enterprise_id" `
+	Team              *sql.Null[string]    `db:"team" `
+	IncidentType      *sql.Null[string]    `db:"incident_type" `
+	Private           *bool                `db:"private" `
+	FirstResponseAt   *sql.Null[time.Time] `db:"first_response_at" `
 }
 
 func (s IncidentSetter) SetColumns() []string {
-	vals := make([]string, 0, 12)
+	vals := make([]string, 0, 24)
 	if s.ID != nil {
 		vals = append(vals, "id")
 	}
@@ -232,6 +323,54 @@ func (s IncidentSetter) SetColumns() []string {
 		vals = append(vals, "last_updated")
 	}
 
+	if s.SlackChannelName != nil {
+		vals = append(vals, "slack_channel_name")
+	}
+
+	if s.StartedByUserID != nil {
+		vals = append(vals, "started_by_user_id")
+	}
+
+	if s.NextUpdateDue != nil {
+		vals = append(vals, "next_update_due")
+	}
+
+	if s.SubStatus != nil {
+		vals = append(vals, "sub_status")
+	}
+
+	if s.StalePromptedAt != nil {
+		vals = append(vals, "stale_prompted_at")
+	}
+
+	if s.StaleSnoozedUntil != nil {
+		vals = append(vals, "stale_snoozed_until")
+	}
+
+	if s.TeamID != nil {
+		vals = append(vals, "team_id")
+	}
+
+	if s.EnterpriseID != nil {
+		vals = append(vals, "enterprise_id")
+	}
+
+	if s.Team != nil {
+		vals = append(vals, "team")
+	}
+
+	if s.IncidentType != nil {
+		vals = append(vals, "incident_type")
+	}
+
+	if s.Private != nil {
+		vals = append(vals, "private")
+	}
+
+	if s.FirstResponseAt != nil {
+		vals = append(vals, "first_response_at")
+	}
+
 	return vals
 }
 
@@ -272,6 +411,42 @@ func (s IncidentSetter) Overwrite(t *Incident) {
 	if s.LastUpdated != nil {
 		t.LastUpdated = *s.LastUpdated
 	}
+	if s.SlackChannelName != nil {
+		t.SlackChannelName = *s.SlackChannelName
+	}
+	if s.StartedByUserID != nil {
+		t.StartedByUserID = *s.StartedByUserID
+	}
+	if s.NextUpdateDue != nil {
+		t.NextUpdateDue = *s.NextUpdateDue
+	}
+	if s.SubStatus != nil {
+		t.SubStatus = *s.SubStatus
+	}
+	if s.StalePromptedAt != nil {
+		t.StalePromptedAt = *s.StalePromptedAt
+	}
+	if s.StaleSnoozedUntil != nil {
+		t.StaleSnoozedUntil = *s.StaleSnoozedUntil
+	}
+	if s.TeamID != nil {
+		t.TeamID = *s.TeamID
+	}
+	if s.EnterpriseID != nil {
+		t.EnterpriseID = *s.EnterpriseID
+	}
+	if s.Team != nil {
+		t.Team = *s.Team
+	}
+	if s.IncidentType != nil {
+		t.IncidentType = *s.IncidentType
+	}
+	if s.Private != nil {
+		t.Private = *s.Private
+	}
+	if s.FirstResponseAt != nil {
+		t.FirstResponseAt = *s.FirstResponseAt
+	}
 }
 
 func (s *IncidentSetter) Apply(q *dialect.InsertQuery) {
@@ -280,7 +455,7 @@ func (s *IncidentSetter) Apply(q *dialect.InsertQuery) {
 	})
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 12)
+		vals := make([]bob.Expression, 24)
 		if s.ID != nil {
 			vals[0] = psql.Arg(*s.ID)
 		} else {
@@ -353,6 +528,78 @@ func (s *IncidentSetter) Apply(q *dialect.InsertQuery) {
 			vals[11] = psql.Raw("DEFAULT")
 		}
 
+		if s.SlackChannelName != nil {
+			vals[12] = psql.Arg(*s.SlackChannelName)
+		} else {
+			vals[12] = psql.Raw("DEFAULT")
+		}
+
+		if s.StartedByUserID != nil {
+			vals[13] = psql.Arg(*s.StartedByUserID)
+		} else {
+			vals[13] = psql.Raw("DEFAULT")
+		}
+
+		if s.NextUpdateDue != nil {
+			vals[14] = psql.Arg(*s.NextUpdateDue)
+		} else {
+			vals[14] = psql.Raw("DEFAULT")
+		}
+
+		if s.SubStatus != nil {
+			vals[15] = psql.Arg(*s.SubStatus)
+		} else {
+			vals[15] = psql.Raw("DEFAULT")
+		}
+
+		if s.StalePromptedAt != nil {
+			vals[16] = psql.Arg(*s.StalePromptedAt)
+		} else {
+			vals[16] = psql.Raw("DEFAULT")
+		}
+
+		if s.StaleSnoozedUntil != nil {
+			vals[17] = psql.Arg(*s.StaleSnoozedUntil)
+		} else {
+			vals[17] = psql.Raw("DEFAULT")
+		}
+
+		if s.TeamID != nil {
+			vals[18] = psql.Arg(*s.TeamID)
+		} else {
+			vals[18] = psql.Raw("DEFAULT")
+		}
+
+		if s.EnterpriseID != nil {
+			vals[19] = psql.Arg(*s.EnterpriseID)
+		} else {
+			vals[19] = psql.Raw("DEFAULT")
+		}
+
+		if s.Team != nil {
+			vals[20] = psql.Arg(*s.Team)
+		} else {
+			vals[20] = psql.Raw("DEFAULT")
+		}
+
+		if s.IncidentType != nil {
+			vals[21] = psql.Arg(*s.IncidentType)
+		} else {
+			vals[21] = psql.Raw("DEFAULT")
+		}
+
+		if s.Private != nil {
+			vals[22] = psql.Arg(*s.Private)
+		} else {
+			vals[22] = psql.Raw("DEFAULT")
+		}
+
+		if s.FirstResponseAt != nil {
+			vals[23] = psql.Arg(*s.FirstResponseAt)
+		} else {
+			vals[23] = psql.Raw("DEFAULT")
+		}
+
 		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
 	}))
 }
@@ -362,7 +609,7 @@ func (s IncidentSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s IncidentSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 12)
+	exprs := make([]bob.Expression, 0, 24)
 
 	if s.ID != nil {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -448,6 +695,90 @@ func (s IncidentSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if s.SlackChannelName != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "slack_channel_name")...),
+			psql.Arg(s.SlackChannelName),
+		}})
+	}
+
+	if s.StartedByUserID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "started_by_user_id")...),
+			psql.Arg(s.StartedByUserID),
+		}})
+	}
+
+	if s.NextUpdateDue != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "next_update_due")...),
+			psql.Arg(s.NextUpdateDue),
+		}})
+	}
+
+	if s.SubStatus != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "sub_status")...),
+			psql.Arg(s.SubStatus),
+		}})
+	}
+
+	if s.StalePromptedAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "stale_prompted_at")...),
+			psql.Arg(s.StalePromptedAt),
+		}})
+	}
+
+	if s.StaleSnoozedUntil != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "stale_snoozed_until")...),
+			psql.Arg(s.StaleSnoozedUntil),
+		}})
+	}
+
+	if s.TeamID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "team_id")...),
+			psql.Arg(s.TeamID),
+		}})
+	}
+
+	if s.EnterpriseID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "enterprise_id")...),
+			psql.Arg(s.EnterpriseID),
+		}})
+	}
+
+	if s.Team != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "team")...),
+			psql.Arg(s.Team),
+		}})
+	}
+
+	if s.IncidentType != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "incident_type")...),
+			psql.Arg(s.IncidentType),
+		}})
+	}
+
+	if s.Private != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "private")...),
+			psql.Arg(s.Private),
+		}})
+	}
+
+	if s.FirstResponseAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "first_response_at")...),
+			psql.Arg(s.FirstResponseAt),
+		}})
+	}
+
 	return exprs
 }
 
@@ -675,8 +1006,15 @@ func (o IncidentSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
 }
 
 type incidentJoins[Q dialect.Joinable] struct {
-	typ            string
-	TimelineEvents modAs[Q, timelineEventColumns]
+	typ                   string
+	AlertIncidents        modAs[Q, alertIncidentColumns]
+	IncidentChecklist     modAs[Q, incidentChecklistColumns]
+	IncidentEscalation    modAs[Q, incidentEscalationColumns]
+	IncidentNotifications modAs[Q, incidentNotificationColumns]
+	IncidentRoles         modAs[Q, incidentRoleColumns]
+	IncidentServices      modAs[Q, incidentServiceColumns]
+	IncidentSubscribers   modAs[Q, incidentSubscriberColumns]
+	TimelineEvents        modAs[Q, timelineEventColumns]
 }
 
 func (j incidentJoins[Q]) aliasedAs(alias string) incidentJoins[Q] {
@@ -686,6 +1024,104 @@ func (j incidentJoins[Q]) aliasedAs(alias string) incidentJoins[Q] {
 func buildIncidentJoins[Q dialect.Joinable](cols incidentColumns, typ string) incidentJoins[Q] {
 	return incidentJoins[Q]{
 		typ: typ,
+		AlertIncidents: modAs[Q, alertIncidentColumns]{
+			c: AlertIncidentColumns,
+			f: func(to alertIncidentColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, AlertIncidents.Name().As(to.Alias())).On(
+						to.IncidentID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		IncidentChecklist: modAs[Q, incidentChecklistColumns]{
+			c: IncidentChecklistColumns,
+			f: func(to incidentChecklistColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, IncidentChecklists.Name().As(to.Alias())).On(
+						to.IncidentID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		IncidentEscalation: modAs[Q, incidentEscalationColumns]{
+			c: IncidentEscalationColumns,
+			f: func(to incidentEscalationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, IncidentEscalations.Name().As(to.Alias())).On(
+						to.IncidentID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		IncidentNotifications: modAs[Q, incidentNotificationColumns]{
+			c: IncidentNotificationColumns,
+			f: func(to incidentNotificationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, IncidentNotifications.Name().As(to.Alias())).On(
+						to.IncidentID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		IncidentRoles: modAs[Q, incidentRoleColumns]{
+			c: IncidentRoleColumns,
+			f: func(to incidentRoleColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, IncidentRoles.Name().As(to.Alias())).On(
+						to.IncidentID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		IncidentServices: modAs[Q, incidentServiceColumns]{
+			c: IncidentServiceColumns,
+			f: func(to incidentServiceColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, IncidentServices.Name().As(to.Alias())).On(
+						to.IncidentID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		IncidentSubscribers: modAs[Q, incidentSubscriberColumns]{
+			c: IncidentSubscriberColumns,
+			f: func(to incidentSubscriberColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, IncidentSubscribers.Name().As(to.Alias())).On(
+						to.IncidentID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
 		TimelineEvents: modAs[Q, timelineEventColumns]{
 			c: TimelineEventColumns,
 			f: func(to timelineEventColumns) bob.Mod[Q] {
@@ -703,14 +1139,14 @@ func buildIncidentJoins[Q dialect.Joinable](cols incidentColumns, typ string) in
 	}
 }
 
-// TimelineEvents starts a query for related objects on timeline_events
-func (o *Incident) TimelineEvents(mods ...bob.Mod[*dialect.SelectQuery]) TimelineEventsQuery {
-	return TimelineEvents.Query(append(mods,
-		sm.Where(TimelineEventColumns.IncidentID.EQ(psql.Arg(o.ID))),
+// AlertIncidents starts a query for related objects on alert_incidents
+func (o *Incident) AlertIncidents(mods ...bob.Mod[*dialect.SelectQuery]) AlertIncidentsQuery {
+	return AlertIncidents.Query(append(mods,
+		sm.Where(AlertIncidentColumns.IncidentID.EQ(psql.Arg(o.ID))),
 	)...)
 }
 
-func (os IncidentSlice) TimelineEvents(mods ...bob.Mod[*dialect.SelectQuery]) TimelineEventsQuery {
+func (os IncidentSlice) AlertIncidents(mods ...bob.Mod[*dialect.SelectQuery]) AlertIncidentsQuery {
 	pkID := make(pgtypes.Array[uuid.UUID], len(os))
 	for i, o := range os {
 		pkID[i] = o.ID
@@ -719,52 +1155,401 @@ func (os IncidentSlice) TimelineEvents(mods ...bob.Mod[*dialect.SelectQuery]) Ti
 		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
 	))
 
-	return TimelineEvents.Query(append(mods,
-		sm.Where(psql.Group(TimelineEventColumns.IncidentID).OP("IN", PKArgExpr)),
+	return AlertIncidents.Query(append(mods,
+		sm.Where(psql.Group(AlertIncidentColumns.IncidentID).OP("IN", PKArgExpr)),
 	)...)
 }
 
-func (o *Incident) Preload(name string, retrieved any) error {
-	if o == nil {
-		return nil
+// IncidentChecklist starts a query for related objects on incident_checklists
+func (o *Incident) IncidentChecklist(mods ...bob.Mod[*dialect.SelectQuery]) IncidentChecklistsQuery {
+	return IncidentChecklists.Query(append(mods,
+		sm.Where(IncidentChecklistColumns.IncidentID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os IncidentSlice) IncidentChecklist(mods ...bob.Mod[*dialect.SelectQuery]) IncidentChecklistsQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], len(os))
+	for i, o := range os {
+		pkID[i] = o.ID
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
 
-	switch name {
-	case "TimelineEvents":
-		rels, ok := retrieved.(TimelineEventSlice)
-		if !ok {
-			return fmt.Errorf("incident cannot load %T as %q", retrieved, name)
-		}
+	return IncidentChecklists.Query(append(mods,
+		sm.Where(psql.Group(IncidentChecklistColumns.IncidentID).OP("IN", PKArgExpr)),
+	)...)
+}
 
-		o.R.TimelineEvents = rels
+// IncidentEscalation starts a query for related objects on incident_escalations
+func (o *Incident) IncidentEscalation(mods ...bob.Mod[*dialect.SelectQuery]) IncidentEscalationsQuery {
+	return IncidentEscalations.Query(append(mods,
+		sm.Where(IncidentEscalationColumns.IncidentID.EQ(psql.Arg(o.ID))),
+	)...)
+}
 
-		for _, rel := range rels {
-			if rel != nil {
-				rel.R.Incident = o
-			}
-		}
-		return nil
-	default:
-		return fmt.Errorf("incident has no relationship %q", name)
+func (os IncidentSlice) IncidentEscalation(mods ...bob.Mod[*dialect.SelectQuery]) IncidentEscalationsQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], len(os))
+	for i, o := range os {
+		pkID[i] = o.ID
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return IncidentEscalations.Query(append(mods,
+		sm.Where(psql.Group(IncidentEscalationColumns.IncidentID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-type incidentPreloader struct{}
+// IncidentNotifications starts a query for related objects on incident_notifications
+func (o *Incident) IncidentNotifications(mods ...bob.Mod[*dialect.SelectQuery]) IncidentNotificationsQuery {
+	return IncidentNotifications.Query(append(mods,
+		sm.Where(IncidentNotificationColumns.IncidentID.EQ(psql.Arg(o.ID))),
+	)...)
+}
 
-func buildIncidentPreloader() incidentPreloader {
-	return incidentPreloader{}
+func (os IncidentSlice) IncidentNotifications(mods ...bob.Mod[*dialect.SelectQuery]) IncidentNotificationsQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], len(os))
+	for i, o := range os {
+		pkID[i] = o.ID
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return IncidentNotifications.Query(append(mods,
+		sm.Where(psql.Group(IncidentNotificationColumns.IncidentID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-type incidentThenLoader[Q orm.Loadable] struct {
-	TimelineEvents func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+// IncidentRoles starts a query for related objects on incident_roles
+func (o *Incident) IncidentRoles(mods ...bob.Mod[*dialect.SelectQuery]) IncidentRolesQuery {
+	return IncidentRoles.Query(append(mods,
+		sm.Where(IncidentRoleColumns.IncidentID.EQ(psql.Arg(o.ID))),
+	)...)
 }
 
-func buildIncidentThenLoader[Q orm.Loadable]() incidentThenLoader[Q] {
-	type TimelineEventsLoadInterface interface {
-		LoadTimelineEvents(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+func (os IncidentSlice) IncidentRoles(mods ...bob.Mod[*dialect.SelectQuery]) IncidentRolesQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], len(os))
+	for i, o := range os {
+		pkID[i] = o.ID
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return IncidentRoles.Query(append(mods,
+		sm.Where(psql.Group(IncidentRoleColumns.IncidentID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// IncidentServices starts a query for related objects on incident_services
+func (o *Incident) IncidentServices(mods ...bob.Mod[*dialect.SelectQuery]) IncidentServicesQuery {
+	return IncidentServices.Query(append(mods,
+		sm.Where(IncidentServiceColumns.IncidentID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os IncidentSlice) IncidentServices(mods ...bob.Mod[*dialect.SelectQuery]) IncidentServicesQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], len(os))
+	for i, o := range os {
+		pkID[i] = o.ID
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return IncidentServices.Query(append(mods,
+		sm.Where(psql.Group(IncidentServiceColumns.IncidentID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// IncidentSubscribers starts a query for related objects on incident_subscribers
+func (o *Incident) IncidentSubscribers(mods ...bob.Mod[*dialect.SelectQuery]) IncidentSubscribersQuery {
+	return IncidentSubscribers.Query(append(mods,
+		sm.Where(IncidentSubscriberColumns.IncidentID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os IncidentSlice) IncidentSubscribers(mods ...bob.Mod[*dialect.SelectQuery]) IncidentSubscribersQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], len(os))
+	for i, o := range os {
+		pkID[i] = o.ID
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return IncidentSubscribers.Query(append(mods,
+		sm.Where(psql.Group(IncidentSubscriberColumns.IncidentID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// TimelineEvents starts a query for related objects on timeline_events
+func (o *Incident) TimelineEvents(mods ...bob.Mod[*dialect.SelectQuery]) TimelineEventsQuery {
+	return TimelineEvents.Query(append(mods,
+		sm.Where(TimelineEventColumns.IncidentID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os IncidentSlice) TimelineEvents(mods ...bob.Mod[*dialect.SelectQuery]) TimelineEventsQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], len(os))
+	for i, o := range os {
+		pkID[i] = o.ID
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return TimelineEvents.Query(append(mods,
+		sm.Where(psql.Group(TimelineEventColumns.IncidentID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func (o *Incident) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "AlertIncidents":
+		rels, ok := retrieved.(AlertIncidentSlice)
+		if !ok {
+			return fmt.Errorf("incident cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.AlertIncidents = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Incident = o
+			}
+		}
+		return nil
+	case "IncidentChecklist":
+		rel, ok := retrieved.(*IncidentChecklist)
+		if !ok {
+			return fmt.Errorf("incident cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.IncidentChecklist = rel
+
+		if rel != nil {
+			rel.R.Incident = o
+		}
+		return nil
+	case "IncidentEscalation":
+		rel, ok := retrieved.(*IncidentEscalation)
+		if !ok {
+			return fmt.Errorf("incident cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.IncidentEscalation = rel
+
+		if rel != nil {
+			rel.R.Incident = o
+		}
+		return nil
+	case "IncidentNotifications":
+		rels, ok := retrieved.(IncidentNotificationSlice)
+		if !ok {
+			return fmt.Errorf("incident cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.IncidentNotifications = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Incident = o
+			}
+		}
+		return nil
+	case "IncidentRoles":
+		rels, ok := retrieved.(IncidentRoleSlice)
+		if !ok {
+			return fmt.Errorf("incident cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.IncidentRoles = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Incident = o
+			}
+		}
+		return nil
+	case "IncidentServices":
+		rels, ok := retrieved.(IncidentServiceSlice)
+		if !ok {
+			return fmt.Errorf("incident cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.IncidentServices = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Incident = o
+			}
+		}
+		return nil
+	case "IncidentSubscribers":
+		rels, ok := retrieved.(IncidentSubscriberSlice)
+		if !ok {
+			return fmt.Errorf("incident cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.IncidentSubscribers = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Incident = o
+			}
+		}
+		return nil
+	case "TimelineEvents":
+		rels, ok := retrieved.(TimelineEventSlice)
+		if !ok {
+			return fmt.Errorf("incident cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.TimelineEvents = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Incident = o
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("incident has no relationship %q", name)
+	}
+}
+
+type incidentPreloader struct {
+	IncidentChecklist  func(...psql.PreloadOption) psql.Preloader
+	IncidentEscalation func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildIncidentPreloader() incidentPreloader {
+	return incidentPreloader{
+		IncidentChecklist: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*IncidentChecklist, IncidentChecklistSlice](orm.Relationship{
+				Name: "IncidentChecklist",
+				Sides: []orm.RelSide{
+					{
+						From: TableNames.Incidents,
+						To:   TableNames.IncidentChecklists,
+						FromColumns: []string{
+							ColumnNames.Incidents.ID,
+						},
+						ToColumns: []string{
+							ColumnNames.IncidentChecklists.IncidentID,
+						},
+					},
+				},
+			}, IncidentChecklists.Columns().Names(), opts...)
+		},
+		IncidentEscalation: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*IncidentEscalation, IncidentEscalationSlice](orm.Relationship{
+				Name: "IncidentEscalation",
+				Sides: []orm.RelSide{
+					{
+						From: TableNames.Incidents,
+						To:   TableNames.IncidentEscalations,
+						FromColumns: []string{
+							ColumnNames.Incidents.ID,
+						},
+						ToColumns: []string{
+							ColumnNames.IncidentEscalations.IncidentID,
+						},
+					},
+				},
+			}, IncidentEscalations.Columns().Names(), opts...)
+		},
+	}
+}
+
+type incidentThenLoader[Q orm.Loadable] struct {
+	AlertIncidents        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	IncidentChecklist     func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	IncidentEscalation    func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	IncidentNotifications func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	IncidentRoles         func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	IncidentServices      func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	IncidentSubscribers   func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	TimelineEvents        func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildIncidentThenLoader[Q orm.Loadable]() incidentThenLoader[Q] {
+	type AlertIncidentsLoadInterface interface {
+		LoadAlertIncidents(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type IncidentChecklistLoadInterface interface {
+		LoadIncidentChecklist(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type IncidentEscalationLoadInterface interface {
+		LoadIncidentEscalation(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type IncidentNotificationsLoadInterface interface {
+		LoadIncidentNotifications(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type IncidentRolesLoadInterface interface {
+		LoadIncidentRoles(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type IncidentServicesLoadInterface interface {
+		LoadIncidentServices(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type IncidentSubscribersLoadInterface interface {
+		LoadIncidentSubscribers(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TimelineEventsLoadInterface interface {
+		LoadTimelineEvents(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
 	}
 
 	return incidentThenLoader[Q]{
+		AlertIncidents: thenLoadBuilder[Q](
+			"AlertIncidents",
+			func(ctx context.Context, exec bob.Executor, retrieved AlertIncidentsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAlertIncidents(ctx, exec, mods...)
+			},
+		),
+		IncidentChecklist: thenLoadBuilder[Q](
+			"IncidentChecklist",
+			func(ctx context.Context, exec bob.Executor, retrieved IncidentChecklistLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadIncidentChecklist(ctx, exec, mods...)
+			},
+		),
+		IncidentEscalation: thenLoadBuilder[Q](
+			"IncidentEscalation",
+			func(ctx context.Context, exec bob.Executor, retrieved IncidentEscalationLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadIncidentEscalation(ctx, exec, mods...)
+			},
+		),
+		IncidentNotifications: thenLoadBuilder[Q](
+			"IncidentNotifications",
+			func(ctx context.Context, exec bob.Executor, retrieved IncidentNotificationsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadIncidentNotifications(ctx, exec, mods...)
+			},
+		),
+		IncidentRoles: thenLoadBuilder[Q](
+			"IncidentRoles",
+			func(ctx context.Context, exec bob.Executor, retrieved IncidentRolesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadIncidentRoles(ctx, exec, mods...)
+			},
+		),
+		IncidentServices: thenLoadBuilder[Q](
+			"IncidentServices",
+			func(ctx context.Context, exec bob.Executor, retrieved IncidentServicesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadIncidentServices(ctx, exec, mods...)
+			},
+		),
+		IncidentSubscribers: thenLoadBuilder[Q](
+			"IncidentSubscribers",
+			func(ctx context.Context, exec bob.Executor, retrieved IncidentSubscribersLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadIncidentSubscribers(ctx, exec, mods...)
+			},
+		),
 		TimelineEvents: thenLoadBuilder[Q](
 			"TimelineEvents",
 			func(ctx context.Context, exec bob.Executor, retrieved TimelineEventsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
@@ -774,16 +1559,16 @@ func buildIncidentThenLoader[Q orm.Loadable]() incidentThenLoader[Q] {
 	}
 }
 
-// LoadTimelineEvents loads the incident's TimelineEvents into the .R struct
-func (o *Incident) LoadTimelineEvents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadAlertIncidents loads the incident's AlertIncidents into the .R struct
+func (o *Incident) LoadAlertIncidents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
 	// Reset the relationship
-	o.R.TimelineEvents = nil
+	o.R.AlertIncidents = nil
 
-	related, err := o.TimelineEvents(mods...).All(ctx, exec)
+	related, err := o.AlertIncidents(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
@@ -792,40 +1577,844 @@ func (o *Incident) LoadTimelineEvents(ctx context.Context, exec bob.Executor, mo
 		rel.R.Incident = o
 	}
 
-	o.R.TimelineEvents = related
+	o.R.AlertIncidents = related
 	return nil
 }
 
-// LoadTimelineEvents loads the incident's TimelineEvents into the .R struct
-func (os IncidentSlice) LoadTimelineEvents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadAlertIncidents loads the incident's AlertIncidents into the .R struct
+func (os IncidentSlice) LoadAlertIncidents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if len(os) == 0 {
 		return nil
 	}
 
-	timelineEvents, err := os.TimelineEvents(mods...).All(ctx, exec)
+	alertIncidents, err := os.AlertIncidents(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
 
 	for _, o := range os {
-		o.R.TimelineEvents = nil
+		o.R.AlertIncidents = nil
 	}
 
 	for _, o := range os {
-		for _, rel := range timelineEvents {
+		for _, rel := range alertIncidents {
+			if o.ID != rel.IncidentID.V {
+				continue
+			}
+
+			rel.R.Incident = o
+
+			o.R.AlertIncidents = append(o.R.AlertIncidents, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadIncidentChecklist loads the incident's IncidentChecklist into the .R struct
+func (o *Incident) LoadIncidentChecklist(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.IncidentChecklist = nil
+
+	related, err := o.IncidentChecklist(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Incident = o
+
+	o.R.IncidentChecklist = related
+	return nil
+}
+
+// LoadIncidentChecklist loads the incident's IncidentChecklist into the .R struct
+func (os IncidentSlice) LoadIncidentChecklist(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	incidentChecklists, err := os.IncidentChecklist(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range incidentChecklists {
 			if o.ID != rel.IncidentID {
 				continue
 			}
 
 			rel.R.Incident = o
 
-			o.R.TimelineEvents = append(o.R.TimelineEvents, rel)
+			o.R.IncidentChecklist = rel
+			break
 		}
 	}
 
 	return nil
 }
 
+// LoadIncidentEscalation loads the incident's IncidentEscalation into the .R struct
+func (o *Incident) LoadIncidentEscalation(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.IncidentEscalation = nil
+
+	related, err := o.IncidentEscalation(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Incident = o
+
+	o.R.IncidentEscalation = related
+	return nil
+}
+
+// LoadIncidentEscalation loads the incident's IncidentEscalation into the .R struct
+func (os IncidentSlice) LoadIncidentEscalation(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	incidentEscalations, err := os.IncidentEscalation(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range incidentEscalations {
+			if o.ID != rel.IncidentID {
+				continue
+			}
+
+			rel.R.Incident = o
+
+			o.R.IncidentEscalation = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadIncidentNotifications loads the incident's IncidentNotifications into the .R struct
+func (o *Incident) LoadIncidentNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.IncidentNotifications = nil
+
+	related, err := o.IncidentNotifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Incident = o
+	}
+
+	o.R.IncidentNotifications = related
+	return nil
+}
+
+// LoadIncidentNotifications loads the incident's IncidentNotifications into the .R struct
+func (os IncidentSlice) LoadIncidentNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	incidentNotifications, err := os.IncidentNotifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.IncidentNotifications = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range incidentNotifications {
+			if o.ID != rel.IncidentID {
+				continue
+			}
+
+			rel.R.Incident = o
+
+			o.R.IncidentNotifications = append(o.R.IncidentNotifications, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadIncidentRoles loads the incident's IncidentRoles into the .R struct
+func (o *Incident) LoadIncidentRoles(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.IncidentRoles = nil
+
+	related, err := o.IncidentRoles(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Incident = o
+	}
+
+	o.R.IncidentRoles = related
+	return nil
+}
+
+// LoadIncidentRoles loads the incident's IncidentRoles into the .R struct
+func (os IncidentSlice) LoadIncidentRoles(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	incidentRoles, err := os.IncidentRoles(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.IncidentRoles = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range incidentRoles {
+			if o.ID != rel.IncidentID {
+				continue
+			}
+
+			rel.R.Incident = o
+
+			o.R.IncidentRoles = append(o.R.IncidentRoles, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadIncidentServices loads the incident's IncidentServices into the .R struct
+func (o *Incident) LoadIncidentServices(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.IncidentServices = nil
+
+	related, err := o.IncidentServices(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Incident = o
+	}
+
+	o.R.IncidentServices = related
+	return nil
+}
+
+// LoadIncidentServices loads the incident's IncidentServices into the .R struct
+func (os IncidentSlice) LoadIncidentServices(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	incidentServices, err := os.IncidentServices(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.IncidentServices = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range incidentServices {
+			if o.ID != rel.IncidentID {
+				continue
+			}
+
+			rel.R.Incident = o
+
+			o.R.IncidentServices = append(o.R.IncidentServices, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadIncidentSubscribers loads the incident's IncidentSubscribers into the .R struct
+func (o *Incident) LoadIncidentSubscribers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.IncidentSubscribers = nil
+
+	related, err := o.IncidentSubscribers(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Incident = o
+	}
+
+	o.R.IncidentSubscribers = related
+	return nil
+}
+
+// LoadIncidentSubscribers loads the incident's IncidentSubscribers into the .R struct
+func (os IncidentSlice) LoadIncidentSubscribers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	incidentSubscribers, err := os.IncidentSubscribers(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.IncidentSubscribers = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range incidentSubscribers {
+			if o.ID != rel.IncidentID {
+				continue
+			}
+
+			rel.R.Incident = o
+
+			o.R.IncidentSubscribers = append(o.R.IncidentSubscribers, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadTimelineEvents loads the incident's TimelineEvents into the .R struct
+func (o *Incident) LoadTimelineEvents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.TimelineEvents = nil
+
+	related, err := o.TimelineEvents(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Incident = o
+	}
+
+	o.R.TimelineEvents = related
+	return nil
+}
+
+// LoadTimelineEvents loads the incident's TimelineEvents into the .R struct
+func (os IncidentSlice) LoadTimelineEvents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	timelineEvents, err := os.TimelineEvents(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.TimelineEvents = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range timelineEvents {
+			if o.ID != rel.IncidentID {
+				continue
+			}
+
+			rel.R.Incident = o
+
+			o.R.TimelineEvents = append(o.R.TimelineEvents, rel)
+		}
+	}
+
+	return nil
+}
+
+func insertIncidentAlertIncidents0(ctx context.Context, exec bob.Executor, alertIncidents1 []*AlertIncidentSetter, incident0 *Incident) (AlertIncidentSlice, error) {
+	for i := range alertIncidents1 {
+		alertIncidents1[i].IncidentID = func() *sql.Null[uuid.UUID] {
+			v := sql.Null[uuid.UUID]{V: incident0.ID, Valid: true}
+			return &v
+		}()
+	}
+
+	ret, err := AlertIncidents.Insert(bob.ToMods(alertIncidents1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertIncidentAlertIncidents0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachIncidentAlertIncidents0(ctx context.Context, exec bob.Executor, count int, alertIncidents1 AlertIncidentSlice, incident0 *Incident) (AlertIncidentSlice, error) {
+	setter := &AlertIncidentSetter{
+		IncidentID: func() *sql.Null[uuid.UUID] {
+			v := sql.Null[uuid.UUID]{V: incident0.ID, Valid: true}
+			return &v
+		}(),
+	}
+
+	err := alertIncidents1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachIncidentAlertIncidents0: %w", err)
+	}
+
+	return alertIncidents1, nil
+}
+
+func (incident0 *Incident) InsertAlertIncidents(ctx context.Context, exec bob.Executor, related ...*AlertIncidentSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	alertIncidents1, err := insertIncidentAlertIncidents0(ctx, exec, related, incident0)
+	if err != nil {
+		return err
+	}
+
+	incident0.R.AlertIncidents = append(incident0.R.AlertIncidents, alertIncidents1...)
+
+	for _, rel := range alertIncidents1 {
+		rel.R.Incident = incident0
+	}
+	return nil
+}
+
+func (incident0 *Incident) AttachAlertIncidents(ctx context.Context, exec bob.Executor, related ...*AlertIncident) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	alertIncidents1 := AlertIncidentSlice(related)
+
+	_, err = attachIncidentAlertIncidents0(ctx, exec, len(related), alertIncidents1, incident0)
+	if err != nil {
+		return err
+	}
+
+	incident0.R.AlertIncidents = append(incident0.R.AlertIncidents, alertIncidents1...)
+
+	for _, rel := range related {
+		rel.R.Incident = incident0
+	}
+
+	return nil
+}
+
+func insertIncidentIncidentChecklist0(ctx context.Context, exec bob.Executor, incidentChecklist1 *IncidentChecklistSetter, incident0 *Incident) (*IncidentChecklist, error) {
+	incidentChecklist1.IncidentID = &incident0.ID
+
+	ret, err := IncidentChecklists.Insert(incidentChecklist1).One(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertIncidentIncidentChecklist0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachIncidentIncidentChecklist0(ctx context.Context, exec bob.Executor, count int, incidentChecklist1 *IncidentChecklist, incident0 *Incident) (*IncidentChecklist, error) {
+	setter := &IncidentChecklistSetter{
+		IncidentID: &incident0.ID,
+	}
+
+	err := incidentChecklist1.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachIncidentIncidentChecklist0: %w", err)
+	}
+
+	return incidentChecklist1, nil
+}
+
+func (incident0 *Incident) InsertIncidentChecklist(ctx context.Context, exec bob.Executor, related *IncidentChecklistSetter) error {
+	incidentChecklist1, err := insertIncidentIncidentChecklist0(ctx, exec, related, incident0)
+	if err != nil {
+		return err
+	}
+
+	incident0.R.IncidentChecklist = incidentChecklist1
+
+	incidentChecklist1.R.Incident = incident0
+
+	return nil
+}
+
+func (incident0 *Incident) AttachIncidentChecklist(ctx context.Context, exec bob.Executor, incidentChecklist1 *IncidentChecklist) error {
+	var err error
+
+	_, err = attachIncidentIncidentChecklist0(ctx, exec, 1, incidentChecklist1, incident0)
+	if err != nil {
+		return err
+	}
+
+	incident0.R.IncidentChecklist = incidentChecklist1
+
+	incidentChecklist1.R.Incident = incident0
+
+	return nil
+}
+
+func insertIncidentIncidentEscalation0(ctx context.Context, exec bob.Executor, incidentEscalation1 *IncidentEscalationSetter, incident0 *Incident) (*IncidentEscalation, error) {
+	incidentEscalation1.IncidentID = &incident0.ID
+
+	ret, err := IncidentEscalations.Insert(incidentEscalation1).One(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertIncidentIncidentEscalation0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachIncidentIncidentEscalation0(ctx context.Context, exec bob.Executor, count int, incidentEscalation1 *IncidentEscalation, incident0 *Incident) (*IncidentEscalation, error) {
+	setter := &IncidentEscalationSetter{
+		IncidentID: &incident0.ID,
+	}
+
+	err := incidentEscalation1.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachIncidentIncidentEscalation0: %w", err)
+	}
+
+	return incidentEscalation1, nil
+}
+
+func (incident0 *Incident) InsertIncidentEscalation(ctx context.Context, exec bob.Executor, related *IncidentEscalationSetter) error {
+	incidentEscalation1, err := insertIncidentIncidentEscalation0(ctx, exec, related, incident0)
+	if err != nil {
+		return err
+	}
+
+	incident0.R.IncidentEscalation = incidentEscalation1
+
+	incidentEscalation1.R.Incident = incident0
+
+	return nil
+}
+
+func (incident0 *Incident) AttachIncidentEscalation(ctx context.Context, exec bob.Executor, incidentEscalation1 *IncidentEscalation) error {
+	var err error
+
+	_, err = attachIncidentIncidentEscalation0(ctx, exec, 1, incidentEscalation1, incident0)
+	if err != nil {
+		return err
+	}
+
+	incident0.R.IncidentEscalation = incidentEscalation1
+
+	incidentEscalation1.R.Incident = incident0
+
+	return nil
+}
+
+func insertIncidentIncidentNotifications0(ctx context.Context, exec bob.Executor, incidentNotifications1 []*IncidentNotificationSetter, incident0 *Incident) (IncidentNotificationSlice, error) {
+	for i := range incidentNotifications1 {
+		incidentNotifications1[i].IncidentID = &incident0.ID
+	}
+
+	ret, err := IncidentNotifications.Insert(bob.ToMods(incidentNotifications1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertIncidentIncidentNotifications0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachIncidentIncidentNotifications0(ctx context.Context, exec bob.Executor, count int, incidentNotifications1 IncidentNotificationSlice, incident0 *Incident) (IncidentNotificationSlice, error) {
+	setter := &IncidentNotificationSetter{
+		IncidentID: &incident0.ID,
+	}
+
+	err := incidentNotifications1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachIncidentIncidentNotifications0: %w", err)
+	}
+
+	return incidentNotifications1, nil
+}
+
+func (incident0 *Incident) InsertIncidentNotifications(ctx context.Context, exec bob.Executor, related ...*IncidentNotificationSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	incidentNotifications1, err := insertIncidentIncidentNotifications0(ctx, exec, related, incident0)
+	if err != nil {
+		return err
+	}
+
+	incident0.R.IncidentNotifications = append(incident0.R.IncidentNotifications, incidentNotifications1...)
+
+	for _, rel := range incidentNotifications1 {
+		rel.R.Incident = incident0
+	}
+	return nil
+}
+
+func (incident0 *Incident) AttachIncidentNotifications(ctx context.Context, exec bob.Executor, related ...*IncidentNotification) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	incidentNotifications1 := IncidentNotificationSlice(related)
+
+	_, err = attachIncidentIncidentNotifications0(ctx, exec, len(related), incidentNotifications1, incident0)
+	if err != nil {
+		return err
+	}
+
+	incident0.R.IncidentNotifications = append(incident0.R.IncidentNotifications, incidentNotifications1...)
+
+	for _, rel := range related {
+		rel.R.Incident = incident0
+	}
+
+	return nil
+}
+
+func insertIncidentIncidentRoles0(ctx context.Context, exec bob.Executor, incidentRoles1 []*IncidentRoleSetter, incident0 *Incident) (IncidentRoleSlice, error) {
+	for i := range incidentRoles1 {
+		incidentRoles1[i].IncidentID = &incident0.ID
+	}
+
+	ret, err := IncidentRoles.Insert(bob.ToMods(incidentRoles1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertIncidentIncidentRoles0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachIncidentIncidentRoles0(ctx context.Context, exec bob.Executor, count int, incidentRoles1 IncidentRoleSlice, incident0 *Incident) (IncidentRoleSlice, error) {
+	setter := &IncidentRoleSetter{
+		IncidentID: &incident0.ID,
+	}
+
+	err := incidentRoles1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachIncidentIncidentRoles0: %w", err)
+	}
+
+	return incidentRoles1, nil
+}
+
+func (incident0 *Incident) InsertIncidentRoles(ctx context.Context, exec bob.Executor, related ...*IncidentRoleSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	incidentRoles1, err := insertIncidentIncidentRoles0(ctx, exec, related, incident0)
+	if err != nil {
+		return err
+	}
+
+	incident0.R.IncidentRoles = append(incident0.R.IncidentRoles, incidentRoles1...)
+
+	for _, rel := range incidentRoles1 {
+		rel.R.Incident = incident0
+	}
+	return nil
+}
+
+func (incident0 *Incident) AttachIncidentRoles(ctx context.Context, exec bob.Executor, related ...*IncidentRole) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	incidentRoles1 := IncidentRoleSlice(related)
+
+	_, err = attachIncidentIncidentRoles0(ctx, exec, len(related), incidentRoles1, incident0)
+	if err != nil {
+		return err
+	}
+
+	incident0.R.IncidentRoles = append(incident0.R.IncidentRoles, incidentRoles1...)
+
+	for _, rel := range related {
+		rel.R.Incident = incident0
+	}
+
+	return nil
+}
+
+func insertIncidentIncidentServices0(ctx context.Context, exec bob.Executor, incidentServices1 []*IncidentServiceSetter, incident0 *Incident) (IncidentServiceSlice, error) {
+	for i := range incidentServices1 {
+		incidentServices1[i].IncidentID = &incident0.ID
+	}
+
+	ret, err := IncidentServices.Insert(bob.ToMods(incidentServices1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertIncidentIncidentServices0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachIncidentIncidentServices0(ctx context.Context, exec bob.Executor, count int, incidentServices1 IncidentServiceSlice, incident0 *Incident) (IncidentServiceSlice, error) {
+	setter := &IncidentServiceSetter{
+		IncidentID: &incident0.ID,
+	}
+
+	err := incidentServices1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachIncidentIncidentServices0: %w", err)
+	}
+
+	return incidentServices1, nil
+}
+
+func (incident0 *Incident) InsertIncidentServices(ctx context.Context, exec bob.Executor, related ...*IncidentServiceSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	incidentServices1, err := insertIncidentIncidentServices0(ctx, exec, related, incident0)
+	if err != nil {
+		return err
+	}
+
+	incident0.R.IncidentServices = append(incident0.R.IncidentServices, incidentServices1...)
+
+	for _, rel := range incidentServices1 {
+		rel.R.Incident = incident0
+	}
+	return nil
+}
+
+func (incident0 *Incident) AttachIncidentServices(ctx context.Context, exec bob.Executor, related ...*IncidentService) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	incidentServices1 := IncidentServiceSlice(related)
+
+	_, err = attachIncidentIncidentServices0(ctx, exec, len(related), incidentServices1, incident0)
+	if err != nil {
+		return err
+	}
+
+	incident0.R.IncidentServices = append(incident0.R.IncidentServices, incidentServices1...)
+
+	for _, rel := range related {
+		rel.R.Incident = incident0
+	}
+
+	return nil
+}
+
+func insertIncidentIncidentSubscribers0(ctx context.Context, exec bob.Executor, incidentSubscribers1 []*IncidentSubscriberSetter, incident0 *Incident) (IncidentSubscriberSlice, error) {
+	for i := range incidentSubscribers1 {
+		incidentSubscribers1[i].IncidentID = &incident0.ID
+	}
+
+	ret, err := IncidentSubscribers.Insert(bob.ToMods(incidentSubscribers1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertIncidentIncidentSubscribers0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachIncidentIncidentSubscribers0(ctx context.Context, exec bob.Executor, count int, incidentSubscribers1 IncidentSubscriberSlice, incident0 *Incident) (IncidentSubscriberSlice, error) {
+	setter := &IncidentSubscriberSetter{
+		IncidentID: &incident0.ID,
+	}
+
+	err := incidentSubscribers1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachIncidentIncidentSubscribers0: %w", err)
+	}
+
+	return incidentSubscribers1, nil
+}
+
+func (incident0 *Incident) InsertIncidentSubscribers(ctx context.Context, exec bob.Executor, related ...*IncidentSubscriberSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	incidentSubscribers1, err := insertIncidentIncidentSubscribers0(ctx, exec, related, incident0)
+	if err != nil {
+		return err
+	}
+
+	incident0.R.IncidentSubscribers = append(incident0.R.IncidentSubscribers, incidentSubscribers1...)
+
+	for _, rel := range incidentSubscribers1 {
+		rel.R.Incident = incident0
+	}
+	return nil
+}
+
+func (incident0 *Incident) AttachIncidentSubscribers(ctx context.Context, exec bob.Executor, related ...*IncidentSubscriber) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	incidentSubscribers1 := IncidentSubscriberSlice(related)
+
+	_, err = attachIncidentIncidentSubscribers0(ctx, exec, len(related), incidentSubscribers1, incident0)
+	if err != nil {
+		return err
+	}
+
+	incident0.R.IncidentSubscribers = append(incident0.R.IncidentSubscribers, incidentSubscribers1...)
+
+	for _, rel := range related {
+		rel.R.Incident = incident0
+	}
+
+	return nil
+}
+
 func insertIncidentTimelineEvents0(ctx context.Context, exec bob.Executor, timelineEvents1 []*TimelineEventSetter, incident0 *Incident) (TimelineEventSlice, error) {
 	for i := range timelineEvents1 {
 		timelineEvents1[i].IncidentID = &incident0.ID
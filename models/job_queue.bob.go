@@ -0,0 +1,556 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/types"
+)
+
+// JobQueue is an object representing the database table.
+type JobQueue struct {
+	ID         int64                       `db:"id,pk" `
+	Kind       string                      `db:"kind" `
+	Payload    types.JSON[json.RawMessage] `db:"payload" `
+	RunAt      time.Time                   `db:"run_at" `
+	Attempts   int32                       `db:"attempts" `
+	LastError  sql.Null[string]            `db:"last_error" `
+	CreatedAt  time.Time                   `db:"created_at" `
+	FinishedAt sql.Null[time.Time]         `db:"finished_at" `
+}
+
+// JobQueueSlice is an alias for a slice of pointers to JobQueue.
+// This should almost always be used instead of []*JobQueue.
+type JobQueueSlice []*JobQueue
+
+// JobQueues contains methods to work with the job_queue table
+var JobQueues = psql.NewTablex[*JobQueue, JobQueueSlice, *JobQueueSetter]("", "job_queue")
+
+// JobQueuesQuery is a query on the job_queue table
+type JobQueuesQuery = *psql.ViewQuery[*JobQueue, JobQueueSlice]
+
+type jobQueueColumnNames struct {
+	ID         string
+	Kind       string
+	Payload    string
+	RunAt      string
+	Attempts   string
+	LastError  string
+	CreatedAt  string
+	FinishedAt string
+}
+
+var JobQueueColumns = buildJobQueueColumns("job_queue")
+
+type jobQueueColumns struct {
+	tableAlias string
+	ID         psql.Expression
+	Kind       psql.Expression
+	Payload    psql.Expression
+	RunAt      psql.Expression
+	Attempts   psql.Expression
+	LastError  psql.Expression
+	CreatedAt  psql.Expression
+	FinishedAt psql.Expression
+}
+
+func (c jobQueueColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (jobQueueColumns) AliasedAs(alias string) jobQueueColumns {
+	return buildJobQueueColumns(alias)
+}
+
+func buildJobQueueColumns(alias string) jobQueueColumns {
+	return jobQueueColumns{
+		tableAlias: alias,
+		ID:         psql.Quote(alias, "id"),
+		Kind:       psql.Quote(alias, "kind"),
+		Payload:    psql.Quote(alias, "payload"),
+		RunAt:      psql.Quote(alias, "run_at"),
+		Attempts:   psql.Quote(alias, "attempts"),
+		LastError:  psql.Quote(alias, "last_error"),
+		CreatedAt:  psql.Quote(alias, "created_at"),
+		FinishedAt: psql.Quote(alias, "finished_at"),
+	}
+}
+
+type jobQueueWhere[Q psql.Filterable] struct {
+	ID         psql.WhereMod[Q, int64]
+	Kind       psql.WhereMod[Q, string]
+	Payload    psql.WhereMod[Q, types.JSON[json.RawMessage]]
+	RunAt      psql.WhereMod[Q, time.Time]
+	Attempts   psql.WhereMod[Q, int32]
+	LastError  psql.WhereNullMod[Q, string]
+	CreatedAt  psql.WhereMod[Q, time.Time]
+	FinishedAt psql.WhereNullMod[Q, time.Time]
+}
+
+func (jobQueueWhere[Q]) AliasedAs(alias string) jobQueueWhere[Q] {
+	return buildJobQueueWhere[Q](buildJobQueueColumns(alias))
+}
+
+func buildJobQueueWhere[Q psql.Filterable](cols jobQueueColumns) jobQueueWhere[Q] {
+	return jobQueueWhere[Q]{
+		ID:         psql.Where[Q, int64](cols.ID),
+		Kind:       psql.Where[Q, string](cols.Kind),
+		Payload:    psql.Where[Q, types.JSON[json.RawMessage]](cols.Payload),
+		RunAt:      psql.Where[Q, time.Time](cols.RunAt),
+		Attempts:   psql.Where[Q, int32](cols.Attempts),
+		LastError:  psql.WhereNull[Q, string](cols.LastError),
+		CreatedAt:  psql.Where[Q, time.Time](cols.CreatedAt),
+		FinishedAt: psql.WhereNull[Q, time.Time](cols.FinishedAt),
+	}
+}
+
+var JobQueueErrors = &jobQueueErrors{
+	ErrUniqueJobQueuePkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "job_queue",
+		columns: []string{"id"},
+		s:       "job_queue_pkey",
+	},
+}
+
+type jobQueueErrors struct {
+	ErrUniqueJobQueuePkey *UniqueConstraintError
+}
+
+// JobQueueSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type JobQueueSetter struct {
+	ID         *int64                       `db:"id,pk" `
+	Kind       *string                      `db:"kind" `
+	Payload    *types.JSON[json.RawMessage] `db:"payload" `
+	RunAt      *time.Time                   `db:"run_at" `
+	Attempts   *int32                       `db:"attempts" `
+	LastError  *sql.Null[string]            `db:"last_error" `
+	CreatedAt  *time.Time                   `db:"created_at" `
+	FinishedAt *sql.Null[time.Time]         `db:"finished_at" `
+}
+
+func (s JobQueueSetter) SetColumns() []string {
+	vals := make([]string, 0, 8)
+	if s.ID != nil {
+		vals = append(vals, "id")
+	}
+
+	if s.Kind != nil {
+		vals = append(vals, "kind")
+	}
+
+	if s.Payload != nil {
+		vals = append(vals, "payload")
+	}
+
+	if s.RunAt != nil {
+		vals = append(vals, "run_at")
+	}
+
+	if s.Attempts != nil {
+		vals = append(vals, "attempts")
+	}
+
+	if s.LastError != nil {
+		vals = append(vals, "last_error")
+	}
+
+	if s.CreatedAt != nil {
+		vals = append(vals, "created_at")
+	}
+
+	if s.FinishedAt != nil {
+		vals = append(vals, "finished_at")
+	}
+
+	return vals
+}
+
+func (s JobQueueSetter) Overwrite(t *JobQueue) {
+	if s.ID != nil {
+		t.ID = *s.ID
+	}
+	if s.Kind != nil {
+		t.Kind = *s.Kind
+	}
+	if s.Payload != nil {
+		t.Payload = *s.Payload
+	}
+	if s.RunAt != nil {
+		t.RunAt = *s.RunAt
+	}
+	if s.Attempts != nil {
+		t.Attempts = *s.Attempts
+	}
+	if s.LastError != nil {
+		t.LastError = *s.LastError
+	}
+	if s.CreatedAt != nil {
+		t.CreatedAt = *s.CreatedAt
+	}
+	if s.FinishedAt != nil {
+		t.FinishedAt = *s.FinishedAt
+	}
+}
+
+func (s *JobQueueSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return JobQueues.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 8)
+		if s.ID != nil {
+			vals[0] = psql.Arg(*s.ID)
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.Kind != nil {
+			vals[1] = psql.Arg(*s.Kind)
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.Payload != nil {
+			vals[2] = psql.Arg(*s.Payload)
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.RunAt != nil {
+			vals[3] = psql.Arg(*s.RunAt)
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.Attempts != nil {
+			vals[4] = psql.Arg(*s.Attempts)
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.LastError != nil {
+			vals[5] = psql.Arg(*s.LastError)
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedAt != nil {
+			vals[6] = psql.Arg(*s.CreatedAt)
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		if s.FinishedAt != nil {
+			vals[7] = psql.Arg(*s.FinishedAt)
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s JobQueueSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s JobQueueSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 8)
+
+	if s.ID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.Kind != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "kind")...),
+			psql.Arg(s.Kind),
+		}})
+	}
+
+	if s.Payload != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "payload")...),
+			psql.Arg(s.Payload),
+		}})
+	}
+
+	if s.RunAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "run_at")...),
+			psql.Arg(s.RunAt),
+		}})
+	}
+
+	if s.Attempts != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "attempts")...),
+			psql.Arg(s.Attempts),
+		}})
+	}
+
+	if s.LastError != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "last_error")...),
+			psql.Arg(s.LastError),
+		}})
+	}
+
+	if s.CreatedAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	if s.FinishedAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "finished_at")...),
+			psql.Arg(s.FinishedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindJobQueue retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindJobQueue(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*JobQueue, error) {
+	if len(cols) == 0 {
+		return JobQueues.Query(
+			SelectWhere.JobQueues.ID.EQ(IDPK),
+		).One(ctx, exec)
+	}
+
+	return JobQueues.Query(
+		SelectWhere.JobQueues.ID.EQ(IDPK),
+		sm.Columns(JobQueues.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// JobQueueExists checks the presence of a single record by primary key
+func JobQueueExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return JobQueues.Query(
+		SelectWhere.JobQueues.ID.EQ(IDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after JobQueue is retrieved from the database
+func (o *JobQueue) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = JobQueues.AfterSelectHooks.RunHooks(ctx, exec, JobQueueSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = JobQueues.AfterInsertHooks.RunHooks(ctx, exec, JobQueueSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = JobQueues.AfterUpdateHooks.RunHooks(ctx, exec, JobQueueSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = JobQueues.AfterDeleteHooks.RunHooks(ctx, exec, JobQueueSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the JobQueue
+func (o *JobQueue) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *JobQueue) pkEQ() dialect.Expression {
+	return psql.Quote("job_queue", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the JobQueue
+func (o *JobQueue) Update(ctx context.Context, exec bob.Executor, s *JobQueueSetter) error {
+	v, err := JobQueues.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single JobQueue record with an executor
+func (o *JobQueue) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := JobQueues.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the JobQueue using the executor
+func (o *JobQueue) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := JobQueues.Query(
+		SelectWhere.JobQueues.ID.EQ(o.ID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after JobQueueSlice is retrieved from the database
+func (o JobQueueSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = JobQueues.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = JobQueues.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = JobQueues.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = JobQueues.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o JobQueueSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("job_queue", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o JobQueueSlice) copyMatchingRows(from ...*JobQueue) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o JobQueueSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return JobQueues.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *JobQueue:
+				o.copyMatchingRows(retrieved)
+			case []*JobQueue:
+				o.copyMatchingRows(retrieved...)
+			case JobQueueSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a JobQueue or a slice of JobQueue
+				// then run the AfterUpdateHooks on the slice
+				_, err = JobQueues.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o JobQueueSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return JobQueues.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *JobQueue:
+				o.copyMatchingRows(retrieved)
+			case []*JobQueue:
+				o.copyMatchingRows(retrieved...)
+			case JobQueueSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a JobQueue or a slice of JobQueue
+				// then run the AfterDeleteHooks on the slice
+				_, err = JobQueues.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o JobQueueSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals JobQueueSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := JobQueues.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o JobQueueSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := JobQueues.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o JobQueueSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := JobQueues.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
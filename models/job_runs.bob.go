@@ -0,0 +1,428 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+)
+
+// JobRun is an object representing the database table.
+type JobRun struct {
+	Job         string    `db:"job,pk" `
+	ScheduledAt time.Time `db:"scheduled_at,pk" `
+	FinishedAt  time.Time `db:"finished_at" `
+}
+
+// JobRunSlice is an alias for a slice of pointers to JobRun.
+// This should almost always be used instead of []*JobRun.
+type JobRunSlice []*JobRun
+
+// JobRuns contains methods to work with the job_runs table
+var JobRuns = psql.NewTablex[*JobRun, JobRunSlice, *JobRunSetter]("", "job_runs")
+
+// JobRunsQuery is a query on the job_runs table
+type JobRunsQuery = *psql.ViewQuery[*JobRun, JobRunSlice]
+
+type jobRunColumnNames struct {
+	Job         string
+	ScheduledAt string
+	FinishedAt  string
+}
+
+var JobRunColumns = buildJobRunColumns("job_runs")
+
+type jobRunColumns struct {
+	tableAlias  string
+	Job         psql.Expression
+	ScheduledAt psql.Expression
+	FinishedAt  psql.Expression
+}
+
+func (c jobRunColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (jobRunColumns) AliasedAs(alias string) jobRunColumns {
+	return buildJobRunColumns(alias)
+}
+
+func buildJobRunColumns(alias string) jobRunColumns {
+	return jobRunColumns{
+		tableAlias:  alias,
+		Job:         psql.Quote(alias, "job"),
+		ScheduledAt: psql.Quote(alias, "scheduled_at"),
+		FinishedAt:  psql.Quote(alias, "finished_at"),
+	}
+}
+
+type jobRunWhere[Q psql.Filterable] struct {
+	Job         psql.WhereMod[Q, string]
+	ScheduledAt psql.WhereMod[Q, time.Time]
+	FinishedAt  psql.WhereMod[Q, time.Time]
+}
+
+func (jobRunWhere[Q]) AliasedAs(alias string) jobRunWhere[Q] {
+	return buildJobRunWhere[Q](buildJobRunColumns(alias))
+}
+
+func buildJobRunWhere[Q psql.Filterable](cols jobRunColumns) jobRunWhere[Q] {
+	return jobRunWhere[Q]{
+		Job:         psql.Where[Q, string](cols.Job),
+		ScheduledAt: psql.Where[Q, time.Time](cols.ScheduledAt),
+		FinishedAt:  psql.Where[Q, time.Time](cols.FinishedAt),
+	}
+}
+
+var JobRunErrors = &jobRunErrors{
+	ErrUniqueJobRunsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "job_runs",
+		columns: []string{"job", "scheduled_at"},
+		s:       "job_runs_pkey",
+	},
+}
+
+type jobRunErrors struct {
+	ErrUniqueJobRunsPkey *UniqueConstraintError
+}
+
+// JobRunSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type JobRunSetter struct {
+	Job         *string    `db:"job,pk" `
+	ScheduledAt *time.Time `db:"scheduled_at,pk" `
+	FinishedAt  *time.Time `db:"finished_at" `
+}
+
+func (s JobRunSetter) SetColumns() []string {
+	vals := make([]string, 0, 3)
+	if s.Job != nil {
+		vals = append(vals, "job")
+	}
+
+	if s.ScheduledAt != nil {
+		vals = append(vals, "scheduled_at")
+	}
+
+	if s.FinishedAt != nil {
+		vals = append(vals, "finished_at")
+	}
+
+	return vals
+}
+
+func (s JobRunSetter) Overwrite(t *JobRun) {
+	if s.Job != nil {
+		t.Job = *s.Job
+	}
+	if s.ScheduledAt != nil {
+		t.ScheduledAt = *s.ScheduledAt
+	}
+	if s.FinishedAt != nil {
+		t.FinishedAt = *s.FinishedAt
+	}
+}
+
+func (s *JobRunSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return JobRuns.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 3)
+		if s.Job != nil {
+			vals[0] = psql.Arg(*s.Job)
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.ScheduledAt != nil {
+			vals[1] = psql.Arg(*s.ScheduledAt)
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.FinishedAt != nil {
+			vals[2] = psql.Arg(*s.FinishedAt)
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s JobRunSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s JobRunSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 3)
+
+	if s.Job != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "job")...),
+			psql.Arg(s.Job),
+		}})
+	}
+
+	if s.ScheduledAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "scheduled_at")...),
+			psql.Arg(s.ScheduledAt),
+		}})
+	}
+
+	if s.FinishedAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "finished_at")...),
+			psql.Arg(s.FinishedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindJobRun retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindJobRun(ctx context.Context, exec bob.Executor, JobPK string, ScheduledAtPK time.Time, cols ...string) (*JobRun, error) {
+	if len(cols) == 0 {
+		return JobRuns.Query(
+			SelectWhere.JobRuns.Job.EQ(JobPK),
+			SelectWhere.JobRuns.ScheduledAt.EQ(ScheduledAtPK),
+		).One(ctx, exec)
+	}
+
+	return JobRuns.Query(
+		SelectWhere.JobRuns.Job.EQ(JobPK),
+		SelectWhere.JobRuns.ScheduledAt.EQ(ScheduledAtPK),
+		sm.Columns(JobRuns.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// JobRunExists checks the presence of a single record by primary key
+func JobRunExists(ctx context.Context, exec bob.Executor, JobPK string, ScheduledAtPK time.Time) (bool, error) {
+	return JobRuns.Query(
+		SelectWhere.JobRuns.Job.EQ(JobPK),
+		SelectWhere.JobRuns.ScheduledAt.EQ(ScheduledAtPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after JobRun is retrieved from the database
+func (o *JobRun) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = JobRuns.AfterSelectHooks.RunHooks(ctx, exec, JobRunSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = JobRuns.AfterInsertHooks.RunHooks(ctx, exec, JobRunSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = JobRuns.AfterUpdateHooks.RunHooks(ctx, exec, JobRunSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = JobRuns.AfterDeleteHooks.RunHooks(ctx, exec, JobRunSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the JobRun
+func (o *JobRun) primaryKeyVals() bob.Expression {
+	return psql.ArgGroup(
+		o.Job,
+		o.ScheduledAt,
+	)
+}
+
+func (o *JobRun) pkEQ() dialect.Expression {
+	return psql.Group(psql.Quote("job_runs", "job"), psql.Quote("job_runs", "scheduled_at")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the JobRun
+func (o *JobRun) Update(ctx context.Context, exec bob.Executor, s *JobRunSetter) error {
+	v, err := JobRuns.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single JobRun record with an executor
+func (o *JobRun) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := JobRuns.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the JobRun using the executor
+func (o *JobRun) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := JobRuns.Query(
+		SelectWhere.JobRuns.Job.EQ(o.Job),
+		SelectWhere.JobRuns.ScheduledAt.EQ(o.ScheduledAt),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after JobRunSlice is retrieved from the database
+func (o JobRunSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = JobRuns.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = JobRuns.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = JobRuns.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = JobRuns.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o JobRunSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Group(psql.Quote("job_runs", "job"), psql.Quote("job_runs", "scheduled_at")).In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o JobRunSlice) copyMatchingRows(from ...*JobRun) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.Job != old.Job {
+				continue
+			}
+			if new.ScheduledAt.Equal(old.ScheduledAt) {
+				continue
+			}
+
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o JobRunSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return JobRuns.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *JobRun:
+				o.copyMatchingRows(retrieved)
+			case []*JobRun:
+				o.copyMatchingRows(retrieved...)
+			case JobRunSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a JobRun or a slice of JobRun
+				// then run the AfterUpdateHooks on the slice
+				_, err = JobRuns.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o JobRunSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return JobRuns.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *JobRun:
+				o.copyMatchingRows(retrieved)
+			case []*JobRun:
+				o.copyMatchingRows(retrieved...)
+			case JobRunSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a JobRun or a slice of JobRun
+				// then run the AfterDeleteHooks on the slice
+				_, err = JobRuns.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o JobRunSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals JobRunSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := JobRuns.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o JobRunSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := JobRuns.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o JobRunSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := JobRuns.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
@@ -0,0 +1,472 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+)
+
+// OncallHandoffNote is an object representing the database table.
+type OncallHandoffNote struct {
+	ID          int64     `db:"id,pk" `
+	Rotation    string    `db:"rotation" `
+	SlackUserID string    `db:"slack_user_id" `
+	Note        string    `db:"note" `
+	CreatedAt   time.Time `db:"created_at" `
+}
+
+// OncallHandoffNoteSlice is an alias for a slice of pointers to OncallHandoffNote.
+// This should almost always be used instead of []*OncallHandoffNote.
+type OncallHandoffNoteSlice []*OncallHandoffNote
+
+// OncallHandoffNotes contains methods to work with the oncall_handoff_notes table
+var OncallHandoffNotes = psql.NewTablex[*OncallHandoffNote, OncallHandoffNoteSlice, *OncallHandoffNoteSetter]("", "oncall_handoff_notes")
+
+// OncallHandoffNotesQuery is a query on the oncall_handoff_notes table
+type OncallHandoffNotesQuery = *psql.ViewQuery[*OncallHandoffNote, OncallHandoffNoteSlice]
+
+type oncallHandoffNoteColumnNames struct {
+	ID          string
+	Rotation    string
+	SlackUserID string
+	Note        string
+	CreatedAt   string
+}
+
+var OncallHandoffNoteColumns = buildOncallHandoffNoteColumns("oncall_handoff_notes")
+
+type oncallHandoffNoteColumns struct {
+	tableAlias  string
+	ID          psql.Expression
+	Rotation    psql.Expression
+	SlackUserID psql.Expression
+	Note        psql.Expression
+	CreatedAt   psql.Expression
+}
+
+func (c oncallHandoffNoteColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (oncallHandoffNoteColumns) AliasedAs(alias string) oncallHandoffNoteColumns {
+	return buildOncallHandoffNoteColumns(alias)
+}
+
+func buildOncallHandoffNoteColumns(alias string) oncallHandoffNoteColumns {
+	return oncallHandoffNoteColumns{
+		tableAlias:  alias,
+		ID:          psql.Quote(alias, "id"),
+		Rotation:    psql.Quote(alias, "rotation"),
+		SlackUserID: psql.Quote(alias, "slack_user_id"),
+		Note:        psql.Quote(alias, "note"),
+		CreatedAt:   psql.Quote(alias, "created_at"),
+	}
+}
+
+type oncallHandoffNoteWhere[Q psql.Filterable] struct {
+	ID          psql.WhereMod[Q, int64]
+	Rotation    psql.WhereMod[Q, string]
+	SlackUserID psql.WhereMod[Q, string]
+	Note        psql.WhereMod[Q, string]
+	CreatedAt   psql.WhereMod[Q, time.Time]
+}
+
+func (oncallHandoffNoteWhere[Q]) AliasedAs(alias string) oncallHandoffNoteWhere[Q] {
+	return buildOncallHandoffNoteWhere[Q](buildOncallHandoffNoteColumns(alias))
+}
+
+func buildOncallHandoffNoteWhere[Q psql.Filterable](cols oncallHandoffNoteColumns) oncallHandoffNoteWhere[Q] {
+	return oncallHandoffNoteWhere[Q]{
+		ID:          psql.Where[Q, int64](cols.ID),
+		Rotation:    psql.Where[Q, string](cols.Rotation),
+		SlackUserID: psql.Where[Q, string](cols.SlackUserID),
+		Note:        psql.Where[Q, string](cols.Note),
+		CreatedAt:   psql.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+var OncallHandoffNoteErrors = &oncallHandoffNoteErrors{
+	ErrUniqueOncallHandoffNotesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "oncall_handoff_notes",
+		columns: []string{"id"},
+		s:       "oncall_handoff_notes_pkey",
+	},
+}
+
+type oncallHandoffNoteErrors struct {
+	ErrUniqueOncallHandoffNotesPkey *UniqueConstraintError
+}
+
+// OncallHandoffNoteSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type OncallHandoffNoteSetter struct {
+	ID          *int64     `db:"id,pk" `
+	Rotation    *string    `db:"rotation" `
+	SlackUserID *string    `db:"slack_user_id" `
+	Note        *string    `db:"note" `
+	CreatedAt   *time.Time `db:"created_at" `
+}
+
+func (s OncallHandoffNoteSetter) SetColumns() []string {
+	vals := make([]string, 0, 5)
+	if s.ID != nil {
+		vals = append(vals, "id")
+	}
+
+	if s.Rotation != nil {
+		vals = append(vals, "rotation")
+	}
+
+	if s.SlackUserID != nil {
+		vals = append(vals, "slack_user_id")
+	}
+
+	if s.Note != nil {
+		vals = append(vals, "note")
+	}
+
+	if s.CreatedAt != nil {
+		vals = append(vals, "created_at")
+	}
+
+	return vals
+}
+
+func (s OncallHandoffNoteSetter) Overwrite(t *OncallHandoffNote) {
+	if s.ID != nil {
+		t.ID = *s.ID
+	}
+	if s.Rotation != nil {
+		t.Rotation = *s.Rotation
+	}
+	if s.SlackUserID != nil {
+		t.SlackUserID = *s.SlackUserID
+	}
+	if s.Note != nil {
+		t.Note = *s.Note
+	}
+	if s.CreatedAt != nil {
+		t.CreatedAt = *s.CreatedAt
+	}
+}
+
+func (s *OncallHandoffNoteSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return OncallHandoffNotes.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 5)
+		if s.ID != nil {
+			vals[0] = psql.Arg(*s.ID)
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.Rotation != nil {
+			vals[1] = psql.Arg(*s.Rotation)
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.SlackUserID != nil {
+			vals[2] = psql.Arg(*s.SlackUserID)
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.Note != nil {
+			vals[3] = psql.Arg(*s.Note)
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedAt != nil {
+			vals[4] = psql.Arg(*s.CreatedAt)
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s OncallHandoffNoteSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s OncallHandoffNoteSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 5)
+
+	if s.ID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.Rotation != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "rotation")...),
+			psql.Arg(s.Rotation),
+		}})
+	}
+
+	if s.SlackUserID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "slack_user_id")...),
+			psql.Arg(s.SlackUserID),
+		}})
+	}
+
+	if s.Note != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "note")...),
+			psql.Arg(s.Note),
+		}})
+	}
+
+	if s.CreatedAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindOncallHandoffNote retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindOncallHandoffNote(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*OncallHandoffNote, error) {
+	if len(cols) == 0 {
+		return OncallHandoffNotes.Query(
+			SelectWhere.OncallHandoffNotes.ID.EQ(IDPK),
+		).One(ctx, exec)
+	}
+
+	return OncallHandoffNotes.Query(
+		SelectWhere.OncallHandoffNotes.ID.EQ(IDPK),
+		sm.Columns(OncallHandoffNotes.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// OncallHandoffNoteExists checks the presence of a single record by primary key
+func OncallHandoffNoteExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return OncallHandoffNotes.Query(
+		SelectWhere.OncallHandoffNotes.ID.EQ(IDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after OncallHandoffNote is retrieved from the database
+func (o *OncallHandoffNote) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OncallHandoffNotes.AfterSelectHooks.RunHooks(ctx, exec, OncallHandoffNoteSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = OncallHandoffNotes.AfterInsertHooks.RunHooks(ctx, exec, OncallHandoffNoteSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = OncallHandoffNotes.AfterUpdateHooks.RunHooks(ctx, exec, OncallHandoffNoteSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = OncallHandoffNotes.AfterDeleteHooks.RunHooks(ctx, exec, OncallHandoffNoteSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the OncallHandoffNote
+func (o *OncallHandoffNote) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *OncallHandoffNote) pkEQ() dialect.Expression {
+	return psql.Quote("oncall_handoff_notes", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the OncallHandoffNote
+func (o *OncallHandoffNote) Update(ctx context.Context, exec bob.Executor, s *OncallHandoffNoteSetter) error {
+	v, err := OncallHandoffNotes.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single OncallHandoffNote record with an executor
+func (o *OncallHandoffNote) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := OncallHandoffNotes.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the OncallHandoffNote using the executor
+func (o *OncallHandoffNote) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := OncallHandoffNotes.Query(
+		SelectWhere.OncallHandoffNotes.ID.EQ(o.ID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after OncallHandoffNoteSlice is retrieved from the database
+func (o OncallHandoffNoteSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OncallHandoffNotes.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = OncallHandoffNotes.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = OncallHandoffNotes.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = OncallHandoffNotes.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o OncallHandoffNoteSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("oncall_handoff_notes", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o OncallHandoffNoteSlice) copyMatchingRows(from ...*OncallHandoffNote) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o OncallHandoffNoteSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OncallHandoffNotes.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OncallHandoffNote:
+				o.copyMatchingRows(retrieved)
+			case []*OncallHandoffNote:
+				o.copyMatchingRows(retrieved...)
+			case OncallHandoffNoteSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OncallHandoffNote or a slice of OncallHandoffNote
+				// then run the AfterUpdateHooks on the slice
+				_, err = OncallHandoffNotes.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o OncallHandoffNoteSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OncallHandoffNotes.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OncallHandoffNote:
+				o.copyMatchingRows(retrieved)
+			case []*OncallHandoffNote:
+				o.copyMatchingRows(retrieved...)
+			case OncallHandoffNoteSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OncallHandoffNote or a slice of OncallHandoffNote
+				// then run the AfterDeleteHooks on the slice
+				_, err = OncallHandoffNotes.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o OncallHandoffNoteSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals OncallHandoffNoteSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OncallHandoffNotes.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o OncallHandoffNoteSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OncallHandoffNotes.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o OncallHandoffNoteSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := OncallHandoffNotes.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
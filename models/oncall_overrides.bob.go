@@ -0,0 +1,750 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// OncallOverride is an object representing the database table.
+type OncallOverride struct {
+	ID          int64     `db:"id,pk" `
+	ScheduleID  uuid.UUID `db:"schedule_id" `
+	SlackUserID string    `db:"slack_user_id" `
+	StartsAt    time.Time `db:"starts_at" `
+	EndsAt      time.Time `db:"ends_at" `
+	CreatedBy   string    `db:"created_by" `
+	CreatedAt   time.Time `db:"created_at" `
+
+	R oncallOverrideR `db:"-" `
+}
+
+// OncallOverrideSlice is an alias for a slice of pointers to OncallOverride.
+// This should almost always be used instead of []*OncallOverride.
+type OncallOverrideSlice []*OncallOverride
+
+// OncallOverrides contains methods to work with the oncall_overrides table
+var OncallOverrides = psql.NewTablex[*OncallOverride, OncallOverrideSlice, *OncallOverrideSetter]("", "oncall_overrides")
+
+// OncallOverridesQuery is a query on the oncall_overrides table
+type OncallOverridesQuery = *psql.ViewQuery[*OncallOverride, OncallOverrideSlice]
+
+// oncallOverrideR is where relationships are stored.
+type oncallOverrideR struct {
+	ScheduleOncallSchedule *OncallSchedule // oncall_overrides.oncall_overrides_schedule_id_fkey
+}
+
+type oncallOverrideColumnNames struct {
+	ID          string
+	ScheduleID  string
+	SlackUserID string
+	StartsAt    string
+	EndsAt      string
+	CreatedBy   string
+	CreatedAt   string
+}
+
+var OncallOverrideColumns = buildOncallOverrideColumns("oncall_overrides")
+
+type oncallOverrideColumns struct {
+	tableAlias  string
+	ID          psql.Expression
+	ScheduleID  psql.Expression
+	SlackUserID psql.Expression
+	StartsAt    psql.Expression
+	EndsAt      psql.Expression
+	CreatedBy   psql.Expression
+	CreatedAt   psql.Expression
+}
+
+func (c oncallOverrideColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (oncallOverrideColumns) AliasedAs(alias string) oncallOverrideColumns {
+	return buildOncallOverrideColumns(alias)
+}
+
+func buildOncallOverrideColumns(alias string) oncallOverrideColumns {
+	return oncallOverrideColumns{
+		tableAlias:  alias,
+		ID:          psql.Quote(alias, "id"),
+		ScheduleID:  psql.Quote(alias, "schedule_id"),
+		SlackUserID: psql.Quote(alias, "slack_user_id"),
+		StartsAt:    psql.Quote(alias, "starts_at"),
+		EndsAt:      psql.Quote(alias, "ends_at"),
+		CreatedBy:   psql.Quote(alias, "created_by"),
+		CreatedAt:   psql.Quote(alias, "created_at"),
+	}
+}
+
+type oncallOverrideWhere[Q psql.Filterable] struct {
+	ID          psql.WhereMod[Q, int64]
+	ScheduleID  psql.WhereMod[Q, uuid.UUID]
+	SlackUserID psql.WhereMod[Q, string]
+	StartsAt    psql.WhereMod[Q, time.Time]
+	EndsAt      psql.WhereMod[Q, time.Time]
+	CreatedBy   psql.WhereMod[Q, string]
+	CreatedAt   psql.WhereMod[Q, time.Time]
+}
+
+func (oncallOverrideWhere[Q]) AliasedAs(alias string) oncallOverrideWhere[Q] {
+	return buildOncallOverrideWhere[Q](buildOncallOverrideColumns(alias))
+}
+
+func buildOncallOverrideWhere[Q psql.Filterable](cols oncallOverrideColumns) oncallOverrideWhere[Q] {
+	return oncallOverrideWhere[Q]{
+		ID:          psql.Where[Q, int64](cols.ID),
+		ScheduleID:  psql.Where[Q, uuid.UUID](cols.ScheduleID),
+		SlackUserID: psql.Where[Q, string](cols.SlackUserID),
+		StartsAt:    psql.Where[Q, time.Time](cols.StartsAt),
+		EndsAt:      psql.Where[Q, time.Time](cols.EndsAt),
+		CreatedBy:   psql.Where[Q, string](cols.CreatedBy),
+		CreatedAt:   psql.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+var OncallOverrideErrors = &oncallOverrideErrors{
+	ErrUniqueOncallOverridesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "oncall_overrides",
+		columns: []string{"id"},
+		s:       "oncall_overrides_pkey",
+	},
+}
+
+type oncallOverrideErrors struct {
+	ErrUniqueOncallOverridesPkey *UniqueConstraintError
+}
+
+// OncallOverrideSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type OncallOverrideSetter struct {
+	ID          *int64     `db:"id,pk" `
+	ScheduleID  *uuid.UUID `db:"schedule_id" `
+	SlackUserID *string    `db:"slack_user_id" `
+	StartsAt    *time.Time `db:"starts_at" `
+	EndsAt      *time.Time `db:"ends_at" `
+	CreatedBy   *string    `db:"created_by" `
+	CreatedAt   *time.Time `db:"created_at" `
+}
+
+func (s OncallOverrideSetter) SetColumns() []string {
+	vals := make([]string, 0, 7)
+	if s.ID != nil {
+		vals = append(vals, "id")
+	}
+
+	if s.ScheduleID != nil {
+		vals = append(vals, "schedule_id")
+	}
+
+	if s.SlackUserID != nil {
+		vals = append(vals, "slack_user_id")
+	}
+
+	if s.StartsAt != nil {
+		vals = append(vals, "starts_at")
+	}
+
+	if s.EndsAt != nil {
+		vals = append(vals, "ends_at")
+	}
+
+	if s.CreatedBy != nil {
+		vals = append(vals, "created_by")
+	}
+
+	if s.CreatedAt != nil {
+		vals = append(vals, "created_at")
+	}
+
+	return vals
+}
+
+func (s OncallOverrideSetter) Overwrite(t *OncallOverride) {
+	if s.ID != nil {
+		t.ID = *s.ID
+	}
+	if s.ScheduleID != nil {
+		t.ScheduleID = *s.ScheduleID
+	}
+	if s.SlackUserID != nil {
+		t.SlackUserID = *s.SlackUserID
+	}
+	if s.StartsAt != nil {
+		t.StartsAt = *s.StartsAt
+	}
+	if s.EndsAt != nil {
+		t.EndsAt = *s.EndsAt
+	}
+	if s.CreatedBy != nil {
+		t.CreatedBy = *s.CreatedBy
+	}
+	if s.CreatedAt != nil {
+		t.CreatedAt = *s.CreatedAt
+	}
+}
+
+func (s *OncallOverrideSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return OncallOverrides.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 7)
+		if s.ID != nil {
+			vals[0] = psql.Arg(*s.ID)
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.ScheduleID != nil {
+			vals[1] = psql.Arg(*s.ScheduleID)
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.SlackUserID != nil {
+			vals[2] = psql.Arg(*s.SlackUserID)
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.StartsAt != nil {
+			vals[3] = psql.Arg(*s.StartsAt)
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.EndsAt != nil {
+			vals[4] = psql.Arg(*s.EndsAt)
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedBy != nil {
+			vals[5] = psql.Arg(*s.CreatedBy)
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedAt != nil {
+			vals[6] = psql.Arg(*s.CreatedAt)
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s OncallOverrideSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s OncallOverrideSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 7)
+
+	if s.ID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.ScheduleID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "schedule_id")...),
+			psql.Arg(s.ScheduleID),
+		}})
+	}
+
+	if s.SlackUserID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "slack_user_id")...),
+			psql.Arg(s.SlackUserID),
+		}})
+	}
+
+	if s.StartsAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "starts_at")...),
+			psql.Arg(s.StartsAt),
+		}})
+	}
+
+	if s.EndsAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "ends_at")...),
+			psql.Arg(s.EndsAt),
+		}})
+	}
+
+	if s.CreatedBy != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_by")...),
+			psql.Arg(s.CreatedBy),
+		}})
+	}
+
+	if s.CreatedAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindOncallOverride retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindOncallOverride(ctx context.Context, exec bob.Executor, IDPK int64, cols ...string) (*OncallOverride, error) {
+	if len(cols) == 0 {
+		return OncallOverrides.Query(
+			SelectWhere.OncallOverrides.ID.EQ(IDPK),
+		).One(ctx, exec)
+	}
+
+	return OncallOverrides.Query(
+		SelectWhere.OncallOverrides.ID.EQ(IDPK),
+		sm.Columns(OncallOverrides.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// OncallOverrideExists checks the presence of a single record by primary key
+func OncallOverrideExists(ctx context.Context, exec bob.Executor, IDPK int64) (bool, error) {
+	return OncallOverrides.Query(
+		SelectWhere.OncallOverrides.ID.EQ(IDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after OncallOverride is retrieved from the database
+func (o *OncallOverride) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OncallOverrides.AfterSelectHooks.RunHooks(ctx, exec, OncallOverrideSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = OncallOverrides.AfterInsertHooks.RunHooks(ctx, exec, OncallOverrideSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = OncallOverrides.AfterUpdateHooks.RunHooks(ctx, exec, OncallOverrideSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = OncallOverrides.AfterDeleteHooks.RunHooks(ctx, exec, OncallOverrideSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the OncallOverride
+func (o *OncallOverride) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *OncallOverride) pkEQ() dialect.Expression {
+	return psql.Quote("oncall_overrides", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the OncallOverride
+func (o *OncallOverride) Update(ctx context.Context, exec bob.Executor, s *OncallOverrideSetter) error {
+	v, err := OncallOverrides.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single OncallOverride record with an executor
+func (o *OncallOverride) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := OncallOverrides.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the OncallOverride using the executor
+func (o *OncallOverride) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := OncallOverrides.Query(
+		SelectWhere.OncallOverrides.ID.EQ(o.ID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after OncallOverrideSlice is retrieved from the database
+func (o OncallOverrideSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OncallOverrides.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = OncallOverrides.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = OncallOverrides.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = OncallOverrides.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o OncallOverrideSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("oncall_overrides", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o OncallOverrideSlice) copyMatchingRows(from ...*OncallOverride) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o OncallOverrideSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OncallOverrides.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OncallOverride:
+				o.copyMatchingRows(retrieved)
+			case []*OncallOverride:
+				o.copyMatchingRows(retrieved...)
+			case OncallOverrideSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OncallOverride or a slice of OncallOverride
+				// then run the AfterUpdateHooks on the slice
+				_, err = OncallOverrides.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o OncallOverrideSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OncallOverrides.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OncallOverride:
+				o.copyMatchingRows(retrieved)
+			case []*OncallOverride:
+				o.copyMatchingRows(retrieved...)
+			case OncallOverrideSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OncallOverride or a slice of OncallOverride
+				// then run the AfterDeleteHooks on the slice
+				_, err = OncallOverrides.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o OncallOverrideSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals OncallOverrideSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OncallOverrides.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o OncallOverrideSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OncallOverrides.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o OncallOverrideSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := OncallOverrides.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type oncallOverrideJoins[Q dialect.Joinable] struct {
+	typ                    string
+	ScheduleOncallSchedule modAs[Q, oncallScheduleColumns]
+}
+
+func (j oncallOverrideJoins[Q]) aliasedAs(alias string) oncallOverrideJoins[Q] {
+	return buildOncallOverrideJoins[Q](buildOncallOverrideColumns(alias), j.typ)
+}
+
+func buildOncallOverrideJoins[Q dialect.Joinable](cols oncallOverrideColumns, typ string) oncallOverrideJoins[Q] {
+	return oncallOverrideJoins[Q]{
+		typ: typ,
+		ScheduleOncallSchedule: modAs[Q, oncallScheduleColumns]{
+			c: OncallScheduleColumns,
+			f: func(to oncallScheduleColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, OncallSchedules.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.ScheduleID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
+
+// ScheduleOncallSchedule starts a query for related objects on oncall_schedules
+func (o *OncallOverride) ScheduleOncallSchedule(mods ...bob.Mod[*dialect.SelectQuery]) OncallSchedulesQuery {
+	return OncallSchedules.Query(append(mods,
+		sm.Where(OncallScheduleColumns.ID.EQ(psql.Arg(o.ScheduleID))),
+	)...)
+}
+
+func (os OncallOverrideSlice) ScheduleOncallSchedule(mods ...bob.Mod[*dialect.SelectQuery]) OncallSchedulesQuery {
+	pkScheduleID := make(pgtypes.Array[uuid.UUID], len(os))
+	for i, o := range os {
+		pkScheduleID[i] = o.ScheduleID
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkScheduleID), "uuid[]")),
+	))
+
+	return OncallSchedules.Query(append(mods,
+		sm.Where(psql.Group(OncallScheduleColumns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func (o *OncallOverride) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "ScheduleOncallSchedule":
+		rel, ok := retrieved.(*OncallSchedule)
+		if !ok {
+			return fmt.Errorf("oncallOverride cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ScheduleOncallSchedule = rel
+
+		if rel != nil {
+			rel.R.ScheduleOncallOverrides = OncallOverrideSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("oncallOverride has no relationship %q", name)
+	}
+}
+
+type oncallOverridePreloader struct {
+	ScheduleOncallSchedule func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildOncallOverridePreloader() oncallOverridePreloader {
+	return oncallOverridePreloader{
+		ScheduleOncallSchedule: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*OncallSchedule, OncallScheduleSlice](orm.Relationship{
+				Name: "ScheduleOncallSchedule",
+				Sides: []orm.RelSide{
+					{
+						From: TableNames.OncallOverrides,
+						To:   TableNames.OncallSchedules,
+						FromColumns: []string{
+							ColumnNames.OncallOverrides.ScheduleID,
+						},
+						ToColumns: []string{
+							ColumnNames.OncallSchedules.ID,
+						},
+					},
+				},
+			}, OncallSchedules.Columns().Names(), opts...)
+		},
+	}
+}
+
+type oncallOverrideThenLoader[Q orm.Loadable] struct {
+	ScheduleOncallSchedule func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildOncallOverrideThenLoader[Q orm.Loadable]() oncallOverrideThenLoader[Q] {
+	type ScheduleOncallScheduleLoadInterface interface {
+		LoadScheduleOncallSchedule(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return oncallOverrideThenLoader[Q]{
+		ScheduleOncallSchedule: thenLoadBuilder[Q](
+			"ScheduleOncallSchedule",
+			func(ctx context.Context, exec bob.Executor, retrieved ScheduleOncallScheduleLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadScheduleOncallSchedule(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadScheduleOncallSchedule loads the oncallOverride's ScheduleOncallSchedule into the .R struct
+func (o *OncallOverride) LoadScheduleOncallSchedule(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ScheduleOncallSchedule = nil
+
+	related, err := o.ScheduleOncallSchedule(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.ScheduleOncallOverrides = OncallOverrideSlice{o}
+
+	o.R.ScheduleOncallSchedule = related
+	return nil
+}
+
+// LoadScheduleOncallSchedule loads the oncallOverride's ScheduleOncallSchedule into the .R struct
+func (os OncallOverrideSlice) LoadScheduleOncallSchedule(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	oncallSchedules, err := os.ScheduleOncallSchedule(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		for _, rel := range oncallSchedules {
+			if o.ScheduleID != rel.ID {
+				continue
+			}
+
+			rel.R.ScheduleOncallOverrides = append(rel.R.ScheduleOncallOverrides, o)
+
+			o.R.ScheduleOncallSchedule = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+func attachOncallOverrideScheduleOncallSchedule0(ctx context.Context, exec bob.Executor, count int, oncallOverride0 *OncallOverride, oncallSchedule1 *OncallSchedule) (*OncallOverride, error) {
+	setter := &OncallOverrideSetter{
+		ScheduleID: &oncallSchedule1.ID,
+	}
+
+	err := oncallOverride0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOncallOverrideScheduleOncallSchedule0: %w", err)
+	}
+
+	return oncallOverride0, nil
+}
+
+func (oncallOverride0 *OncallOverride) InsertScheduleOncallSchedule(ctx context.Context, exec bob.Executor, related *OncallScheduleSetter) error {
+	oncallSchedule1, err := OncallSchedules.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachOncallOverrideScheduleOncallSchedule0(ctx, exec, 1, oncallOverride0, oncallSchedule1)
+	if err != nil {
+		return err
+	}
+
+	oncallOverride0.R.ScheduleOncallSchedule = oncallSchedule1
+
+	oncallSchedule1.R.ScheduleOncallOverrides = append(oncallSchedule1.R.ScheduleOncallOverrides, oncallOverride0)
+
+	return nil
+}
+
+func (oncallOverride0 *OncallOverride) AttachScheduleOncallSchedule(ctx context.Context, exec bob.Executor, oncallSchedule1 *OncallSchedule) error {
+	var err error
+
+	_, err = attachOncallOverrideScheduleOncallSchedule0(ctx, exec, 1, oncallOverride0, oncallSchedule1)
+	if err != nil {
+		return err
+	}
+
+	oncallOverride0.R.ScheduleOncallSchedule = oncallSchedule1
+
+	oncallSchedule1.R.ScheduleOncallOverrides = append(oncallSchedule1.R.ScheduleOncallOverrides, oncallOverride0)
+
+	return nil
+}
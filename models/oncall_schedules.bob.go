@@ -0,0 +1,823 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/lib/pq"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// OncallSchedule is an object representing the database table.
+type OncallSchedule struct {
+	ID           uuid.UUID      `db:"id,pk" `
+	Name         string         `db:"name" `
+	Rotation     string         `db:"rotation" `
+	Participants pq.StringArray `db:"participants" `
+	HandoffTime  string         `db:"handoff_time" `
+	Timezone     string         `db:"timezone" `
+	StartDate    time.Time      `db:"start_date" `
+	CreatedBy    string         `db:"created_by" `
+	CreatedAt    time.Time      `db:"created_at" `
+
+	R oncallScheduleR `db:"-" `
+}
+
+// OncallScheduleSlice is an alias for a slice of pointers to OncallSchedule.
+// This should almost always be used instead of []*OncallSchedule.
+type OncallScheduleSlice []*OncallSchedule
+
+// OncallSchedules contains methods to work with the oncall_schedules table
+var OncallSchedules = psql.NewTablex[*OncallSchedule, OncallScheduleSlice, *OncallScheduleSetter]("", "oncall_schedules")
+
+// OncallSchedulesQuery is a query on the oncall_schedules table
+type OncallSchedulesQuery = *psql.ViewQuery[*OncallSchedule, OncallScheduleSlice]
+
+// oncallScheduleR is where relationships are stored.
+type oncallScheduleR struct {
+	ScheduleOncallOverrides OncallOverrideSlice // oncall_overrides.oncall_overrides_schedule_id_fkey
+}
+
+type oncallScheduleColumnNames struct {
+	ID           string
+	Name         string
+	Rotation     string
+	Participants string
+	HandoffTime  string
+	Timezone     string
+	StartDate    string
+	CreatedBy    string
+	CreatedAt    string
+}
+
+var OncallScheduleColumns = buildOncallScheduleColumns("oncall_schedules")
+
+type oncallScheduleColumns struct {
+	tableAlias   string
+	ID           psql.Expression
+	Name         psql.Expression
+	Rotation     psql.Expression
+	Participants psql.Expression
+	HandoffTime  psql.Expression
+	Timezone     psql.Expression
+	StartDate    psql.Expression
+	CreatedBy    psql.Expression
+	CreatedAt    psql.Expression
+}
+
+func (c oncallScheduleColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (oncallScheduleColumns) AliasedAs(alias string) oncallScheduleColumns {
+	return buildOncallScheduleColumns(alias)
+}
+
+func buildOncallScheduleColumns(alias string) oncallScheduleColumns {
+	return oncallScheduleColumns{
+		tableAlias:   alias,
+		ID:           psql.Quote(alias, "id"),
+		Name:         psql.Quote(alias, "name"),
+		Rotation:     psql.Quote(alias, "rotation"),
+		Participants: psql.Quote(alias, "participants"),
+		HandoffTime:  psql.Quote(alias, "handoff_time"),
+		Timezone:     psql.Quote(alias, "timezone"),
+		StartDate:    psql.Quote(alias, "start_date"),
+		CreatedBy:    psql.Quote(alias, "created_by"),
+		CreatedAt:    psql.Quote(alias, "created_at"),
+	}
+}
+
+type oncallScheduleWhere[Q psql.Filterable] struct {
+	ID           psql.WhereMod[Q, uuid.UUID]
+	Name         psql.WhereMod[Q, string]
+	Rotation     psql.WhereMod[Q, string]
+	Participants psql.WhereMod[Q, pq.StringArray]
+	HandoffTime  psql.WhereMod[Q, string]
+	Timezone     psql.WhereMod[Q, string]
+	StartDate    psql.WhereMod[Q, time.Time]
+	CreatedBy    psql.WhereMod[Q, string]
+	CreatedAt    psql.WhereMod[Q, time.Time]
+}
+
+func (oncallScheduleWhere[Q]) AliasedAs(alias string) oncallScheduleWhere[Q] {
+	return buildOncallScheduleWhere[Q](buildOncallScheduleColumns(alias))
+}
+
+func buildOncallScheduleWhere[Q psql.Filterable](cols oncallScheduleColumns) oncallScheduleWhere[Q] {
+	return oncallScheduleWhere[Q]{
+		ID:           psql.Where[Q, uuid.UUID](cols.ID),
+		Name:         psql.Where[Q, string](cols.Name),
+		Rotation:     psql.Where[Q, string](cols.Rotation),
+		Participants: psql.Where[Q, pq.StringArray](cols.Participants),
+		HandoffTime:  psql.Where[Q, string](cols.HandoffTime),
+		Timezone:     psql.Where[Q, string](cols.Timezone),
+		StartDate:    psql.Where[Q, time.Time](cols.StartDate),
+		CreatedBy:    psql.Where[Q, string](cols.CreatedBy),
+		CreatedAt:    psql.Where[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+var OncallScheduleErrors = &oncallScheduleErrors{
+	ErrUniqueOncallSchedulesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "oncall_schedules",
+		columns: []string{"id"},
+		s:       "oncall_schedules_pkey",
+	},
+
+	ErrUniqueOncallSchedulesNameKey: &UniqueConstraintError{
+		schema:  "",
+		table:   "oncall_schedules",
+		columns: []string{"name"},
+		s:       "oncall_schedules_name_key",
+	},
+}
+
+type oncallScheduleErrors struct {
+	ErrUniqueOncallSchedulesPkey *UniqueConstraintError
+
+	ErrUniqueOncallSchedulesNameKey *UniqueConstraintError
+}
+
+// OncallScheduleSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type OncallScheduleSetter struct {
+	ID           *uuid.UUID      `db:"id,pk" `
+	Name         *string         `db:"name" `
+	Rotation     *string         `db:"rotation" `
+	Participants *pq.StringArray `db:"participants" `
+	HandoffTime  *string         `db:"handoff_time" `
+	Timezone     *string         `db:"timezone" `
+	StartDate    *time.Time      `db:"start_date" `
+	CreatedBy    *string         `db:"created_by" `
+	CreatedAt    *time.Time      `db:"created_at" `
+}
+
+func (s OncallScheduleSetter) SetColumns() []string {
+	vals := make([]string, 0, 9)
+	if s.ID != nil {
+		vals = append(vals, "id")
+	}
+
+	if s.Name != nil {
+		vals = append(vals, "name")
+	}
+
+	if s.Rotation != nil {
+		vals = append(vals, "rotation")
+	}
+
+	if s.Participants != nil {
+		vals = append(vals, "participants")
+	}
+
+	if s.HandoffTime != nil {
+		vals = append(vals, "handoff_time")
+	}
+
+	if s.Timezone != nil {
+		vals = append(vals, "timezone")
+	}
+
+	if s.StartDate != nil {
+		vals = append(vals, "start_date")
+	}
+
+	if s.CreatedBy != nil {
+		vals = append(vals, "created_by")
+	}
+
+	if s.CreatedAt != nil {
+		vals = append(vals, "created_at")
+	}
+
+	return vals
+}
+
+func (s OncallScheduleSetter) Overwrite(t *OncallSchedule) {
+	if s.ID != nil {
+		t.ID = *s.ID
+	}
+	if s.Name != nil {
+		t.Name = *s.Name
+	}
+	if s.Rotation != nil {
+		t.Rotation = *s.Rotation
+	}
+	if s.Participants != nil {
+		t.Participants = *s.Participants
+	}
+	if s.HandoffTime != nil {
+		t.HandoffTime = *s.HandoffTime
+	}
+	if s.Timezone != nil {
+		t.Timezone = *s.Timezone
+	}
+	if s.StartDate != nil {
+		t.StartDate = *s.StartDate
+	}
+	if s.CreatedBy != nil {
+		t.CreatedBy = *s.CreatedBy
+	}
+	if s.CreatedAt != nil {
+		t.CreatedAt = *s.CreatedAt
+	}
+}
+
+func (s *OncallScheduleSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return OncallSchedules.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 9)
+		if s.ID != nil {
+			vals[0] = psql.Arg(*s.ID)
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.Name != nil {
+			vals[1] = psql.Arg(*s.Name)
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.Rotation != nil {
+			vals[2] = psql.Arg(*s.Rotation)
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.Participants != nil {
+			vals[3] = psql.Arg(*s.Participants)
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.HandoffTime != nil {
+			vals[4] = psql.Arg(*s.HandoffTime)
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.Timezone != nil {
+			vals[5] = psql.Arg(*s.Timezone)
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if s.StartDate != nil {
+			vals[6] = psql.Arg(*s.StartDate)
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedBy != nil {
+			vals[7] = psql.Arg(*s.CreatedBy)
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedAt != nil {
+			vals[8] = psql.Arg(*s.CreatedAt)
+		} else {
+			vals[8] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s OncallScheduleSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s OncallScheduleSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 9)
+
+	if s.ID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.Name != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "name")...),
+			psql.Arg(s.Name),
+		}})
+	}
+
+	if s.Rotation != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "rotation")...),
+			psql.Arg(s.Rotation),
+		}})
+	}
+
+	if s.Participants != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "participants")...),
+			psql.Arg(s.Participants),
+		}})
+	}
+
+	if s.HandoffTime != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "handoff_time")...),
+			psql.Arg(s.HandoffTime),
+		}})
+	}
+
+	if s.Timezone != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "timezone")...),
+			psql.Arg(s.Timezone),
+		}})
+	}
+
+	if s.StartDate != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "start_date")...),
+			psql.Arg(s.StartDate),
+		}})
+	}
+
+	if s.CreatedBy != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_by")...),
+			psql.Arg(s.CreatedBy),
+		}})
+	}
+
+	if s.CreatedAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindOncallSchedule retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindOncallSchedule(ctx context.Context, exec bob.Executor, IDPK uuid.UUID, cols ...string) (*OncallSchedule, error) {
+	if len(cols) == 0 {
+		return OncallSchedules.Query(
+			SelectWhere.OncallSchedules.ID.EQ(IDPK),
+		).One(ctx, exec)
+	}
+
+	return OncallSchedules.Query(
+		SelectWhere.OncallSchedules.ID.EQ(IDPK),
+		sm.Columns(OncallSchedules.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// OncallScheduleExists checks the presence of a single record by primary key
+func OncallScheduleExists(ctx context.Context, exec bob.Executor, IDPK uuid.UUID) (bool, error) {
+	return OncallSchedules.Query(
+		SelectWhere.OncallSchedules.ID.EQ(IDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after OncallSchedule is retrieved from the database
+func (o *OncallSchedule) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OncallSchedules.AfterSelectHooks.RunHooks(ctx, exec, OncallScheduleSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = OncallSchedules.AfterInsertHooks.RunHooks(ctx, exec, OncallScheduleSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = OncallSchedules.AfterUpdateHooks.RunHooks(ctx, exec, OncallScheduleSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = OncallSchedules.AfterDeleteHooks.RunHooks(ctx, exec, OncallScheduleSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the OncallSchedule
+func (o *OncallSchedule) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *OncallSchedule) pkEQ() dialect.Expression {
+	return psql.Quote("oncall_schedules", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the OncallSchedule
+func (o *OncallSchedule) Update(ctx context.Context, exec bob.Executor, s *OncallScheduleSetter) error {
+	v, err := OncallSchedules.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single OncallSchedule record with an executor
+func (o *OncallSchedule) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := OncallSchedules.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the OncallSchedule using the executor
+func (o *OncallSchedule) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := OncallSchedules.Query(
+		SelectWhere.OncallSchedules.ID.EQ(o.ID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after OncallScheduleSlice is retrieved from the database
+func (o OncallScheduleSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = OncallSchedules.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = OncallSchedules.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = OncallSchedules.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = OncallSchedules.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o OncallScheduleSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("oncall_schedules", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o OncallScheduleSlice) copyMatchingRows(from ...*OncallSchedule) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o OncallScheduleSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OncallSchedules.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OncallSchedule:
+				o.copyMatchingRows(retrieved)
+			case []*OncallSchedule:
+				o.copyMatchingRows(retrieved...)
+			case OncallScheduleSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OncallSchedule or a slice of OncallSchedule
+				// then run the AfterUpdateHooks on the slice
+				_, err = OncallSchedules.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o OncallScheduleSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return OncallSchedules.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *OncallSchedule:
+				o.copyMatchingRows(retrieved)
+			case []*OncallSchedule:
+				o.copyMatchingRows(retrieved...)
+			case OncallScheduleSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a OncallSchedule or a slice of OncallSchedule
+				// then run the AfterDeleteHooks on the slice
+				_, err = OncallSchedules.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o OncallScheduleSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals OncallScheduleSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OncallSchedules.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o OncallScheduleSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := OncallSchedules.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o OncallScheduleSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := OncallSchedules.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+type oncallScheduleJoins[Q dialect.Joinable] struct {
+	typ                     string
+	ScheduleOncallOverrides modAs[Q, oncallOverrideColumns]
+}
+
+func (j oncallScheduleJoins[Q]) aliasedAs(alias string) oncallScheduleJoins[Q] {
+	return buildOncallScheduleJoins[Q](buildOncallScheduleColumns(alias), j.typ)
+}
+
+func buildOncallScheduleJoins[Q dialect.Joinable](cols oncallScheduleColumns, typ string) oncallScheduleJoins[Q] {
+	return oncallScheduleJoins[Q]{
+		typ: typ,
+		ScheduleOncallOverrides: modAs[Q, oncallOverrideColumns]{
+			c: OncallOverrideColumns,
+			f: func(to oncallOverrideColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, OncallOverrides.Name().As(to.Alias())).On(
+						to.ScheduleID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
+
+// ScheduleOncallOverrides starts a query for related objects on oncall_overrides
+func (o *OncallSchedule) ScheduleOncallOverrides(mods ...bob.Mod[*dialect.SelectQuery]) OncallOverridesQuery {
+	return OncallOverrides.Query(append(mods,
+		sm.Where(OncallOverrideColumns.ScheduleID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os OncallScheduleSlice) ScheduleOncallOverrides(mods ...bob.Mod[*dialect.SelectQuery]) OncallOverridesQuery {
+	pkID := make(pgtypes.Array[uuid.UUID], len(os))
+	for i, o := range os {
+		pkID[i] = o.ID
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return OncallOverrides.Query(append(mods,
+		sm.Where(psql.Group(OncallOverrideColumns.ScheduleID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func (o *OncallSchedule) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "ScheduleOncallOverrides":
+		rels, ok := retrieved.(OncallOverrideSlice)
+		if !ok {
+			return fmt.Errorf("oncallSchedule cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ScheduleOncallOverrides = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ScheduleOncallSchedule = o
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("oncallSchedule has no relationship %q", name)
+	}
+}
+
+type oncallSchedulePreloader struct{}
+
+func buildOncallSchedulePreloader() oncallSchedulePreloader {
+	return oncallSchedulePreloader{}
+}
+
+type oncallScheduleThenLoader[Q orm.Loadable] struct {
+	ScheduleOncallOverrides func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildOncallScheduleThenLoader[Q orm.Loadable]() oncallScheduleThenLoader[Q] {
+	type ScheduleOncallOverridesLoadInterface interface {
+		LoadScheduleOncallOverrides(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return oncallScheduleThenLoader[Q]{
+		ScheduleOncallOverrides: thenLoadBuilder[Q](
+			"ScheduleOncallOverrides",
+			func(ctx context.Context, exec bob.Executor, retrieved ScheduleOncallOverridesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadScheduleOncallOverrides(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadScheduleOncallOverrides loads the oncallSchedule's ScheduleOncallOverrides into the .R struct
+func (o *OncallSchedule) LoadScheduleOncallOverrides(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ScheduleOncallOverrides = nil
+
+	related, err := o.ScheduleOncallOverrides(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ScheduleOncallSchedule = o
+	}
+
+	o.R.ScheduleOncallOverrides = related
+	return nil
+}
+
+// LoadScheduleOncallOverrides loads the oncallSchedule's ScheduleOncallOverrides into the .R struct
+func (os OncallScheduleSlice) LoadScheduleOncallOverrides(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	oncallOverrides, err := os.ScheduleOncallOverrides(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.ScheduleOncallOverrides = nil
+	}
+
+	for _, o := range os {
+		for _, rel := range oncallOverrides {
+			if o.ID != rel.ScheduleID {
+				continue
+			}
+
+			rel.R.ScheduleOncallSchedule = o
+
+			o.R.ScheduleOncallOverrides = append(o.R.ScheduleOncallOverrides, rel)
+		}
+	}
+
+	return nil
+}
+
+func insertOncallScheduleScheduleOncallOverrides0(ctx context.Context, exec bob.Executor, oncallOverrides1 []*OncallOverrideSetter, oncallSchedule0 *OncallSchedule) (OncallOverrideSlice, error) {
+	for i := range oncallOverrides1 {
+		oncallOverrides1[i].ScheduleID = &oncallSchedule0.ID
+	}
+
+	ret, err := OncallOverrides.Insert(bob.ToMods(oncallOverrides1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertOncallScheduleScheduleOncallOverrides0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachOncallScheduleScheduleOncallOverrides0(ctx context.Context, exec bob.Executor, count int, oncallOverrides1 OncallOverrideSlice, oncallSchedule0 *OncallSchedule) (OncallOverrideSlice, error) {
+	setter := &OncallOverrideSetter{
+		ScheduleID: &oncallSchedule0.ID,
+	}
+
+	err := oncallOverrides1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachOncallScheduleScheduleOncallOverrides0: %w", err)
+	}
+
+	return oncallOverrides1, nil
+}
+
+func (oncallSchedule0 *OncallSchedule) InsertScheduleOncallOverrides(ctx context.Context, exec bob.Executor, related ...*OncallOverrideSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	oncallOverrides1, err := insertOncallScheduleScheduleOncallOverrides0(ctx, exec, related, oncallSchedule0)
+	if err != nil {
+		return err
+	}
+
+	oncallSchedule0.R.ScheduleOncallOverrides = append(oncallSchedule0.R.ScheduleOncallOverrides, oncallOverrides1...)
+
+	for _, rel := range oncallOverrides1 {
+		rel.R.ScheduleOncallSchedule = oncallSchedule0
+	}
+	return nil
+}
+
+func (oncallSchedule0 *OncallSchedule) AttachScheduleOncallOverrides(ctx context.Context, exec bob.Executor, related ...*OncallOverride) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	oncallOverrides1 := OncallOverrideSlice(related)
+
+	_, err = attachOncallScheduleScheduleOncallOverrides0(ctx, exec, len(related), oncallOverrides1, oncallSchedule0)
+	if err != nil {
+		return err
+	}
+
+	oncallSchedule0.R.ScheduleOncallOverrides = append(oncallSchedule0.R.ScheduleOncallOverrides, oncallOverrides1...)
+
+	for _, rel := range related {
+		rel.R.ScheduleOncallSchedule = oncallSchedule0
+	}
+
+	return nil
+}
@@ -0,0 +1,527 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"time"
+
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+)
+
+// Service is an object representing the database table.
+type Service struct {
+	Name            string           `db:"name,pk" `
+	OwnerTeam       sql.Null[string] `db:"owner_team" `
+	OncallUsergroup sql.Null[string] `db:"oncall_usergroup" `
+	RunbookURL      sql.Null[string] `db:"runbook_url" `
+	SlackChannel    sql.Null[string] `db:"slack_channel" `
+	CreatedAt       time.Time        `db:"created_at" `
+	UpdatedAt       time.Time        `db:"updated_at" `
+}
+
+// ServiceSlice is an alias for a slice of pointers to Service.
+// This should almost always be used instead of []*Service.
+type ServiceSlice []*Service
+
+// Services contains methods to work with the services table
+var Services = psql.NewTablex[*Service, ServiceSlice, *ServiceSetter]("", "services")
+
+// ServicesQuery is a query on the services table
+type ServicesQuery = *psql.ViewQuery[*Service, ServiceSlice]
+
+type serviceColumnNames struct {
+	Name            string
+	OwnerTeam       string
+	OncallUsergroup string
+	RunbookURL      string
+	SlackChannel    string
+	CreatedAt       string
+	UpdatedAt       string
+}
+
+var ServiceColumns = buildServiceColumns("services")
+
+type serviceColumns struct {
+	tableAlias      string
+	Name            psql.Expression
+	OwnerTeam       psql.Expression
+	OncallUsergroup psql.Expression
+	RunbookURL      psql.Expression
+	SlackChannel    psql.Expression
+	CreatedAt       psql.Expression
+	UpdatedAt       psql.Expression
+}
+
+func (c serviceColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (serviceColumns) AliasedAs(alias string) serviceColumns {
+	return buildServiceColumns(alias)
+}
+
+func buildServiceColumns(alias string) serviceColumns {
+	return serviceColumns{
+		tableAlias:      alias,
+		Name:            psql.Quote(alias, "name"),
+		OwnerTeam:       psql.Quote(alias, "owner_team"),
+		OncallUsergroup: psql.Quote(alias, "oncall_usergroup"),
+		RunbookURL:      psql.Quote(alias, "runbook_url"),
+		SlackChannel:    psql.Quote(alias, "slack_channel"),
+		CreatedAt:       psql.Quote(alias, "created_at"),
+		UpdatedAt:       psql.Quote(alias, "updated_at"),
+	}
+}
+
+type serviceWhere[Q psql.Filterable] struct {
+	Name            psql.WhereMod[Q, string]
+	OwnerTeam       psql.WhereNullMod[Q, string]
+	OncallUsergroup psql.WhereNullMod[Q, string]
+	RunbookURL      psql.WhereNullMod[Q, string]
+	SlackChannel    psql.WhereNullMod[Q, string]
+	CreatedAt       psql.WhereMod[Q, time.Time]
+	UpdatedAt       psql.WhereMod[Q, time.Time]
+}
+
+func (serviceWhere[Q]) AliasedAs(alias string) serviceWhere[Q] {
+	return buildServiceWhere[Q](buildServiceColumns(alias))
+}
+
+func buildServiceWhere[Q psql.Filterable](cols serviceColumns) serviceWhere[Q] {
+	return serviceWhere[Q]{
+		Name:            psql.Where[Q, string](cols.Name),
+		OwnerTeam:       psql.WhereNull[Q, string](cols.OwnerTeam),
+		OncallUsergroup: psql.WhereNull[Q, string](cols.OncallUsergroup),
+		RunbookURL:      psql.WhereNull[Q, string](cols.RunbookURL),
+		SlackChannel:    psql.WhereNull[Q, string](cols.SlackChannel),
+		CreatedAt:       psql.Where[Q, time.Time](cols.CreatedAt),
+		UpdatedAt:       psql.Where[Q, time.Time](cols.UpdatedAt),
+	}
+}
+
+var ServiceErrors = &serviceErrors{
+	ErrUniqueServicesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "services",
+		columns: []string{"name"},
+		s:       "services_pkey",
+	},
+}
+
+type serviceErrors struct {
+	ErrUniqueServicesPkey *UniqueConstraintError
+}
+
+// ServiceSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type ServiceSetter struct {
+	Name            *string           `db:"name,pk" `
+	OwnerTeam       *sql.Null[string] `db:"owner_team" `
+	OncallUsergroup *sql.Null[string] `db:"oncall_usergroup" `
+	RunbookURL      *sql.Null[string] `db:"runbook_url" `
+	SlackChannel    *sql.Null[string] `db:"slack_channel" `
+	CreatedAt       *time.Time        `db:"created_at" `
+	UpdatedAt       *time.Time        `db:"updated_at" `
+}
+
+func (s ServiceSetter) SetColumns() []string {
+	vals := make([]string, 0, 7)
+	if s.Name != nil {
+		vals = append(vals, "name")
+	}
+
+	if s.OwnerTeam != nil {
+		vals = append(vals, "owner_team")
+	}
+
+	if s.OncallUsergroup != nil {
+		vals = append(vals, "oncall_usergroup")
+	}
+
+	if s.RunbookURL != nil {
+		vals = append(vals, "runbook_url")
+	}
+
+	if s.SlackChannel != nil {
+		vals = append(vals, "slack_channel")
+	}
+
+	if s.CreatedAt != nil {
+		vals = append(vals, "created_at")
+	}
+
+	if s.UpdatedAt != nil {
+		vals = append(vals, "updated_at")
+	}
+
+	return vals
+}
+
+func (s ServiceSetter) Overwrite(t *Service) {
+	if s.Name != nil {
+		t.Name = *s.Name
+	}
+	if s.OwnerTeam != nil {
+		t.OwnerTeam = *s.OwnerTeam
+	}
+	if s.OncallUsergroup != nil {
+		t.OncallUsergroup = *s.OncallUsergroup
+	}
+	if s.RunbookURL != nil {
+		t.RunbookURL = *s.RunbookURL
+	}
+	if s.SlackChannel != nil {
+		t.SlackChannel = *s.SlackChannel
+	}
+	if s.CreatedAt != nil {
+		t.CreatedAt = *s.CreatedAt
+	}
+	if s.UpdatedAt != nil {
+		t.UpdatedAt = *s.UpdatedAt
+	}
+}
+
+func (s *ServiceSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Services.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 7)
+		if s.Name != nil {
+			vals[0] = psql.Arg(*s.Name)
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.OwnerTeam != nil {
+			vals[1] = psql.Arg(*s.OwnerTeam)
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.OncallUsergroup != nil {
+			vals[2] = psql.Arg(*s.OncallUsergroup)
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.RunbookURL != nil {
+			vals[3] = psql.Arg(*s.RunbookURL)
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.SlackChannel != nil {
+			vals[4] = psql.Arg(*s.SlackChannel)
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.CreatedAt != nil {
+			vals[5] = psql.Arg(*s.CreatedAt)
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if s.UpdatedAt != nil {
+			vals[6] = psql.Arg(*s.UpdatedAt)
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s ServiceSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s ServiceSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 7)
+
+	if s.Name != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "name")...),
+			psql.Arg(s.Name),
+		}})
+	}
+
+	if s.OwnerTeam != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "owner_team")...),
+			psql.Arg(s.OwnerTeam),
+		}})
+	}
+
+	if s.OncallUsergroup != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "oncall_usergroup")...),
+			psql.Arg(s.OncallUsergroup),
+		}})
+	}
+
+	if s.RunbookURL != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "runbook_url")...),
+			psql.Arg(s.RunbookURL),
+		}})
+	}
+
+	if s.SlackChannel != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "slack_channel")...),
+			psql.Arg(s.SlackChannel),
+		}})
+	}
+
+	if s.CreatedAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	if s.UpdatedAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "updated_at")...),
+			psql.Arg(s.UpdatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindService retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindService(ctx context.Context, exec bob.Executor, NamePK string, cols ...string) (*Service, error) {
+	if len(cols) == 0 {
+		return Services.Query(
+			SelectWhere.Services.Name.EQ(NamePK),
+		).One(ctx, exec)
+	}
+
+	return Services.Query(
+		SelectWhere.Services.Name.EQ(NamePK),
+		sm.Columns(Services.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// ServiceExists checks the presence of a single record by primary key
+func ServiceExists(ctx context.Context, exec bob.Executor, NamePK string) (bool, error) {
+	return Services.Query(
+		SelectWhere.Services.Name.EQ(NamePK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Service is retrieved from the database
+func (o *Service) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Services.AfterSelectHooks.RunHooks(ctx, exec, ServiceSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Services.AfterInsertHooks.RunHooks(ctx, exec, ServiceSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Services.AfterUpdateHooks.RunHooks(ctx, exec, ServiceSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Services.AfterDeleteHooks.RunHooks(ctx, exec, ServiceSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Service
+func (o *Service) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.Name)
+}
+
+func (o *Service) pkEQ() dialect.Expression {
+	return psql.Quote("services", "name").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Service
+func (o *Service) Update(ctx context.Context, exec bob.Executor, s *ServiceSetter) error {
+	v, err := Services.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Service record with an executor
+func (o *Service) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Services.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Service using the executor
+func (o *Service) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Services.Query(
+		SelectWhere.Services.Name.EQ(o.Name),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after ServiceSlice is retrieved from the database
+func (o ServiceSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Services.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Services.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Services.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Services.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o ServiceSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("services", "name").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o ServiceSlice) copyMatchingRows(from ...*Service) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.Name != old.Name {
+				continue
+			}
+
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o ServiceSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Services.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Service:
+				o.copyMatchingRows(retrieved)
+			case []*Service:
+				o.copyMatchingRows(retrieved...)
+			case ServiceSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Service or a slice of Service
+				// then run the AfterUpdateHooks on the slice
+				_, err = Services.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o ServiceSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Services.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Service:
+				o.copyMatchingRows(retrieved)
+			case []*Service:
+				o.copyMatchingRows(retrieved...)
+			case ServiceSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Service or a slice of Service
+				// then run the AfterDeleteHooks on the slice
+				_, err = Services.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o ServiceSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals ServiceSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Services.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o ServiceSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Services.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o ServiceSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Services.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
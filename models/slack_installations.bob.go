@@ -0,0 +1,635 @@
+// Code generated by BobGen psql v0.38.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"time"
+
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+)
+
+// SlackInstallation is an object representing the database table.
+type SlackInstallation struct {
+	ID                  string           `db:"id,pk" `
+	TeamID              sql.Null[string] `db:"team_id" `
+	TeamName            sql.Null[string] `db:"team_name" `
+	EnterpriseID        sql.Null[string] `db:"enterprise_id" `
+	EnterpriseName      sql.Null[string] `db:"enterprise_name" `
+	IsEnterpriseInstall bool             `db:"is_enterprise_install" `
+	BotToken            string           `db:"bot_token" `
+	BotUserID           string           `db:"bot_user_id" `
+	InstalledBy         sql.Null[string] `db:"installed_by" `
+	InstalledAt         time.Time        `db:"installed_at" `
+	UpdatedAt           time.Time        `db:"updated_at" `
+}
+
+// SlackInstallationSlice is an alias for a slice of pointers to SlackInstallation.
+// This should almost always be used instead of []*SlackInstallation.
+type SlackInstallationSlice []*SlackInstallation
+
+// SlackInstallations contains methods to work with the slack_installations table
+var SlackInstallations = psql.NewTablex[*SlackInstallation, SlackInstallationSlice, *SlackInstallationSetter]("", "slack_installations")
+
+// SlackInstallationsQuery is a query on the slack_installations table
+type SlackInstallationsQuery = *psql.ViewQuery[*SlackInstallation, SlackInstallationSlice]
+
+type slackInstallationColumnNames struct {
+	ID                  string
+	TeamID              string
+	TeamName            string
+	EnterpriseID        string
+	EnterpriseName      string
+	IsEnterpriseInstall string
+	BotToken            string
+	BotUserID           string
+	InstalledBy         string
+	InstalledAt         string
+	UpdatedAt           string
+}
+
+var SlackInstallationColumns = buildSlackInstallationColumns("slack_installations")
+
+type slackInstallationColumns struct {
+	tableAlias          string
+	ID                  psql.Expression
+	TeamID              psql.Expression
+	TeamName            psql.Expression
+	EnterpriseID        psql.Expression
+	EnterpriseName      psql.Expression
+	IsEnterpriseInstall psql.Expression
+	BotToken            psql.Expression
+	BotUserID           psql.Expression
+	InstalledBy         psql.Expression
+	InstalledAt         psql.Expression
+	UpdatedAt           psql.Expression
+}
+
+func (c slackInstallationColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (slackInstallationColumns) AliasedAs(alias string) slackInstallationColumns {
+	return buildSlackInstallationColumns(alias)
+}
+
+func buildSlackInstallationColumns(alias string) slackInstallationColumns {
+	return slackInstallationColumns{
+		tableAlias:          alias,
+		ID:                  psql.Quote(alias, "id"),
+		TeamID:              psql.Quote(alias, "team_id"),
+		TeamName:            psql.Quote(alias, "team_name"),
+		EnterpriseID:        psql.Quote(alias, "enterprise_id"),
+		EnterpriseName:      psql.Quote(alias, "enterprise_name"),
+		IsEnterpriseInstall: psql.Quote(alias, "is_enterprise_install"),
+		BotToken:            psql.Quote(alias, "bot_token"),
+		BotUserID:           psql.Quote(alias, "bot_user_id"),
+		InstalledBy:         psql.Quote(alias, "installed_by"),
+		InstalledAt:         psql.Quote(alias, "installed_at"),
+		UpdatedAt:           psql.Quote(alias, "updated_at"),
+	}
+}
+
+type slackInstallationWhere[Q psql.Filterable] struct {
+	ID                  psql.WhereMod[Q, string]
+	TeamID              psql.WhereNullMod[Q, string]
+	TeamName            psql.WhereNullMod[Q, string]
+	EnterpriseID        psql.WhereNullMod[Q, string]
+	EnterpriseName      psql.WhereNullMod[Q, string]
+	IsEnterpriseInstall psql.WhereMod[Q, bool]
+	BotToken            psql.WhereMod[Q, string]
+	BotUserID           psql.WhereMod[Q, string]
+	InstalledBy         psql.WhereNullMod[Q, string]
+	InstalledAt         psql.WhereMod[Q, time.Time]
+	UpdatedAt           psql.WhereMod[Q, time.Time]
+}
+
+func (slackInstallationWhere[Q]) AliasedAs(alias string) slackInstallationWhere[Q] {
+	return buildSlackInstallationWhere[Q](buildSlackInstallationColumns(alias))
+}
+
+func buildSlackInstallationWhere[Q psql.Filterable](cols slackInstallationColumns) slackInstallationWhere[Q] {
+	return slackInstallationWhere[Q]{
+		ID:                  psql.Where[Q, string](cols.ID),
+		TeamID:              psql.WhereNull[Q, string](cols.TeamID),
+		TeamName:            psql.WhereNull[Q, string](cols.TeamName),
+		EnterpriseID:        psql.WhereNull[Q, string](cols.EnterpriseID),
+		EnterpriseName:      psql.WhereNull[Q, string](cols.EnterpriseName),
+		IsEnterpriseInstall: psql.Where[Q, bool](cols.IsEnterpriseInstall),
+		BotToken:            psql.Where[Q, string](cols.BotToken),
+		BotUserID:           psql.Where[Q, string](cols.BotUserID),
+		InstalledBy:         psql.WhereNull[Q, string](cols.InstalledBy),
+		InstalledAt:         psql.Where[Q, time.Time](cols.InstalledAt),
+		UpdatedAt:           psql.Where[Q, time.Time](cols.UpdatedAt),
+	}
+}
+
+var SlackInstallationErrors = &slackInstallationErrors{
+	ErrUniqueSlackInstallationsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "slack_installations",
+		columns: []string{"id"},
+		s:       "slack_installations_pkey",
+	},
+}
+
+type slackInstallationErrors struct {
+	ErrUniqueSlackInstallationsPkey *UniqueConstraintError
+}
+
+// SlackInstallationSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type SlackInstallationSetter struct {
+	ID                  *string           `db:"id,pk" `
+	TeamID              *sql.Null[string] `db:"team_id" `
+	TeamName            *sql.Null[string] `db:"team_name" `
+	EnterpriseID        *sql.Null[string] `db:"enterprise_id" `
+	EnterpriseName      *sql.Null[string] `db:"enterprise_name" `
+	IsEnterpriseInstall *bool             `db:"is_enterprise_install" `
+	BotToken            *string           `db:"bot_token" `
+	BotUserID           *string           `db:"bot_user_id" `
+	InstalledBy         *sql.Null[string] `db:"installed_by" `
+	InstalledAt         *time.Time        `db:"installed_at" `
+	UpdatedAt           *time.Time        `db:"updated_at" `
+}
+
+func (s SlackInstallationSetter) SetColumns() []string {
+	vals := make([]string, 0, 11)
+	if s.ID != nil {
+		vals = append(vals, "id")
+	}
+
+	if s.TeamID != nil {
+		vals = append(vals, "team_id")
+	}
+
+	if s.TeamName != nil {
+		vals = append(vals, "team_name")
+	}
+
+	if s.EnterpriseID != nil {
+		vals = append(vals, "enterprise_id")
+	}
+
+	if s.EnterpriseName != nil {
+		vals = append(vals, "enterprise_name")
+	}
+
+	if s.IsEnterpriseInstall != nil {
+		vals = append(vals, "is_enterprise_install")
+	}
+
+	if s.BotToken != nil {
+		vals = append(vals, "bot_token")
+	}
+
+	if s.BotUserID != nil {
+		vals = append(vals, "bot_user_id")
+	}
+
+	if s.InstalledBy != nil {
+		vals = append(vals, "installed_by")
+	}
+
+	if s.InstalledAt != nil {
+		vals = append(vals, "installed_at")
+	}
+
+	if s.UpdatedAt != nil {
+		vals = append(vals, "updated_at")
+	}
+
+	return vals
+}
+
+func (s SlackInstallationSetter) Overwrite(t *SlackInstallation) {
+	if s.ID != nil {
+		t.ID = *s.ID
+	}
+	if s.TeamID != nil {
+		t.TeamID = *s.TeamID
+	}
+	if s.TeamName != nil {
+		t.TeamName = *s.TeamName
+	}
+	if s.EnterpriseID != nil {
+		t.EnterpriseID = *s.EnterpriseID
+	}
+	if s.EnterpriseName != nil {
+		t.EnterpriseName = *s.EnterpriseName
+	}
+	if s.IsEnterpriseInstall != nil {
+		t.IsEnterpriseInstall = *s.IsEnterpriseInstall
+	}
+	if s.BotToken != nil {
+		t.BotToken = *s.BotToken
+	}
+	if s.BotUserID != nil {
+		t.BotUserID = *s.BotUserID
+	}
+	if s.InstalledBy != nil {
+		t.InstalledBy = *s.InstalledBy
+	}
+	if s.InstalledAt != nil {
+		t.InstalledAt = *s.InstalledAt
+	}
+	if s.UpdatedAt != nil {
+		t.UpdatedAt = *s.UpdatedAt
+	}
+}
+
+func (s *SlackInstallationSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return SlackInstallations.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 11)
+		if s.ID != nil {
+			vals[0] = psql.Arg(*s.ID)
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.TeamID != nil {
+			vals[1] = psql.Arg(*s.TeamID)
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.TeamName != nil {
+			vals[2] = psql.Arg(*s.TeamName)
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.EnterpriseID != nil {
+			vals[3] = psql.Arg(*s.EnterpriseID)
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if s.EnterpriseName != nil {
+			vals[4] = psql.Arg(*s.EnterpriseName)
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.IsEnterpriseInstall != nil {
+			vals[5] = psql.Arg(*s.IsEnterpriseInstall)
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if s.BotToken != nil {
+			vals[6] = psql.Arg(*s.BotToken)
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		if s.BotUserID != nil {
+			vals[7] = psql.Arg(*s.BotUserID)
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		if s.InstalledBy != nil {
+			vals[8] = psql.Arg(*s.InstalledBy)
+		} else {
+			vals[8] = psql.Raw("DEFAULT")
+		}
+
+		if s.InstalledAt != nil {
+			vals[9] = psql.Arg(*s.InstalledAt)
+		} else {
+			vals[9] = psql.Raw("DEFAULT")
+		}
+
+		if s.UpdatedAt != nil {
+			vals[10] = psql.Arg(*s.UpdatedAt)
+		} else {
+			vals[10] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s SlackInstallationSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s SlackInstallationSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 11)
+
+	if s.ID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.TeamID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "team_id")...),
+			psql.Arg(s.TeamID),
+		}})
+	}
+
+	if s.TeamName != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "team_name")...),
+			psql.Arg(s.TeamName),
+		}})
+	}
+
+	if s.EnterpriseID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "enterprise_id")...),
+			psql.Arg(s.EnterpriseID),
+		}})
+	}
+
+	if s.EnterpriseName != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "enterprise_name")...),
+			psql.Arg(s.EnterpriseName),
+		}})
+	}
+
+	if s.IsEnterpriseInstall != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "is_enterprise_install")...),
+			psql.Arg(s.IsEnterpriseInstall),
+		}})
+	}
+
+	if s.BotToken != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bot_token")...),
+			psql.Arg(s.BotToken),
+		}})
+	}
+
+	if s.BotUserID != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "bot_user_id")...),
+			psql.Arg(s.BotUserID),
+		}})
+	}
+
+	if s.InstalledBy != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "installed_by")...),
+			psql.Arg(s.InstalledBy),
+		}})
+	}
+
+	if s.InstalledAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "installed_at")...),
+			psql.Arg(s.InstalledAt),
+		}})
+	}
+
+	if s.UpdatedAt != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "updated_at")...),
+			psql.Arg(s.UpdatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindSlackInstallation retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindSlackInstallation(ctx context.Context, exec bob.Executor, IDPK string, cols ...string) (*SlackInstallation, error) {
+	if len(cols) == 0 {
+		return SlackInstallations.Query(
+			SelectWhere.SlackInstallations.ID.EQ(IDPK),
+		).One(ctx, exec)
+	}
+
+	return SlackInstallations.Query(
+		SelectWhere.SlackInstallations.ID.EQ(IDPK),
+		sm.Columns(SlackInstallations.Columns().Only(cols...)),
+	).One(ctx, exec)
+}
+
+// SlackInstallationExists checks the presence of a single record by primary key
+func SlackInstallationExists(ctx context.Context, exec bob.Executor, IDPK string) (bool, error) {
+	return SlackInstallations.Query(
+		SelectWhere.SlackInstallations.ID.EQ(IDPK),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after SlackInstallation is retrieved from the database
+func (o *SlackInstallation) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = SlackInstallations.AfterSelectHooks.RunHooks(ctx, exec, SlackInstallationSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = SlackInstallations.AfterInsertHooks.RunHooks(ctx, exec, SlackInstallationSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = SlackInstallations.AfterUpdateHooks.RunHooks(ctx, exec, SlackInstallationSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = SlackInstallations.AfterDeleteHooks.RunHooks(ctx, exec, SlackInstallationSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the SlackInstallation
+func (o *SlackInstallation) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *SlackInstallation) pkEQ() dialect.Expression {
+	return psql.Quote("slack_installations", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the SlackInstallation
+func (o *SlackInstallation) Update(ctx context.Context, exec bob.Executor, s *SlackInstallationSetter) error {
+	v, err := SlackInstallations.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single SlackInstallation record with an executor
+func (o *SlackInstallation) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := SlackInstallations.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the SlackInstallation using the executor
+func (o *SlackInstallation) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := SlackInstallations.Query(
+		SelectWhere.SlackInstallations.ID.EQ(o.ID),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after SlackInstallationSlice is retrieved from the database
+func (o SlackInstallationSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = SlackInstallations.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = SlackInstallations.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = SlackInstallations.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = SlackInstallations.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o SlackInstallationSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("slack_installations", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o SlackInstallationSlice) copyMatchingRows(from ...*SlackInstallation) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o SlackInstallationSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return SlackInstallations.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *SlackInstallation:
+				o.copyMatchingRows(retrieved)
+			case []*SlackInstallation:
+				o.copyMatchingRows(retrieved...)
+			case SlackInstallationSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a SlackInstallation or a slice of SlackInstallation
+				// then run the AfterUpdateHooks on the slice
+				_, err = SlackInstallations.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o SlackInstallationSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return SlackInstallations.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *SlackInstallation:
+				o.copyMatchingRows(retrieved)
+			case []*SlackInstallation:
+				o.copyMatchingRows(retrieved...)
+			case SlackInstallationSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a SlackInstallation or a slice of SlackInstallation
+				// then run the AfterDeleteHooks on the slice
+				_, err = SlackInstallations.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o SlackInstallationSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals SlackInstallationSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := SlackInstallations.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o SlackInstallationSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := SlackInstallations.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o SlackInstallationSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := SlackInstallations.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
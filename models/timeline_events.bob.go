@@ -27,13 +27,16 @@ import (
 
 // TimelineEvent is an object representing the database table.
 type TimelineEvent struct {
-	ID             uuid.UUID                             `db:"id,pk" `
-	IncidentID     uuid.UUID                             `db:"incident_id" `
-	Timestamp      sql.Null[time.Time]                   `db:"timestamp" `
-	EventType      string                                `db:"event_type" `
-	SlackUserID    string                                `db:"slack_user_id" `
-	SlackMessageTS sql.Null[string]                      `db:"slack_message_ts" `
-	Metadata       sql.Null[types.JSON[json.RawMessage]] `db:"metadata" `
+	ID             uuid.UUID                   `db:"id,pk" `
+	IncidentID     uuid.UUID                   `db:"incident_id" `
+	Timestamp      time.Time                   `db:"timestamp" `
+	EventType      string                      `db:"event_type" `
+	SlackUserID    string                      `db:"slack_user_id" `
+	SlackMessageTS sql.Null[string]            `db:"slack_message_ts" `
+	Metadata       types.JSON[json.RawMessage] `db:"metadata" `
+	EntryKey       string                      `db:"entry_key" `
+	SlackUsername  string                      `db:"slack_username" `
+	Content        string                      `db:"content" `
 
 	R timelineEventR `db:"-" `
 }
@@ -61,6 +64,9 @@ type timelineEventColumnNames struct {
 	SlackUserID    string
 	SlackMessageTS string
 	Metadata       string
+	EntryKey       string
+	SlackUsername  string
+	Content        string
 }
 
 var TimelineEventColumns = buildTimelineEventColumns("timeline_events")
@@ -74,6 +80,9 @@ type timelineEventColumns struct {
 	SlackUserID    psql.Expression
 	SlackMessageTS psql.Expression
 	Metadata       psql.Expression
+	EntryKey       psql.Expression
+	SlackUsername  psql.Expression
+	Content        psql.Expression
 }
 
 func (c timelineEventColumns) Alias() string {
@@ -94,17 +103,23 @@ func buildTimelineEventColumns(alias string) timelineEventColumns {
 		SlackUserID:    psql.Quote(alias, "slack_user_id"),
 		SlackMessageTS: psql.Quote(alias, "slack_message_ts"),
 		Metadata:       psql.Quote(alias, "metadata"),
+		EntryKey:       psql.Quote(alias, "entry_key"),
+		SlackUsername:  psql.Quote(alias, "slack_username"),
+		Content:        psql.Quote(alias, "content"),
 	}
 }
 
 type timelineEventWhere[Q psql.Filterable] struct {
 	ID             psql.WhereMod[Q, uuid.UUID]
 	IncidentID     psql.WhereMod[Q, uuid.UUID]
-	Timestamp      psql.WhereNullMod[Q, time.Time]
+	Timestamp      psql.WhereMod[Q, time.Time]
 	EventType      psql.WhereMod[Q, string]
 	SlackUserID    psql.WhereMod[Q, string]
 	SlackMessageTS psql.WhereNullMod[Q, string]
-	Metadata       psql.WhereNullMod[Q, types.JSON[json.RawMessage]]
+	Metadata       psql.WhereMod[Q, types.JSON[json.RawMessage]]
+	EntryKey       psql.WhereMod[Q, string]
+	SlackUsername  psql.WhereMod[Q, string]
+	Content        psql.WhereMod[Q, string]
 }
 
 func (timelineEventWhere[Q]) AliasedAs(alias string) timelineEventWhere[Q] {
@@ -115,11 +130,14 @@ func buildTimelineEventWhere[Q psql.Filterable](cols timelineEventColumns) timel
 	return timelineEventWhere[Q]{
 		ID:             psql.Where[Q, uuid.UUID](cols.ID),
 		IncidentID:     psql.Where[Q, uuid.UUID](cols.IncidentID),
-		Timestamp:      psql.WhereNull[Q, time.Time](cols.Timestamp),
+		Timestamp:      psql.Where[Q, time.Time](cols.Timestamp),
 		EventType:      psql.Where[Q, string](cols.EventType),
 		SlackUserID:    psql.Where[Q, string](cols.SlackUserID),
 		SlackMessageTS: psql.WhereNull[Q, string](cols.SlackMessageTS),
-		Metadata:       psql.WhereNull[Q, types.JSON[json.RawMessage]](cols.Metadata),
+		Metadata:       psql.Where[Q, types.JSON[json.RawMessage]](cols.Metadata),
+		EntryKey:       psql.Where[Q, string](cols.EntryKey),
+		SlackUsername:  psql.Where[Q, string](cols.SlackUsername),
+		Content:        psql.Where[Q, string](cols.Content),
 	}
 }
 
@@ -140,17 +158,20 @@ type timelineEventErrors struct {
 // All values are optional, and do not have to be set
 // Generated columns are not included
 type TimelineEventSetter struct {
-	ID             *uuid.UUID                             `db:"id,pk" `
-	IncidentID     *uuid.UUID                             `db:"incident_id" `
-	Timestamp      *sql.Null[time.Time]                   `db:"timestamp" `
-	EventType      *string                                `db:"event_type" `
-	SlackUserID    *string                                `db:"slack_user_id" `
-	SlackMessageTS *sql.Null[string]                      `db:"slack_message_ts" `
-	Metadata       *sql.Null[types.JSON[json.RawMessage]] `db:"metadata" `
+	ID             *uuid.UUID                   `db:"id,pk" `
+	IncidentID     *uuid.UUID                   `db:"incident_id" `
+	Timestamp      *time.Time                   `db:"timestamp" `
+	EventType      *string                      `db:"event_type" `
+	SlackUserID    *string                      `db:"slack_user_id" `
+	SlackMessageTS *sql.Null[string]            `db:"slack_message_ts" `
+	Metadata       *types.JSON[json.RawMessage] `db:"metadata" `
+	EntryKey       *string                      `db:"entry_key" `
+	SlackUsername  *string                      `db:"slack_username" `
+	Content        *string                      `db:"content" `
 }
 
 func (s TimelineEventSetter) SetColumns() []string {
-	vals := make([]string, 0, 7)
+	vals := make([]string, 0, 10)
 	if s.ID != nil {
 		vals = append(vals, "id")
 	}
@@ -179,6 +200,18 @@ func (s TimelineEventSetter) SetColumns() []string {
 		vals = append(vals, "metadata")
 	}
 
+	if s.EntryKey != nil {
+		vals = append(vals, "entry_key")
+	}
+
+	if s.SlackUsername != nil {
+		vals = append(vals, "slack_username")
+	}
+
+	if s.Content != nil {
+		vals = append(vals, "content")
+	}
+
 	return vals
 }
 
@@ -204,6 +237,15 @@ func (s TimelineEventSetter) Overwrite(t *TimelineEvent) {
 	if s.Metadata != nil {
 		t.Metadata = *s.Metadata
 	}
+	if s.EntryKey != nil {
+		t.EntryKey = *s.EntryKey
+	}
+	if s.SlackUsername != nil {
+		t.SlackUsername = *s.SlackUsername
+	}
+	if s.Content != nil {
+		t.Content = *s.Content
+	}
 }
 
 func (s *TimelineEventSetter) Apply(q *dialect.InsertQuery) {
@@ -212,7 +254,7 @@ func (s *TimelineEventSetter) Apply(q *dialect.InsertQuery) {
 	})
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.Writer, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 7)
+		vals := make([]bob.Expression, 10)
 		if s.ID != nil {
 			vals[0] = psql.Arg(*s.ID)
 		} else {
@@ -255,6 +297,24 @@ func (s *TimelineEventSetter) Apply(q *dialect.InsertQuery) {
 			vals[6] = psql.Raw("DEFAULT")
 		}
 
+		if s.EntryKey != nil {
+			vals[7] = psql.Arg(*s.EntryKey)
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		if s.SlackUsername != nil {
+			vals[8] = psql.Arg(*s.SlackUsername)
+		} else {
+			vals[8] = psql.Raw("DEFAULT")
+		}
+
+		if s.Content != nil {
+			vals[9] = psql.Arg(*s.Content)
+		} else {
+			vals[9] = psql.Raw("DEFAULT")
+		}
+
 		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
 	}))
 }
@@ -264,7 +324,7 @@ func (s TimelineEventSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s TimelineEventSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 7)
+	exprs := make([]bob.Expression, 0, 10)
 
 	if s.ID != nil {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -315,6 +375,27 @@ func (s TimelineEventSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if s.EntryKey != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "entry_key")...),
+			psql.Arg(s.EntryKey),
+		}})
+	}
+
+	if s.SlackUsername != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "slack_username")...),
+			psql.Arg(s.SlackUsername),
+		}})
+	}
+
+	if s.Content != nil {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "content")...),
+			psql.Arg(s.Content),
+		}})
+	}
+
 	return exprs
 }
 